@@ -1,5 +1,5 @@
 // Code generated by dynamic-ssz. DO NOT EDIT.
-// Hash: 4f141122dd612c85ab826f4352a71264416d4dd59893f358df14031d70a3059c
+// Hash: 2bc543c5b2d4da266be20bdd2330c3ec9426b962579f68ccba3120923d895959
 // Version: v1.3.2 (https://github.com/pk910/dynamic-ssz)
 package dynamicssz
 
@@ -18,6 +18,15 @@ var _ = sszutils.ErrListTooBig
 var _ = sszutils.Annotate[SignedBeaconBlock](`ssz-static:"false"`)
 var _ = sszutils.Annotate[BeaconBlock](`ssz-static:"false"`)
 var _ = sszutils.Annotate[BeaconState](`ssz-static:"false"`)
+var _ = sszutils.Annotate[BlobSidecar](`ssz-static:"true"`)
+var _ = sszutils.Annotate[LightClientHeader](`ssz-static:"false"`)
+var _ = sszutils.Annotate[LightClientBootstrap](`ssz-static:"false"`)
+var _ = sszutils.Annotate[LightClientUpdate](`ssz-static:"false"`)
+var _ = sszutils.Annotate[LightClientFinalityUpdate](`ssz-static:"false"`)
+var _ = sszutils.Annotate[LightClientOptimisticUpdate](`ssz-static:"false"`)
+var _ = sszutils.Annotate[SignedBeaconBlockElectra](`ssz-static:"false"`)
+var _ = sszutils.Annotate[BeaconBlockElectra](`ssz-static:"false"`)
+var _ = sszutils.Annotate[BeaconStateElectra](`ssz-static:"false"`)
 
 // MarshalSSZ marshals the *SignedBeaconBlock to SSZ-encoded bytes.
 func (t *SignedBeaconBlock) MarshalSSZ() ([]byte, error) {
@@ -307,10 +316,10 @@ func (t *BeaconBlock) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst [
 	}
 	dstlen := len(dst)
 	{ // Static Field #0 'Slot'
-		dst = binary.LittleEndian.AppendUint64(dst, t.Slot)
+		dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Slot))
 	}
 	{ // Static Field #1 'ProposerIndex'
-		dst = binary.LittleEndian.AppendUint64(dst, t.ProposerIndex)
+		dst = binary.LittleEndian.AppendUint64(dst, uint64(t.ProposerIndex))
 	}
 	{ // Static Field #2 'ParentRoot'
 		dst = append(dst, t.ParentRoot[:32]...)
@@ -401,10 +410,10 @@ func (t *BeaconBlock) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst [
 							t = new(BeaconBlockHeader)
 						}
 						{ // Static Field #0 'Slot'
-							dst = binary.LittleEndian.AppendUint64(dst, t.Slot)
+							dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Slot))
 						}
 						{ // Static Field #1 'ProposerIndex'
-							dst = binary.LittleEndian.AppendUint64(dst, t.ProposerIndex)
+							dst = binary.LittleEndian.AppendUint64(dst, uint64(t.ProposerIndex))
 						}
 						{ // Static Field #2 'ParentRoot'
 							dst = append(dst, t.ParentRoot[:32]...)
@@ -431,10 +440,10 @@ func (t *BeaconBlock) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst [
 							t = new(BeaconBlockHeader)
 						}
 						{ // Static Field #0 'Slot'
-							dst = binary.LittleEndian.AppendUint64(dst, t.Slot)
+							dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Slot))
 						}
 						{ // Static Field #1 'ProposerIndex'
-							dst = binary.LittleEndian.AppendUint64(dst, t.ProposerIndex)
+							dst = binary.LittleEndian.AppendUint64(dst, uint64(t.ProposerIndex))
 						}
 						{ // Static Field #2 'ParentRoot'
 							dst = append(dst, t.ParentRoot[:32]...)
@@ -486,7 +495,7 @@ func (t *BeaconBlock) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst [
 							t = new(AttestationData)
 						}
 						{ // Static Field #0 'Slot'
-							dst = binary.LittleEndian.AppendUint64(dst, t.Slot)
+							dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Slot))
 						}
 						{ // Static Field #1 'Index'
 							dst = binary.LittleEndian.AppendUint64(dst, t.Index)
@@ -500,7 +509,7 @@ func (t *BeaconBlock) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst [
 								t = new(Checkpoint)
 							}
 							{ // Static Field #0 'Epoch'
-								dst = binary.LittleEndian.AppendUint64(dst, t.Epoch)
+								dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Epoch))
 							}
 							{ // Static Field #1 'Root'
 								dst = append(dst, t.Root[:32]...)
@@ -512,7 +521,7 @@ func (t *BeaconBlock) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst [
 								t = new(Checkpoint)
 							}
 							{ // Static Field #0 'Epoch'
-								dst = binary.LittleEndian.AppendUint64(dst, t.Epoch)
+								dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Epoch))
 							}
 							{ // Static Field #1 'Root'
 								dst = append(dst, t.Root[:32]...)
@@ -547,7 +556,7 @@ func (t *BeaconBlock) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst [
 							t = new(AttestationData)
 						}
 						{ // Static Field #0 'Slot'
-							dst = binary.LittleEndian.AppendUint64(dst, t.Slot)
+							dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Slot))
 						}
 						{ // Static Field #1 'Index'
 							dst = binary.LittleEndian.AppendUint64(dst, t.Index)
@@ -561,7 +570,7 @@ func (t *BeaconBlock) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst [
 								t = new(Checkpoint)
 							}
 							{ // Static Field #0 'Epoch'
-								dst = binary.LittleEndian.AppendUint64(dst, t.Epoch)
+								dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Epoch))
 							}
 							{ // Static Field #1 'Root'
 								dst = append(dst, t.Root[:32]...)
@@ -573,7 +582,7 @@ func (t *BeaconBlock) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst [
 								t = new(Checkpoint)
 							}
 							{ // Static Field #0 'Epoch'
-								dst = binary.LittleEndian.AppendUint64(dst, t.Epoch)
+								dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Epoch))
 							}
 							{ // Static Field #1 'Root'
 								dst = append(dst, t.Root[:32]...)
@@ -619,7 +628,7 @@ func (t *BeaconBlock) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst [
 						t = new(AttestationData)
 					}
 					{ // Static Field #0 'Slot'
-						dst = binary.LittleEndian.AppendUint64(dst, t.Slot)
+						dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Slot))
 					}
 					{ // Static Field #1 'Index'
 						dst = binary.LittleEndian.AppendUint64(dst, t.Index)
@@ -633,7 +642,7 @@ func (t *BeaconBlock) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst [
 							t = new(Checkpoint)
 						}
 						{ // Static Field #0 'Epoch'
-							dst = binary.LittleEndian.AppendUint64(dst, t.Epoch)
+							dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Epoch))
 						}
 						{ // Static Field #1 'Root'
 							dst = append(dst, t.Root[:32]...)
@@ -645,7 +654,7 @@ func (t *BeaconBlock) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst [
 							t = new(Checkpoint)
 						}
 						{ // Static Field #0 'Epoch'
-							dst = binary.LittleEndian.AppendUint64(dst, t.Epoch)
+							dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Epoch))
 						}
 						{ // Static Field #1 'Root'
 							dst = append(dst, t.Root[:32]...)
@@ -719,7 +728,7 @@ func (t *BeaconBlock) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst [
 						dst = append(dst, t.WithdrawalCredentials[:32]...)
 					}
 					{ // Static Field #2 'Amount'
-						dst = binary.LittleEndian.AppendUint64(dst, t.Amount)
+						dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Amount))
 					}
 					{ // Static Field #3 'Signature'
 						dst = append(dst, t.Signature[:96]...)
@@ -745,10 +754,10 @@ func (t *BeaconBlock) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst [
 						t = new(VoluntaryExit)
 					}
 					{ // Static Field #0 'Epoch'
-						dst = binary.LittleEndian.AppendUint64(dst, t.Epoch)
+						dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Epoch))
 					}
 					{ // Static Field #1 'ValidatorIndex'
-						dst = binary.LittleEndian.AppendUint64(dst, t.ValidatorIndex)
+						dst = binary.LittleEndian.AppendUint64(dst, uint64(t.ValidatorIndex))
 					}
 				}
 				{ // Static Field #1 'Signature'
@@ -849,16 +858,16 @@ func (t *BeaconBlock) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst [
 						t = new(Withdrawal)
 					}
 					{ // Static Field #0 'Index'
-						dst = binary.LittleEndian.AppendUint64(dst, t.Index)
+						dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Index))
 					}
 					{ // Static Field #1 'ValidatorIndex'
-						dst = binary.LittleEndian.AppendUint64(dst, t.ValidatorIndex)
+						dst = binary.LittleEndian.AppendUint64(dst, uint64(t.ValidatorIndex))
 					}
 					{ // Static Field #2 'Address'
 						dst = append(dst, t.Address[:20]...)
 					}
 					{ // Static Field #3 'Amount'
-						dst = binary.LittleEndian.AppendUint64(dst, t.Amount)
+						dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Amount))
 					}
 				}
 			}
@@ -881,7 +890,7 @@ func (t *BeaconBlock) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst [
 						t = new(BLSToExecutionChange)
 					}
 					{ // Static Field #0 'ValidatorIndex'
-						dst = binary.LittleEndian.AppendUint64(dst, t.ValidatorIndex)
+						dst = binary.LittleEndian.AppendUint64(dst, uint64(t.ValidatorIndex))
 					}
 					{ // Static Field #1 'FromBLSPubkey'
 						dst = append(dst, t.FromBLSPubkey[:48]...)
@@ -930,7 +939,7 @@ func (t *BeaconBlock) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 		sizeFn6  func(ctx *encoderCtx, t []*SignedVoluntaryExit) (size int)
 		sizeFn7  func(ctx *encoderCtx, t *ExecutionPayload) (size int)
 		sizeFn8  func(ctx *encoderCtx, t []*SignedBLSToExecutionChange) (size int)
-		sizeFn9  func(ctx *encoderCtx, t [][48]byte) (size int)
+		sizeFn9  func(ctx *encoderCtx, t []KZGCommitment) (size int)
 	}
 	ctx := &encoderCtx{ds: ds}
 	canSeek := enc.Seekable()
@@ -1108,8 +1117,8 @@ func (t *BeaconBlock) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 		size += len(t) * 172
 		return size
 	}
-	// size for [][48]byte
-	ctx.sizeFn9 = func(ctx *encoderCtx, t [][48]byte) (size int) {
+	// size for []KZGCommitment
+	ctx.sizeFn9 = func(ctx *encoderCtx, t []KZGCommitment) (size int) {
 		size += len(t) * 48
 		return size
 	}
@@ -1203,10 +1212,10 @@ func (t *BeaconBlock) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 	dstlen := enc.GetPosition()
 	dynoff := uint32(84)
 	{ // Field #0 'Slot'
-		enc.EncodeUint64(t.Slot)
+		enc.EncodeUint64(uint64(t.Slot))
 	}
 	{ // Field #1 'ProposerIndex'
-		enc.EncodeUint64(t.ProposerIndex)
+		enc.EncodeUint64(uint64(t.ProposerIndex))
 	}
 	{ // Field #2 'ParentRoot'
 		enc.EncodeBytes(t.ParentRoot[:32])
@@ -1361,10 +1370,10 @@ func (t *BeaconBlock) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 							t = new(BeaconBlockHeader)
 						}
 						{ // Field #0 'Slot'
-							enc.EncodeUint64(t.Slot)
+							enc.EncodeUint64(uint64(t.Slot))
 						}
 						{ // Field #1 'ProposerIndex'
-							enc.EncodeUint64(t.ProposerIndex)
+							enc.EncodeUint64(uint64(t.ProposerIndex))
 						}
 						{ // Field #2 'ParentRoot'
 							enc.EncodeBytes(t.ParentRoot[:32])
@@ -1391,10 +1400,10 @@ func (t *BeaconBlock) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 							t = new(BeaconBlockHeader)
 						}
 						{ // Field #0 'Slot'
-							enc.EncodeUint64(t.Slot)
+							enc.EncodeUint64(uint64(t.Slot))
 						}
 						{ // Field #1 'ProposerIndex'
-							enc.EncodeUint64(t.ProposerIndex)
+							enc.EncodeUint64(uint64(t.ProposerIndex))
 						}
 						{ // Field #2 'ParentRoot'
 							enc.EncodeBytes(t.ParentRoot[:32])
@@ -1482,7 +1491,7 @@ func (t *BeaconBlock) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 							t = new(AttestationData)
 						}
 						{ // Field #0 'Slot'
-							enc.EncodeUint64(t.Slot)
+							enc.EncodeUint64(uint64(t.Slot))
 						}
 						{ // Field #1 'Index'
 							enc.EncodeUint64(t.Index)
@@ -1496,7 +1505,7 @@ func (t *BeaconBlock) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 								t = new(Checkpoint)
 							}
 							{ // Field #0 'Epoch'
-								enc.EncodeUint64(t.Epoch)
+								enc.EncodeUint64(uint64(t.Epoch))
 							}
 							{ // Field #1 'Root'
 								enc.EncodeBytes(t.Root[:32])
@@ -1508,7 +1517,7 @@ func (t *BeaconBlock) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 								t = new(Checkpoint)
 							}
 							{ // Field #0 'Epoch'
-								enc.EncodeUint64(t.Epoch)
+								enc.EncodeUint64(uint64(t.Epoch))
 							}
 							{ // Field #1 'Root'
 								enc.EncodeBytes(t.Root[:32])
@@ -1554,7 +1563,7 @@ func (t *BeaconBlock) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 							t = new(AttestationData)
 						}
 						{ // Field #0 'Slot'
-							enc.EncodeUint64(t.Slot)
+							enc.EncodeUint64(uint64(t.Slot))
 						}
 						{ // Field #1 'Index'
 							enc.EncodeUint64(t.Index)
@@ -1568,7 +1577,7 @@ func (t *BeaconBlock) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 								t = new(Checkpoint)
 							}
 							{ // Field #0 'Epoch'
-								enc.EncodeUint64(t.Epoch)
+								enc.EncodeUint64(uint64(t.Epoch))
 							}
 							{ // Field #1 'Root'
 								enc.EncodeBytes(t.Root[:32])
@@ -1580,7 +1589,7 @@ func (t *BeaconBlock) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 								t = new(Checkpoint)
 							}
 							{ // Field #0 'Epoch'
-								enc.EncodeUint64(t.Epoch)
+								enc.EncodeUint64(uint64(t.Epoch))
 							}
 							{ // Field #1 'Root'
 								enc.EncodeBytes(t.Root[:32])
@@ -1648,7 +1657,7 @@ func (t *BeaconBlock) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 						t = new(AttestationData)
 					}
 					{ // Field #0 'Slot'
-						enc.EncodeUint64(t.Slot)
+						enc.EncodeUint64(uint64(t.Slot))
 					}
 					{ // Field #1 'Index'
 						enc.EncodeUint64(t.Index)
@@ -1662,7 +1671,7 @@ func (t *BeaconBlock) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 							t = new(Checkpoint)
 						}
 						{ // Field #0 'Epoch'
-							enc.EncodeUint64(t.Epoch)
+							enc.EncodeUint64(uint64(t.Epoch))
 						}
 						{ // Field #1 'Root'
 							enc.EncodeBytes(t.Root[:32])
@@ -1674,7 +1683,7 @@ func (t *BeaconBlock) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 							t = new(Checkpoint)
 						}
 						{ // Field #0 'Epoch'
-							enc.EncodeUint64(t.Epoch)
+							enc.EncodeUint64(uint64(t.Epoch))
 						}
 						{ // Field #1 'Root'
 							enc.EncodeBytes(t.Root[:32])
@@ -1752,7 +1761,7 @@ func (t *BeaconBlock) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 						enc.EncodeBytes(t.WithdrawalCredentials[:32])
 					}
 					{ // Field #2 'Amount'
-						enc.EncodeUint64(t.Amount)
+						enc.EncodeUint64(uint64(t.Amount))
 					}
 					{ // Field #3 'Signature'
 						enc.EncodeBytes(t.Signature[:96])
@@ -1780,10 +1789,10 @@ func (t *BeaconBlock) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 						t = new(VoluntaryExit)
 					}
 					{ // Field #0 'Epoch'
-						enc.EncodeUint64(t.Epoch)
+						enc.EncodeUint64(uint64(t.Epoch))
 					}
 					{ // Field #1 'ValidatorIndex'
-						enc.EncodeUint64(t.ValidatorIndex)
+						enc.EncodeUint64(uint64(t.ValidatorIndex))
 					}
 				}
 				{ // Field #1 'Signature'
@@ -1923,16 +1932,16 @@ func (t *BeaconBlock) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 						t = new(Withdrawal)
 					}
 					{ // Field #0 'Index'
-						enc.EncodeUint64(t.Index)
+						enc.EncodeUint64(uint64(t.Index))
 					}
 					{ // Field #1 'ValidatorIndex'
-						enc.EncodeUint64(t.ValidatorIndex)
+						enc.EncodeUint64(uint64(t.ValidatorIndex))
 					}
 					{ // Field #2 'Address'
 						enc.EncodeBytes(t.Address[:20])
 					}
 					{ // Field #3 'Amount'
-						enc.EncodeUint64(t.Amount)
+						enc.EncodeUint64(uint64(t.Amount))
 					}
 				}
 			}
@@ -1957,7 +1966,7 @@ func (t *BeaconBlock) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 						t = new(BLSToExecutionChange)
 					}
 					{ // Field #0 'ValidatorIndex'
-						enc.EncodeUint64(t.ValidatorIndex)
+						enc.EncodeUint64(uint64(t.ValidatorIndex))
 					}
 					{ // Field #1 'FromBLSPubkey'
 						enc.EncodeBytes(t.FromBLSPubkey[:48])
@@ -2051,11 +2060,11 @@ func (t *BeaconBlock) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err
 	}
 	{ // Field #0 'Slot' (static)
 		buf := buf[0:8]
-		t.Slot = binary.LittleEndian.Uint64(buf)
+		t.Slot = Slot(binary.LittleEndian.Uint64(buf))
 	}
 	{ // Field #1 'ProposerIndex' (static)
 		buf := buf[8:16]
-		t.ProposerIndex = binary.LittleEndian.Uint64(buf)
+		t.ProposerIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf))
 	}
 	{ // Field #2 'ParentRoot' (static)
 		buf := buf[16:48]
@@ -2237,11 +2246,11 @@ func (t *BeaconBlock) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err
 						}
 						{ // Field #0 'Slot' (static)
 							buf := buf[0:8]
-							val7.Slot = binary.LittleEndian.Uint64(buf)
+							val7.Slot = Slot(binary.LittleEndian.Uint64(buf))
 						}
 						{ // Field #1 'ProposerIndex' (static)
 							buf := buf[8:16]
-							val7.ProposerIndex = binary.LittleEndian.Uint64(buf)
+							val7.ProposerIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf))
 						}
 						{ // Field #2 'ParentRoot' (static)
 							buf := buf[16:48]
@@ -2291,11 +2300,11 @@ func (t *BeaconBlock) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err
 						}
 						{ // Field #0 'Slot' (static)
 							buf := buf[0:8]
-							val9.Slot = binary.LittleEndian.Uint64(buf)
+							val9.Slot = Slot(binary.LittleEndian.Uint64(buf))
 						}
 						{ // Field #1 'ProposerIndex' (static)
 							buf := buf[8:16]
-							val9.ProposerIndex = binary.LittleEndian.Uint64(buf)
+							val9.ProposerIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf))
 						}
 						{ // Field #2 'ParentRoot' (static)
 							buf := buf[16:48]
@@ -2399,7 +2408,7 @@ func (t *BeaconBlock) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err
 						}
 						{ // Field #0 'Slot' (static)
 							buf := buf[0:8]
-							val13.Slot = binary.LittleEndian.Uint64(buf)
+							val13.Slot = Slot(binary.LittleEndian.Uint64(buf))
 						}
 						{ // Field #1 'Index' (static)
 							buf := buf[8:16]
@@ -2424,7 +2433,7 @@ func (t *BeaconBlock) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err
 							}
 							{ // Field #0 'Epoch' (static)
 								buf := buf[0:8]
-								val14.Epoch = binary.LittleEndian.Uint64(buf)
+								val14.Epoch = Epoch(binary.LittleEndian.Uint64(buf))
 							}
 							{ // Field #1 'Root' (static)
 								buf := buf[8:40]
@@ -2447,7 +2456,7 @@ func (t *BeaconBlock) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err
 							}
 							{ // Field #0 'Epoch' (static)
 								buf := buf[0:8]
-								val15.Epoch = binary.LittleEndian.Uint64(buf)
+								val15.Epoch = Epoch(binary.LittleEndian.Uint64(buf))
 							}
 							{ // Field #1 'Root' (static)
 								buf := buf[8:40]
@@ -2507,7 +2516,7 @@ func (t *BeaconBlock) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err
 						}
 						{ // Field #0 'Slot' (static)
 							buf := buf[0:8]
-							val18.Slot = binary.LittleEndian.Uint64(buf)
+							val18.Slot = Slot(binary.LittleEndian.Uint64(buf))
 						}
 						{ // Field #1 'Index' (static)
 							buf := buf[8:16]
@@ -2532,7 +2541,7 @@ func (t *BeaconBlock) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err
 							}
 							{ // Field #0 'Epoch' (static)
 								buf := buf[0:8]
-								val19.Epoch = binary.LittleEndian.Uint64(buf)
+								val19.Epoch = Epoch(binary.LittleEndian.Uint64(buf))
 							}
 							{ // Field #1 'Root' (static)
 								buf := buf[8:40]
@@ -2555,7 +2564,7 @@ func (t *BeaconBlock) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err
 							}
 							{ // Field #0 'Epoch' (static)
 								buf := buf[0:8]
-								val20.Epoch = binary.LittleEndian.Uint64(buf)
+								val20.Epoch = Epoch(binary.LittleEndian.Uint64(buf))
 							}
 							{ // Field #1 'Root' (static)
 								buf := buf[8:40]
@@ -2647,7 +2656,7 @@ func (t *BeaconBlock) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err
 					}
 					{ // Field #0 'Slot' (static)
 						buf := buf[0:8]
-						val24.Slot = binary.LittleEndian.Uint64(buf)
+						val24.Slot = Slot(binary.LittleEndian.Uint64(buf))
 					}
 					{ // Field #1 'Index' (static)
 						buf := buf[8:16]
@@ -2672,7 +2681,7 @@ func (t *BeaconBlock) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err
 						}
 						{ // Field #0 'Epoch' (static)
 							buf := buf[0:8]
-							val25.Epoch = binary.LittleEndian.Uint64(buf)
+							val25.Epoch = Epoch(binary.LittleEndian.Uint64(buf))
 						}
 						{ // Field #1 'Root' (static)
 							buf := buf[8:40]
@@ -2695,7 +2704,7 @@ func (t *BeaconBlock) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err
 						}
 						{ // Field #0 'Epoch' (static)
 							buf := buf[0:8]
-							val26.Epoch = binary.LittleEndian.Uint64(buf)
+							val26.Epoch = Epoch(binary.LittleEndian.Uint64(buf))
 						}
 						{ // Field #1 'Root' (static)
 							buf := buf[8:40]
@@ -2786,7 +2795,7 @@ func (t *BeaconBlock) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err
 					}
 					{ // Field #2 'Amount' (static)
 						buf := buf[80:88]
-						val31.Amount = binary.LittleEndian.Uint64(buf)
+						val31.Amount = Gwei(binary.LittleEndian.Uint64(buf))
 					}
 					{ // Field #3 'Signature' (static)
 						buf := buf[88:184]
@@ -2837,11 +2846,11 @@ func (t *BeaconBlock) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err
 					}
 					{ // Field #0 'Epoch' (static)
 						buf := buf[0:8]
-						val34.Epoch = binary.LittleEndian.Uint64(buf)
+						val34.Epoch = Epoch(binary.LittleEndian.Uint64(buf))
 					}
 					{ // Field #1 'ValidatorIndex' (static)
 						buf := buf[8:16]
-						val34.ValidatorIndex = binary.LittleEndian.Uint64(buf)
+						val34.ValidatorIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf))
 					}
 					val33.Message = val34
 				}
@@ -3008,11 +3017,11 @@ func (t *BeaconBlock) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err
 					}
 					{ // Field #0 'Index' (static)
 						buf := buf[0:8]
-						val39.Index = binary.LittleEndian.Uint64(buf)
+						val39.Index = WithdrawalIndex(binary.LittleEndian.Uint64(buf))
 					}
 					{ // Field #1 'ValidatorIndex' (static)
 						buf := buf[8:16]
-						val39.ValidatorIndex = binary.LittleEndian.Uint64(buf)
+						val39.ValidatorIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf))
 					}
 					{ // Field #2 'Address' (static)
 						buf := buf[16:36]
@@ -3020,7 +3029,7 @@ func (t *BeaconBlock) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err
 					}
 					{ // Field #3 'Amount' (static)
 						buf := buf[36:44]
-						val39.Amount = binary.LittleEndian.Uint64(buf)
+						val39.Amount = Gwei(binary.LittleEndian.Uint64(buf))
 					}
 					val38[idx1] = val39
 				}
@@ -3067,7 +3076,7 @@ func (t *BeaconBlock) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err
 					}
 					{ // Field #0 'ValidatorIndex' (static)
 						buf := buf[0:8]
-						val42.ValidatorIndex = binary.LittleEndian.Uint64(buf)
+						val42.ValidatorIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf))
 					}
 					{ // Field #1 'FromBLSPubkey' (static)
 						buf := buf[8:56]
@@ -3174,13 +3183,13 @@ func (t *BeaconBlock) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils
 	if val, err := dec.DecodeUint64(); err != nil {
 		return sszutils.ErrorWithPath(err, "Slot")
 	} else {
-		t.Slot = val
+		t.Slot = Slot(val)
 	}
 	// Field #1 'ProposerIndex' (static)
 	if val, err := dec.DecodeUint64(); err != nil {
 		return sszutils.ErrorWithPath(err, "ProposerIndex")
 	} else {
-		t.ProposerIndex = val
+		t.ProposerIndex = ValidatorIndex(val)
 	}
 	// Field #2 'ParentRoot' (static)
 	if _, err = dec.DecodeBytes(t.ParentRoot[:32]); err != nil {
@@ -3378,13 +3387,13 @@ func (t *BeaconBlock) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils
 						if val, err := dec.DecodeUint64(); err != nil {
 							return sszutils.ErrorWithPathf(err, "Body.ProposerSlashings[%d].SignedHeader1.Message.Slot", idx1)
 						} else {
-							val7.Slot = val
+							val7.Slot = Slot(val)
 						}
 						// Field #1 'ProposerIndex' (static)
 						if val, err := dec.DecodeUint64(); err != nil {
 							return sszutils.ErrorWithPathf(err, "Body.ProposerSlashings[%d].SignedHeader1.Message.ProposerIndex", idx1)
 						} else {
-							val7.ProposerIndex = val
+							val7.ProposerIndex = ValidatorIndex(val)
 						}
 						// Field #2 'ParentRoot' (static)
 						if _, err = dec.DecodeBytes(val7.ParentRoot[:32]); err != nil {
@@ -3428,13 +3437,13 @@ func (t *BeaconBlock) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils
 						if val, err := dec.DecodeUint64(); err != nil {
 							return sszutils.ErrorWithPathf(err, "Body.ProposerSlashings[%d].SignedHeader2.Message.Slot", idx1)
 						} else {
-							val9.Slot = val
+							val9.Slot = Slot(val)
 						}
 						// Field #1 'ProposerIndex' (static)
 						if val, err := dec.DecodeUint64(); err != nil {
 							return sszutils.ErrorWithPathf(err, "Body.ProposerSlashings[%d].SignedHeader2.Message.ProposerIndex", idx1)
 						} else {
-							val9.ProposerIndex = val
+							val9.ProposerIndex = ValidatorIndex(val)
 						}
 						// Field #2 'ParentRoot' (static)
 						if _, err = dec.DecodeBytes(val9.ParentRoot[:32]); err != nil {
@@ -3580,7 +3589,7 @@ func (t *BeaconBlock) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils
 						if val, err := dec.DecodeUint64(); err != nil {
 							return sszutils.ErrorWithPathf(err, "Body.AttesterSlashings[%d].Attestation1.Data.Slot", idx1)
 						} else {
-							val13.Slot = val
+							val13.Slot = Slot(val)
 						}
 						// Field #1 'Index' (static)
 						if val, err := dec.DecodeUint64(); err != nil {
@@ -3605,7 +3614,7 @@ func (t *BeaconBlock) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils
 							if val, err := dec.DecodeUint64(); err != nil {
 								return sszutils.ErrorWithPathf(err, "Body.AttesterSlashings[%d].Attestation1.Data.Source.Epoch", idx1)
 							} else {
-								val14.Epoch = val
+								val14.Epoch = Epoch(val)
 							}
 							// Field #1 'Root' (static)
 							if _, err = dec.DecodeBytes(val14.Root[:32]); err != nil {
@@ -3626,7 +3635,7 @@ func (t *BeaconBlock) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils
 							if val, err := dec.DecodeUint64(); err != nil {
 								return sszutils.ErrorWithPathf(err, "Body.AttesterSlashings[%d].Attestation1.Data.Target.Epoch", idx1)
 							} else {
-								val15.Epoch = val
+								val15.Epoch = Epoch(val)
 							}
 							// Field #1 'Root' (static)
 							if _, err = dec.DecodeBytes(val15.Root[:32]); err != nil {
@@ -3703,7 +3712,7 @@ func (t *BeaconBlock) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils
 						if val, err := dec.DecodeUint64(); err != nil {
 							return sszutils.ErrorWithPathf(err, "Body.AttesterSlashings[%d].Attestation2.Data.Slot", idx1)
 						} else {
-							val18.Slot = val
+							val18.Slot = Slot(val)
 						}
 						// Field #1 'Index' (static)
 						if val, err := dec.DecodeUint64(); err != nil {
@@ -3728,7 +3737,7 @@ func (t *BeaconBlock) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils
 							if val, err := dec.DecodeUint64(); err != nil {
 								return sszutils.ErrorWithPathf(err, "Body.AttesterSlashings[%d].Attestation2.Data.Source.Epoch", idx1)
 							} else {
-								val19.Epoch = val
+								val19.Epoch = Epoch(val)
 							}
 							// Field #1 'Root' (static)
 							if _, err = dec.DecodeBytes(val19.Root[:32]); err != nil {
@@ -3749,7 +3758,7 @@ func (t *BeaconBlock) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils
 							if val, err := dec.DecodeUint64(); err != nil {
 								return sszutils.ErrorWithPathf(err, "Body.AttesterSlashings[%d].Attestation2.Data.Target.Epoch", idx1)
 							} else {
-								val20.Epoch = val
+								val20.Epoch = Epoch(val)
 							}
 							// Field #1 'Root' (static)
 							if _, err = dec.DecodeBytes(val20.Root[:32]); err != nil {
@@ -3885,7 +3894,7 @@ func (t *BeaconBlock) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils
 					if val, err := dec.DecodeUint64(); err != nil {
 						return sszutils.ErrorWithPathf(err, "Body.Attestations[%d].Data.Slot", idx1)
 					} else {
-						val24.Slot = val
+						val24.Slot = Slot(val)
 					}
 					// Field #1 'Index' (static)
 					if val, err := dec.DecodeUint64(); err != nil {
@@ -3910,7 +3919,7 @@ func (t *BeaconBlock) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils
 						if val, err := dec.DecodeUint64(); err != nil {
 							return sszutils.ErrorWithPathf(err, "Body.Attestations[%d].Data.Source.Epoch", idx1)
 						} else {
-							val25.Epoch = val
+							val25.Epoch = Epoch(val)
 						}
 						// Field #1 'Root' (static)
 						if _, err = dec.DecodeBytes(val25.Root[:32]); err != nil {
@@ -3931,7 +3940,7 @@ func (t *BeaconBlock) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils
 						if val, err := dec.DecodeUint64(); err != nil {
 							return sszutils.ErrorWithPathf(err, "Body.Attestations[%d].Data.Target.Epoch", idx1)
 						} else {
-							val26.Epoch = val
+							val26.Epoch = Epoch(val)
 						}
 						// Field #1 'Root' (static)
 						if _, err = dec.DecodeBytes(val26.Root[:32]); err != nil {
@@ -4039,7 +4048,7 @@ func (t *BeaconBlock) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils
 					if val, err := dec.DecodeUint64(); err != nil {
 						return sszutils.ErrorWithPathf(err, "Body.Deposits[%d].Data.Amount", idx1)
 					} else {
-						val31.Amount = val
+						val31.Amount = Gwei(val)
 					}
 					// Field #3 'Signature' (static)
 					if _, err = dec.DecodeBytes(val31.Signature[:96]); err != nil {
@@ -4095,13 +4104,13 @@ func (t *BeaconBlock) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils
 					if val, err := dec.DecodeUint64(); err != nil {
 						return sszutils.ErrorWithPathf(err, "Body.VoluntaryExits[%d].Message.Epoch", idx1)
 					} else {
-						val34.Epoch = val
+						val34.Epoch = Epoch(val)
 					}
 					// Field #1 'ValidatorIndex' (static)
 					if val, err := dec.DecodeUint64(); err != nil {
 						return sszutils.ErrorWithPathf(err, "Body.VoluntaryExits[%d].Message.ValidatorIndex", idx1)
 					} else {
-						val34.ValidatorIndex = val
+						val34.ValidatorIndex = ValidatorIndex(val)
 					}
 					val33.Message = val34
 				}
@@ -4346,13 +4355,13 @@ func (t *BeaconBlock) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils
 					if val, err := dec.DecodeUint64(); err != nil {
 						return sszutils.ErrorWithPathf(err, "Body.ExecutionPayload.Withdrawals[%d].Index", idx1)
 					} else {
-						val40.Index = val
+						val40.Index = WithdrawalIndex(val)
 					}
 					// Field #1 'ValidatorIndex' (static)
 					if val, err := dec.DecodeUint64(); err != nil {
 						return sszutils.ErrorWithPathf(err, "Body.ExecutionPayload.Withdrawals[%d].ValidatorIndex", idx1)
 					} else {
-						val40.ValidatorIndex = val
+						val40.ValidatorIndex = ValidatorIndex(val)
 					}
 					// Field #2 'Address' (static)
 					if _, err = dec.DecodeBytes(val40.Address[:20]); err != nil {
@@ -4362,7 +4371,7 @@ func (t *BeaconBlock) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils
 					if val, err := dec.DecodeUint64(); err != nil {
 						return sszutils.ErrorWithPathf(err, "Body.ExecutionPayload.Withdrawals[%d].Amount", idx1)
 					} else {
-						val40.Amount = val
+						val40.Amount = Gwei(val)
 					}
 					if dec.GetPosition() != startPos14+int(44*(idx1+1)) {
 						return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos14+int(44*(idx1+1))), "Body.ExecutionPayload.Withdrawals[%d]", idx1)
@@ -4417,7 +4426,7 @@ func (t *BeaconBlock) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils
 					if val, err := dec.DecodeUint64(); err != nil {
 						return sszutils.ErrorWithPathf(err, "Body.BLSToExecutionChanges[%d].Message.ValidatorIndex", idx1)
 					} else {
-						val43.ValidatorIndex = val
+						val43.ValidatorIndex = ValidatorIndex(val)
 					}
 					// Field #1 'FromBLSPubkey' (static)
 					if _, err = dec.DecodeBytes(val43.FromBLSPubkey[:48]); err != nil {
@@ -4731,10 +4740,10 @@ func (t *BeaconBlock) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 	}
 	idx := hh.StartTree(sszutils.TreeTypeNone)
 	{ // Field #0 'Slot'
-		hh.PutUint64(t.Slot)
+		hh.PutUint64(uint64(t.Slot))
 	}
 	{ // Field #1 'ProposerIndex'
-		hh.PutUint64(t.ProposerIndex)
+		hh.PutUint64(uint64(t.ProposerIndex))
 	}
 	{ // Field #2 'ParentRoot'
 		hh.PutBytes(t.ParentRoot[:32])
@@ -4797,10 +4806,10 @@ func (t *BeaconBlock) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 						}
 						idx := hh.StartTree(sszutils.TreeTypeNone)
 						{ // Field #0 'Slot'
-							hh.PutUint64(t.Slot)
+							hh.PutUint64(uint64(t.Slot))
 						}
 						{ // Field #1 'ProposerIndex'
-							hh.PutUint64(t.ProposerIndex)
+							hh.PutUint64(uint64(t.ProposerIndex))
 						}
 						{ // Field #2 'ParentRoot'
 							hh.PutBytes(t.ParentRoot[:32])
@@ -4831,10 +4840,10 @@ func (t *BeaconBlock) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 						}
 						idx := hh.StartTree(sszutils.TreeTypeNone)
 						{ // Field #0 'Slot'
-							hh.PutUint64(t.Slot)
+							hh.PutUint64(uint64(t.Slot))
 						}
 						{ // Field #1 'ProposerIndex'
-							hh.PutUint64(t.ProposerIndex)
+							hh.PutUint64(uint64(t.ProposerIndex))
 						}
 						{ // Field #2 'ParentRoot'
 							hh.PutBytes(t.ParentRoot[:32])
@@ -4896,7 +4905,7 @@ func (t *BeaconBlock) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 						}
 						idx := hh.StartTree(sszutils.TreeTypeNone)
 						{ // Field #0 'Slot'
-							hh.PutUint64(t.Slot)
+							hh.PutUint64(uint64(t.Slot))
 						}
 						{ // Field #1 'Index'
 							hh.PutUint64(t.Index)
@@ -4911,7 +4920,7 @@ func (t *BeaconBlock) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 							}
 							idx := hh.StartTree(sszutils.TreeTypeNone)
 							{ // Field #0 'Epoch'
-								hh.PutUint64(t.Epoch)
+								hh.PutUint64(uint64(t.Epoch))
 							}
 							{ // Field #1 'Root'
 								hh.PutBytes(t.Root[:32])
@@ -4925,7 +4934,7 @@ func (t *BeaconBlock) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 							}
 							idx := hh.StartTree(sszutils.TreeTypeNone)
 							{ // Field #0 'Epoch'
-								hh.PutUint64(t.Epoch)
+								hh.PutUint64(uint64(t.Epoch))
 							}
 							{ // Field #1 'Root'
 								hh.PutBytes(t.Root[:32])
@@ -4963,7 +4972,7 @@ func (t *BeaconBlock) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 						}
 						idx := hh.StartTree(sszutils.TreeTypeNone)
 						{ // Field #0 'Slot'
-							hh.PutUint64(t.Slot)
+							hh.PutUint64(uint64(t.Slot))
 						}
 						{ // Field #1 'Index'
 							hh.PutUint64(t.Index)
@@ -4978,7 +4987,7 @@ func (t *BeaconBlock) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 							}
 							idx := hh.StartTree(sszutils.TreeTypeNone)
 							{ // Field #0 'Epoch'
-								hh.PutUint64(t.Epoch)
+								hh.PutUint64(uint64(t.Epoch))
 							}
 							{ // Field #1 'Root'
 								hh.PutBytes(t.Root[:32])
@@ -4992,7 +5001,7 @@ func (t *BeaconBlock) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 							}
 							idx := hh.StartTree(sszutils.TreeTypeNone)
 							{ // Field #0 'Epoch'
-								hh.PutUint64(t.Epoch)
+								hh.PutUint64(uint64(t.Epoch))
 							}
 							{ // Field #1 'Root'
 								hh.PutBytes(t.Root[:32])
@@ -5046,7 +5055,7 @@ func (t *BeaconBlock) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 					}
 					idx := hh.StartTree(sszutils.TreeTypeNone)
 					{ // Field #0 'Slot'
-						hh.PutUint64(t.Slot)
+						hh.PutUint64(uint64(t.Slot))
 					}
 					{ // Field #1 'Index'
 						hh.PutUint64(t.Index)
@@ -5061,7 +5070,7 @@ func (t *BeaconBlock) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 						}
 						idx := hh.StartTree(sszutils.TreeTypeNone)
 						{ // Field #0 'Epoch'
-							hh.PutUint64(t.Epoch)
+							hh.PutUint64(uint64(t.Epoch))
 						}
 						{ // Field #1 'Root'
 							hh.PutBytes(t.Root[:32])
@@ -5075,7 +5084,7 @@ func (t *BeaconBlock) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 						}
 						idx := hh.StartTree(sszutils.TreeTypeNone)
 						{ // Field #0 'Epoch'
-							hh.PutUint64(t.Epoch)
+							hh.PutUint64(uint64(t.Epoch))
 						}
 						{ // Field #1 'Root'
 							hh.PutBytes(t.Root[:32])
@@ -5149,7 +5158,7 @@ func (t *BeaconBlock) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 						hh.PutBytes(t.WithdrawalCredentials[:32])
 					}
 					{ // Field #2 'Amount'
-						hh.PutUint64(t.Amount)
+						hh.PutUint64(uint64(t.Amount))
 					}
 					{ // Field #3 'Signature'
 						hh.PutBytes(t.Signature[:96])
@@ -5183,10 +5192,10 @@ func (t *BeaconBlock) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 					}
 					idx := hh.StartTree(sszutils.TreeTypeNone)
 					{ // Field #0 'Epoch'
-						hh.PutUint64(t.Epoch)
+						hh.PutUint64(uint64(t.Epoch))
 					}
 					{ // Field #1 'ValidatorIndex'
-						hh.PutUint64(t.ValidatorIndex)
+						hh.PutUint64(uint64(t.ValidatorIndex))
 					}
 					hh.Merkleize(idx)
 				}
@@ -5308,16 +5317,16 @@ func (t *BeaconBlock) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 					}
 					idx := hh.StartTree(sszutils.TreeTypeNone)
 					{ // Field #0 'Index'
-						hh.PutUint64(t.Index)
+						hh.PutUint64(uint64(t.Index))
 					}
 					{ // Field #1 'ValidatorIndex'
-						hh.PutUint64(t.ValidatorIndex)
+						hh.PutUint64(uint64(t.ValidatorIndex))
 					}
 					{ // Field #2 'Address'
 						hh.PutBytes(t.Address[:20])
 					}
 					{ // Field #3 'Amount'
-						hh.PutUint64(t.Amount)
+						hh.PutUint64(uint64(t.Amount))
 					}
 					hh.Merkleize(idx)
 					if (idx1+1)%256 == 0 {
@@ -5354,7 +5363,7 @@ func (t *BeaconBlock) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 					}
 					idx := hh.StartTree(sszutils.TreeTypeNone)
 					{ // Field #0 'ValidatorIndex'
-						hh.PutUint64(t.ValidatorIndex)
+						hh.PutUint64(uint64(t.ValidatorIndex))
 					}
 					{ // Field #1 'FromBLSPubkey'
 						hh.PutBytes(t.FromBLSPubkey[:48])
@@ -5451,7 +5460,7 @@ func (t *BeaconState) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst [
 		dst = append(dst, t.GenesisValidatorsRoot[:32]...)
 	}
 	{ // Static Field #2 'Slot'
-		dst = binary.LittleEndian.AppendUint64(dst, t.Slot)
+		dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Slot))
 	}
 	{ // Static Field #3 'Fork'
 		t := t.Fork
@@ -5465,7 +5474,7 @@ func (t *BeaconState) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst [
 			dst = append(dst, t.CurrentVersion[:4]...)
 		}
 		{ // Static Field #2 'Epoch'
-			dst = binary.LittleEndian.AppendUint64(dst, t.Epoch)
+			dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Epoch))
 		}
 	}
 	{ // Static Field #4 'LatestBlockHeader'
@@ -5474,10 +5483,10 @@ func (t *BeaconState) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst [
 			t = new(BeaconBlockHeader)
 		}
 		{ // Static Field #0 'Slot'
-			dst = binary.LittleEndian.AppendUint64(dst, t.Slot)
+			dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Slot))
 		}
 		{ // Static Field #1 'ProposerIndex'
-			dst = binary.LittleEndian.AppendUint64(dst, t.ProposerIndex)
+			dst = binary.LittleEndian.AppendUint64(dst, uint64(t.ProposerIndex))
 		}
 		{ // Static Field #2 'ParentRoot'
 			dst = append(dst, t.ParentRoot[:32]...)
@@ -5579,7 +5588,7 @@ func (t *BeaconState) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst [
 			t = new(Checkpoint)
 		}
 		{ // Static Field #0 'Epoch'
-			dst = binary.LittleEndian.AppendUint64(dst, t.Epoch)
+			dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Epoch))
 		}
 		{ // Static Field #1 'Root'
 			dst = append(dst, t.Root[:32]...)
@@ -5591,7 +5600,7 @@ func (t *BeaconState) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst [
 			t = new(Checkpoint)
 		}
 		{ // Static Field #0 'Epoch'
-			dst = binary.LittleEndian.AppendUint64(dst, t.Epoch)
+			dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Epoch))
 		}
 		{ // Static Field #1 'Root'
 			dst = append(dst, t.Root[:32]...)
@@ -5603,7 +5612,7 @@ func (t *BeaconState) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst [
 			t = new(Checkpoint)
 		}
 		{ // Static Field #0 'Epoch'
-			dst = binary.LittleEndian.AppendUint64(dst, t.Epoch)
+			dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Epoch))
 		}
 		{ // Static Field #1 'Root'
 			dst = append(dst, t.Root[:32]...)
@@ -5655,10 +5664,10 @@ func (t *BeaconState) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst [
 	offset24 := len(dst)
 	dst = append(dst, 0, 0, 0, 0)
 	{ // Static Field #25 'NextWithdrawalIndex'
-		dst = binary.LittleEndian.AppendUint64(dst, t.NextWithdrawalIndex)
+		dst = binary.LittleEndian.AppendUint64(dst, uint64(t.NextWithdrawalIndex))
 	}
 	{ // Static Field #26 'NextWithdrawalValidatorIndex'
-		dst = binary.LittleEndian.AppendUint64(dst, t.NextWithdrawalValidatorIndex)
+		dst = binary.LittleEndian.AppendUint64(dst, uint64(t.NextWithdrawalValidatorIndex))
 	}
 	// Offset Field #27 'HistoricalSummaries'
 	dst = append(dst, 0, 0, 0, 0)
@@ -5713,22 +5722,22 @@ func (t *BeaconState) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst [
 				dst = append(dst, t.WithdrawalCredentials[:32]...)
 			}
 			{ // Static Field #2 'EffectiveBalance'
-				dst = binary.LittleEndian.AppendUint64(dst, t.EffectiveBalance)
+				dst = binary.LittleEndian.AppendUint64(dst, uint64(t.EffectiveBalance))
 			}
 			{ // Static Field #3 'Slashed'
 				dst = sszutils.MarshalBool(dst, t.Slashed)
 			}
 			{ // Static Field #4 'ActivationEligibilityEpoch'
-				dst = binary.LittleEndian.AppendUint64(dst, t.ActivationEligibilityEpoch)
+				dst = binary.LittleEndian.AppendUint64(dst, uint64(t.ActivationEligibilityEpoch))
 			}
 			{ // Static Field #5 'ActivationEpoch'
-				dst = binary.LittleEndian.AppendUint64(dst, t.ActivationEpoch)
+				dst = binary.LittleEndian.AppendUint64(dst, uint64(t.ActivationEpoch))
 			}
 			{ // Static Field #6 'ExitEpoch'
-				dst = binary.LittleEndian.AppendUint64(dst, t.ExitEpoch)
+				dst = binary.LittleEndian.AppendUint64(dst, uint64(t.ExitEpoch))
 			}
 			{ // Static Field #7 'WithdrawableEpoch'
-				dst = binary.LittleEndian.AppendUint64(dst, t.WithdrawableEpoch)
+				dst = binary.LittleEndian.AppendUint64(dst, uint64(t.WithdrawableEpoch))
 			}
 		}
 	}
@@ -5860,13 +5869,13 @@ func (t *BeaconState) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 	type encoderCtx struct {
 		ds       sszutils.DynamicSpecs
 		exprs    [8]uint64
-		sizeFn1  func(ctx *encoderCtx, t [][32]byte) (size int)
+		sizeFn1  func(ctx *encoderCtx, t []Root) (size int)
 		sizeFn10 func(ctx *encoderCtx, t []byte) (size int)
 		sizeFn2  func(ctx *encoderCtx, t []*ETH1Data) (size int)
 		sizeFn3  func(ctx *encoderCtx, t []*Validator) (size int)
-		sizeFn4  func(ctx *encoderCtx, t []uint64) (size int)
-		sizeFn5  func(ctx *encoderCtx, t []uint8) (size int)
-		sizeFn6  func(ctx *encoderCtx, t []uint8) (size int)
+		sizeFn4  func(ctx *encoderCtx, t []Gwei) (size int)
+		sizeFn5  func(ctx *encoderCtx, t []ParticipationFlags) (size int)
+		sizeFn6  func(ctx *encoderCtx, t []ParticipationFlags) (size int)
 		sizeFn7  func(ctx *encoderCtx, t []uint64) (size int)
 		sizeFn8  func(ctx *encoderCtx, t *ExecutionPayloadHeader) (size int)
 		sizeFn9  func(ctx *encoderCtx, t []*HistoricalSummary) (size int)
@@ -5905,8 +5914,8 @@ func (t *BeaconState) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 	if err != nil {
 		return err
 	}
-	// size for [][32]byte
-	ctx.sizeFn1 = func(ctx *encoderCtx, t [][32]byte) (size int) {
+	// size for []Root
+	ctx.sizeFn1 = func(ctx *encoderCtx, t []Root) (size int) {
 		size += len(t) * 32
 		return size
 	}
@@ -5920,18 +5929,18 @@ func (t *BeaconState) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 		size += len(t) * 121
 		return size
 	}
-	// size for []uint64
-	ctx.sizeFn4 = func(ctx *encoderCtx, t []uint64) (size int) {
+	// size for []Gwei
+	ctx.sizeFn4 = func(ctx *encoderCtx, t []Gwei) (size int) {
 		size += len(t) * 8
 		return size
 	}
-	// size for []uint8
-	ctx.sizeFn5 = func(ctx *encoderCtx, t []uint8) (size int) {
+	// size for []ParticipationFlags
+	ctx.sizeFn5 = func(ctx *encoderCtx, t []ParticipationFlags) (size int) {
 		size += len(t)
 		return size
 	}
-	// size for []uint8
-	ctx.sizeFn6 = func(ctx *encoderCtx, t []uint8) (size int) {
+	// size for []ParticipationFlags
+	ctx.sizeFn6 = func(ctx *encoderCtx, t []ParticipationFlags) (size int) {
 		size += len(t)
 		return size
 	}
@@ -5995,7 +6004,7 @@ func (t *BeaconState) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 		enc.EncodeBytes(t.GenesisValidatorsRoot[:32])
 	}
 	{ // Field #2 'Slot'
-		enc.EncodeUint64(t.Slot)
+		enc.EncodeUint64(uint64(t.Slot))
 	}
 	{ // Field #3 'Fork'
 		t := t.Fork
@@ -6009,7 +6018,7 @@ func (t *BeaconState) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 			enc.EncodeBytes(t.CurrentVersion[:4])
 		}
 		{ // Field #2 'Epoch'
-			enc.EncodeUint64(t.Epoch)
+			enc.EncodeUint64(uint64(t.Epoch))
 		}
 	}
 	{ // Field #4 'LatestBlockHeader'
@@ -6018,10 +6027,10 @@ func (t *BeaconState) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 			t = new(BeaconBlockHeader)
 		}
 		{ // Field #0 'Slot'
-			enc.EncodeUint64(t.Slot)
+			enc.EncodeUint64(uint64(t.Slot))
 		}
 		{ // Field #1 'ProposerIndex'
-			enc.EncodeUint64(t.ProposerIndex)
+			enc.EncodeUint64(uint64(t.ProposerIndex))
 		}
 		{ // Field #2 'ParentRoot'
 			enc.EncodeBytes(t.ParentRoot[:32])
@@ -6165,7 +6174,7 @@ func (t *BeaconState) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 			t = new(Checkpoint)
 		}
 		{ // Field #0 'Epoch'
-			enc.EncodeUint64(t.Epoch)
+			enc.EncodeUint64(uint64(t.Epoch))
 		}
 		{ // Field #1 'Root'
 			enc.EncodeBytes(t.Root[:32])
@@ -6177,7 +6186,7 @@ func (t *BeaconState) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 			t = new(Checkpoint)
 		}
 		{ // Field #0 'Epoch'
-			enc.EncodeUint64(t.Epoch)
+			enc.EncodeUint64(uint64(t.Epoch))
 		}
 		{ // Field #1 'Root'
 			enc.EncodeBytes(t.Root[:32])
@@ -6189,7 +6198,7 @@ func (t *BeaconState) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 			t = new(Checkpoint)
 		}
 		{ // Field #0 'Epoch'
-			enc.EncodeUint64(t.Epoch)
+			enc.EncodeUint64(uint64(t.Epoch))
 		}
 		{ // Field #1 'Root'
 			enc.EncodeBytes(t.Root[:32])
@@ -6256,10 +6265,10 @@ func (t *BeaconState) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 		dynoff += uint32(ctx.sizeFn8(ctx, t.LatestExecutionPayloadHeader))
 	}
 	{ // Field #25 'NextWithdrawalIndex'
-		enc.EncodeUint64(t.NextWithdrawalIndex)
+		enc.EncodeUint64(uint64(t.NextWithdrawalIndex))
 	}
 	{ // Field #26 'NextWithdrawalValidatorIndex'
-		enc.EncodeUint64(t.NextWithdrawalValidatorIndex)
+		enc.EncodeUint64(uint64(t.NextWithdrawalValidatorIndex))
 	}
 	// Offset #27 'HistoricalSummaries'
 	offset27 := enc.GetPosition()
@@ -6328,22 +6337,22 @@ func (t *BeaconState) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.E
 				enc.EncodeBytes(t.WithdrawalCredentials[:32])
 			}
 			{ // Field #2 'EffectiveBalance'
-				enc.EncodeUint64(t.EffectiveBalance)
+				enc.EncodeUint64(uint64(t.EffectiveBalance))
 			}
 			{ // Field #3 'Slashed'
 				enc.EncodeBool(t.Slashed)
 			}
 			{ // Field #4 'ActivationEligibilityEpoch'
-				enc.EncodeUint64(t.ActivationEligibilityEpoch)
+				enc.EncodeUint64(uint64(t.ActivationEligibilityEpoch))
 			}
 			{ // Field #5 'ActivationEpoch'
-				enc.EncodeUint64(t.ActivationEpoch)
+				enc.EncodeUint64(uint64(t.ActivationEpoch))
 			}
 			{ // Field #6 'ExitEpoch'
-				enc.EncodeUint64(t.ExitEpoch)
+				enc.EncodeUint64(uint64(t.ExitEpoch))
 			}
 			{ // Field #7 'WithdrawableEpoch'
-				enc.EncodeUint64(t.WithdrawableEpoch)
+				enc.EncodeUint64(uint64(t.WithdrawableEpoch))
 			}
 		}
 	}
@@ -6551,7 +6560,7 @@ func (t *BeaconState) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err
 	}
 	{ // Field #2 'Slot' (static)
 		buf := buf[40:48]
-		t.Slot = binary.LittleEndian.Uint64(buf)
+		t.Slot = Slot(binary.LittleEndian.Uint64(buf))
 	}
 	{ // Field #3 'Fork' (static)
 		buf := buf[48:64]
@@ -6576,7 +6585,7 @@ func (t *BeaconState) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err
 		}
 		{ // Field #2 'Epoch' (static)
 			buf := buf[8:16]
-			val1.Epoch = binary.LittleEndian.Uint64(buf)
+			val1.Epoch = Epoch(binary.LittleEndian.Uint64(buf))
 		}
 		t.Fork = val1
 	}
@@ -6595,11 +6604,11 @@ func (t *BeaconState) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err
 		}
 		{ // Field #0 'Slot' (static)
 			buf := buf[0:8]
-			val2.Slot = binary.LittleEndian.Uint64(buf)
+			val2.Slot = Slot(binary.LittleEndian.Uint64(buf))
 		}
 		{ // Field #1 'ProposerIndex' (static)
 			buf := buf[8:16]
-			val2.ProposerIndex = binary.LittleEndian.Uint64(buf)
+			val2.ProposerIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf))
 		}
 		{ // Field #2 'ParentRoot' (static)
 			buf := buf[16:48]
@@ -6728,7 +6737,7 @@ func (t *BeaconState) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err
 		}
 		{ // Field #0 'Epoch' (static)
 			buf := buf[0:8]
-			val8.Epoch = binary.LittleEndian.Uint64(buf)
+			val8.Epoch = Epoch(binary.LittleEndian.Uint64(buf))
 		}
 		{ // Field #1 'Root' (static)
 			buf := buf[8:40]
@@ -6751,7 +6760,7 @@ func (t *BeaconState) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err
 		}
 		{ // Field #0 'Epoch' (static)
 			buf := buf[0:8]
-			val9.Epoch = binary.LittleEndian.Uint64(buf)
+			val9.Epoch = Epoch(binary.LittleEndian.Uint64(buf))
 		}
 		{ // Field #1 'Root' (static)
 			buf := buf[8:40]
@@ -6774,7 +6783,7 @@ func (t *BeaconState) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err
 		}
 		{ // Field #0 'Epoch' (static)
 			buf := buf[0:8]
-			val10.Epoch = binary.LittleEndian.Uint64(buf)
+			val10.Epoch = Epoch(binary.LittleEndian.Uint64(buf))
 		}
 		{ // Field #1 'Root' (static)
 			buf := buf[8:40]
@@ -6854,11 +6863,11 @@ func (t *BeaconState) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err
 	}
 	{ // Field #25 'NextWithdrawalIndex' (static)
 		buf := buf[exproffset+409 : exproffset+417]
-		t.NextWithdrawalIndex = binary.LittleEndian.Uint64(buf)
+		t.NextWithdrawalIndex = WithdrawalIndex(binary.LittleEndian.Uint64(buf))
 	}
 	{ // Field #26 'NextWithdrawalValidatorIndex' (static)
 		buf := buf[exproffset+417 : exproffset+425]
-		t.NextWithdrawalValidatorIndex = binary.LittleEndian.Uint64(buf)
+		t.NextWithdrawalValidatorIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf))
 	}
 	// Field #27 'HistoricalSummaries' (offset)
 	offset27 := int(binary.LittleEndian.Uint32(buf[exproffset+425 : exproffset+429]))
@@ -6953,7 +6962,7 @@ func (t *BeaconState) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err
 			}
 			{ // Field #2 'EffectiveBalance' (static)
 				buf := buf[80:88]
-				val19.EffectiveBalance = binary.LittleEndian.Uint64(buf)
+				val19.EffectiveBalance = Gwei(binary.LittleEndian.Uint64(buf))
 			}
 			{ // Field #3 'Slashed' (static)
 				buf := buf[88:89]
@@ -6964,19 +6973,19 @@ func (t *BeaconState) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err
 			}
 			{ // Field #4 'ActivationEligibilityEpoch' (static)
 				buf := buf[89:97]
-				val19.ActivationEligibilityEpoch = binary.LittleEndian.Uint64(buf)
+				val19.ActivationEligibilityEpoch = Epoch(binary.LittleEndian.Uint64(buf))
 			}
 			{ // Field #5 'ActivationEpoch' (static)
 				buf := buf[97:105]
-				val19.ActivationEpoch = binary.LittleEndian.Uint64(buf)
+				val19.ActivationEpoch = Epoch(binary.LittleEndian.Uint64(buf))
 			}
 			{ // Field #6 'ExitEpoch' (static)
 				buf := buf[105:113]
-				val19.ExitEpoch = binary.LittleEndian.Uint64(buf)
+				val19.ExitEpoch = Epoch(binary.LittleEndian.Uint64(buf))
 			}
 			{ // Field #7 'WithdrawableEpoch' (static)
 				buf := buf[113:121]
-				val19.WithdrawableEpoch = binary.LittleEndian.Uint64(buf)
+				val19.WithdrawableEpoch = Epoch(binary.LittleEndian.Uint64(buf))
 			}
 			val18[idx1] = val19
 		}
@@ -7213,7 +7222,7 @@ func (t *BeaconState) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils
 	if val, err := dec.DecodeUint64(); err != nil {
 		return sszutils.ErrorWithPath(err, "Slot")
 	} else {
-		t.Slot = val
+		t.Slot = Slot(val)
 	}
 	{ // Field #3 'Fork' (static)
 		val1 := t.Fork
@@ -7236,7 +7245,7 @@ func (t *BeaconState) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils
 		if val, err := dec.DecodeUint64(); err != nil {
 			return sszutils.ErrorWithPath(err, "Fork.Epoch")
 		} else {
-			val1.Epoch = val
+			val1.Epoch = Epoch(val)
 		}
 		t.Fork = val1
 	}
@@ -7253,13 +7262,13 @@ func (t *BeaconState) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils
 		if val, err := dec.DecodeUint64(); err != nil {
 			return sszutils.ErrorWithPath(err, "LatestBlockHeader.Slot")
 		} else {
-			val2.Slot = val
+			val2.Slot = Slot(val)
 		}
 		// Field #1 'ProposerIndex' (static)
 		if val, err := dec.DecodeUint64(); err != nil {
 			return sszutils.ErrorWithPath(err, "LatestBlockHeader.ProposerIndex")
 		} else {
-			val2.ProposerIndex = val
+			val2.ProposerIndex = ValidatorIndex(val)
 		}
 		// Field #2 'ParentRoot' (static)
 		if _, err = dec.DecodeBytes(val2.ParentRoot[:32]); err != nil {
@@ -7422,7 +7431,7 @@ func (t *BeaconState) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils
 		if val, err := dec.DecodeUint64(); err != nil {
 			return sszutils.ErrorWithPath(err, "PreviousJustifiedCheckpoint.Epoch")
 		} else {
-			val8.Epoch = val
+			val8.Epoch = Epoch(val)
 		}
 		// Field #1 'Root' (static)
 		if _, err = dec.DecodeBytes(val8.Root[:32]); err != nil {
@@ -7443,7 +7452,7 @@ func (t *BeaconState) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils
 		if val, err := dec.DecodeUint64(); err != nil {
 			return sszutils.ErrorWithPath(err, "CurrentJustifiedCheckpoint.Epoch")
 		} else {
-			val9.Epoch = val
+			val9.Epoch = Epoch(val)
 		}
 		// Field #1 'Root' (static)
 		if _, err = dec.DecodeBytes(val9.Root[:32]); err != nil {
@@ -7464,7 +7473,7 @@ func (t *BeaconState) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils
 		if val, err := dec.DecodeUint64(); err != nil {
 			return sszutils.ErrorWithPath(err, "FinalizedCheckpoint.Epoch")
 		} else {
-			val10.Epoch = val
+			val10.Epoch = Epoch(val)
 		}
 		// Field #1 'Root' (static)
 		if _, err = dec.DecodeBytes(val10.Root[:32]); err != nil {
@@ -7552,13 +7561,13 @@ func (t *BeaconState) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils
 	if val, err := dec.DecodeUint64(); err != nil {
 		return sszutils.ErrorWithPath(err, "NextWithdrawalIndex")
 	} else {
-		t.NextWithdrawalIndex = val
+		t.NextWithdrawalIndex = WithdrawalIndex(val)
 	}
 	// Field #26 'NextWithdrawalValidatorIndex' (static)
 	if val, err := dec.DecodeUint64(); err != nil {
 		return sszutils.ErrorWithPath(err, "NextWithdrawalValidatorIndex")
 	} else {
-		t.NextWithdrawalValidatorIndex = val
+		t.NextWithdrawalValidatorIndex = ValidatorIndex(val)
 	}
 	// Field #27 'HistoricalSummaries' (offset)
 	offset27, err := dec.DecodeOffset()
@@ -7683,7 +7692,7 @@ func (t *BeaconState) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils
 			if val, err := dec.DecodeUint64(); err != nil {
 				return sszutils.ErrorWithPathf(err, "Validators[%d].EffectiveBalance", idx1)
 			} else {
-				val19.EffectiveBalance = val
+				val19.EffectiveBalance = Gwei(val)
 			}
 			// Field #3 'Slashed' (static)
 			if val, err := dec.DecodeBool(); err != nil {
@@ -7695,25 +7704,25 @@ func (t *BeaconState) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils
 			if val, err := dec.DecodeUint64(); err != nil {
 				return sszutils.ErrorWithPathf(err, "Validators[%d].ActivationEligibilityEpoch", idx1)
 			} else {
-				val19.ActivationEligibilityEpoch = val
+				val19.ActivationEligibilityEpoch = Epoch(val)
 			}
 			// Field #5 'ActivationEpoch' (static)
 			if val, err := dec.DecodeUint64(); err != nil {
 				return sszutils.ErrorWithPathf(err, "Validators[%d].ActivationEpoch", idx1)
 			} else {
-				val19.ActivationEpoch = val
+				val19.ActivationEpoch = Epoch(val)
 			}
 			// Field #6 'ExitEpoch' (static)
 			if val, err := dec.DecodeUint64(); err != nil {
 				return sszutils.ErrorWithPathf(err, "Validators[%d].ExitEpoch", idx1)
 			} else {
-				val19.ExitEpoch = val
+				val19.ExitEpoch = Epoch(val)
 			}
 			// Field #7 'WithdrawableEpoch' (static)
 			if val, err := dec.DecodeUint64(); err != nil {
 				return sszutils.ErrorWithPathf(err, "Validators[%d].WithdrawableEpoch", idx1)
 			} else {
-				val19.WithdrawableEpoch = val
+				val19.WithdrawableEpoch = Epoch(val)
 			}
 			if dec.GetPosition() != startPos8+int(121*(idx1+1)) {
 				return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos8+int(121*(idx1+1))), "Validators[%d]", idx1)
@@ -8188,7 +8197,7 @@ func (t *BeaconState) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 		hh.PutBytes(t.GenesisValidatorsRoot[:32])
 	}
 	{ // Field #2 'Slot'
-		hh.PutUint64(t.Slot)
+		hh.PutUint64(uint64(t.Slot))
 	}
 	{ // Field #3 'Fork'
 		t := t.Fork
@@ -8203,7 +8212,7 @@ func (t *BeaconState) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 			hh.PutBytes(t.CurrentVersion[:4])
 		}
 		{ // Field #2 'Epoch'
-			hh.PutUint64(t.Epoch)
+			hh.PutUint64(uint64(t.Epoch))
 		}
 		hh.Merkleize(idx)
 	}
@@ -8214,10 +8223,10 @@ func (t *BeaconState) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 		}
 		idx := hh.StartTree(sszutils.TreeTypeNone)
 		{ // Field #0 'Slot'
-			hh.PutUint64(t.Slot)
+			hh.PutUint64(uint64(t.Slot))
 		}
 		{ // Field #1 'ProposerIndex'
-			hh.PutUint64(t.ProposerIndex)
+			hh.PutUint64(uint64(t.ProposerIndex))
 		}
 		{ // Field #2 'ParentRoot'
 			hh.PutBytes(t.ParentRoot[:32])
@@ -8237,12 +8246,12 @@ func (t *BeaconState) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr0)), "BlockRoots")
 		}
 		idx := hh.StartTree(sszutils.TreeTypeBinary)
-		var val1 *[32]byte
+		var val1 *Root
 		for idx1 := range int(expr0) {
 			if idx1 < vlen {
 				val1 = &t[idx1]
 			} else if idx1 == vlen {
-				val1 = new([32]byte)
+				val1 = new(Root)
 			}
 			hh.PutBytes(val1[:32])
 			if (idx1+1)%256 == 0 {
@@ -8258,12 +8267,12 @@ func (t *BeaconState) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr0)), "StateRoots")
 		}
 		idx := hh.StartTree(sszutils.TreeTypeBinary)
-		var val2 *[32]byte
+		var val2 *Root
 		for idx1 := range int(expr0) {
 			if idx1 < vlen {
 				val2 = &t[idx1]
 			} else if idx1 == vlen {
-				val2 = new([32]byte)
+				val2 = new(Root)
 			}
 			hh.PutBytes(val2[:32])
 			if (idx1+1)%256 == 0 {
@@ -8356,22 +8365,22 @@ func (t *BeaconState) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 				hh.PutBytes(t.WithdrawalCredentials[:32])
 			}
 			{ // Field #2 'EffectiveBalance'
-				hh.PutUint64(t.EffectiveBalance)
+				hh.PutUint64(uint64(t.EffectiveBalance))
 			}
 			{ // Field #3 'Slashed'
 				hh.PutBool(t.Slashed)
 			}
 			{ // Field #4 'ActivationEligibilityEpoch'
-				hh.PutUint64(t.ActivationEligibilityEpoch)
+				hh.PutUint64(uint64(t.ActivationEligibilityEpoch))
 			}
 			{ // Field #5 'ActivationEpoch'
-				hh.PutUint64(t.ActivationEpoch)
+				hh.PutUint64(uint64(t.ActivationEpoch))
 			}
 			{ // Field #6 'ExitEpoch'
-				hh.PutUint64(t.ExitEpoch)
+				hh.PutUint64(uint64(t.ExitEpoch))
 			}
 			{ // Field #7 'WithdrawableEpoch'
-				hh.PutUint64(t.WithdrawableEpoch)
+				hh.PutUint64(uint64(t.WithdrawableEpoch))
 			}
 			hh.Merkleize(idx)
 			if (idx1+1)%256 == 0 {
@@ -8398,12 +8407,12 @@ func (t *BeaconState) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr4)), "RANDAOMixes")
 		}
 		idx := hh.StartTree(sszutils.TreeTypeBinary)
-		var val3 *[32]byte
+		var val3 *Root
 		for idx1 := range int(expr4) {
 			if idx1 < vlen {
 				val3 = &t[idx1]
 			} else if idx1 == vlen {
-				val3 = new([32]byte)
+				val3 = new(Root)
 			}
 			hh.PutBytes(val3[:32])
 			if (idx1+1)%256 == 0 {
@@ -8419,14 +8428,14 @@ func (t *BeaconState) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr5)), "Slashings")
 		}
 		idx := hh.StartTree(sszutils.TreeTypeBinary)
-		var val4, val4Empty uint64
+		var val4, val4Empty Gwei
 		for idx1 := range int(expr5) {
 			if idx1 < vlen {
 				val4 = t[idx1]
 			} else if idx1 == vlen {
 				val4 = val4Empty
 			}
-			hh.AppendUint64(val4)
+			hh.AppendUint64(uint64(val4))
 			if (idx1+1)%256 == 0 {
 				hh.Collapse()
 			}
@@ -8470,7 +8479,7 @@ func (t *BeaconState) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 		}
 		idx := hh.StartTree(sszutils.TreeTypeNone)
 		{ // Field #0 'Epoch'
-			hh.PutUint64(t.Epoch)
+			hh.PutUint64(uint64(t.Epoch))
 		}
 		{ // Field #1 'Root'
 			hh.PutBytes(t.Root[:32])
@@ -8484,7 +8493,7 @@ func (t *BeaconState) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 		}
 		idx := hh.StartTree(sszutils.TreeTypeNone)
 		{ // Field #0 'Epoch'
-			hh.PutUint64(t.Epoch)
+			hh.PutUint64(uint64(t.Epoch))
 		}
 		{ // Field #1 'Root'
 			hh.PutBytes(t.Root[:32])
@@ -8498,7 +8507,7 @@ func (t *BeaconState) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 		}
 		idx := hh.StartTree(sszutils.TreeTypeNone)
 		{ // Field #0 'Epoch'
-			hh.PutUint64(t.Epoch)
+			hh.PutUint64(uint64(t.Epoch))
 		}
 		{ // Field #1 'Root'
 			hh.PutBytes(t.Root[:32])
@@ -8529,12 +8538,12 @@ func (t *BeaconState) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 				return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr6)), "CurrentSyncCommittee.Pubkeys")
 			}
 			idx := hh.StartTree(sszutils.TreeTypeBinary)
-			var val5 *[48]byte
+			var val5 *BLSPubKey
 			for idx1 := range int(expr6) {
 				if idx1 < vlen {
 					val5 = &t[idx1]
 				} else if idx1 == vlen {
-					val5 = new([48]byte)
+					val5 = new(BLSPubKey)
 				}
 				hh.PutBytes(val5[:48])
 				if (idx1+1)%256 == 0 {
@@ -8561,12 +8570,12 @@ func (t *BeaconState) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 				return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr6)), "NextSyncCommittee.Pubkeys")
 			}
 			idx := hh.StartTree(sszutils.TreeTypeBinary)
-			var val6 *[48]byte
+			var val6 *BLSPubKey
 			for idx1 := range int(expr6) {
 				if idx1 < vlen {
 					val6 = &t[idx1]
 				} else if idx1 == vlen {
-					val6 = new([48]byte)
+					val6 = new(BLSPubKey)
 				}
 				hh.PutBytes(val6[:48])
 				if (idx1+1)%256 == 0 {
@@ -8646,10 +8655,10 @@ func (t *BeaconState) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 		hh.Merkleize(idx)
 	}
 	{ // Field #25 'NextWithdrawalIndex'
-		hh.PutUint64(t.NextWithdrawalIndex)
+		hh.PutUint64(uint64(t.NextWithdrawalIndex))
 	}
 	{ // Field #26 'NextWithdrawalValidatorIndex'
-		hh.PutUint64(t.NextWithdrawalValidatorIndex)
+		hh.PutUint64(uint64(t.NextWithdrawalValidatorIndex))
 	}
 	{ // Field #27 'HistoricalSummaries'
 		t := t.HistoricalSummaries
@@ -8680,3 +8689,13875 @@ func (t *BeaconState) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.
 	hh.Merkleize(idx)
 	return nil
 }
+
+// MarshalSSZ marshals the *BlobSidecar to SSZ-encoded bytes.
+func (t *BlobSidecar) MarshalSSZ() ([]byte, error) {
+	return dynssz.GetGlobalDynSsz().MarshalSSZ(t)
+}
+
+// MarshalSSZTo marshals the *BlobSidecar to SSZ-encoded bytes, appending to the provided buffer.
+func (t *BlobSidecar) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	return t.MarshalSSZDyn(dynssz.GetGlobalDynSsz(), buf)
+}
+
+// MarshalSSZDyn marshals the *BlobSidecar to SSZ-encoded bytes using dynamic specifications.
+func (t *BlobSidecar) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst []byte, err error) {
+	dst = buf
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "FIELD_ELEMENTS_PER_BLOB*32", 131072)
+	if err != nil {
+		return dst, err
+	}
+	expr1, err := sszutils.ResolveSpecValueWithDefault(ds, "KZG_COMMITMENT_INCLUSION_PROOF_DEPTH", 17)
+	if err != nil {
+		return dst, err
+	}
+	if t == nil {
+		t = new(BlobSidecar)
+	}
+	{ // Static Field #0 'Index'
+		dst = binary.LittleEndian.AppendUint64(dst, t.Index)
+	}
+	{ // Static Field #1 'Blob'
+		vlen := len(t.Blob)
+		if vlen > int(expr0) {
+			return nil, sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr0)), "Blob")
+		}
+		dst = append(dst, t.Blob[:vlen]...)
+		if vlen < int(expr0) {
+			dst = sszutils.AppendZeroPadding(dst, (int(expr0)-vlen)*1)
+		}
+	}
+	{ // Static Field #2 'KZGCommitment'
+		dst = append(dst, t.KZGCommitment[:48]...)
+	}
+	{ // Static Field #3 'KZGProof'
+		dst = append(dst, t.KZGProof[:48]...)
+	}
+	{ // Static Field #4 'SignedBlockHeader'
+		t := t.SignedBlockHeader
+		if t == nil {
+			t = new(SignedBeaconBlockHeader)
+		}
+		{ // Static Field #0 'Message'
+			t := t.Message
+			if t == nil {
+				t = new(BeaconBlockHeader)
+			}
+			{ // Static Field #0 'Slot'
+				dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Slot))
+			}
+			{ // Static Field #1 'ProposerIndex'
+				dst = binary.LittleEndian.AppendUint64(dst, uint64(t.ProposerIndex))
+			}
+			{ // Static Field #2 'ParentRoot'
+				dst = append(dst, t.ParentRoot[:32]...)
+			}
+			{ // Static Field #3 'StateRoot'
+				dst = append(dst, t.StateRoot[:32]...)
+			}
+			{ // Static Field #4 'BodyRoot'
+				dst = append(dst, t.BodyRoot[:32]...)
+			}
+		}
+		{ // Static Field #1 'Signature'
+			dst = append(dst, t.Signature[:96]...)
+		}
+	}
+	{ // Static Field #5 'KZGCommitmentInclusionProof'
+		t := t.KZGCommitmentInclusionProof
+		vlen := len(t)
+		if vlen > int(expr1) {
+			return nil, sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr1)), "KZGCommitmentInclusionProof")
+		}
+		dst = sszutils.MarshalFixedBytesSlice(dst, t[:vlen])
+		if vlen < int(expr1) {
+			dst = sszutils.AppendZeroPadding(dst, (int(expr1)-vlen)*32)
+		}
+	}
+	return dst, nil
+}
+
+// MarshalSSZEncoder marshals the *BlobSidecar to the given SSZ encoder using dynamic specifications.
+func (t *BlobSidecar) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.Encoder) (err error) {
+	type encoderCtx struct {
+		ds    sszutils.DynamicSpecs
+		exprs [2]uint64
+	}
+	ctx := &encoderCtx{ds: ds}
+	ctx.exprs[0], err = sszutils.ResolveSpecValueWithDefault(ds, "FIELD_ELEMENTS_PER_BLOB*32", 131072)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[1], err = sszutils.ResolveSpecValueWithDefault(ds, "KZG_COMMITMENT_INCLUSION_PROOF_DEPTH", 17)
+	if err != nil {
+		return err
+	}
+	if t == nil {
+		t = new(BlobSidecar)
+	}
+	{ // Field #0 'Index'
+		enc.EncodeUint64(t.Index)
+	}
+	{ // Field #1 'Blob'
+		vlen := len(t.Blob)
+		if vlen > int(ctx.exprs[0]) {
+			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(ctx.exprs[0])), "Blob")
+		}
+		enc.EncodeBytes(t.Blob[:vlen])
+		if vlen < int(ctx.exprs[0]) {
+			enc.EncodeZeroPadding((int(ctx.exprs[0]) - vlen) * 1)
+		}
+	}
+	{ // Field #2 'KZGCommitment'
+		enc.EncodeBytes(t.KZGCommitment[:48])
+	}
+	{ // Field #3 'KZGProof'
+		enc.EncodeBytes(t.KZGProof[:48])
+	}
+	{ // Field #4 'SignedBlockHeader'
+		t := t.SignedBlockHeader
+		if t == nil {
+			t = new(SignedBeaconBlockHeader)
+		}
+		{ // Field #0 'Message'
+			t := t.Message
+			if t == nil {
+				t = new(BeaconBlockHeader)
+			}
+			{ // Field #0 'Slot'
+				enc.EncodeUint64(uint64(t.Slot))
+			}
+			{ // Field #1 'ProposerIndex'
+				enc.EncodeUint64(uint64(t.ProposerIndex))
+			}
+			{ // Field #2 'ParentRoot'
+				enc.EncodeBytes(t.ParentRoot[:32])
+			}
+			{ // Field #3 'StateRoot'
+				enc.EncodeBytes(t.StateRoot[:32])
+			}
+			{ // Field #4 'BodyRoot'
+				enc.EncodeBytes(t.BodyRoot[:32])
+			}
+		}
+		{ // Field #1 'Signature'
+			enc.EncodeBytes(t.Signature[:96])
+		}
+	}
+	{ // Field #5 'KZGCommitmentInclusionProof'
+		t := t.KZGCommitmentInclusionProof
+		vlen := len(t)
+		if vlen > int(ctx.exprs[1]) {
+			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(ctx.exprs[1])), "KZGCommitmentInclusionProof")
+		}
+		for idx1 := range vlen {
+			enc.EncodeBytes(t[idx1][:32])
+		}
+		if vlen < int(ctx.exprs[1]) {
+			enc.EncodeZeroPadding((int(ctx.exprs[1]) - vlen) * 32)
+		}
+	}
+	return nil
+}
+
+// UnmarshalSSZ unmarshals the *BlobSidecar from SSZ-encoded bytes.
+func (t *BlobSidecar) UnmarshalSSZ(buf []byte) (err error) {
+	return t.UnmarshalSSZDyn(dynssz.GetGlobalDynSsz(), buf)
+}
+
+// UnmarshalSSZDyn unmarshals the *BlobSidecar from SSZ-encoded bytes using dynamic specifications.
+func (t *BlobSidecar) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err error) {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "FIELD_ELEMENTS_PER_BLOB*32", 131072)
+	if err != nil {
+		return err
+	}
+	expr1, err := sszutils.ResolveSpecValueWithDefault(ds, "KZG_COMMITMENT_INCLUSION_PROOF_DEPTH", 17)
+	if err != nil {
+		return err
+	}
+	size1 := 1 * int(expr0)
+	size2 := 32 * int(expr1)
+	exproffset := 0
+	totalSize := size1+size2+312
+	buflen := len(buf)
+	if buflen < totalSize {
+		return sszutils.ErrFixedFieldsEOFFn(buflen, totalSize)
+	}
+	if buflen > totalSize {
+		return sszutils.ErrTrailingDataFn(buflen - totalSize)
+	}
+	{ // Field #0 'Index' (static)
+		buf := buf[0:8]
+		t.Index = binary.LittleEndian.Uint64(buf)
+	}
+	{ // Field #1 'Blob' (static)
+		buf := buf[8 : size1+8]
+		exproffset += int(size1)
+		t.Blob = sszutils.ExpandSlice(t.Blob, int(expr0))
+		copy(t.Blob[:], buf)
+	}
+	{ // Field #2 'KZGCommitment' (static)
+		buf := buf[exproffset+8 : exproffset+56]
+		copy(t.KZGCommitment[:], buf)
+	}
+	{ // Field #3 'KZGProof' (static)
+		buf := buf[exproffset+56 : exproffset+104]
+		copy(t.KZGProof[:], buf)
+	}
+	{ // Field #4 'SignedBlockHeader' (static)
+		buf := buf[exproffset+104 : exproffset+312]
+		val1 := t.SignedBlockHeader
+		if val1 == nil {
+			val1 = new(SignedBeaconBlockHeader)
+		}
+		buflen := len(buf)
+		if buflen < 208 {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(buflen, 208), "SignedBlockHeader")
+		}
+		if buflen > 208 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(buflen - 208), "SignedBlockHeader")
+		}
+		{ // Field #0 'Message' (static)
+			buf := buf[0:112]
+			val2 := val1.Message
+			if val2 == nil {
+				val2 = new(BeaconBlockHeader)
+			}
+			buflen := len(buf)
+			if buflen < 112 {
+				return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(buflen, 112), "SignedBlockHeader.Message")
+			}
+			if buflen > 112 {
+				return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(buflen - 112), "SignedBlockHeader.Message")
+			}
+			{ // Field #0 'Slot' (static)
+				buf := buf[0:8]
+				val2.Slot = Slot(binary.LittleEndian.Uint64(buf))
+			}
+			{ // Field #1 'ProposerIndex' (static)
+				buf := buf[8:16]
+				val2.ProposerIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf))
+			}
+			{ // Field #2 'ParentRoot' (static)
+				buf := buf[16:48]
+				copy(val2.ParentRoot[:], buf)
+			}
+			{ // Field #3 'StateRoot' (static)
+				buf := buf[48:80]
+				copy(val2.StateRoot[:], buf)
+			}
+			{ // Field #4 'BodyRoot' (static)
+				buf := buf[80:112]
+				copy(val2.BodyRoot[:], buf)
+			}
+			val1.Message = val2
+		}
+		{ // Field #1 'Signature' (static)
+			buf := buf[112:208]
+			copy(val1.Signature[:], buf)
+		}
+		t.SignedBlockHeader = val1
+	}
+	{ // Field #5 'KZGCommitmentInclusionProof' (static)
+		buf := buf[exproffset+312 : exproffset+size2+312]
+		exproffset += int(size2)
+		val3 := t.KZGCommitmentInclusionProof
+		val3 = sszutils.ExpandSlice(val3, int(expr1))
+		sszutils.UnmarshalFixedBytesSlice(val3[:int(expr1)], buf)
+		t.KZGCommitmentInclusionProof = val3
+	}
+	return nil
+}
+
+// UnmarshalSSZDecoder unmarshals the *BlobSidecar from the given SSZ decoder using dynamic specifications.
+func (t *BlobSidecar) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils.Decoder) (err error) {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "FIELD_ELEMENTS_PER_BLOB*32", 131072)
+	if err != nil {
+		return err
+	}
+	expr1, err := sszutils.ResolveSpecValueWithDefault(ds, "KZG_COMMITMENT_INCLUSION_PROOF_DEPTH", 17)
+	if err != nil {
+		return err
+	}
+	size1 := 1 * int(expr0)
+	size2 := 32 * int(expr1)
+	totalSize := size1+size2+312
+	maxOffset := uint32(dec.GetLength())
+	if maxOffset < uint32(totalSize) {
+		return sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(totalSize))
+	}
+	// Field #0 'Index' (static)
+	if val, err := dec.DecodeUint64(); err != nil {
+		return sszutils.ErrorWithPath(err, "Index")
+	} else {
+		t.Index = val
+	}
+	// Field #1 'Blob' (static)
+	t.Blob = sszutils.ExpandSlice(t.Blob, int(expr0))
+	if _, err = dec.DecodeBytes(t.Blob[:int(expr0)]); err != nil {
+		return err
+	}
+	// Field #2 'KZGCommitment' (static)
+	if _, err = dec.DecodeBytes(t.KZGCommitment[:48]); err != nil {
+		return err
+	}
+	// Field #3 'KZGProof' (static)
+	if _, err = dec.DecodeBytes(t.KZGProof[:48]); err != nil {
+		return err
+	}
+	{ // Field #4 'SignedBlockHeader' (static)
+		val1 := t.SignedBlockHeader
+		if val1 == nil {
+			val1 = new(SignedBeaconBlockHeader)
+		}
+		maxOffset := uint32(dec.GetLength())
+		if maxOffset < uint32(208) {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(208)), "SignedBlockHeader")
+		}
+		{ // Field #0 'Message' (static)
+			val2 := val1.Message
+			if val2 == nil {
+				val2 = new(BeaconBlockHeader)
+			}
+			maxOffset := uint32(dec.GetLength())
+			if maxOffset < uint32(112) {
+				return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(112)), "SignedBlockHeader.Message")
+			}
+			// Field #0 'Slot' (static)
+			if val, err := dec.DecodeUint64(); err != nil {
+				return sszutils.ErrorWithPath(err, "SignedBlockHeader.Message.Slot")
+			} else {
+				val2.Slot = Slot(val)
+			}
+			// Field #1 'ProposerIndex' (static)
+			if val, err := dec.DecodeUint64(); err != nil {
+				return sszutils.ErrorWithPath(err, "SignedBlockHeader.Message.ProposerIndex")
+			} else {
+				val2.ProposerIndex = ValidatorIndex(val)
+			}
+			// Field #2 'ParentRoot' (static)
+			if _, err = dec.DecodeBytes(val2.ParentRoot[:32]); err != nil {
+				return err
+			}
+			// Field #3 'StateRoot' (static)
+			if _, err = dec.DecodeBytes(val2.StateRoot[:32]); err != nil {
+				return err
+			}
+			// Field #4 'BodyRoot' (static)
+			if _, err = dec.DecodeBytes(val2.BodyRoot[:32]); err != nil {
+				return err
+			}
+			val1.Message = val2
+		}
+		// Field #1 'Signature' (static)
+		if _, err = dec.DecodeBytes(val1.Signature[:96]); err != nil {
+			return err
+		}
+		t.SignedBlockHeader = val1
+	}
+	{ // Field #5 'KZGCommitmentInclusionProof' (static)
+		val3 := t.KZGCommitmentInclusionProof
+		val3 = sszutils.ExpandSlice(val3, int(expr1))
+		startPos0 := dec.GetPosition()
+		for idx1 := range int(expr1) {
+			if _, err = dec.DecodeBytes(val3[idx1][:32]); err != nil {
+				return err
+			}
+			if dec.GetPosition() != startPos0+int(32*(idx1+1)) {
+				return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos0+int(32*(idx1+1))), "KZGCommitmentInclusionProof[%d]", idx1)
+			}
+		}
+		t.KZGCommitmentInclusionProof = val3
+	}
+	return nil
+}
+
+// SizeSSZ returns the SSZ encoded size of the *BlobSidecar.
+func (t *BlobSidecar) SizeSSZ() (size int) {
+	return t.SizeSSZDyn(dynssz.GetGlobalDynSsz())
+}
+
+// SizeSSZDyn returns the SSZ encoded size of the *BlobSidecar using dynamic specifications.
+func (t *BlobSidecar) SizeSSZDyn(ds sszutils.DynamicSpecs) (size int) {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "FIELD_ELEMENTS_PER_BLOB*32", 131072)
+	if err != nil {
+		return 0
+	}
+	expr1, err := sszutils.ResolveSpecValueWithDefault(ds, "KZG_COMMITMENT_INCLUSION_PROOF_DEPTH", 17)
+	if err != nil {
+		return 0
+	}
+	if t == nil {
+		t = new(BlobSidecar)
+	}
+	// Field #0 'Index' static (8 bytes)
+	// Field #2 'KZGCommitment' static (48 bytes)
+	// Field #3 'KZGProof' static (48 bytes)
+	// Field #4 'SignedBlockHeader' static (208 bytes)
+	size += 312
+	{ // Field #1 'Blob'
+		size += int(expr0)
+	}
+	{ // Field #5 'KZGCommitmentInclusionProof'
+		size += int(expr1) * 32
+	}
+	return size
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the *BlobSidecar.
+func (t *BlobSidecar) HashTreeRoot() (root [32]byte, err error) {
+	err = hasher.WithDefaultHasher(func(hh sszutils.HashWalker) (err error) {
+		err = t.HashTreeRootWith(hh)
+		if err == nil {
+			root, err = hh.HashRoot()
+		}
+		return
+	})
+	return
+}
+
+// HashTreeRootWith computes the SSZ hash tree root of the *BlobSidecar using the given hash walker.
+func (t *BlobSidecar) HashTreeRootWith(hh sszutils.HashWalker) error {
+	return t.HashTreeRootWithDyn(dynssz.GetGlobalDynSsz(), hh)
+}
+
+// HashTreeRootDyn computes the SSZ hash tree root of the *BlobSidecar using dynamic specifications.
+func (t *BlobSidecar) HashTreeRootDyn(ds sszutils.DynamicSpecs) (root [32]byte, err error) {
+	err = hasher.WithDefaultHasher(func(hh sszutils.HashWalker) (err error) {
+		err = t.HashTreeRootWithDyn(ds, hh)
+		if err == nil {
+			root, err = hh.HashRoot()
+		}
+		return
+	})
+	return
+}
+
+// HashTreeRootWithDyn computes the SSZ hash tree root of the *BlobSidecar using dynamic specifications and the given hash walker.
+func (t *BlobSidecar) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.HashWalker) error {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "FIELD_ELEMENTS_PER_BLOB*32", 131072)
+	if err != nil {
+		return err
+	}
+	expr1, err := sszutils.ResolveSpecValueWithDefault(ds, "KZG_COMMITMENT_INCLUSION_PROOF_DEPTH", 17)
+	if err != nil {
+		return err
+	}
+	if t == nil {
+		t = new(BlobSidecar)
+	}
+	idx := hh.StartTree(sszutils.TreeTypeNone)
+	{ // Field #0 'Index'
+		hh.PutUint64(t.Index)
+	}
+	{ // Field #1 'Blob'
+		vlen := len(t.Blob)
+		if vlen > int(expr0) {
+			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr0)), "Blob")
+		}
+		val := t.Blob[:]
+		if vlen < int(expr0) {
+			val = sszutils.AppendZeroPadding(val, (int(expr0)-vlen)*1)
+		}
+		hh.PutBytes(val[:int(expr0)])
+	}
+	{ // Field #2 'KZGCommitment'
+		hh.PutBytes(t.KZGCommitment[:48])
+	}
+	{ // Field #3 'KZGProof'
+		hh.PutBytes(t.KZGProof[:48])
+	}
+	{ // Field #4 'SignedBlockHeader'
+		t := t.SignedBlockHeader
+		if t == nil {
+			t = new(SignedBeaconBlockHeader)
+		}
+		idx := hh.StartTree(sszutils.TreeTypeNone)
+		{ // Field #0 'Message'
+			t := t.Message
+			if t == nil {
+				t = new(BeaconBlockHeader)
+			}
+			idx := hh.StartTree(sszutils.TreeTypeNone)
+			{ // Field #0 'Slot'
+				hh.PutUint64(uint64(t.Slot))
+			}
+			{ // Field #1 'ProposerIndex'
+				hh.PutUint64(uint64(t.ProposerIndex))
+			}
+			{ // Field #2 'ParentRoot'
+				hh.PutBytes(t.ParentRoot[:32])
+			}
+			{ // Field #3 'StateRoot'
+				hh.PutBytes(t.StateRoot[:32])
+			}
+			{ // Field #4 'BodyRoot'
+				hh.PutBytes(t.BodyRoot[:32])
+			}
+			hh.Merkleize(idx)
+		}
+		{ // Field #1 'Signature'
+			hh.PutBytes(t.Signature[:96])
+		}
+		hh.Merkleize(idx)
+	}
+	{ // Field #5 'KZGCommitmentInclusionProof'
+		t := t.KZGCommitmentInclusionProof
+		vlen := len(t)
+		if vlen > int(expr1) {
+			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr1)), "KZGCommitmentInclusionProof")
+		}
+		idx := hh.StartTree(sszutils.TreeTypeBinary)
+		var val1 *Root
+		for idx1 := range int(expr1) {
+			if idx1 < vlen {
+				val1 = &t[idx1]
+			} else if idx1 == vlen {
+				val1 = new(Root)
+			}
+			hh.PutBytes(val1[:32])
+			if (idx1+1)%256 == 0 {
+				hh.Collapse()
+			}
+		}
+		hh.Merkleize(idx)
+	}
+	hh.Merkleize(idx)
+	return nil
+}
+
+// MarshalSSZ marshals the *LightClientHeader to SSZ-encoded bytes.
+func (t *LightClientHeader) MarshalSSZ() ([]byte, error) {
+	return dynssz.GetGlobalDynSsz().MarshalSSZ(t)
+}
+
+// MarshalSSZTo marshals the *LightClientHeader to SSZ-encoded bytes, appending to the provided buffer.
+func (t *LightClientHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	return t.MarshalSSZDyn(dynssz.GetGlobalDynSsz(), buf)
+}
+
+// MarshalSSZDyn marshals the *LightClientHeader to SSZ-encoded bytes using dynamic specifications.
+func (t *LightClientHeader) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst []byte, err error) {
+	dst = buf
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_EXTRA_DATA_BYTES", 32)
+	if err != nil {
+		return dst, err
+	}
+	if t == nil {
+		t = new(LightClientHeader)
+	}
+	dstlen := len(dst)
+	{ // Static Field #0 'Beacon'
+		t := t.Beacon
+		if t == nil {
+			t = new(BeaconBlockHeader)
+		}
+		{ // Static Field #0 'Slot'
+			dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Slot))
+		}
+		{ // Static Field #1 'ProposerIndex'
+			dst = binary.LittleEndian.AppendUint64(dst, uint64(t.ProposerIndex))
+		}
+		{ // Static Field #2 'ParentRoot'
+			dst = append(dst, t.ParentRoot[:32]...)
+		}
+		{ // Static Field #3 'StateRoot'
+			dst = append(dst, t.StateRoot[:32]...)
+		}
+		{ // Static Field #4 'BodyRoot'
+			dst = append(dst, t.BodyRoot[:32]...)
+		}
+	}
+	// Offset Field #1 'Execution'
+	dst = append(dst, 0, 0, 0, 0)
+	{ // Static Field #2 'ExecutionBranch'
+		t := t.ExecutionBranch
+		vlen := len(t)
+		if vlen > 4 {
+			return nil, sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, 4), "ExecutionBranch")
+		}
+		dst = sszutils.MarshalFixedBytesSlice(dst, t[:vlen])
+		if vlen < 4 {
+			dst = sszutils.AppendZeroPadding(dst, (4-vlen)*32)
+		}
+	}
+	{ // Dynamic Field #1 'Execution'
+		binary.LittleEndian.PutUint32(dst[dstlen+112:], uint32(len(dst)-dstlen))
+		t := t.Execution
+		if t == nil {
+			t = new(ExecutionPayloadHeader)
+		}
+		dstlen := len(dst)
+		{ // Static Field #0 'ParentHash'
+			dst = append(dst, t.ParentHash[:32]...)
+		}
+		{ // Static Field #1 'FeeRecipient'
+			dst = append(dst, t.FeeRecipient[:20]...)
+		}
+		{ // Static Field #2 'StateRoot'
+			dst = append(dst, t.StateRoot[:32]...)
+		}
+		{ // Static Field #3 'ReceiptsRoot'
+			dst = append(dst, t.ReceiptsRoot[:32]...)
+		}
+		{ // Static Field #4 'LogsBloom'
+			dst = append(dst, t.LogsBloom[:256]...)
+		}
+		{ // Static Field #5 'PrevRandao'
+			dst = append(dst, t.PrevRandao[:32]...)
+		}
+		{ // Static Field #6 'BlockNumber'
+			dst = binary.LittleEndian.AppendUint64(dst, t.BlockNumber)
+		}
+		{ // Static Field #7 'GasLimit'
+			dst = binary.LittleEndian.AppendUint64(dst, t.GasLimit)
+		}
+		{ // Static Field #8 'GasUsed'
+			dst = binary.LittleEndian.AppendUint64(dst, t.GasUsed)
+		}
+		{ // Static Field #9 'Timestamp'
+			dst = binary.LittleEndian.AppendUint64(dst, t.Timestamp)
+		}
+		// Offset Field #10 'ExtraData'
+		dst = append(dst, 0, 0, 0, 0)
+		{ // Static Field #11 'BaseFeePerGas'
+			dst = append(dst, t.BaseFeePerGas[:32]...)
+		}
+		{ // Static Field #12 'BlockHash'
+			dst = append(dst, t.BlockHash[:32]...)
+		}
+		{ // Static Field #13 'TransactionsRoot'
+			dst = append(dst, t.TransactionsRoot[:32]...)
+		}
+		{ // Static Field #14 'WithdrawalsRoot'
+			dst = append(dst, t.WithdrawalsRoot[:32]...)
+		}
+		{ // Static Field #15 'BlobGasUsed'
+			dst = binary.LittleEndian.AppendUint64(dst, t.BlobGasUsed)
+		}
+		{ // Static Field #16 'ExcessBlobGas'
+			dst = binary.LittleEndian.AppendUint64(dst, t.ExcessBlobGas)
+		}
+		{ // Dynamic Field #10 'ExtraData'
+			binary.LittleEndian.PutUint32(dst[dstlen+436:], uint32(len(dst)-dstlen))
+			vlen := len(t.ExtraData)
+			if vlen > int(expr0) {
+				return nil, sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(expr0)), "Execution.ExtraData")
+			}
+			dst = append(dst, t.ExtraData[:]...)
+		}
+	}
+	return dst, nil
+}
+
+// MarshalSSZEncoder marshals the *LightClientHeader to the given SSZ encoder using dynamic specifications.
+func (t *LightClientHeader) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.Encoder) (err error) {
+	type encoderCtx struct {
+		ds      sszutils.DynamicSpecs
+		exprs   [1]uint64
+		sizeFn1 func(ctx *encoderCtx, t *ExecutionPayloadHeader) (size int)
+		sizeFn2 func(ctx *encoderCtx, t []byte) (size int)
+	}
+	ctx := &encoderCtx{ds: ds}
+	canSeek := enc.Seekable()
+	ctx.exprs[0], err = sszutils.ResolveSpecValueWithDefault(ds, "MAX_EXTRA_DATA_BYTES", 32)
+	if err != nil {
+		return err
+	}
+	// size for *ExecutionPayloadHeader
+	ctx.sizeFn1 = func(ctx *encoderCtx, t *ExecutionPayloadHeader) (size int) {
+		if t == nil {
+			t = new(ExecutionPayloadHeader)
+		}
+		// Field #0 'ParentHash' static (32 bytes)
+		// Field #1 'FeeRecipient' static (20 bytes)
+		// Field #2 'StateRoot' static (32 bytes)
+		// Field #3 'ReceiptsRoot' static (32 bytes)
+		// Field #4 'LogsBloom' static (256 bytes)
+		// Field #5 'PrevRandao' static (32 bytes)
+		// Field #6 'BlockNumber' static (8 bytes)
+		// Field #7 'GasLimit' static (8 bytes)
+		// Field #8 'GasUsed' static (8 bytes)
+		// Field #9 'Timestamp' static (8 bytes)
+		// Field #10 'ExtraData' offset (4 bytes)
+		// Field #11 'BaseFeePerGas' static (32 bytes)
+		// Field #12 'BlockHash' static (32 bytes)
+		// Field #13 'TransactionsRoot' static (32 bytes)
+		// Field #14 'WithdrawalsRoot' static (32 bytes)
+		// Field #15 'BlobGasUsed' static (8 bytes)
+		// Field #16 'ExcessBlobGas' static (8 bytes)
+		size += 584
+		{ // Dynamic field #10 'ExtraData'
+			size += ctx.sizeFn2(ctx, t.ExtraData)
+		}
+		return size
+	}
+	// size for []byte
+	ctx.sizeFn2 = func(ctx *encoderCtx, t []byte) (size int) {
+		size += len(t)
+		return size
+	}
+	if t == nil {
+		t = new(LightClientHeader)
+	}
+	dstlen := enc.GetPosition()
+	dynoff := uint32(244)
+	{ // Field #0 'Beacon'
+		t := t.Beacon
+		if t == nil {
+			t = new(BeaconBlockHeader)
+		}
+		{ // Field #0 'Slot'
+			enc.EncodeUint64(uint64(t.Slot))
+		}
+		{ // Field #1 'ProposerIndex'
+			enc.EncodeUint64(uint64(t.ProposerIndex))
+		}
+		{ // Field #2 'ParentRoot'
+			enc.EncodeBytes(t.ParentRoot[:32])
+		}
+		{ // Field #3 'StateRoot'
+			enc.EncodeBytes(t.StateRoot[:32])
+		}
+		{ // Field #4 'BodyRoot'
+			enc.EncodeBytes(t.BodyRoot[:32])
+		}
+	}
+	// Offset #1 'Execution'
+	offset1 := enc.GetPosition()
+	if canSeek {
+		enc.EncodeOffset(0)
+	} else {
+		enc.EncodeOffset(dynoff)
+		dynoff += uint32(ctx.sizeFn1(ctx, t.Execution))
+	}
+	{ // Field #2 'ExecutionBranch'
+		t := t.ExecutionBranch
+		vlen := len(t)
+		if vlen > 4 {
+			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, 4), "ExecutionBranch")
+		}
+		for idx1 := range vlen {
+			enc.EncodeBytes(t[idx1][:32])
+		}
+		if vlen < 4 {
+			enc.EncodeZeroPadding((4 - vlen) * 32)
+		}
+	}
+	{ // Dynamic Field #1 'Execution'
+		if canSeek {
+			enc.EncodeOffsetAt(offset1, uint32(enc.GetPosition()-dstlen))
+		}
+		t := t.Execution
+		if t == nil {
+			t = new(ExecutionPayloadHeader)
+		}
+		dstlen := enc.GetPosition()
+		dynoff := uint32(584)
+		{ // Field #0 'ParentHash'
+			enc.EncodeBytes(t.ParentHash[:32])
+		}
+		{ // Field #1 'FeeRecipient'
+			enc.EncodeBytes(t.FeeRecipient[:20])
+		}
+		{ // Field #2 'StateRoot'
+			enc.EncodeBytes(t.StateRoot[:32])
+		}
+		{ // Field #3 'ReceiptsRoot'
+			enc.EncodeBytes(t.ReceiptsRoot[:32])
+		}
+		{ // Field #4 'LogsBloom'
+			enc.EncodeBytes(t.LogsBloom[:256])
+		}
+		{ // Field #5 'PrevRandao'
+			enc.EncodeBytes(t.PrevRandao[:32])
+		}
+		{ // Field #6 'BlockNumber'
+			enc.EncodeUint64(t.BlockNumber)
+		}
+		{ // Field #7 'GasLimit'
+			enc.EncodeUint64(t.GasLimit)
+		}
+		{ // Field #8 'GasUsed'
+			enc.EncodeUint64(t.GasUsed)
+		}
+		{ // Field #9 'Timestamp'
+			enc.EncodeUint64(t.Timestamp)
+		}
+		// Offset #10 'ExtraData'
+		offset10 := enc.GetPosition()
+		if canSeek {
+			enc.EncodeOffset(0)
+		} else {
+			enc.EncodeOffset(dynoff)
+			dynoff += uint32(ctx.sizeFn2(ctx, t.ExtraData))
+		}
+		{ // Field #11 'BaseFeePerGas'
+			enc.EncodeBytes(t.BaseFeePerGas[:32])
+		}
+		{ // Field #12 'BlockHash'
+			enc.EncodeBytes(t.BlockHash[:32])
+		}
+		{ // Field #13 'TransactionsRoot'
+			enc.EncodeBytes(t.TransactionsRoot[:32])
+		}
+		{ // Field #14 'WithdrawalsRoot'
+			enc.EncodeBytes(t.WithdrawalsRoot[:32])
+		}
+		{ // Field #15 'BlobGasUsed'
+			enc.EncodeUint64(t.BlobGasUsed)
+		}
+		{ // Field #16 'ExcessBlobGas'
+			enc.EncodeUint64(t.ExcessBlobGas)
+		}
+		{ // Dynamic Field #10 'ExtraData'
+			if canSeek {
+				enc.EncodeOffsetAt(offset10, uint32(enc.GetPosition()-dstlen))
+			}
+			vlen := len(t.ExtraData)
+			if vlen > int(ctx.exprs[0]) {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[0])), "Execution.ExtraData")
+			}
+			enc.EncodeBytes(t.ExtraData[:])
+		}
+	}
+	return nil
+}
+
+// UnmarshalSSZ unmarshals the *LightClientHeader from SSZ-encoded bytes.
+func (t *LightClientHeader) UnmarshalSSZ(buf []byte) (err error) {
+	return t.UnmarshalSSZDyn(dynssz.GetGlobalDynSsz(), buf)
+}
+
+// UnmarshalSSZDyn unmarshals the *LightClientHeader from SSZ-encoded bytes using dynamic specifications.
+func (t *LightClientHeader) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err error) {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_EXTRA_DATA_BYTES", 32)
+	if err != nil {
+		return err
+	}
+	buflen := len(buf)
+	if buflen < 244 {
+		return sszutils.ErrFixedFieldsEOFFn(buflen, 244)
+	}
+	{ // Field #0 'Beacon' (static)
+		buf := buf[0:112]
+		val1 := t.Beacon
+		if val1 == nil {
+			val1 = new(BeaconBlockHeader)
+		}
+		buflen := len(buf)
+		if buflen < 112 {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(buflen, 112), "Beacon")
+		}
+		if buflen > 112 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(buflen - 112), "Beacon")
+		}
+		{ // Field #0 'Slot' (static)
+			buf := buf[0:8]
+			val1.Slot = Slot(binary.LittleEndian.Uint64(buf))
+		}
+		{ // Field #1 'ProposerIndex' (static)
+			buf := buf[8:16]
+			val1.ProposerIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf))
+		}
+		{ // Field #2 'ParentRoot' (static)
+			buf := buf[16:48]
+			copy(val1.ParentRoot[:], buf)
+		}
+		{ // Field #3 'StateRoot' (static)
+			buf := buf[48:80]
+			copy(val1.StateRoot[:], buf)
+		}
+		{ // Field #4 'BodyRoot' (static)
+			buf := buf[80:112]
+			copy(val1.BodyRoot[:], buf)
+		}
+		t.Beacon = val1
+	}
+	// Field #1 'Execution' (offset)
+	offset1 := int(binary.LittleEndian.Uint32(buf[112:116]))
+	if offset1 != 244 {
+		return sszutils.ErrorWithPath(sszutils.ErrFirstOffsetMismatchFn(offset1, 244), "Execution:o")
+	}
+	{ // Field #2 'ExecutionBranch' (static)
+		buf := buf[116:244]
+		val2 := t.ExecutionBranch
+		val2 = sszutils.ExpandSlice(val2, 4)
+		sszutils.UnmarshalFixedBytesSlice(val2[:4], buf)
+		t.ExecutionBranch = val2
+	}
+	{ // Field #1 'Execution' (dynamic)
+		buf := buf[offset1:]
+		val3 := t.Execution
+		if val3 == nil {
+			val3 = new(ExecutionPayloadHeader)
+		}
+		buflen := len(buf)
+		if buflen < 584 {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(buflen, 584), "Execution")
+		}
+		{ // Field #0 'ParentHash' (static)
+			buf := buf[0:32]
+			copy(val3.ParentHash[:], buf)
+		}
+		{ // Field #1 'FeeRecipient' (static)
+			buf := buf[32:52]
+			copy(val3.FeeRecipient[:], buf)
+		}
+		{ // Field #2 'StateRoot' (static)
+			buf := buf[52:84]
+			copy(val3.StateRoot[:], buf)
+		}
+		{ // Field #3 'ReceiptsRoot' (static)
+			buf := buf[84:116]
+			copy(val3.ReceiptsRoot[:], buf)
+		}
+		{ // Field #4 'LogsBloom' (static)
+			buf := buf[116:372]
+			copy(val3.LogsBloom[:], buf)
+		}
+		{ // Field #5 'PrevRandao' (static)
+			buf := buf[372:404]
+			copy(val3.PrevRandao[:], buf)
+		}
+		{ // Field #6 'BlockNumber' (static)
+			buf := buf[404:412]
+			val3.BlockNumber = binary.LittleEndian.Uint64(buf)
+		}
+		{ // Field #7 'GasLimit' (static)
+			buf := buf[412:420]
+			val3.GasLimit = binary.LittleEndian.Uint64(buf)
+		}
+		{ // Field #8 'GasUsed' (static)
+			buf := buf[420:428]
+			val3.GasUsed = binary.LittleEndian.Uint64(buf)
+		}
+		{ // Field #9 'Timestamp' (static)
+			buf := buf[428:436]
+			val3.Timestamp = binary.LittleEndian.Uint64(buf)
+		}
+		// Field #10 'ExtraData' (offset)
+		offset10 := int(binary.LittleEndian.Uint32(buf[436:440]))
+		if offset10 != 584 {
+			return sszutils.ErrorWithPath(sszutils.ErrFirstOffsetMismatchFn(offset10, 584), "Execution.ExtraData:o")
+		}
+		{ // Field #11 'BaseFeePerGas' (static)
+			buf := buf[440:472]
+			copy(val3.BaseFeePerGas[:], buf)
+		}
+		{ // Field #12 'BlockHash' (static)
+			buf := buf[472:504]
+			copy(val3.BlockHash[:], buf)
+		}
+		{ // Field #13 'TransactionsRoot' (static)
+			buf := buf[504:536]
+			copy(val3.TransactionsRoot[:], buf)
+		}
+		{ // Field #14 'WithdrawalsRoot' (static)
+			buf := buf[536:568]
+			copy(val3.WithdrawalsRoot[:], buf)
+		}
+		{ // Field #15 'BlobGasUsed' (static)
+			buf := buf[568:576]
+			val3.BlobGasUsed = binary.LittleEndian.Uint64(buf)
+		}
+		{ // Field #16 'ExcessBlobGas' (static)
+			buf := buf[576:584]
+			val3.ExcessBlobGas = binary.LittleEndian.Uint64(buf)
+		}
+		{ // Field #10 'ExtraData' (dynamic)
+			buf := buf[offset10:]
+			if len(buf) > int(expr0) {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(len(buf), int(expr0)), "Execution.ExtraData")
+			}
+			val3.ExtraData = sszutils.ExpandSlice(val3.ExtraData, len(buf))
+			copy(val3.ExtraData[:], buf)
+		}
+		t.Execution = val3
+	}
+	return nil
+}
+
+// UnmarshalSSZDecoder unmarshals the *LightClientHeader from the given SSZ decoder using dynamic specifications.
+func (t *LightClientHeader) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils.Decoder) (err error) {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_EXTRA_DATA_BYTES", 32)
+	if err != nil {
+		return err
+	}
+	maxOffset := uint32(dec.GetLength())
+	startPos0 := dec.GetPosition()
+	if maxOffset < uint32(244) {
+		return sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(244))
+	}
+	{ // Field #0 'Beacon' (static)
+		val1 := t.Beacon
+		if val1 == nil {
+			val1 = new(BeaconBlockHeader)
+		}
+		maxOffset := uint32(dec.GetLength())
+		if maxOffset < uint32(112) {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(112)), "Beacon")
+		}
+		// Field #0 'Slot' (static)
+		if val, err := dec.DecodeUint64(); err != nil {
+			return sszutils.ErrorWithPath(err, "Beacon.Slot")
+		} else {
+			val1.Slot = Slot(val)
+		}
+		// Field #1 'ProposerIndex' (static)
+		if val, err := dec.DecodeUint64(); err != nil {
+			return sszutils.ErrorWithPath(err, "Beacon.ProposerIndex")
+		} else {
+			val1.ProposerIndex = ValidatorIndex(val)
+		}
+		// Field #2 'ParentRoot' (static)
+		if _, err = dec.DecodeBytes(val1.ParentRoot[:32]); err != nil {
+			return err
+		}
+		// Field #3 'StateRoot' (static)
+		if _, err = dec.DecodeBytes(val1.StateRoot[:32]); err != nil {
+			return err
+		}
+		// Field #4 'BodyRoot' (static)
+		if _, err = dec.DecodeBytes(val1.BodyRoot[:32]); err != nil {
+			return err
+		}
+		t.Beacon = val1
+	}
+	// Field #1 'Execution' (offset)
+	offset1, err := dec.DecodeOffset()
+	if err != nil {
+		return sszutils.ErrorWithPath(err, "Execution")
+	}
+	if offset1 != uint32(244) {
+		return sszutils.ErrorWithPath(sszutils.ErrFirstOffsetMismatchFn(offset1, 244), "Execution")
+	}
+	{ // Field #2 'ExecutionBranch' (static)
+		val2 := t.ExecutionBranch
+		val2 = sszutils.ExpandSlice(val2, 4)
+		startPos1 := dec.GetPosition()
+		for idx1 := range 4 {
+			if _, err = dec.DecodeBytes(val2[idx1][:32]); err != nil {
+				return err
+			}
+			if dec.GetPosition() != startPos1+int(32*(idx1+1)) {
+				return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos1+int(32*(idx1+1))), "ExecutionBranch[%d]", idx1)
+			}
+		}
+		t.ExecutionBranch = val2
+	}
+	{ // Field #1 'Execution' (dynamic)
+		if dec.GetPosition() != startPos0+int(offset1) {
+			return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos0+int(offset1)), "Execution")
+		}
+		dec.PushLimit(int(maxOffset - offset1))
+		val3 := t.Execution
+		if val3 == nil {
+			val3 = new(ExecutionPayloadHeader)
+		}
+		maxOffset := uint32(dec.GetLength())
+		startPos2 := dec.GetPosition()
+		if maxOffset < uint32(584) {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(584)), "Execution")
+		}
+		// Field #0 'ParentHash' (static)
+		if _, err = dec.DecodeBytes(val3.ParentHash[:32]); err != nil {
+			return err
+		}
+		// Field #1 'FeeRecipient' (static)
+		if _, err = dec.DecodeBytes(val3.FeeRecipient[:20]); err != nil {
+			return err
+		}
+		// Field #2 'StateRoot' (static)
+		if _, err = dec.DecodeBytes(val3.StateRoot[:32]); err != nil {
+			return err
+		}
+		// Field #3 'ReceiptsRoot' (static)
+		if _, err = dec.DecodeBytes(val3.ReceiptsRoot[:32]); err != nil {
+			return err
+		}
+		// Field #4 'LogsBloom' (static)
+		if _, err = dec.DecodeBytes(val3.LogsBloom[:256]); err != nil {
+			return err
+		}
+		// Field #5 'PrevRandao' (static)
+		if _, err = dec.DecodeBytes(val3.PrevRandao[:32]); err != nil {
+			return err
+		}
+		// Field #6 'BlockNumber' (static)
+		if val, err := dec.DecodeUint64(); err != nil {
+			return sszutils.ErrorWithPath(err, "Execution.BlockNumber")
+		} else {
+			val3.BlockNumber = val
+		}
+		// Field #7 'GasLimit' (static)
+		if val, err := dec.DecodeUint64(); err != nil {
+			return sszutils.ErrorWithPath(err, "Execution.GasLimit")
+		} else {
+			val3.GasLimit = val
+		}
+		// Field #8 'GasUsed' (static)
+		if val, err := dec.DecodeUint64(); err != nil {
+			return sszutils.ErrorWithPath(err, "Execution.GasUsed")
+		} else {
+			val3.GasUsed = val
+		}
+		// Field #9 'Timestamp' (static)
+		if val, err := dec.DecodeUint64(); err != nil {
+			return sszutils.ErrorWithPath(err, "Execution.Timestamp")
+		} else {
+			val3.Timestamp = val
+		}
+		// Field #10 'ExtraData' (offset)
+		offset10, err := dec.DecodeOffset()
+		if err != nil {
+			return sszutils.ErrorWithPath(err, "Execution.ExtraData")
+		}
+		if offset10 != uint32(584) {
+			return sszutils.ErrorWithPath(sszutils.ErrFirstOffsetMismatchFn(offset10, 584), "Execution.ExtraData")
+		}
+		// Field #11 'BaseFeePerGas' (static)
+		if _, err = dec.DecodeBytes(val3.BaseFeePerGas[:32]); err != nil {
+			return err
+		}
+		// Field #12 'BlockHash' (static)
+		if _, err = dec.DecodeBytes(val3.BlockHash[:32]); err != nil {
+			return err
+		}
+		// Field #13 'TransactionsRoot' (static)
+		if _, err = dec.DecodeBytes(val3.TransactionsRoot[:32]); err != nil {
+			return err
+		}
+		// Field #14 'WithdrawalsRoot' (static)
+		if _, err = dec.DecodeBytes(val3.WithdrawalsRoot[:32]); err != nil {
+			return err
+		}
+		// Field #15 'BlobGasUsed' (static)
+		if val, err := dec.DecodeUint64(); err != nil {
+			return sszutils.ErrorWithPath(err, "Execution.BlobGasUsed")
+		} else {
+			val3.BlobGasUsed = val
+		}
+		// Field #16 'ExcessBlobGas' (static)
+		if val, err := dec.DecodeUint64(); err != nil {
+			return sszutils.ErrorWithPath(err, "Execution.ExcessBlobGas")
+		} else {
+			val3.ExcessBlobGas = val
+		}
+		{ // Field #10 'ExtraData' (dynamic)
+			if dec.GetPosition() != startPos2+int(offset10) {
+				return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos2+int(offset10)), "Execution.ExtraData")
+			}
+			dec.PushLimit(int(maxOffset - offset10))
+			val4 := val3.ExtraData
+			if dec.GetLength() > int(expr0) {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(dec.GetLength(), int(expr0)), "Execution.ExtraData")
+			}
+			listLen := dec.GetLength()
+			val4 = sszutils.ExpandSlice(val4, listLen)
+			if _, err = dec.DecodeBytes(val4[:listLen]); err != nil {
+				return sszutils.ErrorWithPath(err, "Execution.ExtraData")
+			}
+			if diff := dec.PopLimit(); diff != 0 {
+				return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "Execution.ExtraData")
+			}
+			val3.ExtraData = val4
+		}
+		if diff := dec.PopLimit(); diff != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "Execution")
+		}
+		t.Execution = val3
+	}
+	return nil
+}
+
+// SizeSSZ returns the SSZ encoded size of the *LightClientHeader.
+func (t *LightClientHeader) SizeSSZ() (size int) {
+	if t == nil {
+		t = new(LightClientHeader)
+	}
+	// Field #0 'Beacon' static (112 bytes)
+	// Field #1 'Execution' offset (4 bytes)
+	// Field #2 'ExecutionBranch' static (128 bytes)
+	size += 244
+	{ // Dynamic field #1 'Execution'
+		t := t.Execution
+		if t == nil {
+			t = new(ExecutionPayloadHeader)
+		}
+		// Field #0 'ParentHash' static (32 bytes)
+		// Field #1 'FeeRecipient' static (20 bytes)
+		// Field #2 'StateRoot' static (32 bytes)
+		// Field #3 'ReceiptsRoot' static (32 bytes)
+		// Field #4 'LogsBloom' static (256 bytes)
+		// Field #5 'PrevRandao' static (32 bytes)
+		// Field #6 'BlockNumber' static (8 bytes)
+		// Field #7 'GasLimit' static (8 bytes)
+		// Field #8 'GasUsed' static (8 bytes)
+		// Field #9 'Timestamp' static (8 bytes)
+		// Field #10 'ExtraData' offset (4 bytes)
+		// Field #11 'BaseFeePerGas' static (32 bytes)
+		// Field #12 'BlockHash' static (32 bytes)
+		// Field #13 'TransactionsRoot' static (32 bytes)
+		// Field #14 'WithdrawalsRoot' static (32 bytes)
+		// Field #15 'BlobGasUsed' static (8 bytes)
+		// Field #16 'ExcessBlobGas' static (8 bytes)
+		size += 584
+		{ // Dynamic field #10 'ExtraData'
+			size += len(t.ExtraData)
+		}
+	}
+	return size
+}
+
+// SizeSSZDyn returns the SSZ encoded size of the *LightClientHeader using dynamic specifications.
+func (t *LightClientHeader) SizeSSZDyn(_ sszutils.DynamicSpecs) (size int) {
+	return t.SizeSSZ()
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the *LightClientHeader.
+func (t *LightClientHeader) HashTreeRoot() (root [32]byte, err error) {
+	err = hasher.WithDefaultHasher(func(hh sszutils.HashWalker) (err error) {
+		err = t.HashTreeRootWith(hh)
+		if err == nil {
+			root, err = hh.HashRoot()
+		}
+		return
+	})
+	return
+}
+
+// HashTreeRootWith computes the SSZ hash tree root of the *LightClientHeader using the given hash walker.
+func (t *LightClientHeader) HashTreeRootWith(hh sszutils.HashWalker) error {
+	return t.HashTreeRootWithDyn(dynssz.GetGlobalDynSsz(), hh)
+}
+
+// HashTreeRootDyn computes the SSZ hash tree root of the *LightClientHeader using dynamic specifications.
+func (t *LightClientHeader) HashTreeRootDyn(ds sszutils.DynamicSpecs) (root [32]byte, err error) {
+	err = hasher.WithDefaultHasher(func(hh sszutils.HashWalker) (err error) {
+		err = t.HashTreeRootWithDyn(ds, hh)
+		if err == nil {
+			root, err = hh.HashRoot()
+		}
+		return
+	})
+	return
+}
+
+// HashTreeRootWithDyn computes the SSZ hash tree root of the *LightClientHeader using dynamic specifications and the given hash walker.
+func (t *LightClientHeader) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.HashWalker) error {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_EXTRA_DATA_BYTES", 32)
+	if err != nil {
+		return err
+	}
+	if t == nil {
+		t = new(LightClientHeader)
+	}
+	idx := hh.StartTree(sszutils.TreeTypeNone)
+	{ // Field #0 'Beacon'
+		t := t.Beacon
+		if t == nil {
+			t = new(BeaconBlockHeader)
+		}
+		idx := hh.StartTree(sszutils.TreeTypeNone)
+		{ // Field #0 'Slot'
+			hh.PutUint64(uint64(t.Slot))
+		}
+		{ // Field #1 'ProposerIndex'
+			hh.PutUint64(uint64(t.ProposerIndex))
+		}
+		{ // Field #2 'ParentRoot'
+			hh.PutBytes(t.ParentRoot[:32])
+		}
+		{ // Field #3 'StateRoot'
+			hh.PutBytes(t.StateRoot[:32])
+		}
+		{ // Field #4 'BodyRoot'
+			hh.PutBytes(t.BodyRoot[:32])
+		}
+		hh.Merkleize(idx)
+	}
+	{ // Field #1 'Execution'
+		t := t.Execution
+		if t == nil {
+			t = new(ExecutionPayloadHeader)
+		}
+		idx := hh.StartTree(sszutils.TreeTypeNone)
+		{ // Field #0 'ParentHash'
+			hh.PutBytes(t.ParentHash[:32])
+		}
+		{ // Field #1 'FeeRecipient'
+			hh.PutBytes(t.FeeRecipient[:20])
+		}
+		{ // Field #2 'StateRoot'
+			hh.PutBytes(t.StateRoot[:32])
+		}
+		{ // Field #3 'ReceiptsRoot'
+			hh.PutBytes(t.ReceiptsRoot[:32])
+		}
+		{ // Field #4 'LogsBloom'
+			hh.PutBytes(t.LogsBloom[:256])
+		}
+		{ // Field #5 'PrevRandao'
+			hh.PutBytes(t.PrevRandao[:32])
+		}
+		{ // Field #6 'BlockNumber'
+			hh.PutUint64(t.BlockNumber)
+		}
+		{ // Field #7 'GasLimit'
+			hh.PutUint64(t.GasLimit)
+		}
+		{ // Field #8 'GasUsed'
+			hh.PutUint64(t.GasUsed)
+		}
+		{ // Field #9 'Timestamp'
+			hh.PutUint64(t.Timestamp)
+		}
+		{ // Field #10 'ExtraData'
+			vlen := uint64(len(t.ExtraData))
+			if vlen > expr0 {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, expr0), "Execution.ExtraData")
+			}
+			idx := hh.StartTree(sszutils.TreeTypeBinary)
+			hh.AppendBytes32(t.ExtraData[:])
+			hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr0, vlen, 1))
+		}
+		{ // Field #11 'BaseFeePerGas'
+			hh.PutBytes(t.BaseFeePerGas[:32])
+		}
+		{ // Field #12 'BlockHash'
+			hh.PutBytes(t.BlockHash[:32])
+		}
+		{ // Field #13 'TransactionsRoot'
+			hh.PutBytes(t.TransactionsRoot[:32])
+		}
+		{ // Field #14 'WithdrawalsRoot'
+			hh.PutBytes(t.WithdrawalsRoot[:32])
+		}
+		{ // Field #15 'BlobGasUsed'
+			hh.PutUint64(t.BlobGasUsed)
+		}
+		{ // Field #16 'ExcessBlobGas'
+			hh.PutUint64(t.ExcessBlobGas)
+		}
+		hh.Merkleize(idx)
+	}
+	{ // Field #2 'ExecutionBranch'
+		t := t.ExecutionBranch
+		vlen := len(t)
+		if vlen > 4 {
+			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, 4), "ExecutionBranch")
+		}
+		idx := hh.StartTree(sszutils.TreeTypeBinary)
+		var val1 *Root
+		for idx1 := range 4 {
+			if idx1 < vlen {
+				val1 = &t[idx1]
+			} else if idx1 == vlen {
+				val1 = new(Root)
+			}
+			hh.PutBytes(val1[:32])
+			if (idx1+1)%256 == 0 {
+				hh.Collapse()
+			}
+		}
+		hh.Merkleize(idx)
+	}
+	hh.Merkleize(idx)
+	return nil
+}
+
+// MarshalSSZ marshals the *LightClientBootstrap to SSZ-encoded bytes.
+func (t *LightClientBootstrap) MarshalSSZ() ([]byte, error) {
+	return dynssz.GetGlobalDynSsz().MarshalSSZ(t)
+}
+
+// MarshalSSZTo marshals the *LightClientBootstrap to SSZ-encoded bytes, appending to the provided buffer.
+func (t *LightClientBootstrap) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	return t.MarshalSSZDyn(dynssz.GetGlobalDynSsz(), buf)
+}
+
+// MarshalSSZDyn marshals the *LightClientBootstrap to SSZ-encoded bytes using dynamic specifications.
+func (t *LightClientBootstrap) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst []byte, err error) {
+	dst = buf
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE", 512)
+	if err != nil {
+		return dst, err
+	}
+	if t == nil {
+		t = new(LightClientBootstrap)
+	}
+	dstlen := len(dst)
+	// Offset Field #0 'Header'
+	dst = append(dst, 0, 0, 0, 0)
+	{ // Static Field #1 'CurrentSyncCommittee'
+		t := t.CurrentSyncCommittee
+		if t == nil {
+			t = new(SyncCommittee)
+		}
+		{ // Static Field #0 'Pubkeys'
+			t := t.Pubkeys
+			vlen := len(t)
+			if vlen > int(expr0) {
+				return nil, sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr0)), "CurrentSyncCommittee.Pubkeys")
+			}
+			dst = sszutils.MarshalFixedBytesSlice(dst, t[:vlen])
+			if vlen < int(expr0) {
+				dst = sszutils.AppendZeroPadding(dst, (int(expr0)-vlen)*48)
+			}
+		}
+		{ // Static Field #1 'AggregatePubkey'
+			dst = append(dst, t.AggregatePubkey[:48]...)
+		}
+	}
+	{ // Static Field #2 'CurrentSyncCommitteeBranch'
+		t := t.CurrentSyncCommitteeBranch
+		vlen := len(t)
+		if vlen > 5 {
+			return nil, sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, 5), "CurrentSyncCommitteeBranch")
+		}
+		dst = sszutils.MarshalFixedBytesSlice(dst, t[:vlen])
+		if vlen < 5 {
+			dst = sszutils.AppendZeroPadding(dst, (5-vlen)*32)
+		}
+	}
+	{ // Dynamic Field #0 'Header'
+		binary.LittleEndian.PutUint32(dst[dstlen:], uint32(len(dst)-dstlen))
+		t := t.Header
+		if t == nil {
+			t = new(LightClientHeader)
+		}
+		if dst, err = t.MarshalSSZTo(dst); err != nil {
+			return nil, sszutils.ErrorWithPath(err, "Header")
+		}
+	}
+	return dst, nil
+}
+
+// MarshalSSZEncoder marshals the *LightClientBootstrap to the given SSZ encoder using dynamic specifications.
+func (t *LightClientBootstrap) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.Encoder) (err error) {
+	type encoderCtx struct {
+		ds      sszutils.DynamicSpecs
+		exprs   [1]uint64
+		sizeFn1 func(ctx *encoderCtx, t *LightClientHeader) (size int)
+	}
+	ctx := &encoderCtx{ds: ds}
+	canSeek := enc.Seekable()
+	ctx.exprs[0], err = sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE", 512)
+	if err != nil {
+		return err
+	}
+	// size for *LightClientHeader
+	ctx.sizeFn1 = func(ctx *encoderCtx, t *LightClientHeader) (size int) {
+		size += t.SizeSSZDyn(ds)
+		return size
+	}
+	size2 := 48 * int(ctx.exprs[0])
+	size1 := size2 + 48 // size expression for '*SyncCommittee'
+	if t == nil {
+		t = new(LightClientBootstrap)
+	}
+	dstlen := enc.GetPosition()
+	dynoff := uint32(size1 + 164)
+	// Offset #0 'Header'
+	offset0 := enc.GetPosition()
+	if canSeek {
+		enc.EncodeOffset(0)
+	} else {
+		enc.EncodeOffset(dynoff)
+		dynoff += uint32(ctx.sizeFn1(ctx, t.Header))
+	}
+	{ // Field #1 'CurrentSyncCommittee'
+		t := t.CurrentSyncCommittee
+		if t == nil {
+			t = new(SyncCommittee)
+		}
+		{ // Field #0 'Pubkeys'
+			t := t.Pubkeys
+			vlen := len(t)
+			if vlen > int(ctx.exprs[0]) {
+				return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(ctx.exprs[0])), "CurrentSyncCommittee.Pubkeys")
+			}
+			for idx1 := range vlen {
+				enc.EncodeBytes(t[idx1][:48])
+			}
+			if vlen < int(ctx.exprs[0]) {
+				enc.EncodeZeroPadding((int(ctx.exprs[0]) - vlen) * 48)
+			}
+		}
+		{ // Field #1 'AggregatePubkey'
+			enc.EncodeBytes(t.AggregatePubkey[:48])
+		}
+	}
+	{ // Field #2 'CurrentSyncCommitteeBranch'
+		t := t.CurrentSyncCommitteeBranch
+		vlen := len(t)
+		if vlen > 5 {
+			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, 5), "CurrentSyncCommitteeBranch")
+		}
+		for idx1 := range vlen {
+			enc.EncodeBytes(t[idx1][:32])
+		}
+		if vlen < 5 {
+			enc.EncodeZeroPadding((5 - vlen) * 32)
+		}
+	}
+	{ // Dynamic Field #0 'Header'
+		if canSeek {
+			enc.EncodeOffsetAt(offset0, uint32(enc.GetPosition()-dstlen))
+		}
+		t := t.Header
+		if t == nil {
+			t = new(LightClientHeader)
+		}
+		if buf, err := t.MarshalSSZTo(enc.GetBuffer()); err != nil {
+			return sszutils.ErrorWithPath(err, "Header")
+		} else {
+			enc.SetBuffer(buf)
+		}
+	}
+	return nil
+}
+
+// UnmarshalSSZ unmarshals the *LightClientBootstrap from SSZ-encoded bytes.
+func (t *LightClientBootstrap) UnmarshalSSZ(buf []byte) (err error) {
+	return t.UnmarshalSSZDyn(dynssz.GetGlobalDynSsz(), buf)
+}
+
+// UnmarshalSSZDyn unmarshals the *LightClientBootstrap from SSZ-encoded bytes using dynamic specifications.
+func (t *LightClientBootstrap) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err error) {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE", 512)
+	if err != nil {
+		return err
+	}
+	size2 := 48 * int(expr0)
+	size1 := size2 + 48 // size expression for '*SyncCommittee'
+	exproffset := 0
+	totalSize := size1+164
+	buflen := len(buf)
+	if buflen < totalSize {
+		return sszutils.ErrFixedFieldsEOFFn(buflen, totalSize)
+	}
+	// Field #0 'Header' (offset)
+	offset0 := int(binary.LittleEndian.Uint32(buf[0:4]))
+	if offset0 != totalSize {
+		return sszutils.ErrorWithPath(sszutils.ErrFirstOffsetMismatchFn(offset0, totalSize), "Header:o")
+	}
+	{ // Field #1 'CurrentSyncCommittee' (static)
+		buf := buf[4 : size1+4]
+		exproffset += int(size1)
+		val1 := t.CurrentSyncCommittee
+		if val1 == nil {
+			val1 = new(SyncCommittee)
+		}
+		exproffset := 0
+		totalSize := size2+48
+		buflen := len(buf)
+		if buflen < totalSize {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(buflen, totalSize), "CurrentSyncCommittee")
+		}
+		if buflen > totalSize {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(buflen - totalSize), "CurrentSyncCommittee")
+		}
+		{ // Field #0 'Pubkeys' (static)
+			buf := buf[0 : size2+0]
+			exproffset += int(size2)
+			val2 := val1.Pubkeys
+			val2 = sszutils.ExpandSlice(val2, int(expr0))
+			sszutils.UnmarshalFixedBytesSlice(val2[:int(expr0)], buf)
+			val1.Pubkeys = val2
+		}
+		{ // Field #1 'AggregatePubkey' (static)
+			buf := buf[exproffset+0 : exproffset+48]
+			copy(val1.AggregatePubkey[:], buf)
+		}
+		t.CurrentSyncCommittee = val1
+	}
+	{ // Field #2 'CurrentSyncCommitteeBranch' (static)
+		buf := buf[exproffset+4 : exproffset+164]
+		val3 := t.CurrentSyncCommitteeBranch
+		val3 = sszutils.ExpandSlice(val3, 5)
+		sszutils.UnmarshalFixedBytesSlice(val3[:5], buf)
+		t.CurrentSyncCommitteeBranch = val3
+	}
+	{ // Field #0 'Header' (dynamic)
+		buf := buf[offset0:]
+		if t.Header == nil {
+			t.Header = new(LightClientHeader)
+		}
+		if err = t.Header.UnmarshalSSZ(buf); err != nil {
+			return sszutils.ErrorWithPath(err, "Header")
+		}
+	}
+	return nil
+}
+
+// UnmarshalSSZDecoder unmarshals the *LightClientBootstrap from the given SSZ decoder using dynamic specifications.
+func (t *LightClientBootstrap) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils.Decoder) (err error) {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE", 512)
+	if err != nil {
+		return err
+	}
+	size2 := 48 * int(expr0)
+	size1 := size2 + 48 // size expression for '*SyncCommittee'
+	totalSize := size1+164
+	maxOffset := uint32(dec.GetLength())
+	startPos0 := dec.GetPosition()
+	if maxOffset < uint32(totalSize) {
+		return sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(totalSize))
+	}
+	// Field #0 'Header' (offset)
+	offset0, err := dec.DecodeOffset()
+	if err != nil {
+		return sszutils.ErrorWithPath(err, "Header")
+	}
+	if offset0 != uint32(totalSize) {
+		return sszutils.ErrorWithPath(sszutils.ErrFirstOffsetMismatchFn(offset0, totalSize), "Header")
+	}
+	{ // Field #1 'CurrentSyncCommittee' (static)
+		val1 := t.CurrentSyncCommittee
+		if val1 == nil {
+			val1 = new(SyncCommittee)
+		}
+		totalSize := size2+48
+		maxOffset := uint32(dec.GetLength())
+		if maxOffset < uint32(totalSize) {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(totalSize)), "CurrentSyncCommittee")
+		}
+		{ // Field #0 'Pubkeys' (static)
+			val2 := val1.Pubkeys
+			val2 = sszutils.ExpandSlice(val2, int(expr0))
+			startPos1 := dec.GetPosition()
+			for idx1 := range int(expr0) {
+				if _, err = dec.DecodeBytes(val2[idx1][:48]); err != nil {
+					return err
+				}
+				if dec.GetPosition() != startPos1+int(48*(idx1+1)) {
+					return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos1+int(48*(idx1+1))), "CurrentSyncCommittee.Pubkeys[%d]", idx1)
+				}
+			}
+			val1.Pubkeys = val2
+		}
+		// Field #1 'AggregatePubkey' (static)
+		if _, err = dec.DecodeBytes(val1.AggregatePubkey[:48]); err != nil {
+			return err
+		}
+		t.CurrentSyncCommittee = val1
+	}
+	{ // Field #2 'CurrentSyncCommitteeBranch' (static)
+		val3 := t.CurrentSyncCommitteeBranch
+		val3 = sszutils.ExpandSlice(val3, 5)
+		startPos2 := dec.GetPosition()
+		for idx1 := range 5 {
+			if _, err = dec.DecodeBytes(val3[idx1][:32]); err != nil {
+				return err
+			}
+			if dec.GetPosition() != startPos2+int(32*(idx1+1)) {
+				return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos2+int(32*(idx1+1))), "CurrentSyncCommitteeBranch[%d]", idx1)
+			}
+		}
+		t.CurrentSyncCommitteeBranch = val3
+	}
+	{ // Field #0 'Header' (dynamic)
+		if dec.GetPosition() != startPos0+int(offset0) {
+			return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos0+int(offset0)), "Header")
+		}
+		dec.PushLimit(int(maxOffset - offset0))
+		val4 := t.Header
+		if val4 == nil {
+			val4 = new(LightClientHeader)
+		}
+		if err = val4.UnmarshalSSZDecoder(ds, dec); err != nil {
+			return sszutils.ErrorWithPath(err, "Header")
+		}
+		if diff := dec.PopLimit(); diff != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "Header")
+		}
+		t.Header = val4
+	}
+	return nil
+}
+
+// SizeSSZ returns the SSZ encoded size of the *LightClientBootstrap.
+func (t *LightClientBootstrap) SizeSSZ() (size int) {
+	return t.SizeSSZDyn(dynssz.GetGlobalDynSsz())
+}
+
+// SizeSSZDyn returns the SSZ encoded size of the *LightClientBootstrap using dynamic specifications.
+func (t *LightClientBootstrap) SizeSSZDyn(ds sszutils.DynamicSpecs) (size int) {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE", 512)
+	if err != nil {
+		return 0
+	}
+	if t == nil {
+		t = new(LightClientBootstrap)
+	}
+	// Field #0 'Header' offset (4 bytes)
+	// Field #2 'CurrentSyncCommitteeBranch' static (160 bytes)
+	size += 164
+	{ // Field #1 'CurrentSyncCommittee'
+		t := t.CurrentSyncCommittee
+		if t == nil {
+			t = new(SyncCommittee)
+		}
+		// Field #1 'AggregatePubkey' static (48 bytes)
+		size += 48
+		{ // Field #0 'Pubkeys'
+			size += int(expr0) * 48
+		}
+	}
+	{ // Dynamic field #0 'Header'
+		size += t.Header.SizeSSZDyn(ds)
+	}
+	return size
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the *LightClientBootstrap.
+func (t *LightClientBootstrap) HashTreeRoot() (root [32]byte, err error) {
+	err = hasher.WithDefaultHasher(func(hh sszutils.HashWalker) (err error) {
+		err = t.HashTreeRootWith(hh)
+		if err == nil {
+			root, err = hh.HashRoot()
+		}
+		return
+	})
+	return
+}
+
+// HashTreeRootWith computes the SSZ hash tree root of the *LightClientBootstrap using the given hash walker.
+func (t *LightClientBootstrap) HashTreeRootWith(hh sszutils.HashWalker) error {
+	return t.HashTreeRootWithDyn(dynssz.GetGlobalDynSsz(), hh)
+}
+
+// HashTreeRootDyn computes the SSZ hash tree root of the *LightClientBootstrap using dynamic specifications.
+func (t *LightClientBootstrap) HashTreeRootDyn(ds sszutils.DynamicSpecs) (root [32]byte, err error) {
+	err = hasher.WithDefaultHasher(func(hh sszutils.HashWalker) (err error) {
+		err = t.HashTreeRootWithDyn(ds, hh)
+		if err == nil {
+			root, err = hh.HashRoot()
+		}
+		return
+	})
+	return
+}
+
+// HashTreeRootWithDyn computes the SSZ hash tree root of the *LightClientBootstrap using dynamic specifications and the given hash walker.
+func (t *LightClientBootstrap) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.HashWalker) error {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE", 512)
+	if err != nil {
+		return err
+	}
+	if t == nil {
+		t = new(LightClientBootstrap)
+	}
+	idx := hh.StartTree(sszutils.TreeTypeNone)
+	{ // Field #0 'Header'
+		t := t.Header
+		if t == nil {
+			t = new(LightClientHeader)
+		}
+		if err := t.HashTreeRootWithDyn(ds, hh); err != nil {
+			return sszutils.ErrorWithPath(err, "Header")
+		}
+	}
+	{ // Field #1 'CurrentSyncCommittee'
+		t := t.CurrentSyncCommittee
+		if t == nil {
+			t = new(SyncCommittee)
+		}
+		idx := hh.StartTree(sszutils.TreeTypeNone)
+		{ // Field #0 'Pubkeys'
+			t := t.Pubkeys
+			vlen := len(t)
+			if vlen > int(expr0) {
+				return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr0)), "CurrentSyncCommittee.Pubkeys")
+			}
+			idx := hh.StartTree(sszutils.TreeTypeBinary)
+			var val1 *BLSPubKey
+			for idx1 := range int(expr0) {
+				if idx1 < vlen {
+					val1 = &t[idx1]
+				} else if idx1 == vlen {
+					val1 = new(BLSPubKey)
+				}
+				hh.PutBytes(val1[:48])
+				if (idx1+1)%256 == 0 {
+					hh.Collapse()
+				}
+			}
+			hh.Merkleize(idx)
+		}
+		{ // Field #1 'AggregatePubkey'
+			hh.PutBytes(t.AggregatePubkey[:48])
+		}
+		hh.Merkleize(idx)
+	}
+	{ // Field #2 'CurrentSyncCommitteeBranch'
+		t := t.CurrentSyncCommitteeBranch
+		vlen := len(t)
+		if vlen > 5 {
+			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, 5), "CurrentSyncCommitteeBranch")
+		}
+		idx := hh.StartTree(sszutils.TreeTypeBinary)
+		var val2 *Root
+		for idx1 := range 5 {
+			if idx1 < vlen {
+				val2 = &t[idx1]
+			} else if idx1 == vlen {
+				val2 = new(Root)
+			}
+			hh.PutBytes(val2[:32])
+			if (idx1+1)%256 == 0 {
+				hh.Collapse()
+			}
+		}
+		hh.Merkleize(idx)
+	}
+	hh.Merkleize(idx)
+	return nil
+}
+
+// MarshalSSZ marshals the *LightClientUpdate to SSZ-encoded bytes.
+func (t *LightClientUpdate) MarshalSSZ() ([]byte, error) {
+	return dynssz.GetGlobalDynSsz().MarshalSSZ(t)
+}
+
+// MarshalSSZTo marshals the *LightClientUpdate to SSZ-encoded bytes, appending to the provided buffer.
+func (t *LightClientUpdate) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	return t.MarshalSSZDyn(dynssz.GetGlobalDynSsz(), buf)
+}
+
+// MarshalSSZDyn marshals the *LightClientUpdate to SSZ-encoded bytes using dynamic specifications.
+func (t *LightClientUpdate) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst []byte, err error) {
+	dst = buf
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE", 512)
+	if err != nil {
+		return dst, err
+	}
+	expr1, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE/8", 64)
+	if err != nil {
+		return dst, err
+	}
+	if t == nil {
+		t = new(LightClientUpdate)
+	}
+	dstlen := len(dst)
+	// Offset Field #0 'AttestedHeader'
+	dst = append(dst, 0, 0, 0, 0)
+	{ // Static Field #1 'NextSyncCommittee'
+		t := t.NextSyncCommittee
+		if t == nil {
+			t = new(SyncCommittee)
+		}
+		{ // Static Field #0 'Pubkeys'
+			t := t.Pubkeys
+			vlen := len(t)
+			if vlen > int(expr0) {
+				return nil, sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr0)), "NextSyncCommittee.Pubkeys")
+			}
+			dst = sszutils.MarshalFixedBytesSlice(dst, t[:vlen])
+			if vlen < int(expr0) {
+				dst = sszutils.AppendZeroPadding(dst, (int(expr0)-vlen)*48)
+			}
+		}
+		{ // Static Field #1 'AggregatePubkey'
+			dst = append(dst, t.AggregatePubkey[:48]...)
+		}
+	}
+	{ // Static Field #2 'NextSyncCommitteeBranch'
+		t := t.NextSyncCommitteeBranch
+		vlen := len(t)
+		if vlen > 5 {
+			return nil, sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, 5), "NextSyncCommitteeBranch")
+		}
+		dst = sszutils.MarshalFixedBytesSlice(dst, t[:vlen])
+		if vlen < 5 {
+			dst = sszutils.AppendZeroPadding(dst, (5-vlen)*32)
+		}
+	}
+	// Offset Field #3 'FinalizedHeader'
+	offset3 := len(dst)
+	dst = append(dst, 0, 0, 0, 0)
+	{ // Static Field #4 'FinalityBranch'
+		t := t.FinalityBranch
+		vlen := len(t)
+		if vlen > 6 {
+			return nil, sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, 6), "FinalityBranch")
+		}
+		dst = sszutils.MarshalFixedBytesSlice(dst, t[:vlen])
+		if vlen < 6 {
+			dst = sszutils.AppendZeroPadding(dst, (6-vlen)*32)
+		}
+	}
+	{ // Static Field #5 'SyncAggregate'
+		t := t.SyncAggregate
+		if t == nil {
+			t = new(SyncAggregate)
+		}
+		{ // Static Field #0 'SyncCommitteeBits'
+			vlen := len(t.SyncCommitteeBits)
+			if vlen > int(expr1) {
+				return nil, sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr1)), "SyncAggregate.SyncCommitteeBits")
+			}
+			dst = append(dst, t.SyncCommitteeBits[:vlen]...)
+			if vlen < int(expr1) {
+				dst = sszutils.AppendZeroPadding(dst, (int(expr1)-vlen)*1)
+			}
+		}
+		{ // Static Field #1 'SyncCommitteeSignature'
+			dst = append(dst, t.SyncCommitteeSignature[:96]...)
+		}
+	}
+	{ // Static Field #6 'SignatureSlot'
+		dst = binary.LittleEndian.AppendUint64(dst, uint64(t.SignatureSlot))
+	}
+	{ // Dynamic Field #0 'AttestedHeader'
+		binary.LittleEndian.PutUint32(dst[dstlen:], uint32(len(dst)-dstlen))
+		t := t.AttestedHeader
+		if t == nil {
+			t = new(LightClientHeader)
+		}
+		if dst, err = t.MarshalSSZTo(dst); err != nil {
+			return nil, sszutils.ErrorWithPath(err, "AttestedHeader")
+		}
+	}
+	{ // Dynamic Field #3 'FinalizedHeader'
+		binary.LittleEndian.PutUint32(dst[offset3:], uint32(len(dst)-dstlen))
+		t := t.FinalizedHeader
+		if t == nil {
+			t = new(LightClientHeader)
+		}
+		if dst, err = t.MarshalSSZTo(dst); err != nil {
+			return nil, sszutils.ErrorWithPath(err, "FinalizedHeader")
+		}
+	}
+	return dst, nil
+}
+
+// MarshalSSZEncoder marshals the *LightClientUpdate to the given SSZ encoder using dynamic specifications.
+func (t *LightClientUpdate) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.Encoder) (err error) {
+	type encoderCtx struct {
+		ds      sszutils.DynamicSpecs
+		exprs   [2]uint64
+		sizeFn1 func(ctx *encoderCtx, t *LightClientHeader) (size int)
+	}
+	ctx := &encoderCtx{ds: ds}
+	canSeek := enc.Seekable()
+	ctx.exprs[0], err = sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE", 512)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[1], err = sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE/8", 64)
+	if err != nil {
+		return err
+	}
+	// size for *LightClientHeader
+	ctx.sizeFn1 = func(ctx *encoderCtx, t *LightClientHeader) (size int) {
+		size += t.SizeSSZDyn(ds)
+		return size
+	}
+	size2 := 48 * int(ctx.exprs[0])
+	size1 := size2 + 48 // size expression for '*SyncCommittee'
+	size4 := 1 * int(ctx.exprs[1])
+	size3 := size4 + 96 // size expression for '*SyncAggregate'
+	if t == nil {
+		t = new(LightClientUpdate)
+	}
+	dstlen := enc.GetPosition()
+	dynoff := uint32(size1 + size3 + 368)
+	// Offset #0 'AttestedHeader'
+	offset0 := enc.GetPosition()
+	if canSeek {
+		enc.EncodeOffset(0)
+	} else {
+		enc.EncodeOffset(dynoff)
+		dynoff += uint32(ctx.sizeFn1(ctx, t.AttestedHeader))
+	}
+	{ // Field #1 'NextSyncCommittee'
+		t := t.NextSyncCommittee
+		if t == nil {
+			t = new(SyncCommittee)
+		}
+		{ // Field #0 'Pubkeys'
+			t := t.Pubkeys
+			vlen := len(t)
+			if vlen > int(ctx.exprs[0]) {
+				return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(ctx.exprs[0])), "NextSyncCommittee.Pubkeys")
+			}
+			for idx1 := range vlen {
+				enc.EncodeBytes(t[idx1][:48])
+			}
+			if vlen < int(ctx.exprs[0]) {
+				enc.EncodeZeroPadding((int(ctx.exprs[0]) - vlen) * 48)
+			}
+		}
+		{ // Field #1 'AggregatePubkey'
+			enc.EncodeBytes(t.AggregatePubkey[:48])
+		}
+	}
+	{ // Field #2 'NextSyncCommitteeBranch'
+		t := t.NextSyncCommitteeBranch
+		vlen := len(t)
+		if vlen > 5 {
+			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, 5), "NextSyncCommitteeBranch")
+		}
+		for idx1 := range vlen {
+			enc.EncodeBytes(t[idx1][:32])
+		}
+		if vlen < 5 {
+			enc.EncodeZeroPadding((5 - vlen) * 32)
+		}
+	}
+	// Offset #3 'FinalizedHeader'
+	offset3 := enc.GetPosition()
+	if canSeek {
+		enc.EncodeOffset(0)
+	} else {
+		enc.EncodeOffset(dynoff)
+		dynoff += uint32(ctx.sizeFn1(ctx, t.FinalizedHeader))
+	}
+	{ // Field #4 'FinalityBranch'
+		t := t.FinalityBranch
+		vlen := len(t)
+		if vlen > 6 {
+			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, 6), "FinalityBranch")
+		}
+		for idx1 := range vlen {
+			enc.EncodeBytes(t[idx1][:32])
+		}
+		if vlen < 6 {
+			enc.EncodeZeroPadding((6 - vlen) * 32)
+		}
+	}
+	{ // Field #5 'SyncAggregate'
+		t := t.SyncAggregate
+		if t == nil {
+			t = new(SyncAggregate)
+		}
+		{ // Field #0 'SyncCommitteeBits'
+			vlen := len(t.SyncCommitteeBits)
+			if vlen > int(ctx.exprs[1]) {
+				return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(ctx.exprs[1])), "SyncAggregate.SyncCommitteeBits")
+			}
+			enc.EncodeBytes(t.SyncCommitteeBits[:vlen])
+			if vlen < int(ctx.exprs[1]) {
+				enc.EncodeZeroPadding((int(ctx.exprs[1]) - vlen) * 1)
+			}
+		}
+		{ // Field #1 'SyncCommitteeSignature'
+			enc.EncodeBytes(t.SyncCommitteeSignature[:96])
+		}
+	}
+	{ // Field #6 'SignatureSlot'
+		enc.EncodeUint64(uint64(t.SignatureSlot))
+	}
+	{ // Dynamic Field #0 'AttestedHeader'
+		if canSeek {
+			enc.EncodeOffsetAt(offset0, uint32(enc.GetPosition()-dstlen))
+		}
+		t := t.AttestedHeader
+		if t == nil {
+			t = new(LightClientHeader)
+		}
+		if buf, err := t.MarshalSSZTo(enc.GetBuffer()); err != nil {
+			return sszutils.ErrorWithPath(err, "AttestedHeader")
+		} else {
+			enc.SetBuffer(buf)
+		}
+	}
+	{ // Dynamic Field #3 'FinalizedHeader'
+		if canSeek {
+			enc.EncodeOffsetAt(offset3, uint32(enc.GetPosition()-dstlen))
+		}
+		t := t.FinalizedHeader
+		if t == nil {
+			t = new(LightClientHeader)
+		}
+		if buf, err := t.MarshalSSZTo(enc.GetBuffer()); err != nil {
+			return sszutils.ErrorWithPath(err, "FinalizedHeader")
+		} else {
+			enc.SetBuffer(buf)
+		}
+	}
+	return nil
+}
+
+// UnmarshalSSZ unmarshals the *LightClientUpdate from SSZ-encoded bytes.
+func (t *LightClientUpdate) UnmarshalSSZ(buf []byte) (err error) {
+	return t.UnmarshalSSZDyn(dynssz.GetGlobalDynSsz(), buf)
+}
+
+// UnmarshalSSZDyn unmarshals the *LightClientUpdate from SSZ-encoded bytes using dynamic specifications.
+func (t *LightClientUpdate) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err error) {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE", 512)
+	if err != nil {
+		return err
+	}
+	expr1, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE/8", 64)
+	if err != nil {
+		return err
+	}
+	size2 := 48 * int(expr0)
+	size1 := size2 + 48 // size expression for '*SyncCommittee'
+	size4 := 1 * int(expr1)
+	size3 := size4 + 96 // size expression for '*SyncAggregate'
+	exproffset := 0
+	totalSize := size1+size3+368
+	buflen := len(buf)
+	if buflen < totalSize {
+		return sszutils.ErrFixedFieldsEOFFn(buflen, totalSize)
+	}
+	// Field #0 'AttestedHeader' (offset)
+	offset0 := int(binary.LittleEndian.Uint32(buf[0:4]))
+	if offset0 != totalSize {
+		return sszutils.ErrorWithPath(sszutils.ErrFirstOffsetMismatchFn(offset0, totalSize), "AttestedHeader:o")
+	}
+	{ // Field #1 'NextSyncCommittee' (static)
+		buf := buf[4 : size1+4]
+		exproffset += int(size1)
+		val1 := t.NextSyncCommittee
+		if val1 == nil {
+			val1 = new(SyncCommittee)
+		}
+		exproffset := 0
+		totalSize := size2+48
+		buflen := len(buf)
+		if buflen < totalSize {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(buflen, totalSize), "NextSyncCommittee")
+		}
+		if buflen > totalSize {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(buflen - totalSize), "NextSyncCommittee")
+		}
+		{ // Field #0 'Pubkeys' (static)
+			buf := buf[0 : size2+0]
+			exproffset += int(size2)
+			val2 := val1.Pubkeys
+			val2 = sszutils.ExpandSlice(val2, int(expr0))
+			sszutils.UnmarshalFixedBytesSlice(val2[:int(expr0)], buf)
+			val1.Pubkeys = val2
+		}
+		{ // Field #1 'AggregatePubkey' (static)
+			buf := buf[exproffset+0 : exproffset+48]
+			copy(val1.AggregatePubkey[:], buf)
+		}
+		t.NextSyncCommittee = val1
+	}
+	{ // Field #2 'NextSyncCommitteeBranch' (static)
+		buf := buf[exproffset+4 : exproffset+164]
+		val3 := t.NextSyncCommitteeBranch
+		val3 = sszutils.ExpandSlice(val3, 5)
+		sszutils.UnmarshalFixedBytesSlice(val3[:5], buf)
+		t.NextSyncCommitteeBranch = val3
+	}
+	// Field #3 'FinalizedHeader' (offset)
+	offset3 := int(binary.LittleEndian.Uint32(buf[exproffset+164 : exproffset+168]))
+	if offset3 < offset0 || offset3 > buflen {
+		return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset3, offset0, buflen), "FinalizedHeader:o")
+	}
+	{ // Field #4 'FinalityBranch' (static)
+		buf := buf[exproffset+168 : exproffset+360]
+		val4 := t.FinalityBranch
+		val4 = sszutils.ExpandSlice(val4, 6)
+		sszutils.UnmarshalFixedBytesSlice(val4[:6], buf)
+		t.FinalityBranch = val4
+	}
+	{ // Field #5 'SyncAggregate' (static)
+		buf := buf[exproffset+360 : exproffset+size3+360]
+		exproffset += int(size3)
+		val5 := t.SyncAggregate
+		if val5 == nil {
+			val5 = new(SyncAggregate)
+		}
+		exproffset := 0
+		totalSize := size4+96
+		buflen := len(buf)
+		if buflen < totalSize {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(buflen, totalSize), "SyncAggregate")
+		}
+		if buflen > totalSize {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(buflen - totalSize), "SyncAggregate")
+		}
+		{ // Field #0 'SyncCommitteeBits' (static)
+			buf := buf[0 : size4+0]
+			exproffset += int(size4)
+			val5.SyncCommitteeBits = sszutils.ExpandSlice(val5.SyncCommitteeBits, int(expr1))
+			copy(val5.SyncCommitteeBits[:], buf)
+		}
+		{ // Field #1 'SyncCommitteeSignature' (static)
+			buf := buf[exproffset+0 : exproffset+96]
+			copy(val5.SyncCommitteeSignature[:], buf)
+		}
+		t.SyncAggregate = val5
+	}
+	{ // Field #6 'SignatureSlot' (static)
+		buf := buf[exproffset+360 : exproffset+368]
+		t.SignatureSlot = Slot(binary.LittleEndian.Uint64(buf))
+	}
+	{ // Field #0 'AttestedHeader' (dynamic)
+		buf := buf[offset0:offset3]
+		if t.AttestedHeader == nil {
+			t.AttestedHeader = new(LightClientHeader)
+		}
+		if err = t.AttestedHeader.UnmarshalSSZ(buf); err != nil {
+			return sszutils.ErrorWithPath(err, "AttestedHeader")
+		}
+	}
+	{ // Field #3 'FinalizedHeader' (dynamic)
+		buf := buf[offset3:]
+		if t.FinalizedHeader == nil {
+			t.FinalizedHeader = new(LightClientHeader)
+		}
+		if err = t.FinalizedHeader.UnmarshalSSZ(buf); err != nil {
+			return sszutils.ErrorWithPath(err, "FinalizedHeader")
+		}
+	}
+	return nil
+}
+
+// UnmarshalSSZDecoder unmarshals the *LightClientUpdate from the given SSZ decoder using dynamic specifications.
+func (t *LightClientUpdate) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils.Decoder) (err error) {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE", 512)
+	if err != nil {
+		return err
+	}
+	expr1, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE/8", 64)
+	if err != nil {
+		return err
+	}
+	size2 := 48 * int(expr0)
+	size1 := size2 + 48 // size expression for '*SyncCommittee'
+	size4 := 1 * int(expr1)
+	size3 := size4 + 96 // size expression for '*SyncAggregate'
+	totalSize := size1+size3+368
+	maxOffset := uint32(dec.GetLength())
+	startPos0 := dec.GetPosition()
+	if maxOffset < uint32(totalSize) {
+		return sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(totalSize))
+	}
+	// Field #0 'AttestedHeader' (offset)
+	offset0, err := dec.DecodeOffset()
+	if err != nil {
+		return sszutils.ErrorWithPath(err, "AttestedHeader")
+	}
+	if offset0 != uint32(totalSize) {
+		return sszutils.ErrorWithPath(sszutils.ErrFirstOffsetMismatchFn(offset0, totalSize), "AttestedHeader")
+	}
+	{ // Field #1 'NextSyncCommittee' (static)
+		val1 := t.NextSyncCommittee
+		if val1 == nil {
+			val1 = new(SyncCommittee)
+		}
+		totalSize := size2+48
+		maxOffset := uint32(dec.GetLength())
+		if maxOffset < uint32(totalSize) {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(totalSize)), "NextSyncCommittee")
+		}
+		{ // Field #0 'Pubkeys' (static)
+			val2 := val1.Pubkeys
+			val2 = sszutils.ExpandSlice(val2, int(expr0))
+			startPos1 := dec.GetPosition()
+			for idx1 := range int(expr0) {
+				if _, err = dec.DecodeBytes(val2[idx1][:48]); err != nil {
+					return err
+				}
+				if dec.GetPosition() != startPos1+int(48*(idx1+1)) {
+					return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos1+int(48*(idx1+1))), "NextSyncCommittee.Pubkeys[%d]", idx1)
+				}
+			}
+			val1.Pubkeys = val2
+		}
+		// Field #1 'AggregatePubkey' (static)
+		if _, err = dec.DecodeBytes(val1.AggregatePubkey[:48]); err != nil {
+			return err
+		}
+		t.NextSyncCommittee = val1
+	}
+	{ // Field #2 'NextSyncCommitteeBranch' (static)
+		val3 := t.NextSyncCommitteeBranch
+		val3 = sszutils.ExpandSlice(val3, 5)
+		startPos2 := dec.GetPosition()
+		for idx1 := range 5 {
+			if _, err = dec.DecodeBytes(val3[idx1][:32]); err != nil {
+				return err
+			}
+			if dec.GetPosition() != startPos2+int(32*(idx1+1)) {
+				return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos2+int(32*(idx1+1))), "NextSyncCommitteeBranch[%d]", idx1)
+			}
+		}
+		t.NextSyncCommitteeBranch = val3
+	}
+	// Field #3 'FinalizedHeader' (offset)
+	offset3, err := dec.DecodeOffset()
+	if err != nil {
+		return sszutils.ErrorWithPath(err, "FinalizedHeader")
+	}
+	if offset3 < offset0 || offset3 > maxOffset {
+		return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset3, offset0, maxOffset), "FinalizedHeader")
+	}
+	{ // Field #4 'FinalityBranch' (static)
+		val4 := t.FinalityBranch
+		val4 = sszutils.ExpandSlice(val4, 6)
+		startPos3 := dec.GetPosition()
+		for idx1 := range 6 {
+			if _, err = dec.DecodeBytes(val4[idx1][:32]); err != nil {
+				return err
+			}
+			if dec.GetPosition() != startPos3+int(32*(idx1+1)) {
+				return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos3+int(32*(idx1+1))), "FinalityBranch[%d]", idx1)
+			}
+		}
+		t.FinalityBranch = val4
+	}
+	{ // Field #5 'SyncAggregate' (static)
+		val5 := t.SyncAggregate
+		if val5 == nil {
+			val5 = new(SyncAggregate)
+		}
+		totalSize := size4+96
+		maxOffset := uint32(dec.GetLength())
+		if maxOffset < uint32(totalSize) {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(totalSize)), "SyncAggregate")
+		}
+		// Field #0 'SyncCommitteeBits' (static)
+		val5.SyncCommitteeBits = sszutils.ExpandSlice(val5.SyncCommitteeBits, int(expr1))
+		if _, err = dec.DecodeBytes(val5.SyncCommitteeBits[:int(expr1)]); err != nil {
+			return err
+		}
+		// Field #1 'SyncCommitteeSignature' (static)
+		if _, err = dec.DecodeBytes(val5.SyncCommitteeSignature[:96]); err != nil {
+			return err
+		}
+		t.SyncAggregate = val5
+	}
+	// Field #6 'SignatureSlot' (static)
+	if val, err := dec.DecodeUint64(); err != nil {
+		return sszutils.ErrorWithPath(err, "SignatureSlot")
+	} else {
+		t.SignatureSlot = Slot(val)
+	}
+	{ // Field #0 'AttestedHeader' (dynamic)
+		if dec.GetPosition() != startPos0+int(offset0) {
+			return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos0+int(offset0)), "AttestedHeader")
+		}
+		dec.PushLimit(int(offset3 - offset0))
+		val6 := t.AttestedHeader
+		if val6 == nil {
+			val6 = new(LightClientHeader)
+		}
+		if err = val6.UnmarshalSSZDecoder(ds, dec); err != nil {
+			return sszutils.ErrorWithPath(err, "AttestedHeader")
+		}
+		if diff := dec.PopLimit(); diff != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "AttestedHeader")
+		}
+		t.AttestedHeader = val6
+	}
+	{ // Field #3 'FinalizedHeader' (dynamic)
+		if dec.GetPosition() != startPos0+int(offset3) {
+			return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos0+int(offset3)), "FinalizedHeader")
+		}
+		dec.PushLimit(int(maxOffset - offset3))
+		val7 := t.FinalizedHeader
+		if val7 == nil {
+			val7 = new(LightClientHeader)
+		}
+		if err = val7.UnmarshalSSZDecoder(ds, dec); err != nil {
+			return sszutils.ErrorWithPath(err, "FinalizedHeader")
+		}
+		if diff := dec.PopLimit(); diff != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "FinalizedHeader")
+		}
+		t.FinalizedHeader = val7
+	}
+	return nil
+}
+
+// SizeSSZ returns the SSZ encoded size of the *LightClientUpdate.
+func (t *LightClientUpdate) SizeSSZ() (size int) {
+	return t.SizeSSZDyn(dynssz.GetGlobalDynSsz())
+}
+
+// SizeSSZDyn returns the SSZ encoded size of the *LightClientUpdate using dynamic specifications.
+func (t *LightClientUpdate) SizeSSZDyn(ds sszutils.DynamicSpecs) (size int) {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE", 512)
+	if err != nil {
+		return 0
+	}
+	expr1, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE/8", 64)
+	if err != nil {
+		return 0
+	}
+	if t == nil {
+		t = new(LightClientUpdate)
+	}
+	// Field #0 'AttestedHeader' offset (4 bytes)
+	// Field #2 'NextSyncCommitteeBranch' static (160 bytes)
+	// Field #3 'FinalizedHeader' offset (4 bytes)
+	// Field #4 'FinalityBranch' static (192 bytes)
+	// Field #6 'SignatureSlot' static (8 bytes)
+	size += 368
+	{ // Field #1 'NextSyncCommittee'
+		t := t.NextSyncCommittee
+		if t == nil {
+			t = new(SyncCommittee)
+		}
+		// Field #1 'AggregatePubkey' static (48 bytes)
+		size += 48
+		{ // Field #0 'Pubkeys'
+			size += int(expr0) * 48
+		}
+	}
+	{ // Field #5 'SyncAggregate'
+		t := t.SyncAggregate
+		if t == nil {
+			t = new(SyncAggregate)
+		}
+		// Field #1 'SyncCommitteeSignature' static (96 bytes)
+		size += 96
+		{ // Field #0 'SyncCommitteeBits'
+			size += int(expr1)
+		}
+	}
+	{ // Dynamic field #0 'AttestedHeader'
+		size += t.AttestedHeader.SizeSSZDyn(ds)
+	}
+	{ // Dynamic field #3 'FinalizedHeader'
+		size += t.FinalizedHeader.SizeSSZDyn(ds)
+	}
+	return size
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the *LightClientUpdate.
+func (t *LightClientUpdate) HashTreeRoot() (root [32]byte, err error) {
+	err = hasher.WithDefaultHasher(func(hh sszutils.HashWalker) (err error) {
+		err = t.HashTreeRootWith(hh)
+		if err == nil {
+			root, err = hh.HashRoot()
+		}
+		return
+	})
+	return
+}
+
+// HashTreeRootWith computes the SSZ hash tree root of the *LightClientUpdate using the given hash walker.
+func (t *LightClientUpdate) HashTreeRootWith(hh sszutils.HashWalker) error {
+	return t.HashTreeRootWithDyn(dynssz.GetGlobalDynSsz(), hh)
+}
+
+// HashTreeRootDyn computes the SSZ hash tree root of the *LightClientUpdate using dynamic specifications.
+func (t *LightClientUpdate) HashTreeRootDyn(ds sszutils.DynamicSpecs) (root [32]byte, err error) {
+	err = hasher.WithDefaultHasher(func(hh sszutils.HashWalker) (err error) {
+		err = t.HashTreeRootWithDyn(ds, hh)
+		if err == nil {
+			root, err = hh.HashRoot()
+		}
+		return
+	})
+	return
+}
+
+// HashTreeRootWithDyn computes the SSZ hash tree root of the *LightClientUpdate using dynamic specifications and the given hash walker.
+func (t *LightClientUpdate) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.HashWalker) error {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE", 512)
+	if err != nil {
+		return err
+	}
+	expr1, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE/8", 64)
+	if err != nil {
+		return err
+	}
+	if t == nil {
+		t = new(LightClientUpdate)
+	}
+	idx := hh.StartTree(sszutils.TreeTypeNone)
+	{ // Field #0 'AttestedHeader'
+		t := t.AttestedHeader
+		if t == nil {
+			t = new(LightClientHeader)
+		}
+		if err := t.HashTreeRootWithDyn(ds, hh); err != nil {
+			return sszutils.ErrorWithPath(err, "AttestedHeader")
+		}
+	}
+	{ // Field #1 'NextSyncCommittee'
+		t := t.NextSyncCommittee
+		if t == nil {
+			t = new(SyncCommittee)
+		}
+		idx := hh.StartTree(sszutils.TreeTypeNone)
+		{ // Field #0 'Pubkeys'
+			t := t.Pubkeys
+			vlen := len(t)
+			if vlen > int(expr0) {
+				return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr0)), "NextSyncCommittee.Pubkeys")
+			}
+			idx := hh.StartTree(sszutils.TreeTypeBinary)
+			var val1 *BLSPubKey
+			for idx1 := range int(expr0) {
+				if idx1 < vlen {
+					val1 = &t[idx1]
+				} else if idx1 == vlen {
+					val1 = new(BLSPubKey)
+				}
+				hh.PutBytes(val1[:48])
+				if (idx1+1)%256 == 0 {
+					hh.Collapse()
+				}
+			}
+			hh.Merkleize(idx)
+		}
+		{ // Field #1 'AggregatePubkey'
+			hh.PutBytes(t.AggregatePubkey[:48])
+		}
+		hh.Merkleize(idx)
+	}
+	{ // Field #2 'NextSyncCommitteeBranch'
+		t := t.NextSyncCommitteeBranch
+		vlen := len(t)
+		if vlen > 5 {
+			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, 5), "NextSyncCommitteeBranch")
+		}
+		idx := hh.StartTree(sszutils.TreeTypeBinary)
+		var val2 *Root
+		for idx1 := range 5 {
+			if idx1 < vlen {
+				val2 = &t[idx1]
+			} else if idx1 == vlen {
+				val2 = new(Root)
+			}
+			hh.PutBytes(val2[:32])
+			if (idx1+1)%256 == 0 {
+				hh.Collapse()
+			}
+		}
+		hh.Merkleize(idx)
+	}
+	{ // Field #3 'FinalizedHeader'
+		t := t.FinalizedHeader
+		if t == nil {
+			t = new(LightClientHeader)
+		}
+		if err := t.HashTreeRootWithDyn(ds, hh); err != nil {
+			return sszutils.ErrorWithPath(err, "FinalizedHeader")
+		}
+	}
+	{ // Field #4 'FinalityBranch'
+		t := t.FinalityBranch
+		vlen := len(t)
+		if vlen > 6 {
+			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, 6), "FinalityBranch")
+		}
+		idx := hh.StartTree(sszutils.TreeTypeBinary)
+		var val3 *Root
+		for idx1 := range 6 {
+			if idx1 < vlen {
+				val3 = &t[idx1]
+			} else if idx1 == vlen {
+				val3 = new(Root)
+			}
+			hh.PutBytes(val3[:32])
+			if (idx1+1)%256 == 0 {
+				hh.Collapse()
+			}
+		}
+		hh.Merkleize(idx)
+	}
+	{ // Field #5 'SyncAggregate'
+		t := t.SyncAggregate
+		if t == nil {
+			t = new(SyncAggregate)
+		}
+		idx := hh.StartTree(sszutils.TreeTypeNone)
+		{ // Field #0 'SyncCommitteeBits'
+			vlen := len(t.SyncCommitteeBits)
+			if vlen > int(expr1) {
+				return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr1)), "SyncAggregate.SyncCommitteeBits")
+			}
+			val := t.SyncCommitteeBits[:]
+			if vlen < int(expr1) {
+				val = sszutils.AppendZeroPadding(val, (int(expr1)-vlen)*1)
+			}
+			hh.PutBytes(val[:int(expr1)])
+		}
+		{ // Field #1 'SyncCommitteeSignature'
+			hh.PutBytes(t.SyncCommitteeSignature[:96])
+		}
+		hh.Merkleize(idx)
+	}
+	{ // Field #6 'SignatureSlot'
+		hh.PutUint64(uint64(t.SignatureSlot))
+	}
+	hh.Merkleize(idx)
+	return nil
+}
+
+// MarshalSSZ marshals the *LightClientFinalityUpdate to SSZ-encoded bytes.
+func (t *LightClientFinalityUpdate) MarshalSSZ() ([]byte, error) {
+	return dynssz.GetGlobalDynSsz().MarshalSSZ(t)
+}
+
+// MarshalSSZTo marshals the *LightClientFinalityUpdate to SSZ-encoded bytes, appending to the provided buffer.
+func (t *LightClientFinalityUpdate) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	return t.MarshalSSZDyn(dynssz.GetGlobalDynSsz(), buf)
+}
+
+// MarshalSSZDyn marshals the *LightClientFinalityUpdate to SSZ-encoded bytes using dynamic specifications.
+func (t *LightClientFinalityUpdate) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst []byte, err error) {
+	dst = buf
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE/8", 64)
+	if err != nil {
+		return dst, err
+	}
+	if t == nil {
+		t = new(LightClientFinalityUpdate)
+	}
+	dstlen := len(dst)
+	// Offset Field #0 'AttestedHeader'
+	// Offset Field #1 'FinalizedHeader'
+	dst = append(dst, 0, 0, 0, 0, 0, 0, 0, 0)
+	{ // Static Field #2 'FinalityBranch'
+		t := t.FinalityBranch
+		vlen := len(t)
+		if vlen > 6 {
+			return nil, sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, 6), "FinalityBranch")
+		}
+		dst = sszutils.MarshalFixedBytesSlice(dst, t[:vlen])
+		if vlen < 6 {
+			dst = sszutils.AppendZeroPadding(dst, (6-vlen)*32)
+		}
+	}
+	{ // Static Field #3 'SyncAggregate'
+		t := t.SyncAggregate
+		if t == nil {
+			t = new(SyncAggregate)
+		}
+		{ // Static Field #0 'SyncCommitteeBits'
+			vlen := len(t.SyncCommitteeBits)
+			if vlen > int(expr0) {
+				return nil, sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr0)), "SyncAggregate.SyncCommitteeBits")
+			}
+			dst = append(dst, t.SyncCommitteeBits[:vlen]...)
+			if vlen < int(expr0) {
+				dst = sszutils.AppendZeroPadding(dst, (int(expr0)-vlen)*1)
+			}
+		}
+		{ // Static Field #1 'SyncCommitteeSignature'
+			dst = append(dst, t.SyncCommitteeSignature[:96]...)
+		}
+	}
+	{ // Static Field #4 'SignatureSlot'
+		dst = binary.LittleEndian.AppendUint64(dst, uint64(t.SignatureSlot))
+	}
+	{ // Dynamic Field #0 'AttestedHeader'
+		binary.LittleEndian.PutUint32(dst[dstlen:], uint32(len(dst)-dstlen))
+		t := t.AttestedHeader
+		if t == nil {
+			t = new(LightClientHeader)
+		}
+		if dst, err = t.MarshalSSZTo(dst); err != nil {
+			return nil, sszutils.ErrorWithPath(err, "AttestedHeader")
+		}
+	}
+	{ // Dynamic Field #1 'FinalizedHeader'
+		binary.LittleEndian.PutUint32(dst[dstlen+4:], uint32(len(dst)-dstlen))
+		t := t.FinalizedHeader
+		if t == nil {
+			t = new(LightClientHeader)
+		}
+		if dst, err = t.MarshalSSZTo(dst); err != nil {
+			return nil, sszutils.ErrorWithPath(err, "FinalizedHeader")
+		}
+	}
+	return dst, nil
+}
+
+// MarshalSSZEncoder marshals the *LightClientFinalityUpdate to the given SSZ encoder using dynamic specifications.
+func (t *LightClientFinalityUpdate) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.Encoder) (err error) {
+	type encoderCtx struct {
+		ds      sszutils.DynamicSpecs
+		exprs   [1]uint64
+		sizeFn1 func(ctx *encoderCtx, t *LightClientHeader) (size int)
+	}
+	ctx := &encoderCtx{ds: ds}
+	canSeek := enc.Seekable()
+	ctx.exprs[0], err = sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE/8", 64)
+	if err != nil {
+		return err
+	}
+	// size for *LightClientHeader
+	ctx.sizeFn1 = func(ctx *encoderCtx, t *LightClientHeader) (size int) {
+		size += t.SizeSSZDyn(ds)
+		return size
+	}
+	size2 := 1 * int(ctx.exprs[0])
+	size1 := size2 + 96 // size expression for '*SyncAggregate'
+	if t == nil {
+		t = new(LightClientFinalityUpdate)
+	}
+	dstlen := enc.GetPosition()
+	dynoff := uint32(size1 + 208)
+	// Offset #0 'AttestedHeader'
+	offset0 := enc.GetPosition()
+	if canSeek {
+		enc.EncodeOffset(0)
+	} else {
+		enc.EncodeOffset(dynoff)
+		dynoff += uint32(ctx.sizeFn1(ctx, t.AttestedHeader))
+	}
+	// Offset #1 'FinalizedHeader'
+	offset1 := enc.GetPosition()
+	if canSeek {
+		enc.EncodeOffset(0)
+	} else {
+		enc.EncodeOffset(dynoff)
+		dynoff += uint32(ctx.sizeFn1(ctx, t.FinalizedHeader))
+	}
+	{ // Field #2 'FinalityBranch'
+		t := t.FinalityBranch
+		vlen := len(t)
+		if vlen > 6 {
+			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, 6), "FinalityBranch")
+		}
+		for idx1 := range vlen {
+			enc.EncodeBytes(t[idx1][:32])
+		}
+		if vlen < 6 {
+			enc.EncodeZeroPadding((6 - vlen) * 32)
+		}
+	}
+	{ // Field #3 'SyncAggregate'
+		t := t.SyncAggregate
+		if t == nil {
+			t = new(SyncAggregate)
+		}
+		{ // Field #0 'SyncCommitteeBits'
+			vlen := len(t.SyncCommitteeBits)
+			if vlen > int(ctx.exprs[0]) {
+				return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(ctx.exprs[0])), "SyncAggregate.SyncCommitteeBits")
+			}
+			enc.EncodeBytes(t.SyncCommitteeBits[:vlen])
+			if vlen < int(ctx.exprs[0]) {
+				enc.EncodeZeroPadding((int(ctx.exprs[0]) - vlen) * 1)
+			}
+		}
+		{ // Field #1 'SyncCommitteeSignature'
+			enc.EncodeBytes(t.SyncCommitteeSignature[:96])
+		}
+	}
+	{ // Field #4 'SignatureSlot'
+		enc.EncodeUint64(uint64(t.SignatureSlot))
+	}
+	{ // Dynamic Field #0 'AttestedHeader'
+		if canSeek {
+			enc.EncodeOffsetAt(offset0, uint32(enc.GetPosition()-dstlen))
+		}
+		t := t.AttestedHeader
+		if t == nil {
+			t = new(LightClientHeader)
+		}
+		if buf, err := t.MarshalSSZTo(enc.GetBuffer()); err != nil {
+			return sszutils.ErrorWithPath(err, "AttestedHeader")
+		} else {
+			enc.SetBuffer(buf)
+		}
+	}
+	{ // Dynamic Field #1 'FinalizedHeader'
+		if canSeek {
+			enc.EncodeOffsetAt(offset1, uint32(enc.GetPosition()-dstlen))
+		}
+		t := t.FinalizedHeader
+		if t == nil {
+			t = new(LightClientHeader)
+		}
+		if buf, err := t.MarshalSSZTo(enc.GetBuffer()); err != nil {
+			return sszutils.ErrorWithPath(err, "FinalizedHeader")
+		} else {
+			enc.SetBuffer(buf)
+		}
+	}
+	return nil
+}
+
+// UnmarshalSSZ unmarshals the *LightClientFinalityUpdate from SSZ-encoded bytes.
+func (t *LightClientFinalityUpdate) UnmarshalSSZ(buf []byte) (err error) {
+	return t.UnmarshalSSZDyn(dynssz.GetGlobalDynSsz(), buf)
+}
+
+// UnmarshalSSZDyn unmarshals the *LightClientFinalityUpdate from SSZ-encoded bytes using dynamic specifications.
+func (t *LightClientFinalityUpdate) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err error) {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE/8", 64)
+	if err != nil {
+		return err
+	}
+	size2 := 1 * int(expr0)
+	size1 := size2 + 96 // size expression for '*SyncAggregate'
+	exproffset := 0
+	totalSize := size1+208
+	buflen := len(buf)
+	if buflen < totalSize {
+		return sszutils.ErrFixedFieldsEOFFn(buflen, totalSize)
+	}
+	// Field #0 'AttestedHeader' (offset)
+	offset0 := int(binary.LittleEndian.Uint32(buf[0:4]))
+	if offset0 != totalSize {
+		return sszutils.ErrorWithPath(sszutils.ErrFirstOffsetMismatchFn(offset0, totalSize), "AttestedHeader:o")
+	}
+	// Field #1 'FinalizedHeader' (offset)
+	offset1 := int(binary.LittleEndian.Uint32(buf[4:8]))
+	if offset1 < offset0 || offset1 > buflen {
+		return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset1, offset0, buflen), "FinalizedHeader:o")
+	}
+	{ // Field #2 'FinalityBranch' (static)
+		buf := buf[8:200]
+		val1 := t.FinalityBranch
+		val1 = sszutils.ExpandSlice(val1, 6)
+		sszutils.UnmarshalFixedBytesSlice(val1[:6], buf)
+		t.FinalityBranch = val1
+	}
+	{ // Field #3 'SyncAggregate' (static)
+		buf := buf[200 : size1+200]
+		exproffset += int(size1)
+		val2 := t.SyncAggregate
+		if val2 == nil {
+			val2 = new(SyncAggregate)
+		}
+		exproffset := 0
+		totalSize := size2+96
+		buflen := len(buf)
+		if buflen < totalSize {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(buflen, totalSize), "SyncAggregate")
+		}
+		if buflen > totalSize {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(buflen - totalSize), "SyncAggregate")
+		}
+		{ // Field #0 'SyncCommitteeBits' (static)
+			buf := buf[0 : size2+0]
+			exproffset += int(size2)
+			val2.SyncCommitteeBits = sszutils.ExpandSlice(val2.SyncCommitteeBits, int(expr0))
+			copy(val2.SyncCommitteeBits[:], buf)
+		}
+		{ // Field #1 'SyncCommitteeSignature' (static)
+			buf := buf[exproffset+0 : exproffset+96]
+			copy(val2.SyncCommitteeSignature[:], buf)
+		}
+		t.SyncAggregate = val2
+	}
+	{ // Field #4 'SignatureSlot' (static)
+		buf := buf[exproffset+200 : exproffset+208]
+		t.SignatureSlot = Slot(binary.LittleEndian.Uint64(buf))
+	}
+	{ // Field #0 'AttestedHeader' (dynamic)
+		buf := buf[offset0:offset1]
+		if t.AttestedHeader == nil {
+			t.AttestedHeader = new(LightClientHeader)
+		}
+		if err = t.AttestedHeader.UnmarshalSSZ(buf); err != nil {
+			return sszutils.ErrorWithPath(err, "AttestedHeader")
+		}
+	}
+	{ // Field #1 'FinalizedHeader' (dynamic)
+		buf := buf[offset1:]
+		if t.FinalizedHeader == nil {
+			t.FinalizedHeader = new(LightClientHeader)
+		}
+		if err = t.FinalizedHeader.UnmarshalSSZ(buf); err != nil {
+			return sszutils.ErrorWithPath(err, "FinalizedHeader")
+		}
+	}
+	return nil
+}
+
+// UnmarshalSSZDecoder unmarshals the *LightClientFinalityUpdate from the given SSZ decoder using dynamic specifications.
+func (t *LightClientFinalityUpdate) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils.Decoder) (err error) {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE/8", 64)
+	if err != nil {
+		return err
+	}
+	size2 := 1 * int(expr0)
+	size1 := size2 + 96 // size expression for '*SyncAggregate'
+	totalSize := size1+208
+	maxOffset := uint32(dec.GetLength())
+	startPos0 := dec.GetPosition()
+	if maxOffset < uint32(totalSize) {
+		return sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(totalSize))
+	}
+	// Field #0 'AttestedHeader' (offset)
+	offset0, err := dec.DecodeOffset()
+	if err != nil {
+		return sszutils.ErrorWithPath(err, "AttestedHeader")
+	}
+	if offset0 != uint32(totalSize) {
+		return sszutils.ErrorWithPath(sszutils.ErrFirstOffsetMismatchFn(offset0, totalSize), "AttestedHeader")
+	}
+	// Field #1 'FinalizedHeader' (offset)
+	offset1, err := dec.DecodeOffset()
+	if err != nil {
+		return sszutils.ErrorWithPath(err, "FinalizedHeader")
+	}
+	if offset1 < offset0 || offset1 > maxOffset {
+		return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset1, offset0, maxOffset), "FinalizedHeader")
+	}
+	{ // Field #2 'FinalityBranch' (static)
+		val1 := t.FinalityBranch
+		val1 = sszutils.ExpandSlice(val1, 6)
+		startPos1 := dec.GetPosition()
+		for idx1 := range 6 {
+			if _, err = dec.DecodeBytes(val1[idx1][:32]); err != nil {
+				return err
+			}
+			if dec.GetPosition() != startPos1+int(32*(idx1+1)) {
+				return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos1+int(32*(idx1+1))), "FinalityBranch[%d]", idx1)
+			}
+		}
+		t.FinalityBranch = val1
+	}
+	{ // Field #3 'SyncAggregate' (static)
+		val2 := t.SyncAggregate
+		if val2 == nil {
+			val2 = new(SyncAggregate)
+		}
+		totalSize := size2+96
+		maxOffset := uint32(dec.GetLength())
+		if maxOffset < uint32(totalSize) {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(totalSize)), "SyncAggregate")
+		}
+		// Field #0 'SyncCommitteeBits' (static)
+		val2.SyncCommitteeBits = sszutils.ExpandSlice(val2.SyncCommitteeBits, int(expr0))
+		if _, err = dec.DecodeBytes(val2.SyncCommitteeBits[:int(expr0)]); err != nil {
+			return err
+		}
+		// Field #1 'SyncCommitteeSignature' (static)
+		if _, err = dec.DecodeBytes(val2.SyncCommitteeSignature[:96]); err != nil {
+			return err
+		}
+		t.SyncAggregate = val2
+	}
+	// Field #4 'SignatureSlot' (static)
+	if val, err := dec.DecodeUint64(); err != nil {
+		return sszutils.ErrorWithPath(err, "SignatureSlot")
+	} else {
+		t.SignatureSlot = Slot(val)
+	}
+	{ // Field #0 'AttestedHeader' (dynamic)
+		if dec.GetPosition() != startPos0+int(offset0) {
+			return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos0+int(offset0)), "AttestedHeader")
+		}
+		dec.PushLimit(int(offset1 - offset0))
+		val3 := t.AttestedHeader
+		if val3 == nil {
+			val3 = new(LightClientHeader)
+		}
+		if err = val3.UnmarshalSSZDecoder(ds, dec); err != nil {
+			return sszutils.ErrorWithPath(err, "AttestedHeader")
+		}
+		if diff := dec.PopLimit(); diff != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "AttestedHeader")
+		}
+		t.AttestedHeader = val3
+	}
+	{ // Field #1 'FinalizedHeader' (dynamic)
+		if dec.GetPosition() != startPos0+int(offset1) {
+			return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos0+int(offset1)), "FinalizedHeader")
+		}
+		dec.PushLimit(int(maxOffset - offset1))
+		val4 := t.FinalizedHeader
+		if val4 == nil {
+			val4 = new(LightClientHeader)
+		}
+		if err = val4.UnmarshalSSZDecoder(ds, dec); err != nil {
+			return sszutils.ErrorWithPath(err, "FinalizedHeader")
+		}
+		if diff := dec.PopLimit(); diff != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "FinalizedHeader")
+		}
+		t.FinalizedHeader = val4
+	}
+	return nil
+}
+
+// SizeSSZ returns the SSZ encoded size of the *LightClientFinalityUpdate.
+func (t *LightClientFinalityUpdate) SizeSSZ() (size int) {
+	return t.SizeSSZDyn(dynssz.GetGlobalDynSsz())
+}
+
+// SizeSSZDyn returns the SSZ encoded size of the *LightClientFinalityUpdate using dynamic specifications.
+func (t *LightClientFinalityUpdate) SizeSSZDyn(ds sszutils.DynamicSpecs) (size int) {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE/8", 64)
+	if err != nil {
+		return 0
+	}
+	if t == nil {
+		t = new(LightClientFinalityUpdate)
+	}
+	// Field #0 'AttestedHeader' offset (4 bytes)
+	// Field #1 'FinalizedHeader' offset (4 bytes)
+	// Field #2 'FinalityBranch' static (192 bytes)
+	// Field #4 'SignatureSlot' static (8 bytes)
+	size += 208
+	{ // Field #3 'SyncAggregate'
+		t := t.SyncAggregate
+		if t == nil {
+			t = new(SyncAggregate)
+		}
+		// Field #1 'SyncCommitteeSignature' static (96 bytes)
+		size += 96
+		{ // Field #0 'SyncCommitteeBits'
+			size += int(expr0)
+		}
+	}
+	{ // Dynamic field #0 'AttestedHeader'
+		size += t.AttestedHeader.SizeSSZDyn(ds)
+	}
+	{ // Dynamic field #1 'FinalizedHeader'
+		size += t.FinalizedHeader.SizeSSZDyn(ds)
+	}
+	return size
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the *LightClientFinalityUpdate.
+func (t *LightClientFinalityUpdate) HashTreeRoot() (root [32]byte, err error) {
+	err = hasher.WithDefaultHasher(func(hh sszutils.HashWalker) (err error) {
+		err = t.HashTreeRootWith(hh)
+		if err == nil {
+			root, err = hh.HashRoot()
+		}
+		return
+	})
+	return
+}
+
+// HashTreeRootWith computes the SSZ hash tree root of the *LightClientFinalityUpdate using the given hash walker.
+func (t *LightClientFinalityUpdate) HashTreeRootWith(hh sszutils.HashWalker) error {
+	return t.HashTreeRootWithDyn(dynssz.GetGlobalDynSsz(), hh)
+}
+
+// HashTreeRootDyn computes the SSZ hash tree root of the *LightClientFinalityUpdate using dynamic specifications.
+func (t *LightClientFinalityUpdate) HashTreeRootDyn(ds sszutils.DynamicSpecs) (root [32]byte, err error) {
+	err = hasher.WithDefaultHasher(func(hh sszutils.HashWalker) (err error) {
+		err = t.HashTreeRootWithDyn(ds, hh)
+		if err == nil {
+			root, err = hh.HashRoot()
+		}
+		return
+	})
+	return
+}
+
+// HashTreeRootWithDyn computes the SSZ hash tree root of the *LightClientFinalityUpdate using dynamic specifications and the given hash walker.
+func (t *LightClientFinalityUpdate) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.HashWalker) error {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE/8", 64)
+	if err != nil {
+		return err
+	}
+	if t == nil {
+		t = new(LightClientFinalityUpdate)
+	}
+	idx := hh.StartTree(sszutils.TreeTypeNone)
+	{ // Field #0 'AttestedHeader'
+		t := t.AttestedHeader
+		if t == nil {
+			t = new(LightClientHeader)
+		}
+		if err := t.HashTreeRootWithDyn(ds, hh); err != nil {
+			return sszutils.ErrorWithPath(err, "AttestedHeader")
+		}
+	}
+	{ // Field #1 'FinalizedHeader'
+		t := t.FinalizedHeader
+		if t == nil {
+			t = new(LightClientHeader)
+		}
+		if err := t.HashTreeRootWithDyn(ds, hh); err != nil {
+			return sszutils.ErrorWithPath(err, "FinalizedHeader")
+		}
+	}
+	{ // Field #2 'FinalityBranch'
+		t := t.FinalityBranch
+		vlen := len(t)
+		if vlen > 6 {
+			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, 6), "FinalityBranch")
+		}
+		idx := hh.StartTree(sszutils.TreeTypeBinary)
+		var val1 *Root
+		for idx1 := range 6 {
+			if idx1 < vlen {
+				val1 = &t[idx1]
+			} else if idx1 == vlen {
+				val1 = new(Root)
+			}
+			hh.PutBytes(val1[:32])
+			if (idx1+1)%256 == 0 {
+				hh.Collapse()
+			}
+		}
+		hh.Merkleize(idx)
+	}
+	{ // Field #3 'SyncAggregate'
+		t := t.SyncAggregate
+		if t == nil {
+			t = new(SyncAggregate)
+		}
+		idx := hh.StartTree(sszutils.TreeTypeNone)
+		{ // Field #0 'SyncCommitteeBits'
+			vlen := len(t.SyncCommitteeBits)
+			if vlen > int(expr0) {
+				return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr0)), "SyncAggregate.SyncCommitteeBits")
+			}
+			val := t.SyncCommitteeBits[:]
+			if vlen < int(expr0) {
+				val = sszutils.AppendZeroPadding(val, (int(expr0)-vlen)*1)
+			}
+			hh.PutBytes(val[:int(expr0)])
+		}
+		{ // Field #1 'SyncCommitteeSignature'
+			hh.PutBytes(t.SyncCommitteeSignature[:96])
+		}
+		hh.Merkleize(idx)
+	}
+	{ // Field #4 'SignatureSlot'
+		hh.PutUint64(uint64(t.SignatureSlot))
+	}
+	hh.Merkleize(idx)
+	return nil
+}
+
+// MarshalSSZ marshals the *LightClientOptimisticUpdate to SSZ-encoded bytes.
+func (t *LightClientOptimisticUpdate) MarshalSSZ() ([]byte, error) {
+	return dynssz.GetGlobalDynSsz().MarshalSSZ(t)
+}
+
+// MarshalSSZTo marshals the *LightClientOptimisticUpdate to SSZ-encoded bytes, appending to the provided buffer.
+func (t *LightClientOptimisticUpdate) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	return t.MarshalSSZDyn(dynssz.GetGlobalDynSsz(), buf)
+}
+
+// MarshalSSZDyn marshals the *LightClientOptimisticUpdate to SSZ-encoded bytes using dynamic specifications.
+func (t *LightClientOptimisticUpdate) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst []byte, err error) {
+	dst = buf
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE/8", 64)
+	if err != nil {
+		return dst, err
+	}
+	if t == nil {
+		t = new(LightClientOptimisticUpdate)
+	}
+	dstlen := len(dst)
+	// Offset Field #0 'AttestedHeader'
+	dst = append(dst, 0, 0, 0, 0)
+	{ // Static Field #1 'SyncAggregate'
+		t := t.SyncAggregate
+		if t == nil {
+			t = new(SyncAggregate)
+		}
+		{ // Static Field #0 'SyncCommitteeBits'
+			vlen := len(t.SyncCommitteeBits)
+			if vlen > int(expr0) {
+				return nil, sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr0)), "SyncAggregate.SyncCommitteeBits")
+			}
+			dst = append(dst, t.SyncCommitteeBits[:vlen]...)
+			if vlen < int(expr0) {
+				dst = sszutils.AppendZeroPadding(dst, (int(expr0)-vlen)*1)
+			}
+		}
+		{ // Static Field #1 'SyncCommitteeSignature'
+			dst = append(dst, t.SyncCommitteeSignature[:96]...)
+		}
+	}
+	{ // Static Field #2 'SignatureSlot'
+		dst = binary.LittleEndian.AppendUint64(dst, uint64(t.SignatureSlot))
+	}
+	{ // Dynamic Field #0 'AttestedHeader'
+		binary.LittleEndian.PutUint32(dst[dstlen:], uint32(len(dst)-dstlen))
+		t := t.AttestedHeader
+		if t == nil {
+			t = new(LightClientHeader)
+		}
+		if dst, err = t.MarshalSSZTo(dst); err != nil {
+			return nil, sszutils.ErrorWithPath(err, "AttestedHeader")
+		}
+	}
+	return dst, nil
+}
+
+// MarshalSSZEncoder marshals the *LightClientOptimisticUpdate to the given SSZ encoder using dynamic specifications.
+func (t *LightClientOptimisticUpdate) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.Encoder) (err error) {
+	type encoderCtx struct {
+		ds      sszutils.DynamicSpecs
+		exprs   [1]uint64
+		sizeFn1 func(ctx *encoderCtx, t *LightClientHeader) (size int)
+	}
+	ctx := &encoderCtx{ds: ds}
+	canSeek := enc.Seekable()
+	ctx.exprs[0], err = sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE/8", 64)
+	if err != nil {
+		return err
+	}
+	// size for *LightClientHeader
+	ctx.sizeFn1 = func(ctx *encoderCtx, t *LightClientHeader) (size int) {
+		size += t.SizeSSZDyn(ds)
+		return size
+	}
+	size2 := 1 * int(ctx.exprs[0])
+	size1 := size2 + 96 // size expression for '*SyncAggregate'
+	if t == nil {
+		t = new(LightClientOptimisticUpdate)
+	}
+	dstlen := enc.GetPosition()
+	dynoff := uint32(size1 + 12)
+	// Offset #0 'AttestedHeader'
+	offset0 := enc.GetPosition()
+	if canSeek {
+		enc.EncodeOffset(0)
+	} else {
+		enc.EncodeOffset(dynoff)
+		dynoff += uint32(ctx.sizeFn1(ctx, t.AttestedHeader))
+	}
+	{ // Field #1 'SyncAggregate'
+		t := t.SyncAggregate
+		if t == nil {
+			t = new(SyncAggregate)
+		}
+		{ // Field #0 'SyncCommitteeBits'
+			vlen := len(t.SyncCommitteeBits)
+			if vlen > int(ctx.exprs[0]) {
+				return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(ctx.exprs[0])), "SyncAggregate.SyncCommitteeBits")
+			}
+			enc.EncodeBytes(t.SyncCommitteeBits[:vlen])
+			if vlen < int(ctx.exprs[0]) {
+				enc.EncodeZeroPadding((int(ctx.exprs[0]) - vlen) * 1)
+			}
+		}
+		{ // Field #1 'SyncCommitteeSignature'
+			enc.EncodeBytes(t.SyncCommitteeSignature[:96])
+		}
+	}
+	{ // Field #2 'SignatureSlot'
+		enc.EncodeUint64(uint64(t.SignatureSlot))
+	}
+	{ // Dynamic Field #0 'AttestedHeader'
+		if canSeek {
+			enc.EncodeOffsetAt(offset0, uint32(enc.GetPosition()-dstlen))
+		}
+		t := t.AttestedHeader
+		if t == nil {
+			t = new(LightClientHeader)
+		}
+		if buf, err := t.MarshalSSZTo(enc.GetBuffer()); err != nil {
+			return sszutils.ErrorWithPath(err, "AttestedHeader")
+		} else {
+			enc.SetBuffer(buf)
+		}
+	}
+	return nil
+}
+
+// UnmarshalSSZ unmarshals the *LightClientOptimisticUpdate from SSZ-encoded bytes.
+func (t *LightClientOptimisticUpdate) UnmarshalSSZ(buf []byte) (err error) {
+	return t.UnmarshalSSZDyn(dynssz.GetGlobalDynSsz(), buf)
+}
+
+// UnmarshalSSZDyn unmarshals the *LightClientOptimisticUpdate from SSZ-encoded bytes using dynamic specifications.
+func (t *LightClientOptimisticUpdate) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err error) {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE/8", 64)
+	if err != nil {
+		return err
+	}
+	size2 := 1 * int(expr0)
+	size1 := size2 + 96 // size expression for '*SyncAggregate'
+	exproffset := 0
+	totalSize := size1+12
+	buflen := len(buf)
+	if buflen < totalSize {
+		return sszutils.ErrFixedFieldsEOFFn(buflen, totalSize)
+	}
+	// Field #0 'AttestedHeader' (offset)
+	offset0 := int(binary.LittleEndian.Uint32(buf[0:4]))
+	if offset0 != totalSize {
+		return sszutils.ErrorWithPath(sszutils.ErrFirstOffsetMismatchFn(offset0, totalSize), "AttestedHeader:o")
+	}
+	{ // Field #1 'SyncAggregate' (static)
+		buf := buf[4 : size1+4]
+		exproffset += int(size1)
+		val1 := t.SyncAggregate
+		if val1 == nil {
+			val1 = new(SyncAggregate)
+		}
+		exproffset := 0
+		totalSize := size2+96
+		buflen := len(buf)
+		if buflen < totalSize {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(buflen, totalSize), "SyncAggregate")
+		}
+		if buflen > totalSize {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(buflen - totalSize), "SyncAggregate")
+		}
+		{ // Field #0 'SyncCommitteeBits' (static)
+			buf := buf[0 : size2+0]
+			exproffset += int(size2)
+			val1.SyncCommitteeBits = sszutils.ExpandSlice(val1.SyncCommitteeBits, int(expr0))
+			copy(val1.SyncCommitteeBits[:], buf)
+		}
+		{ // Field #1 'SyncCommitteeSignature' (static)
+			buf := buf[exproffset+0 : exproffset+96]
+			copy(val1.SyncCommitteeSignature[:], buf)
+		}
+		t.SyncAggregate = val1
+	}
+	{ // Field #2 'SignatureSlot' (static)
+		buf := buf[exproffset+4 : exproffset+12]
+		t.SignatureSlot = Slot(binary.LittleEndian.Uint64(buf))
+	}
+	{ // Field #0 'AttestedHeader' (dynamic)
+		buf := buf[offset0:]
+		if t.AttestedHeader == nil {
+			t.AttestedHeader = new(LightClientHeader)
+		}
+		if err = t.AttestedHeader.UnmarshalSSZ(buf); err != nil {
+			return sszutils.ErrorWithPath(err, "AttestedHeader")
+		}
+	}
+	return nil
+}
+
+// UnmarshalSSZDecoder unmarshals the *LightClientOptimisticUpdate from the given SSZ decoder using dynamic specifications.
+func (t *LightClientOptimisticUpdate) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils.Decoder) (err error) {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE/8", 64)
+	if err != nil {
+		return err
+	}
+	size2 := 1 * int(expr0)
+	size1 := size2 + 96 // size expression for '*SyncAggregate'
+	totalSize := size1+12
+	maxOffset := uint32(dec.GetLength())
+	startPos0 := dec.GetPosition()
+	if maxOffset < uint32(totalSize) {
+		return sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(totalSize))
+	}
+	// Field #0 'AttestedHeader' (offset)
+	offset0, err := dec.DecodeOffset()
+	if err != nil {
+		return sszutils.ErrorWithPath(err, "AttestedHeader")
+	}
+	if offset0 != uint32(totalSize) {
+		return sszutils.ErrorWithPath(sszutils.ErrFirstOffsetMismatchFn(offset0, totalSize), "AttestedHeader")
+	}
+	{ // Field #1 'SyncAggregate' (static)
+		val1 := t.SyncAggregate
+		if val1 == nil {
+			val1 = new(SyncAggregate)
+		}
+		totalSize := size2+96
+		maxOffset := uint32(dec.GetLength())
+		if maxOffset < uint32(totalSize) {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(totalSize)), "SyncAggregate")
+		}
+		// Field #0 'SyncCommitteeBits' (static)
+		val1.SyncCommitteeBits = sszutils.ExpandSlice(val1.SyncCommitteeBits, int(expr0))
+		if _, err = dec.DecodeBytes(val1.SyncCommitteeBits[:int(expr0)]); err != nil {
+			return err
+		}
+		// Field #1 'SyncCommitteeSignature' (static)
+		if _, err = dec.DecodeBytes(val1.SyncCommitteeSignature[:96]); err != nil {
+			return err
+		}
+		t.SyncAggregate = val1
+	}
+	// Field #2 'SignatureSlot' (static)
+	if val, err := dec.DecodeUint64(); err != nil {
+		return sszutils.ErrorWithPath(err, "SignatureSlot")
+	} else {
+		t.SignatureSlot = Slot(val)
+	}
+	{ // Field #0 'AttestedHeader' (dynamic)
+		if dec.GetPosition() != startPos0+int(offset0) {
+			return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos0+int(offset0)), "AttestedHeader")
+		}
+		dec.PushLimit(int(maxOffset - offset0))
+		val2 := t.AttestedHeader
+		if val2 == nil {
+			val2 = new(LightClientHeader)
+		}
+		if err = val2.UnmarshalSSZDecoder(ds, dec); err != nil {
+			return sszutils.ErrorWithPath(err, "AttestedHeader")
+		}
+		if diff := dec.PopLimit(); diff != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "AttestedHeader")
+		}
+		t.AttestedHeader = val2
+	}
+	return nil
+}
+
+// SizeSSZ returns the SSZ encoded size of the *LightClientOptimisticUpdate.
+func (t *LightClientOptimisticUpdate) SizeSSZ() (size int) {
+	return t.SizeSSZDyn(dynssz.GetGlobalDynSsz())
+}
+
+// SizeSSZDyn returns the SSZ encoded size of the *LightClientOptimisticUpdate using dynamic specifications.
+func (t *LightClientOptimisticUpdate) SizeSSZDyn(ds sszutils.DynamicSpecs) (size int) {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE/8", 64)
+	if err != nil {
+		return 0
+	}
+	if t == nil {
+		t = new(LightClientOptimisticUpdate)
+	}
+	// Field #0 'AttestedHeader' offset (4 bytes)
+	// Field #2 'SignatureSlot' static (8 bytes)
+	size += 12
+	{ // Field #1 'SyncAggregate'
+		t := t.SyncAggregate
+		if t == nil {
+			t = new(SyncAggregate)
+		}
+		// Field #1 'SyncCommitteeSignature' static (96 bytes)
+		size += 96
+		{ // Field #0 'SyncCommitteeBits'
+			size += int(expr0)
+		}
+	}
+	{ // Dynamic field #0 'AttestedHeader'
+		size += t.AttestedHeader.SizeSSZDyn(ds)
+	}
+	return size
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the *LightClientOptimisticUpdate.
+func (t *LightClientOptimisticUpdate) HashTreeRoot() (root [32]byte, err error) {
+	err = hasher.WithDefaultHasher(func(hh sszutils.HashWalker) (err error) {
+		err = t.HashTreeRootWith(hh)
+		if err == nil {
+			root, err = hh.HashRoot()
+		}
+		return
+	})
+	return
+}
+
+// HashTreeRootWith computes the SSZ hash tree root of the *LightClientOptimisticUpdate using the given hash walker.
+func (t *LightClientOptimisticUpdate) HashTreeRootWith(hh sszutils.HashWalker) error {
+	return t.HashTreeRootWithDyn(dynssz.GetGlobalDynSsz(), hh)
+}
+
+// HashTreeRootDyn computes the SSZ hash tree root of the *LightClientOptimisticUpdate using dynamic specifications.
+func (t *LightClientOptimisticUpdate) HashTreeRootDyn(ds sszutils.DynamicSpecs) (root [32]byte, err error) {
+	err = hasher.WithDefaultHasher(func(hh sszutils.HashWalker) (err error) {
+		err = t.HashTreeRootWithDyn(ds, hh)
+		if err == nil {
+			root, err = hh.HashRoot()
+		}
+		return
+	})
+	return
+}
+
+// HashTreeRootWithDyn computes the SSZ hash tree root of the *LightClientOptimisticUpdate using dynamic specifications and the given hash walker.
+func (t *LightClientOptimisticUpdate) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.HashWalker) error {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE/8", 64)
+	if err != nil {
+		return err
+	}
+	if t == nil {
+		t = new(LightClientOptimisticUpdate)
+	}
+	idx := hh.StartTree(sszutils.TreeTypeNone)
+	{ // Field #0 'AttestedHeader'
+		t := t.AttestedHeader
+		if t == nil {
+			t = new(LightClientHeader)
+		}
+		if err := t.HashTreeRootWithDyn(ds, hh); err != nil {
+			return sszutils.ErrorWithPath(err, "AttestedHeader")
+		}
+	}
+	{ // Field #1 'SyncAggregate'
+		t := t.SyncAggregate
+		if t == nil {
+			t = new(SyncAggregate)
+		}
+		idx := hh.StartTree(sszutils.TreeTypeNone)
+		{ // Field #0 'SyncCommitteeBits'
+			vlen := len(t.SyncCommitteeBits)
+			if vlen > int(expr0) {
+				return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr0)), "SyncAggregate.SyncCommitteeBits")
+			}
+			val := t.SyncCommitteeBits[:]
+			if vlen < int(expr0) {
+				val = sszutils.AppendZeroPadding(val, (int(expr0)-vlen)*1)
+			}
+			hh.PutBytes(val[:int(expr0)])
+		}
+		{ // Field #1 'SyncCommitteeSignature'
+			hh.PutBytes(t.SyncCommitteeSignature[:96])
+		}
+		hh.Merkleize(idx)
+	}
+	{ // Field #2 'SignatureSlot'
+		hh.PutUint64(uint64(t.SignatureSlot))
+	}
+	hh.Merkleize(idx)
+	return nil
+}
+
+// MarshalSSZ marshals the *SignedBeaconBlockElectra to SSZ-encoded bytes.
+func (t *SignedBeaconBlockElectra) MarshalSSZ() ([]byte, error) {
+	return dynssz.GetGlobalDynSsz().MarshalSSZ(t)
+}
+
+// MarshalSSZTo marshals the *SignedBeaconBlockElectra to SSZ-encoded bytes, appending to the provided buffer.
+func (t *SignedBeaconBlockElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	return t.MarshalSSZDyn(dynssz.GetGlobalDynSsz(), buf)
+}
+
+// MarshalSSZDyn marshals the *SignedBeaconBlockElectra to SSZ-encoded bytes using dynamic specifications.
+func (t *SignedBeaconBlockElectra) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst []byte, err error) {
+	dst = buf
+	if t == nil {
+		t = new(SignedBeaconBlockElectra)
+	}
+	dstlen := len(dst)
+	// Offset Field #0 'Message'
+	dst = append(dst, 0, 0, 0, 0)
+	{ // Static Field #1 'Signature'
+		dst = append(dst, t.Signature[:96]...)
+	}
+	{ // Dynamic Field #0 'Message'
+		binary.LittleEndian.PutUint32(dst[dstlen:], uint32(len(dst)-dstlen))
+		t := t.Message
+		if t == nil {
+			t = new(BeaconBlockElectra)
+		}
+		if dst, err = t.MarshalSSZDyn(ds, dst); err != nil {
+			return nil, sszutils.ErrorWithPath(err, "Message")
+		}
+	}
+	return dst, nil
+}
+
+// MarshalSSZEncoder marshals the *SignedBeaconBlockElectra to the given SSZ encoder using dynamic specifications.
+func (t *SignedBeaconBlockElectra) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.Encoder) (err error) {
+	type encoderCtx struct {
+		ds      sszutils.DynamicSpecs
+		exprs   [0]uint64
+		sizeFn1 func(ctx *encoderCtx, t *BeaconBlockElectra) (size int)
+	}
+	ctx := &encoderCtx{ds: ds}
+	canSeek := enc.Seekable()
+	// size for *BeaconBlockElectra
+	ctx.sizeFn1 = func(ctx *encoderCtx, t *BeaconBlockElectra) (size int) {
+		size += t.SizeSSZDyn(ds)
+		return size
+	}
+	if t == nil {
+		t = new(SignedBeaconBlockElectra)
+	}
+	dstlen := enc.GetPosition()
+	dynoff := uint32(100)
+	// Offset #0 'Message'
+	offset0 := enc.GetPosition()
+	if canSeek {
+		enc.EncodeOffset(0)
+	} else {
+		enc.EncodeOffset(dynoff)
+		dynoff += uint32(ctx.sizeFn1(ctx, t.Message))
+	}
+	{ // Field #1 'Signature'
+		enc.EncodeBytes(t.Signature[:96])
+	}
+	{ // Dynamic Field #0 'Message'
+		if canSeek {
+			enc.EncodeOffsetAt(offset0, uint32(enc.GetPosition()-dstlen))
+		}
+		t := t.Message
+		if t == nil {
+			t = new(BeaconBlockElectra)
+		}
+		if err = t.MarshalSSZEncoder(ds, enc); err != nil {
+			return sszutils.ErrorWithPath(err, "Message")
+		}
+	}
+	return nil
+}
+
+// UnmarshalSSZ unmarshals the *SignedBeaconBlockElectra from SSZ-encoded bytes.
+func (t *SignedBeaconBlockElectra) UnmarshalSSZ(buf []byte) (err error) {
+	return t.UnmarshalSSZDyn(dynssz.GetGlobalDynSsz(), buf)
+}
+
+// UnmarshalSSZDyn unmarshals the *SignedBeaconBlockElectra from SSZ-encoded bytes using dynamic specifications.
+func (t *SignedBeaconBlockElectra) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err error) {
+	buflen := len(buf)
+	if buflen < 100 {
+		return sszutils.ErrFixedFieldsEOFFn(buflen, 100)
+	}
+	// Field #0 'Message' (offset)
+	offset0 := int(binary.LittleEndian.Uint32(buf[0:4]))
+	if offset0 != 100 {
+		return sszutils.ErrorWithPath(sszutils.ErrFirstOffsetMismatchFn(offset0, 100), "Message:o")
+	}
+	{ // Field #1 'Signature' (static)
+		buf := buf[4:100]
+		copy(t.Signature[:], buf)
+	}
+	{ // Field #0 'Message' (dynamic)
+		buf := buf[offset0:]
+		if t.Message == nil {
+			t.Message = new(BeaconBlockElectra)
+		}
+		if err = t.Message.UnmarshalSSZDyn(ds, buf); err != nil {
+			return sszutils.ErrorWithPath(err, "Message")
+		}
+	}
+	return nil
+}
+
+// UnmarshalSSZDecoder unmarshals the *SignedBeaconBlockElectra from the given SSZ decoder using dynamic specifications.
+func (t *SignedBeaconBlockElectra) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils.Decoder) (err error) {
+	maxOffset := uint32(dec.GetLength())
+	startPos0 := dec.GetPosition()
+	if maxOffset < uint32(100) {
+		return sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(100))
+	}
+	// Field #0 'Message' (offset)
+	offset0, err := dec.DecodeOffset()
+	if err != nil {
+		return sszutils.ErrorWithPath(err, "Message")
+	}
+	if offset0 != uint32(100) {
+		return sszutils.ErrorWithPath(sszutils.ErrFirstOffsetMismatchFn(offset0, 100), "Message")
+	}
+	// Field #1 'Signature' (static)
+	if _, err = dec.DecodeBytes(t.Signature[:96]); err != nil {
+		return err
+	}
+	{ // Field #0 'Message' (dynamic)
+		if dec.GetPosition() != startPos0+int(offset0) {
+			return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos0+int(offset0)), "Message")
+		}
+		dec.PushLimit(int(maxOffset - offset0))
+		val1 := t.Message
+		if val1 == nil {
+			val1 = new(BeaconBlockElectra)
+		}
+		if err = val1.UnmarshalSSZDecoder(ds, dec); err != nil {
+			return sszutils.ErrorWithPath(err, "Message")
+		}
+		if diff := dec.PopLimit(); diff != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "Message")
+		}
+		t.Message = val1
+	}
+	return nil
+}
+
+// SizeSSZ returns the SSZ encoded size of the *SignedBeaconBlockElectra.
+func (t *SignedBeaconBlockElectra) SizeSSZ() (size int) {
+	return t.SizeSSZDyn(dynssz.GetGlobalDynSsz())
+}
+
+// SizeSSZDyn returns the SSZ encoded size of the *SignedBeaconBlockElectra using dynamic specifications.
+func (t *SignedBeaconBlockElectra) SizeSSZDyn(ds sszutils.DynamicSpecs) (size int) {
+	if t == nil {
+		t = new(SignedBeaconBlockElectra)
+	}
+	// Field #0 'Message' offset (4 bytes)
+	// Field #1 'Signature' static (96 bytes)
+	size += 100
+	{ // Dynamic field #0 'Message'
+		size += t.Message.SizeSSZDyn(ds)
+	}
+	return size
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the *SignedBeaconBlockElectra.
+func (t *SignedBeaconBlockElectra) HashTreeRoot() (root [32]byte, err error) {
+	err = hasher.WithDefaultHasher(func(hh sszutils.HashWalker) (err error) {
+		err = t.HashTreeRootWith(hh)
+		if err == nil {
+			root, err = hh.HashRoot()
+		}
+		return
+	})
+	return
+}
+
+// HashTreeRootWith computes the SSZ hash tree root of the *SignedBeaconBlockElectra using the given hash walker.
+func (t *SignedBeaconBlockElectra) HashTreeRootWith(hh sszutils.HashWalker) error {
+	return t.HashTreeRootWithDyn(dynssz.GetGlobalDynSsz(), hh)
+}
+
+// HashTreeRootDyn computes the SSZ hash tree root of the *SignedBeaconBlockElectra using dynamic specifications.
+func (t *SignedBeaconBlockElectra) HashTreeRootDyn(ds sszutils.DynamicSpecs) (root [32]byte, err error) {
+	err = hasher.WithDefaultHasher(func(hh sszutils.HashWalker) (err error) {
+		err = t.HashTreeRootWithDyn(ds, hh)
+		if err == nil {
+			root, err = hh.HashRoot()
+		}
+		return
+	})
+	return
+}
+
+// HashTreeRootWithDyn computes the SSZ hash tree root of the *SignedBeaconBlockElectra using dynamic specifications and the given hash walker.
+func (t *SignedBeaconBlockElectra) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.HashWalker) error {
+	if t == nil {
+		t = new(SignedBeaconBlockElectra)
+	}
+	idx := hh.StartTree(sszutils.TreeTypeNone)
+	{ // Field #0 'Message'
+		t := t.Message
+		if t == nil {
+			t = new(BeaconBlockElectra)
+		}
+		if err := t.HashTreeRootWithDyn(ds, hh); err != nil {
+			return sszutils.ErrorWithPath(err, "Message")
+		}
+	}
+	{ // Field #1 'Signature'
+		hh.PutBytes(t.Signature[:96])
+	}
+	hh.Merkleize(idx)
+	return nil
+}
+
+// MarshalSSZ marshals the *BeaconBlockElectra to SSZ-encoded bytes.
+func (t *BeaconBlockElectra) MarshalSSZ() ([]byte, error) {
+	return dynssz.GetGlobalDynSsz().MarshalSSZ(t)
+}
+
+// MarshalSSZTo marshals the *BeaconBlockElectra to SSZ-encoded bytes, appending to the provided buffer.
+func (t *BeaconBlockElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	return t.MarshalSSZDyn(dynssz.GetGlobalDynSsz(), buf)
+}
+
+// MarshalSSZDyn marshals the *BeaconBlockElectra to SSZ-encoded bytes using dynamic specifications.
+func (t *BeaconBlockElectra) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst []byte, err error) {
+	dst = buf
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE/8", 64)
+	if err != nil {
+		return dst, err
+	}
+	expr1, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_PROPOSER_SLASHINGS", 16)
+	if err != nil {
+		return dst, err
+	}
+	expr2, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_ATTESTER_SLASHINGS_ELECTRA", 1)
+	if err != nil {
+		return dst, err
+	}
+	expr3, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_VALIDATORS_PER_COMMITTEE*MAX_COMMITTEES_PER_SLOT", 131072)
+	if err != nil {
+		return dst, err
+	}
+	expr4, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_ATTESTATIONS_ELECTRA", 8)
+	if err != nil {
+		return dst, err
+	}
+	expr5, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_COMMITTEES_PER_SLOT/8", 8)
+	if err != nil {
+		return dst, err
+	}
+	expr6, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_DEPOSITS", 16)
+	if err != nil {
+		return dst, err
+	}
+	expr7, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_VOLUNTARY_EXITS", 16)
+	if err != nil {
+		return dst, err
+	}
+	expr8, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_EXTRA_DATA_BYTES", 32)
+	if err != nil {
+		return dst, err
+	}
+	expr9, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_TRANSACTIONS_PER_PAYLOAD", 1048576)
+	if err != nil {
+		return dst, err
+	}
+	expr10, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_BYTES_PER_TRANSACTION", 1073741824)
+	if err != nil {
+		return dst, err
+	}
+	expr11, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_WITHDRAWALS_PER_PAYLOAD", 16)
+	if err != nil {
+		return dst, err
+	}
+	expr12, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_BLS_TO_EXECUTION_CHANGES", 16)
+	if err != nil {
+		return dst, err
+	}
+	expr13, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_BLOB_COMMITMENTS_PER_BLOCK", 4096)
+	if err != nil {
+		return dst, err
+	}
+	expr14, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_DEPOSIT_REQUESTS_PER_PAYLOAD", 8192)
+	if err != nil {
+		return dst, err
+	}
+	expr15, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_WITHDRAWAL_REQUESTS_PER_PAYLOAD", 16)
+	if err != nil {
+		return dst, err
+	}
+	expr16, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_CONSOLIDATION_REQUESTS_PER_PAYLOAD", 2)
+	if err != nil {
+		return dst, err
+	}
+	zeroBytes := sszutils.ZeroBytes()
+	if t == nil {
+		t = new(BeaconBlockElectra)
+	}
+	dstlen := len(dst)
+	{ // Static Field #0 'Slot'
+		dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Slot))
+	}
+	{ // Static Field #1 'ProposerIndex'
+		dst = binary.LittleEndian.AppendUint64(dst, uint64(t.ProposerIndex))
+	}
+	{ // Static Field #2 'ParentRoot'
+		dst = append(dst, t.ParentRoot[:32]...)
+	}
+	{ // Static Field #3 'StateRoot'
+		dst = append(dst, t.StateRoot[:32]...)
+	}
+	// Offset Field #4 'Body'
+	dst = append(dst, 0, 0, 0, 0)
+	{ // Dynamic Field #4 'Body'
+		binary.LittleEndian.PutUint32(dst[dstlen+80:], uint32(len(dst)-dstlen))
+		t := t.Body
+		if t == nil {
+			t = new(BeaconBlockBodyElectra)
+		}
+		dstlen := len(dst)
+		{ // Static Field #0 'RANDAOReveal'
+			dst = append(dst, t.RANDAOReveal[:96]...)
+		}
+		{ // Static Field #1 'ETH1Data'
+			t := t.ETH1Data
+			if t == nil {
+				t = new(ETH1Data)
+			}
+			{ // Static Field #0 'DepositRoot'
+				dst = append(dst, t.DepositRoot[:32]...)
+			}
+			{ // Static Field #1 'DepositCount'
+				dst = binary.LittleEndian.AppendUint64(dst, t.DepositCount)
+			}
+			{ // Static Field #2 'BlockHash'
+				dst = append(dst, t.BlockHash[:32]...)
+			}
+		}
+		{ // Static Field #2 'Graffiti'
+			dst = append(dst, t.Graffiti[:32]...)
+		}
+		// Offset Field #3 'ProposerSlashings'
+		// Offset Field #4 'AttesterSlashings'
+		// Offset Field #5 'Attestations'
+		// Offset Field #6 'Deposits'
+		// Offset Field #7 'VoluntaryExits'
+		dst = append(dst, zeroBytes[:20]...)
+		{ // Static Field #8 'SyncAggregate'
+			t := t.SyncAggregate
+			if t == nil {
+				t = new(SyncAggregate)
+			}
+			{ // Static Field #0 'SyncCommitteeBits'
+				vlen := len(t.SyncCommitteeBits)
+				if vlen > int(expr0) {
+					return nil, sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr0)), "Body.SyncAggregate.SyncCommitteeBits")
+				}
+				dst = append(dst, t.SyncCommitteeBits[:vlen]...)
+				if vlen < int(expr0) {
+					dst = sszutils.AppendZeroPadding(dst, (int(expr0)-vlen)*1)
+				}
+			}
+			{ // Static Field #1 'SyncCommitteeSignature'
+				dst = append(dst, t.SyncCommitteeSignature[:96]...)
+			}
+		}
+		// Offset Field #9 'ExecutionPayload'
+		offset9 := len(dst)
+		// Offset Field #10 'BLSToExecutionChanges'
+		// Offset Field #11 'BlobKZGCommitments'
+		// Offset Field #12 'ExecutionRequests'
+		dst = append(dst, zeroBytes[:16]...)
+		{ // Dynamic Field #3 'ProposerSlashings'
+			binary.LittleEndian.PutUint32(dst[dstlen+200:], uint32(len(dst)-dstlen))
+			t := t.ProposerSlashings
+			vlen := len(t)
+			if vlen > int(expr1) {
+				return nil, sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(expr1)), "Body.ProposerSlashings")
+			}
+			for idx1 := range vlen {
+				t := t[idx1]
+				if t == nil {
+					t = new(ProposerSlashing)
+				}
+				{ // Static Field #0 'SignedHeader1'
+					t := t.SignedHeader1
+					if t == nil {
+						t = new(SignedBeaconBlockHeader)
+					}
+					{ // Static Field #0 'Message'
+						t := t.Message
+						if t == nil {
+							t = new(BeaconBlockHeader)
+						}
+						{ // Static Field #0 'Slot'
+							dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Slot))
+						}
+						{ // Static Field #1 'ProposerIndex'
+							dst = binary.LittleEndian.AppendUint64(dst, uint64(t.ProposerIndex))
+						}
+						{ // Static Field #2 'ParentRoot'
+							dst = append(dst, t.ParentRoot[:32]...)
+						}
+						{ // Static Field #3 'StateRoot'
+							dst = append(dst, t.StateRoot[:32]...)
+						}
+						{ // Static Field #4 'BodyRoot'
+							dst = append(dst, t.BodyRoot[:32]...)
+						}
+					}
+					{ // Static Field #1 'Signature'
+						dst = append(dst, t.Signature[:96]...)
+					}
+				}
+				{ // Static Field #1 'SignedHeader2'
+					t := t.SignedHeader2
+					if t == nil {
+						t = new(SignedBeaconBlockHeader)
+					}
+					{ // Static Field #0 'Message'
+						t := t.Message
+						if t == nil {
+							t = new(BeaconBlockHeader)
+						}
+						{ // Static Field #0 'Slot'
+							dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Slot))
+						}
+						{ // Static Field #1 'ProposerIndex'
+							dst = binary.LittleEndian.AppendUint64(dst, uint64(t.ProposerIndex))
+						}
+						{ // Static Field #2 'ParentRoot'
+							dst = append(dst, t.ParentRoot[:32]...)
+						}
+						{ // Static Field #3 'StateRoot'
+							dst = append(dst, t.StateRoot[:32]...)
+						}
+						{ // Static Field #4 'BodyRoot'
+							dst = append(dst, t.BodyRoot[:32]...)
+						}
+					}
+					{ // Static Field #1 'Signature'
+						dst = append(dst, t.Signature[:96]...)
+					}
+				}
+			}
+		}
+		{ // Dynamic Field #4 'AttesterSlashings'
+			binary.LittleEndian.PutUint32(dst[dstlen+204:], uint32(len(dst)-dstlen))
+			t := t.AttesterSlashings
+			vlen := len(t)
+			if vlen > int(expr2) {
+				return nil, sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(expr2)), "Body.AttesterSlashings")
+			}
+			dstlen := len(dst)
+			dst = sszutils.AppendZeroPadding(dst, vlen*4)
+			for idx1 := range vlen {
+				binary.LittleEndian.PutUint32(dst[dstlen+(idx1*4):], uint32(len(dst)-dstlen))
+				t := t[idx1]
+				if t == nil {
+					t = new(AttesterSlashingElectra)
+				}
+				dstlen := len(dst)
+				// Offset Field #0 'Attestation1'
+				// Offset Field #1 'Attestation2'
+				dst = append(dst, 0, 0, 0, 0, 0, 0, 0, 0)
+				{ // Dynamic Field #0 'Attestation1'
+					binary.LittleEndian.PutUint32(dst[dstlen:], uint32(len(dst)-dstlen))
+					t := t.Attestation1
+					if t == nil {
+						t = new(IndexedAttestationElectra)
+					}
+					dstlen := len(dst)
+					// Offset Field #0 'AttestingIndices'
+					dst = append(dst, 0, 0, 0, 0)
+					{ // Static Field #1 'Data'
+						t := t.Data
+						if t == nil {
+							t = new(AttestationData)
+						}
+						{ // Static Field #0 'Slot'
+							dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Slot))
+						}
+						{ // Static Field #1 'Index'
+							dst = binary.LittleEndian.AppendUint64(dst, t.Index)
+						}
+						{ // Static Field #2 'BeaconBlockRoot'
+							dst = append(dst, t.BeaconBlockRoot[:32]...)
+						}
+						{ // Static Field #3 'Source'
+							t := t.Source
+							if t == nil {
+								t = new(Checkpoint)
+							}
+							{ // Static Field #0 'Epoch'
+								dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Epoch))
+							}
+							{ // Static Field #1 'Root'
+								dst = append(dst, t.Root[:32]...)
+							}
+						}
+						{ // Static Field #4 'Target'
+							t := t.Target
+							if t == nil {
+								t = new(Checkpoint)
+							}
+							{ // Static Field #0 'Epoch'
+								dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Epoch))
+							}
+							{ // Static Field #1 'Root'
+								dst = append(dst, t.Root[:32]...)
+							}
+						}
+					}
+					{ // Static Field #2 'Signature'
+						dst = append(dst, t.Signature[:96]...)
+					}
+					{ // Dynamic Field #0 'AttestingIndices'
+						binary.LittleEndian.PutUint32(dst[dstlen:], uint32(len(dst)-dstlen))
+						t := t.AttestingIndices
+						vlen := len(t)
+						if vlen > int(expr3) {
+							return nil, sszutils.ErrorWithPathf(sszutils.ErrListLengthFn(vlen, int(expr3)), "Body.AttesterSlashings[%d].Attestation1.AttestingIndices", idx1)
+						}
+						dst = sszutils.MarshalUint64Slice(dst, t[:vlen])
+					}
+				}
+				{ // Dynamic Field #1 'Attestation2'
+					binary.LittleEndian.PutUint32(dst[dstlen+4:], uint32(len(dst)-dstlen))
+					t := t.Attestation2
+					if t == nil {
+						t = new(IndexedAttestationElectra)
+					}
+					dstlen := len(dst)
+					// Offset Field #0 'AttestingIndices'
+					dst = append(dst, 0, 0, 0, 0)
+					{ // Static Field #1 'Data'
+						t := t.Data
+						if t == nil {
+							t = new(AttestationData)
+						}
+						{ // Static Field #0 'Slot'
+							dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Slot))
+						}
+						{ // Static Field #1 'Index'
+							dst = binary.LittleEndian.AppendUint64(dst, t.Index)
+						}
+						{ // Static Field #2 'BeaconBlockRoot'
+							dst = append(dst, t.BeaconBlockRoot[:32]...)
+						}
+						{ // Static Field #3 'Source'
+							t := t.Source
+							if t == nil {
+								t = new(Checkpoint)
+							}
+							{ // Static Field #0 'Epoch'
+								dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Epoch))
+							}
+							{ // Static Field #1 'Root'
+								dst = append(dst, t.Root[:32]...)
+							}
+						}
+						{ // Static Field #4 'Target'
+							t := t.Target
+							if t == nil {
+								t = new(Checkpoint)
+							}
+							{ // Static Field #0 'Epoch'
+								dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Epoch))
+							}
+							{ // Static Field #1 'Root'
+								dst = append(dst, t.Root[:32]...)
+							}
+						}
+					}
+					{ // Static Field #2 'Signature'
+						dst = append(dst, t.Signature[:96]...)
+					}
+					{ // Dynamic Field #0 'AttestingIndices'
+						binary.LittleEndian.PutUint32(dst[dstlen:], uint32(len(dst)-dstlen))
+						t := t.AttestingIndices
+						vlen := len(t)
+						if vlen > int(expr3) {
+							return nil, sszutils.ErrorWithPathf(sszutils.ErrListLengthFn(vlen, int(expr3)), "Body.AttesterSlashings[%d].Attestation2.AttestingIndices", idx1)
+						}
+						dst = sszutils.MarshalUint64Slice(dst, t[:vlen])
+					}
+				}
+			}
+		}
+		{ // Dynamic Field #5 'Attestations'
+			binary.LittleEndian.PutUint32(dst[dstlen+208:], uint32(len(dst)-dstlen))
+			t := t.Attestations
+			vlen := len(t)
+			if vlen > int(expr4) {
+				return nil, sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(expr4)), "Body.Attestations")
+			}
+			dstlen := len(dst)
+			dst = sszutils.AppendZeroPadding(dst, vlen*4)
+			for idx1 := range vlen {
+				binary.LittleEndian.PutUint32(dst[dstlen+(idx1*4):], uint32(len(dst)-dstlen))
+				t := t[idx1]
+				if t == nil {
+					t = new(AttestationElectra)
+				}
+				dstlen := len(dst)
+				// Offset Field #0 'AggregationBits'
+				dst = append(dst, 0, 0, 0, 0)
+				{ // Static Field #1 'Data'
+					t := t.Data
+					if t == nil {
+						t = new(AttestationData)
+					}
+					{ // Static Field #0 'Slot'
+						dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Slot))
+					}
+					{ // Static Field #1 'Index'
+						dst = binary.LittleEndian.AppendUint64(dst, t.Index)
+					}
+					{ // Static Field #2 'BeaconBlockRoot'
+						dst = append(dst, t.BeaconBlockRoot[:32]...)
+					}
+					{ // Static Field #3 'Source'
+						t := t.Source
+						if t == nil {
+							t = new(Checkpoint)
+						}
+						{ // Static Field #0 'Epoch'
+							dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Epoch))
+						}
+						{ // Static Field #1 'Root'
+							dst = append(dst, t.Root[:32]...)
+						}
+					}
+					{ // Static Field #4 'Target'
+						t := t.Target
+						if t == nil {
+							t = new(Checkpoint)
+						}
+						{ // Static Field #0 'Epoch'
+							dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Epoch))
+						}
+						{ // Static Field #1 'Root'
+							dst = append(dst, t.Root[:32]...)
+						}
+					}
+				}
+				{ // Static Field #2 'Signature'
+					dst = append(dst, t.Signature[:96]...)
+				}
+				{ // Static Field #3 'CommitteeBits'
+					vlen := len(t.CommitteeBits)
+					if vlen > int(expr5) {
+						return nil, sszutils.ErrorWithPathf(sszutils.ErrVectorLengthFn(vlen, int(expr5)), "Body.Attestations[%d].CommitteeBits", idx1)
+					}
+					dst = append(dst, t.CommitteeBits[:vlen]...)
+					if vlen < int(expr5) {
+						dst = sszutils.AppendZeroPadding(dst, (int(expr5)-vlen)*1)
+					}
+				}
+				{ // Dynamic Field #0 'AggregationBits'
+					binary.LittleEndian.PutUint32(dst[dstlen:], uint32(len(dst)-dstlen))
+					t := t.AggregationBits
+					vlen := len(t)
+					bval := []byte(t[:])
+					if vlen == 0 {
+						bval = []byte{0x01}
+					} else if bval[vlen-1] == 0x00 {
+						return nil, sszutils.ErrorWithPathf(sszutils.ErrBitlistNotTerminatedFn(), "Body.Attestations[%d].AggregationBits", idx1)
+					}
+					if vlen > 0 {
+						bitCount := 8*(vlen-1) + bits.Len8(bval[vlen-1]) - 1
+						if bitCount > int(expr3) {
+							return nil, sszutils.ErrorWithPathf(sszutils.ErrBitlistLengthFn(bitCount, int(expr3)), "Body.Attestations[%d].AggregationBits", idx1)
+						}
+					}
+					dst = append(dst, bval...)
+				}
+			}
+		}
+		{ // Dynamic Field #6 'Deposits'
+			binary.LittleEndian.PutUint32(dst[dstlen+212:], uint32(len(dst)-dstlen))
+			t := t.Deposits
+			vlen := len(t)
+			if vlen > int(expr6) {
+				return nil, sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(expr6)), "Body.Deposits")
+			}
+			for idx1 := range vlen {
+				t := t[idx1]
+				if t == nil {
+					t = new(Deposit)
+				}
+				{ // Static Field #0 'Proof'
+					t := t.Proof
+					vlen := len(t)
+					if vlen > 33 {
+						return nil, sszutils.ErrorWithPathf(sszutils.ErrVectorLengthFn(vlen, 33), "Body.Deposits[%d].Proof", idx1)
+					}
+					for idx2 := range vlen {
+						vlen := len(t[idx2])
+						if vlen > 32 {
+							return nil, sszutils.ErrorWithPathf(sszutils.ErrVectorLengthFn(vlen, 32), "Body.Deposits[%d].Proof[%d]", idx1, idx2)
+						}
+						dst = append(dst, t[idx2][:vlen]...)
+						if vlen < 32 {
+							dst = sszutils.AppendZeroPadding(dst, (32-vlen)*1)
+						}
+					}
+					if vlen < 33 {
+						dst = sszutils.AppendZeroPadding(dst, (33-vlen)*32)
+					}
+				}
+				{ // Static Field #1 'Data'
+					t := t.Data
+					if t == nil {
+						t = new(DepositData)
+					}
+					{ // Static Field #0 'Pubkey'
+						dst = append(dst, t.Pubkey[:48]...)
+					}
+					{ // Static Field #1 'WithdrawalCredentials'
+						dst = append(dst, t.WithdrawalCredentials[:32]...)
+					}
+					{ // Static Field #2 'Amount'
+						dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Amount))
+					}
+					{ // Static Field #3 'Signature'
+						dst = append(dst, t.Signature[:96]...)
+					}
+				}
+			}
+		}
+		{ // Dynamic Field #7 'VoluntaryExits'
+			binary.LittleEndian.PutUint32(dst[dstlen+216:], uint32(len(dst)-dstlen))
+			t := t.VoluntaryExits
+			vlen := len(t)
+			if vlen > int(expr7) {
+				return nil, sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(expr7)), "Body.VoluntaryExits")
+			}
+			for idx1 := range vlen {
+				t := t[idx1]
+				if t == nil {
+					t = new(SignedVoluntaryExit)
+				}
+				{ // Static Field #0 'Message'
+					t := t.Message
+					if t == nil {
+						t = new(VoluntaryExit)
+					}
+					{ // Static Field #0 'Epoch'
+						dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Epoch))
+					}
+					{ // Static Field #1 'ValidatorIndex'
+						dst = binary.LittleEndian.AppendUint64(dst, uint64(t.ValidatorIndex))
+					}
+				}
+				{ // Static Field #1 'Signature'
+					dst = append(dst, t.Signature[:96]...)
+				}
+			}
+		}
+		{ // Dynamic Field #9 'ExecutionPayload'
+			binary.LittleEndian.PutUint32(dst[offset9:], uint32(len(dst)-dstlen))
+			t := t.ExecutionPayload
+			if t == nil {
+				t = new(ExecutionPayload)
+			}
+			dstlen := len(dst)
+			{ // Static Field #0 'ParentHash'
+				dst = append(dst, t.ParentHash[:32]...)
+			}
+			{ // Static Field #1 'FeeRecipient'
+				dst = append(dst, t.FeeRecipient[:20]...)
+			}
+			{ // Static Field #2 'StateRoot'
+				dst = append(dst, t.StateRoot[:32]...)
+			}
+			{ // Static Field #3 'ReceiptsRoot'
+				dst = append(dst, t.ReceiptsRoot[:32]...)
+			}
+			{ // Static Field #4 'LogsBloom'
+				dst = append(dst, t.LogsBloom[:256]...)
+			}
+			{ // Static Field #5 'PrevRandao'
+				dst = append(dst, t.PrevRandao[:32]...)
+			}
+			{ // Static Field #6 'BlockNumber'
+				dst = binary.LittleEndian.AppendUint64(dst, t.BlockNumber)
+			}
+			{ // Static Field #7 'GasLimit'
+				dst = binary.LittleEndian.AppendUint64(dst, t.GasLimit)
+			}
+			{ // Static Field #8 'GasUsed'
+				dst = binary.LittleEndian.AppendUint64(dst, t.GasUsed)
+			}
+			{ // Static Field #9 'Timestamp'
+				dst = binary.LittleEndian.AppendUint64(dst, t.Timestamp)
+			}
+			// Offset Field #10 'ExtraData'
+			dst = append(dst, 0, 0, 0, 0)
+			{ // Static Field #11 'BaseFeePerGas'
+				dst = append(dst, t.BaseFeePerGas[:32]...)
+			}
+			{ // Static Field #12 'BlockHash'
+				dst = append(dst, t.BlockHash[:32]...)
+			}
+			// Offset Field #13 'Transactions'
+			// Offset Field #14 'Withdrawals'
+			dst = append(dst, 0, 0, 0, 0, 0, 0, 0, 0)
+			{ // Static Field #15 'BlobGasUsed'
+				dst = binary.LittleEndian.AppendUint64(dst, t.BlobGasUsed)
+			}
+			{ // Static Field #16 'ExcessBlobGas'
+				dst = binary.LittleEndian.AppendUint64(dst, t.ExcessBlobGas)
+			}
+			{ // Dynamic Field #10 'ExtraData'
+				binary.LittleEndian.PutUint32(dst[dstlen+436:], uint32(len(dst)-dstlen))
+				vlen := len(t.ExtraData)
+				if vlen > int(expr8) {
+					return nil, sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(expr8)), "Body.ExecutionPayload.ExtraData")
+				}
+				dst = append(dst, t.ExtraData[:]...)
+			}
+			{ // Dynamic Field #13 'Transactions'
+				binary.LittleEndian.PutUint32(dst[dstlen+504:], uint32(len(dst)-dstlen))
+				t := t.Transactions
+				vlen := len(t)
+				if vlen > int(expr9) {
+					return nil, sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(expr9)), "Body.ExecutionPayload.Transactions")
+				}
+				dstlen := len(dst)
+				dst = sszutils.AppendZeroPadding(dst, vlen*4)
+				for idx1 := range vlen {
+					binary.LittleEndian.PutUint32(dst[dstlen+(idx1*4):], uint32(len(dst)-dstlen))
+					vlen := len(t[idx1])
+					if vlen > int(expr10) {
+						return nil, sszutils.ErrorWithPathf(sszutils.ErrListLengthFn(vlen, int(expr10)), "Body.ExecutionPayload.Transactions[%d]", idx1)
+					}
+					dst = append(dst, t[idx1][:]...)
+				}
+			}
+			{ // Dynamic Field #14 'Withdrawals'
+				binary.LittleEndian.PutUint32(dst[dstlen+508:], uint32(len(dst)-dstlen))
+				t := t.Withdrawals
+				vlen := len(t)
+				if vlen > int(expr11) {
+					return nil, sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(expr11)), "Body.ExecutionPayload.Withdrawals")
+				}
+				for idx1 := range vlen {
+					t := t[idx1]
+					if t == nil {
+						t = new(Withdrawal)
+					}
+					{ // Static Field #0 'Index'
+						dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Index))
+					}
+					{ // Static Field #1 'ValidatorIndex'
+						dst = binary.LittleEndian.AppendUint64(dst, uint64(t.ValidatorIndex))
+					}
+					{ // Static Field #2 'Address'
+						dst = append(dst, t.Address[:20]...)
+					}
+					{ // Static Field #3 'Amount'
+						dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Amount))
+					}
+				}
+			}
+		}
+		{ // Dynamic Field #10 'BLSToExecutionChanges'
+			binary.LittleEndian.PutUint32(dst[offset9+4:], uint32(len(dst)-dstlen))
+			t := t.BLSToExecutionChanges
+			vlen := len(t)
+			if vlen > int(expr12) {
+				return nil, sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(expr12)), "Body.BLSToExecutionChanges")
+			}
+			for idx1 := range vlen {
+				t := t[idx1]
+				if t == nil {
+					t = new(SignedBLSToExecutionChange)
+				}
+				{ // Static Field #0 'Message'
+					t := t.Message
+					if t == nil {
+						t = new(BLSToExecutionChange)
+					}
+					{ // Static Field #0 'ValidatorIndex'
+						dst = binary.LittleEndian.AppendUint64(dst, uint64(t.ValidatorIndex))
+					}
+					{ // Static Field #1 'FromBLSPubkey'
+						dst = append(dst, t.FromBLSPubkey[:48]...)
+					}
+					{ // Static Field #2 'ToExecutionAddress'
+						dst = append(dst, t.ToExecutionAddress[:20]...)
+					}
+				}
+				{ // Static Field #1 'Signature'
+					dst = append(dst, t.Signature[:96]...)
+				}
+			}
+		}
+		{ // Dynamic Field #11 'BlobKZGCommitments'
+			binary.LittleEndian.PutUint32(dst[offset9+8:], uint32(len(dst)-dstlen))
+			t := t.BlobKZGCommitments
+			vlen := len(t)
+			if vlen > int(expr13) {
+				return nil, sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(expr13)), "Body.BlobKZGCommitments")
+			}
+			dst = sszutils.MarshalFixedBytesSlice(dst, t[:vlen])
+		}
+		{ // Dynamic Field #12 'ExecutionRequests'
+			binary.LittleEndian.PutUint32(dst[offset9+12:], uint32(len(dst)-dstlen))
+			t := t.ExecutionRequests
+			if t == nil {
+				t = new(ExecutionRequests)
+			}
+			dstlen := len(dst)
+			// Offset Field #0 'Deposits'
+			// Offset Field #1 'Withdrawals'
+			// Offset Field #2 'Consolidations'
+			dst = append(dst, zeroBytes[:12]...)
+			{ // Dynamic Field #0 'Deposits'
+				binary.LittleEndian.PutUint32(dst[dstlen:], uint32(len(dst)-dstlen))
+				t := t.Deposits
+				vlen := len(t)
+				if vlen > int(expr14) {
+					return nil, sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(expr14)), "Body.ExecutionRequests.Deposits")
+				}
+				for idx1 := range vlen {
+					t := t[idx1]
+					if t == nil {
+						t = new(DepositRequest)
+					}
+					{ // Static Field #0 'Pubkey'
+						dst = append(dst, t.Pubkey[:48]...)
+					}
+					{ // Static Field #1 'WithdrawalCredentials'
+						dst = append(dst, t.WithdrawalCredentials[:32]...)
+					}
+					{ // Static Field #2 'Amount'
+						dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Amount))
+					}
+					{ // Static Field #3 'Signature'
+						dst = append(dst, t.Signature[:96]...)
+					}
+					{ // Static Field #4 'Index'
+						dst = binary.LittleEndian.AppendUint64(dst, t.Index)
+					}
+				}
+			}
+			{ // Dynamic Field #1 'Withdrawals'
+				binary.LittleEndian.PutUint32(dst[dstlen+4:], uint32(len(dst)-dstlen))
+				t := t.Withdrawals
+				vlen := len(t)
+				if vlen > int(expr15) {
+					return nil, sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(expr15)), "Body.ExecutionRequests.Withdrawals")
+				}
+				for idx1 := range vlen {
+					t := t[idx1]
+					if t == nil {
+						t = new(WithdrawalRequest)
+					}
+					{ // Static Field #0 'SourceAddress'
+						dst = append(dst, t.SourceAddress[:20]...)
+					}
+					{ // Static Field #1 'ValidatorPubkey'
+						dst = append(dst, t.ValidatorPubkey[:48]...)
+					}
+					{ // Static Field #2 'Amount'
+						dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Amount))
+					}
+				}
+			}
+			{ // Dynamic Field #2 'Consolidations'
+				binary.LittleEndian.PutUint32(dst[dstlen+8:], uint32(len(dst)-dstlen))
+				t := t.Consolidations
+				vlen := len(t)
+				if vlen > int(expr16) {
+					return nil, sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(expr16)), "Body.ExecutionRequests.Consolidations")
+				}
+				for idx1 := range vlen {
+					t := t[idx1]
+					if t == nil {
+						t = new(ConsolidationRequest)
+					}
+					{ // Static Field #0 'SourceAddress'
+						dst = append(dst, t.SourceAddress[:20]...)
+					}
+					{ // Static Field #1 'SourcePubkey'
+						dst = append(dst, t.SourcePubkey[:48]...)
+					}
+					{ // Static Field #2 'TargetPubkey'
+						dst = append(dst, t.TargetPubkey[:48]...)
+					}
+				}
+			}
+		}
+	}
+	return dst, nil
+}
+
+// MarshalSSZEncoder marshals the *BeaconBlockElectra to the given SSZ encoder using dynamic specifications.
+func (t *BeaconBlockElectra) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.Encoder) (err error) {
+	type encoderCtx struct {
+		ds       sszutils.DynamicSpecs
+		exprs    [17]uint64
+		sizeFn1  func(ctx *encoderCtx, t *BeaconBlockBodyElectra) (size int)
+		sizeFn10 func(ctx *encoderCtx, t *ExecutionRequests) (size int)
+		sizeFn11 func(ctx *encoderCtx, t *AttesterSlashingElectra) (size int)
+		sizeFn12 func(ctx *encoderCtx, t *IndexedAttestationElectra) (size int)
+		sizeFn13 func(ctx *encoderCtx, t []uint64) (size int)
+		sizeFn14 func(ctx *encoderCtx, t *AttestationElectra) (size int)
+		sizeFn15 func(ctx *encoderCtx, t go_bitfield.Bitlist) (size int)
+		sizeFn16 func(ctx *encoderCtx, t []byte) (size int)
+		sizeFn17 func(ctx *encoderCtx, t [][]byte) (size int)
+		sizeFn18 func(ctx *encoderCtx, t []*Withdrawal) (size int)
+		sizeFn19 func(ctx *encoderCtx, t []byte) (size int)
+		sizeFn2  func(ctx *encoderCtx, t []*ProposerSlashing) (size int)
+		sizeFn20 func(ctx *encoderCtx, t []*DepositRequest) (size int)
+		sizeFn21 func(ctx *encoderCtx, t []*WithdrawalRequest) (size int)
+		sizeFn22 func(ctx *encoderCtx, t []*ConsolidationRequest) (size int)
+		sizeFn3  func(ctx *encoderCtx, t []*AttesterSlashingElectra) (size int)
+		sizeFn4  func(ctx *encoderCtx, t []*AttestationElectra) (size int)
+		sizeFn5  func(ctx *encoderCtx, t []*Deposit) (size int)
+		sizeFn6  func(ctx *encoderCtx, t []*SignedVoluntaryExit) (size int)
+		sizeFn7  func(ctx *encoderCtx, t *ExecutionPayload) (size int)
+		sizeFn8  func(ctx *encoderCtx, t []*SignedBLSToExecutionChange) (size int)
+		sizeFn9  func(ctx *encoderCtx, t []KZGCommitment) (size int)
+	}
+	ctx := &encoderCtx{ds: ds}
+	canSeek := enc.Seekable()
+	ctx.exprs[0], err = sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE/8", 64)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[1], err = sszutils.ResolveSpecValueWithDefault(ds, "MAX_PROPOSER_SLASHINGS", 16)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[2], err = sszutils.ResolveSpecValueWithDefault(ds, "MAX_ATTESTER_SLASHINGS_ELECTRA", 1)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[3], err = sszutils.ResolveSpecValueWithDefault(ds, "MAX_VALIDATORS_PER_COMMITTEE*MAX_COMMITTEES_PER_SLOT", 131072)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[4], err = sszutils.ResolveSpecValueWithDefault(ds, "MAX_ATTESTATIONS_ELECTRA", 8)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[5], err = sszutils.ResolveSpecValueWithDefault(ds, "MAX_COMMITTEES_PER_SLOT/8", 8)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[6], err = sszutils.ResolveSpecValueWithDefault(ds, "MAX_DEPOSITS", 16)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[7], err = sszutils.ResolveSpecValueWithDefault(ds, "MAX_VOLUNTARY_EXITS", 16)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[8], err = sszutils.ResolveSpecValueWithDefault(ds, "MAX_EXTRA_DATA_BYTES", 32)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[9], err = sszutils.ResolveSpecValueWithDefault(ds, "MAX_TRANSACTIONS_PER_PAYLOAD", 1048576)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[10], err = sszutils.ResolveSpecValueWithDefault(ds, "MAX_BYTES_PER_TRANSACTION", 1073741824)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[11], err = sszutils.ResolveSpecValueWithDefault(ds, "MAX_WITHDRAWALS_PER_PAYLOAD", 16)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[12], err = sszutils.ResolveSpecValueWithDefault(ds, "MAX_BLS_TO_EXECUTION_CHANGES", 16)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[13], err = sszutils.ResolveSpecValueWithDefault(ds, "MAX_BLOB_COMMITMENTS_PER_BLOCK", 4096)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[14], err = sszutils.ResolveSpecValueWithDefault(ds, "MAX_DEPOSIT_REQUESTS_PER_PAYLOAD", 8192)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[15], err = sszutils.ResolveSpecValueWithDefault(ds, "MAX_WITHDRAWAL_REQUESTS_PER_PAYLOAD", 16)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[16], err = sszutils.ResolveSpecValueWithDefault(ds, "MAX_CONSOLIDATION_REQUESTS_PER_PAYLOAD", 2)
+	if err != nil {
+		return err
+	}
+	// size for *BeaconBlockBodyElectra
+	ctx.sizeFn1 = func(ctx *encoderCtx, t *BeaconBlockBodyElectra) (size int) {
+		if t == nil {
+			t = new(BeaconBlockBodyElectra)
+		}
+		// Field #0 'RANDAOReveal' static (96 bytes)
+		// Field #1 'ETH1Data' static (72 bytes)
+		// Field #2 'Graffiti' static (32 bytes)
+		// Field #3 'ProposerSlashings' offset (4 bytes)
+		// Field #4 'AttesterSlashings' offset (4 bytes)
+		// Field #5 'Attestations' offset (4 bytes)
+		// Field #6 'Deposits' offset (4 bytes)
+		// Field #7 'VoluntaryExits' offset (4 bytes)
+		// Field #9 'ExecutionPayload' offset (4 bytes)
+		// Field #10 'BLSToExecutionChanges' offset (4 bytes)
+		// Field #11 'BlobKZGCommitments' offset (4 bytes)
+		// Field #12 'ExecutionRequests' offset (4 bytes)
+		size += 236
+		{ // Field #8 'SyncAggregate'
+			t := t.SyncAggregate
+			if t == nil {
+				t = new(SyncAggregate)
+			}
+			// Field #1 'SyncCommitteeSignature' static (96 bytes)
+			size += 96
+			{ // Field #0 'SyncCommitteeBits'
+				size += int(ctx.exprs[0])
+			}
+		}
+		{ // Dynamic field #3 'ProposerSlashings'
+			size += ctx.sizeFn2(ctx, t.ProposerSlashings)
+		}
+		{ // Dynamic field #4 'AttesterSlashings'
+			size += ctx.sizeFn3(ctx, t.AttesterSlashings)
+		}
+		{ // Dynamic field #5 'Attestations'
+			size += ctx.sizeFn4(ctx, t.Attestations)
+		}
+		{ // Dynamic field #6 'Deposits'
+			size += ctx.sizeFn5(ctx, t.Deposits)
+		}
+		{ // Dynamic field #7 'VoluntaryExits'
+			size += ctx.sizeFn6(ctx, t.VoluntaryExits)
+		}
+		{ // Dynamic field #9 'ExecutionPayload'
+			size += ctx.sizeFn7(ctx, t.ExecutionPayload)
+		}
+		{ // Dynamic field #10 'BLSToExecutionChanges'
+			size += ctx.sizeFn8(ctx, t.BLSToExecutionChanges)
+		}
+		{ // Dynamic field #11 'BlobKZGCommitments'
+			size += ctx.sizeFn9(ctx, t.BlobKZGCommitments)
+		}
+		{ // Dynamic field #12 'ExecutionRequests'
+			size += ctx.sizeFn10(ctx, t.ExecutionRequests)
+		}
+		return size
+	}
+	// size for []*ProposerSlashing
+	ctx.sizeFn2 = func(ctx *encoderCtx, t []*ProposerSlashing) (size int) {
+		size += len(t) * 416
+		return size
+	}
+	// size for []*AttesterSlashingElectra
+	ctx.sizeFn3 = func(ctx *encoderCtx, t []*AttesterSlashingElectra) (size int) {
+		vlen := len(t)
+		size += vlen * 4 // Offsets
+		for i1 := range vlen {
+			size += ctx.sizeFn11(ctx, t[i1])
+		}
+		return size
+	}
+	// size for []*AttestationElectra
+	ctx.sizeFn4 = func(ctx *encoderCtx, t []*AttestationElectra) (size int) {
+		vlen := len(t)
+		size += vlen * 4 // Offsets
+		for i1 := range vlen {
+			size += ctx.sizeFn14(ctx, t[i1])
+		}
+		return size
+	}
+	// size for []*Deposit
+	ctx.sizeFn5 = func(ctx *encoderCtx, t []*Deposit) (size int) {
+		size += len(t) * 1240
+		return size
+	}
+	// size for []*SignedVoluntaryExit
+	ctx.sizeFn6 = func(ctx *encoderCtx, t []*SignedVoluntaryExit) (size int) {
+		size += len(t) * 112
+		return size
+	}
+	// size for *ExecutionPayload
+	ctx.sizeFn7 = func(ctx *encoderCtx, t *ExecutionPayload) (size int) {
+		if t == nil {
+			t = new(ExecutionPayload)
+		}
+		// Field #0 'ParentHash' static (32 bytes)
+		// Field #1 'FeeRecipient' static (20 bytes)
+		// Field #2 'StateRoot' static (32 bytes)
+		// Field #3 'ReceiptsRoot' static (32 bytes)
+		// Field #4 'LogsBloom' static (256 bytes)
+		// Field #5 'PrevRandao' static (32 bytes)
+		// Field #6 'BlockNumber' static (8 bytes)
+		// Field #7 'GasLimit' static (8 bytes)
+		// Field #8 'GasUsed' static (8 bytes)
+		// Field #9 'Timestamp' static (8 bytes)
+		// Field #10 'ExtraData' offset (4 bytes)
+		// Field #11 'BaseFeePerGas' static (32 bytes)
+		// Field #12 'BlockHash' static (32 bytes)
+		// Field #13 'Transactions' offset (4 bytes)
+		// Field #14 'Withdrawals' offset (4 bytes)
+		// Field #15 'BlobGasUsed' static (8 bytes)
+		// Field #16 'ExcessBlobGas' static (8 bytes)
+		size += 528
+		{ // Dynamic field #10 'ExtraData'
+			size += ctx.sizeFn16(ctx, t.ExtraData)
+		}
+		{ // Dynamic field #13 'Transactions'
+			size += ctx.sizeFn17(ctx, t.Transactions)
+		}
+		{ // Dynamic field #14 'Withdrawals'
+			size += ctx.sizeFn18(ctx, t.Withdrawals)
+		}
+		return size
+	}
+	// size for []*SignedBLSToExecutionChange
+	ctx.sizeFn8 = func(ctx *encoderCtx, t []*SignedBLSToExecutionChange) (size int) {
+		size += len(t) * 172
+		return size
+	}
+	// size for []KZGCommitment
+	ctx.sizeFn9 = func(ctx *encoderCtx, t []KZGCommitment) (size int) {
+		size += len(t) * 48
+		return size
+	}
+	// size for *ExecutionRequests
+	ctx.sizeFn10 = func(ctx *encoderCtx, t *ExecutionRequests) (size int) {
+		if t == nil {
+			t = new(ExecutionRequests)
+		}
+		// Field #0 'Deposits' offset (4 bytes)
+		// Field #1 'Withdrawals' offset (4 bytes)
+		// Field #2 'Consolidations' offset (4 bytes)
+		size += 12
+		{ // Dynamic field #0 'Deposits'
+			size += ctx.sizeFn20(ctx, t.Deposits)
+		}
+		{ // Dynamic field #1 'Withdrawals'
+			size += ctx.sizeFn21(ctx, t.Withdrawals)
+		}
+		{ // Dynamic field #2 'Consolidations'
+			size += ctx.sizeFn22(ctx, t.Consolidations)
+		}
+		return size
+	}
+	// size for *AttesterSlashingElectra
+	ctx.sizeFn11 = func(ctx *encoderCtx, t *AttesterSlashingElectra) (size int) {
+		if t == nil {
+			t = new(AttesterSlashingElectra)
+		}
+		// Field #0 'Attestation1' offset (4 bytes)
+		// Field #1 'Attestation2' offset (4 bytes)
+		size += 8
+		{ // Dynamic field #0 'Attestation1'
+			size += ctx.sizeFn12(ctx, t.Attestation1)
+		}
+		{ // Dynamic field #1 'Attestation2'
+			size += ctx.sizeFn12(ctx, t.Attestation2)
+		}
+		return size
+	}
+	// size for *IndexedAttestationElectra
+	ctx.sizeFn12 = func(ctx *encoderCtx, t *IndexedAttestationElectra) (size int) {
+		if t == nil {
+			t = new(IndexedAttestationElectra)
+		}
+		// Field #0 'AttestingIndices' offset (4 bytes)
+		// Field #1 'Data' static (128 bytes)
+		// Field #2 'Signature' static (96 bytes)
+		size += 228
+		{ // Dynamic field #0 'AttestingIndices'
+			size += ctx.sizeFn13(ctx, t.AttestingIndices)
+		}
+		return size
+	}
+	// size for []uint64
+	ctx.sizeFn13 = func(ctx *encoderCtx, t []uint64) (size int) {
+		size += len(t) * 8
+		return size
+	}
+	// size for *AttestationElectra
+	ctx.sizeFn14 = func(ctx *encoderCtx, t *AttestationElectra) (size int) {
+		if t == nil {
+			t = new(AttestationElectra)
+		}
+		// Field #0 'AggregationBits' offset (4 bytes)
+		// Field #1 'Data' static (128 bytes)
+		// Field #2 'Signature' static (96 bytes)
+		size += 228
+		{ // Field #3 'CommitteeBits'
+			size += int(ctx.exprs[5])
+		}
+		{ // Dynamic field #0 'AggregationBits'
+			size += ctx.sizeFn15(ctx, t.AggregationBits)
+		}
+		return size
+	}
+	// size for go_bitfield.Bitlist
+	ctx.sizeFn15 = func(ctx *encoderCtx, t go_bitfield.Bitlist) (size int) {
+		if len(t) == 0 {
+			size += 1
+		} else {
+			size += len(t)
+		}
+		return size
+	}
+	// size for []byte
+	ctx.sizeFn16 = func(ctx *encoderCtx, t []byte) (size int) {
+		size += len(t)
+		return size
+	}
+	// size for [][]byte
+	ctx.sizeFn17 = func(ctx *encoderCtx, t [][]byte) (size int) {
+		vlen := len(t)
+		size += vlen * 4 // Offsets
+		for i1 := range vlen {
+			size += ctx.sizeFn19(ctx, t[i1])
+		}
+		return size
+	}
+	// size for []*Withdrawal
+	ctx.sizeFn18 = func(ctx *encoderCtx, t []*Withdrawal) (size int) {
+		size += len(t) * 44
+		return size
+	}
+	// size for []byte
+	ctx.sizeFn19 = func(ctx *encoderCtx, t []byte) (size int) {
+		size += len(t)
+		return size
+	}
+	// size for []*DepositRequest
+	ctx.sizeFn20 = func(ctx *encoderCtx, t []*DepositRequest) (size int) {
+		size += len(t) * 192
+		return size
+	}
+	// size for []*WithdrawalRequest
+	ctx.sizeFn21 = func(ctx *encoderCtx, t []*WithdrawalRequest) (size int) {
+		size += len(t) * 76
+		return size
+	}
+	// size for []*ConsolidationRequest
+	ctx.sizeFn22 = func(ctx *encoderCtx, t []*ConsolidationRequest) (size int) {
+		size += len(t) * 116
+		return size
+	}
+	size2 := 1 * int(ctx.exprs[0])
+	size1 := size2 + 96 // size expression for '*SyncAggregate'
+	size3 := 1 * int(ctx.exprs[5])
+	if t == nil {
+		t = new(BeaconBlockElectra)
+	}
+	dstlen := enc.GetPosition()
+	dynoff := uint32(84)
+	{ // Field #0 'Slot'
+		enc.EncodeUint64(uint64(t.Slot))
+	}
+	{ // Field #1 'ProposerIndex'
+		enc.EncodeUint64(uint64(t.ProposerIndex))
+	}
+	{ // Field #2 'ParentRoot'
+		enc.EncodeBytes(t.ParentRoot[:32])
+	}
+	{ // Field #3 'StateRoot'
+		enc.EncodeBytes(t.StateRoot[:32])
+	}
+	// Offset #4 'Body'
+	offset4 := enc.GetPosition()
+	if canSeek {
+		enc.EncodeOffset(0)
+	} else {
+		enc.EncodeOffset(dynoff)
+		dynoff += uint32(ctx.sizeFn1(ctx, t.Body))
+	}
+	{ // Dynamic Field #4 'Body'
+		if canSeek {
+			enc.EncodeOffsetAt(offset4, uint32(enc.GetPosition()-dstlen))
+		}
+		t := t.Body
+		if t == nil {
+			t = new(BeaconBlockBodyElectra)
+		}
+		dstlen := enc.GetPosition()
+		dynoff := uint32(size1 + 236)
+		{ // Field #0 'RANDAOReveal'
+			enc.EncodeBytes(t.RANDAOReveal[:96])
+		}
+		{ // Field #1 'ETH1Data'
+			t := t.ETH1Data
+			if t == nil {
+				t = new(ETH1Data)
+			}
+			{ // Field #0 'DepositRoot'
+				enc.EncodeBytes(t.DepositRoot[:32])
+			}
+			{ // Field #1 'DepositCount'
+				enc.EncodeUint64(t.DepositCount)
+			}
+			{ // Field #2 'BlockHash'
+				enc.EncodeBytes(t.BlockHash[:32])
+			}
+		}
+		{ // Field #2 'Graffiti'
+			enc.EncodeBytes(t.Graffiti[:32])
+		}
+		// Offset #3 'ProposerSlashings'
+		offset3 := enc.GetPosition()
+		if canSeek {
+			enc.EncodeOffset(0)
+		} else {
+			enc.EncodeOffset(dynoff)
+			dynoff += uint32(ctx.sizeFn2(ctx, t.ProposerSlashings))
+		}
+		// Offset #4 'AttesterSlashings'
+		offset4 := enc.GetPosition()
+		if canSeek {
+			enc.EncodeOffset(0)
+		} else {
+			enc.EncodeOffset(dynoff)
+			dynoff += uint32(ctx.sizeFn3(ctx, t.AttesterSlashings))
+		}
+		// Offset #5 'Attestations'
+		offset5 := enc.GetPosition()
+		if canSeek {
+			enc.EncodeOffset(0)
+		} else {
+			enc.EncodeOffset(dynoff)
+			dynoff += uint32(ctx.sizeFn4(ctx, t.Attestations))
+		}
+		// Offset #6 'Deposits'
+		offset6 := enc.GetPosition()
+		if canSeek {
+			enc.EncodeOffset(0)
+		} else {
+			enc.EncodeOffset(dynoff)
+			dynoff += uint32(ctx.sizeFn5(ctx, t.Deposits))
+		}
+		// Offset #7 'VoluntaryExits'
+		offset7 := enc.GetPosition()
+		if canSeek {
+			enc.EncodeOffset(0)
+		} else {
+			enc.EncodeOffset(dynoff)
+			dynoff += uint32(ctx.sizeFn6(ctx, t.VoluntaryExits))
+		}
+		{ // Field #8 'SyncAggregate'
+			t := t.SyncAggregate
+			if t == nil {
+				t = new(SyncAggregate)
+			}
+			{ // Field #0 'SyncCommitteeBits'
+				vlen := len(t.SyncCommitteeBits)
+				if vlen > int(ctx.exprs[0]) {
+					return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(ctx.exprs[0])), "Body.SyncAggregate.SyncCommitteeBits")
+				}
+				enc.EncodeBytes(t.SyncCommitteeBits[:vlen])
+				if vlen < int(ctx.exprs[0]) {
+					enc.EncodeZeroPadding((int(ctx.exprs[0]) - vlen) * 1)
+				}
+			}
+			{ // Field #1 'SyncCommitteeSignature'
+				enc.EncodeBytes(t.SyncCommitteeSignature[:96])
+			}
+		}
+		// Offset #9 'ExecutionPayload'
+		offset9 := enc.GetPosition()
+		if canSeek {
+			enc.EncodeOffset(0)
+		} else {
+			enc.EncodeOffset(dynoff)
+			dynoff += uint32(ctx.sizeFn7(ctx, t.ExecutionPayload))
+		}
+		// Offset #10 'BLSToExecutionChanges'
+		offset10 := enc.GetPosition()
+		if canSeek {
+			enc.EncodeOffset(0)
+		} else {
+			enc.EncodeOffset(dynoff)
+			dynoff += uint32(ctx.sizeFn8(ctx, t.BLSToExecutionChanges))
+		}
+		// Offset #11 'BlobKZGCommitments'
+		offset11 := enc.GetPosition()
+		if canSeek {
+			enc.EncodeOffset(0)
+		} else {
+			enc.EncodeOffset(dynoff)
+			dynoff += uint32(ctx.sizeFn9(ctx, t.BlobKZGCommitments))
+		}
+		// Offset #12 'ExecutionRequests'
+		offset12 := enc.GetPosition()
+		if canSeek {
+			enc.EncodeOffset(0)
+		} else {
+			enc.EncodeOffset(dynoff)
+			dynoff += uint32(ctx.sizeFn10(ctx, t.ExecutionRequests))
+		}
+		{ // Dynamic Field #3 'ProposerSlashings'
+			if canSeek {
+				enc.EncodeOffsetAt(offset3, uint32(enc.GetPosition()-dstlen))
+			}
+			t := t.ProposerSlashings
+			vlen := len(t)
+			if vlen > int(ctx.exprs[1]) {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[1])), "Body.ProposerSlashings")
+			}
+			for idx1 := range vlen {
+				t := t[idx1]
+				if t == nil {
+					t = new(ProposerSlashing)
+				}
+				{ // Field #0 'SignedHeader1'
+					t := t.SignedHeader1
+					if t == nil {
+						t = new(SignedBeaconBlockHeader)
+					}
+					{ // Field #0 'Message'
+						t := t.Message
+						if t == nil {
+							t = new(BeaconBlockHeader)
+						}
+						{ // Field #0 'Slot'
+							enc.EncodeUint64(uint64(t.Slot))
+						}
+						{ // Field #1 'ProposerIndex'
+							enc.EncodeUint64(uint64(t.ProposerIndex))
+						}
+						{ // Field #2 'ParentRoot'
+							enc.EncodeBytes(t.ParentRoot[:32])
+						}
+						{ // Field #3 'StateRoot'
+							enc.EncodeBytes(t.StateRoot[:32])
+						}
+						{ // Field #4 'BodyRoot'
+							enc.EncodeBytes(t.BodyRoot[:32])
+						}
+					}
+					{ // Field #1 'Signature'
+						enc.EncodeBytes(t.Signature[:96])
+					}
+				}
+				{ // Field #1 'SignedHeader2'
+					t := t.SignedHeader2
+					if t == nil {
+						t = new(SignedBeaconBlockHeader)
+					}
+					{ // Field #0 'Message'
+						t := t.Message
+						if t == nil {
+							t = new(BeaconBlockHeader)
+						}
+						{ // Field #0 'Slot'
+							enc.EncodeUint64(uint64(t.Slot))
+						}
+						{ // Field #1 'ProposerIndex'
+							enc.EncodeUint64(uint64(t.ProposerIndex))
+						}
+						{ // Field #2 'ParentRoot'
+							enc.EncodeBytes(t.ParentRoot[:32])
+						}
+						{ // Field #3 'StateRoot'
+							enc.EncodeBytes(t.StateRoot[:32])
+						}
+						{ // Field #4 'BodyRoot'
+							enc.EncodeBytes(t.BodyRoot[:32])
+						}
+					}
+					{ // Field #1 'Signature'
+						enc.EncodeBytes(t.Signature[:96])
+					}
+				}
+			}
+		}
+		{ // Dynamic Field #4 'AttesterSlashings'
+			if canSeek {
+				enc.EncodeOffsetAt(offset4, uint32(enc.GetPosition()-dstlen))
+			}
+			t := t.AttesterSlashings
+			vlen := len(t)
+			if vlen > int(ctx.exprs[2]) {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[2])), "Body.AttesterSlashings")
+			}
+			dstlen := enc.GetPosition()
+			if canSeek {
+				enc.EncodeZeroPadding(vlen * 4)
+			} else if vlen > 0 {
+				offset := vlen * 4
+				enc.EncodeOffset(uint32(offset))
+				for i := range vlen-1 {
+					offset += ctx.sizeFn11(ctx, t[i])
+					enc.EncodeOffset(uint32(offset))
+				}
+			}
+			for idx1 := range vlen {
+				if canSeek {
+					enc.EncodeOffsetAt(dstlen+(idx1*4), uint32(enc.GetPosition()-dstlen))
+				}
+				t := t[idx1]
+				if t == nil {
+					t = new(AttesterSlashingElectra)
+				}
+				dstlen := enc.GetPosition()
+				dynoff := uint32(8)
+				// Offset #0 'Attestation1'
+				offset0 := enc.GetPosition()
+				if canSeek {
+					enc.EncodeOffset(0)
+				} else {
+					enc.EncodeOffset(dynoff)
+					dynoff += uint32(ctx.sizeFn12(ctx, t.Attestation1))
+				}
+				// Offset #1 'Attestation2'
+				offset1 := enc.GetPosition()
+				if canSeek {
+					enc.EncodeOffset(0)
+				} else {
+					enc.EncodeOffset(dynoff)
+					dynoff += uint32(ctx.sizeFn12(ctx, t.Attestation2))
+				}
+				{ // Dynamic Field #0 'Attestation1'
+					if canSeek {
+						enc.EncodeOffsetAt(offset0, uint32(enc.GetPosition()-dstlen))
+					}
+					t := t.Attestation1
+					if t == nil {
+						t = new(IndexedAttestationElectra)
+					}
+					dstlen := enc.GetPosition()
+					dynoff := uint32(228)
+					// Offset #0 'AttestingIndices'
+					offset0 := enc.GetPosition()
+					if canSeek {
+						enc.EncodeOffset(0)
+					} else {
+						enc.EncodeOffset(dynoff)
+						dynoff += uint32(ctx.sizeFn13(ctx, t.AttestingIndices))
+					}
+					{ // Field #1 'Data'
+						t := t.Data
+						if t == nil {
+							t = new(AttestationData)
+						}
+						{ // Field #0 'Slot'
+							enc.EncodeUint64(uint64(t.Slot))
+						}
+						{ // Field #1 'Index'
+							enc.EncodeUint64(t.Index)
+						}
+						{ // Field #2 'BeaconBlockRoot'
+							enc.EncodeBytes(t.BeaconBlockRoot[:32])
+						}
+						{ // Field #3 'Source'
+							t := t.Source
+							if t == nil {
+								t = new(Checkpoint)
+							}
+							{ // Field #0 'Epoch'
+								enc.EncodeUint64(uint64(t.Epoch))
+							}
+							{ // Field #1 'Root'
+								enc.EncodeBytes(t.Root[:32])
+							}
+						}
+						{ // Field #4 'Target'
+							t := t.Target
+							if t == nil {
+								t = new(Checkpoint)
+							}
+							{ // Field #0 'Epoch'
+								enc.EncodeUint64(uint64(t.Epoch))
+							}
+							{ // Field #1 'Root'
+								enc.EncodeBytes(t.Root[:32])
+							}
+						}
+					}
+					{ // Field #2 'Signature'
+						enc.EncodeBytes(t.Signature[:96])
+					}
+					{ // Dynamic Field #0 'AttestingIndices'
+						if canSeek {
+							enc.EncodeOffsetAt(offset0, uint32(enc.GetPosition()-dstlen))
+						}
+						t := t.AttestingIndices
+						vlen := len(t)
+						if vlen > int(ctx.exprs[3]) {
+							return sszutils.ErrorWithPathf(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[3])), "Body.AttesterSlashings[%d].Attestation1.AttestingIndices", idx1)
+						}
+						sszutils.EncodeUint64Slice(enc, t[:vlen])
+					}
+				}
+				{ // Dynamic Field #1 'Attestation2'
+					if canSeek {
+						enc.EncodeOffsetAt(offset1, uint32(enc.GetPosition()-dstlen))
+					}
+					t := t.Attestation2
+					if t == nil {
+						t = new(IndexedAttestationElectra)
+					}
+					dstlen := enc.GetPosition()
+					dynoff := uint32(228)
+					// Offset #0 'AttestingIndices'
+					offset0 := enc.GetPosition()
+					if canSeek {
+						enc.EncodeOffset(0)
+					} else {
+						enc.EncodeOffset(dynoff)
+						dynoff += uint32(ctx.sizeFn13(ctx, t.AttestingIndices))
+					}
+					{ // Field #1 'Data'
+						t := t.Data
+						if t == nil {
+							t = new(AttestationData)
+						}
+						{ // Field #0 'Slot'
+							enc.EncodeUint64(uint64(t.Slot))
+						}
+						{ // Field #1 'Index'
+							enc.EncodeUint64(t.Index)
+						}
+						{ // Field #2 'BeaconBlockRoot'
+							enc.EncodeBytes(t.BeaconBlockRoot[:32])
+						}
+						{ // Field #3 'Source'
+							t := t.Source
+							if t == nil {
+								t = new(Checkpoint)
+							}
+							{ // Field #0 'Epoch'
+								enc.EncodeUint64(uint64(t.Epoch))
+							}
+							{ // Field #1 'Root'
+								enc.EncodeBytes(t.Root[:32])
+							}
+						}
+						{ // Field #4 'Target'
+							t := t.Target
+							if t == nil {
+								t = new(Checkpoint)
+							}
+							{ // Field #0 'Epoch'
+								enc.EncodeUint64(uint64(t.Epoch))
+							}
+							{ // Field #1 'Root'
+								enc.EncodeBytes(t.Root[:32])
+							}
+						}
+					}
+					{ // Field #2 'Signature'
+						enc.EncodeBytes(t.Signature[:96])
+					}
+					{ // Dynamic Field #0 'AttestingIndices'
+						if canSeek {
+							enc.EncodeOffsetAt(offset0, uint32(enc.GetPosition()-dstlen))
+						}
+						t := t.AttestingIndices
+						vlen := len(t)
+						if vlen > int(ctx.exprs[3]) {
+							return sszutils.ErrorWithPathf(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[3])), "Body.AttesterSlashings[%d].Attestation2.AttestingIndices", idx1)
+						}
+						sszutils.EncodeUint64Slice(enc, t[:vlen])
+					}
+				}
+			}
+		}
+		{ // Dynamic Field #5 'Attestations'
+			if canSeek {
+				enc.EncodeOffsetAt(offset5, uint32(enc.GetPosition()-dstlen))
+			}
+			t := t.Attestations
+			vlen := len(t)
+			if vlen > int(ctx.exprs[4]) {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[4])), "Body.Attestations")
+			}
+			dstlen := enc.GetPosition()
+			if canSeek {
+				enc.EncodeZeroPadding(vlen * 4)
+			} else if vlen > 0 {
+				offset := vlen * 4
+				enc.EncodeOffset(uint32(offset))
+				for i := range vlen-1 {
+					offset += ctx.sizeFn14(ctx, t[i])
+					enc.EncodeOffset(uint32(offset))
+				}
+			}
+			for idx1 := range vlen {
+				if canSeek {
+					enc.EncodeOffsetAt(dstlen+(idx1*4), uint32(enc.GetPosition()-dstlen))
+				}
+				t := t[idx1]
+				if t == nil {
+					t = new(AttestationElectra)
+				}
+				dstlen := enc.GetPosition()
+				dynoff := uint32(size3 + 228)
+				// Offset #0 'AggregationBits'
+				offset0 := enc.GetPosition()
+				if canSeek {
+					enc.EncodeOffset(0)
+				} else {
+					enc.EncodeOffset(dynoff)
+					dynoff += uint32(ctx.sizeFn15(ctx, t.AggregationBits))
+				}
+				{ // Field #1 'Data'
+					t := t.Data
+					if t == nil {
+						t = new(AttestationData)
+					}
+					{ // Field #0 'Slot'
+						enc.EncodeUint64(uint64(t.Slot))
+					}
+					{ // Field #1 'Index'
+						enc.EncodeUint64(t.Index)
+					}
+					{ // Field #2 'BeaconBlockRoot'
+						enc.EncodeBytes(t.BeaconBlockRoot[:32])
+					}
+					{ // Field #3 'Source'
+						t := t.Source
+						if t == nil {
+							t = new(Checkpoint)
+						}
+						{ // Field #0 'Epoch'
+							enc.EncodeUint64(uint64(t.Epoch))
+						}
+						{ // Field #1 'Root'
+							enc.EncodeBytes(t.Root[:32])
+						}
+					}
+					{ // Field #4 'Target'
+						t := t.Target
+						if t == nil {
+							t = new(Checkpoint)
+						}
+						{ // Field #0 'Epoch'
+							enc.EncodeUint64(uint64(t.Epoch))
+						}
+						{ // Field #1 'Root'
+							enc.EncodeBytes(t.Root[:32])
+						}
+					}
+				}
+				{ // Field #2 'Signature'
+					enc.EncodeBytes(t.Signature[:96])
+				}
+				{ // Field #3 'CommitteeBits'
+					vlen := len(t.CommitteeBits)
+					if vlen > int(ctx.exprs[5]) {
+						return sszutils.ErrorWithPathf(sszutils.ErrVectorLengthFn(vlen, int(ctx.exprs[5])), "Body.Attestations[%d].CommitteeBits", idx1)
+					}
+					enc.EncodeBytes(t.CommitteeBits[:vlen])
+					if vlen < int(ctx.exprs[5]) {
+						enc.EncodeZeroPadding((int(ctx.exprs[5]) - vlen) * 1)
+					}
+				}
+				{ // Dynamic Field #0 'AggregationBits'
+					if canSeek {
+						enc.EncodeOffsetAt(offset0, uint32(enc.GetPosition()-dstlen))
+					}
+					t := t.AggregationBits
+					vlen := len(t)
+					bval := []byte(t[:])
+					if vlen == 0 {
+						bval = []byte{0x01}
+					} else if bval[vlen-1] == 0x00 {
+						return sszutils.ErrorWithPathf(sszutils.ErrBitlistNotTerminatedFn(), "Body.Attestations[%d].AggregationBits", idx1)
+					}
+					if vlen > 0 {
+						bitCount := 8*(vlen-1) + bits.Len8(bval[vlen-1]) - 1
+						if bitCount > int(ctx.exprs[3]) {
+							return sszutils.ErrorWithPathf(sszutils.ErrBitlistLengthFn(bitCount, int(ctx.exprs[3])), "Body.Attestations[%d].AggregationBits", idx1)
+						}
+					}
+					enc.EncodeBytes(bval)
+				}
+			}
+		}
+		{ // Dynamic Field #6 'Deposits'
+			if canSeek {
+				enc.EncodeOffsetAt(offset6, uint32(enc.GetPosition()-dstlen))
+			}
+			t := t.Deposits
+			vlen := len(t)
+			if vlen > int(ctx.exprs[6]) {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[6])), "Body.Deposits")
+			}
+			for idx1 := range vlen {
+				t := t[idx1]
+				if t == nil {
+					t = new(Deposit)
+				}
+				{ // Field #0 'Proof'
+					t := t.Proof
+					vlen := len(t)
+					if vlen > 33 {
+						return sszutils.ErrorWithPathf(sszutils.ErrVectorLengthFn(vlen, 33), "Body.Deposits[%d].Proof", idx1)
+					}
+					for idx2 := range vlen {
+						vlen := len(t[idx2])
+						if vlen > 32 {
+							return sszutils.ErrorWithPathf(sszutils.ErrVectorLengthFn(vlen, 32), "Body.Deposits[%d].Proof[%d]", idx1, idx2)
+						}
+						enc.EncodeBytes(t[idx2][:vlen])
+						if vlen < 32 {
+							enc.EncodeZeroPadding((32 - vlen) * 1)
+						}
+					}
+					if vlen < 33 {
+						enc.EncodeZeroPadding((33 - vlen) * 32)
+					}
+				}
+				{ // Field #1 'Data'
+					t := t.Data
+					if t == nil {
+						t = new(DepositData)
+					}
+					{ // Field #0 'Pubkey'
+						enc.EncodeBytes(t.Pubkey[:48])
+					}
+					{ // Field #1 'WithdrawalCredentials'
+						enc.EncodeBytes(t.WithdrawalCredentials[:32])
+					}
+					{ // Field #2 'Amount'
+						enc.EncodeUint64(uint64(t.Amount))
+					}
+					{ // Field #3 'Signature'
+						enc.EncodeBytes(t.Signature[:96])
+					}
+				}
+			}
+		}
+		{ // Dynamic Field #7 'VoluntaryExits'
+			if canSeek {
+				enc.EncodeOffsetAt(offset7, uint32(enc.GetPosition()-dstlen))
+			}
+			t := t.VoluntaryExits
+			vlen := len(t)
+			if vlen > int(ctx.exprs[7]) {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[7])), "Body.VoluntaryExits")
+			}
+			for idx1 := range vlen {
+				t := t[idx1]
+				if t == nil {
+					t = new(SignedVoluntaryExit)
+				}
+				{ // Field #0 'Message'
+					t := t.Message
+					if t == nil {
+						t = new(VoluntaryExit)
+					}
+					{ // Field #0 'Epoch'
+						enc.EncodeUint64(uint64(t.Epoch))
+					}
+					{ // Field #1 'ValidatorIndex'
+						enc.EncodeUint64(uint64(t.ValidatorIndex))
+					}
+				}
+				{ // Field #1 'Signature'
+					enc.EncodeBytes(t.Signature[:96])
+				}
+			}
+		}
+		{ // Dynamic Field #9 'ExecutionPayload'
+			if canSeek {
+				enc.EncodeOffsetAt(offset9, uint32(enc.GetPosition()-dstlen))
+			}
+			t := t.ExecutionPayload
+			if t == nil {
+				t = new(ExecutionPayload)
+			}
+			dstlen := enc.GetPosition()
+			dynoff := uint32(528)
+			{ // Field #0 'ParentHash'
+				enc.EncodeBytes(t.ParentHash[:32])
+			}
+			{ // Field #1 'FeeRecipient'
+				enc.EncodeBytes(t.FeeRecipient[:20])
+			}
+			{ // Field #2 'StateRoot'
+				enc.EncodeBytes(t.StateRoot[:32])
+			}
+			{ // Field #3 'ReceiptsRoot'
+				enc.EncodeBytes(t.ReceiptsRoot[:32])
+			}
+			{ // Field #4 'LogsBloom'
+				enc.EncodeBytes(t.LogsBloom[:256])
+			}
+			{ // Field #5 'PrevRandao'
+				enc.EncodeBytes(t.PrevRandao[:32])
+			}
+			{ // Field #6 'BlockNumber'
+				enc.EncodeUint64(t.BlockNumber)
+			}
+			{ // Field #7 'GasLimit'
+				enc.EncodeUint64(t.GasLimit)
+			}
+			{ // Field #8 'GasUsed'
+				enc.EncodeUint64(t.GasUsed)
+			}
+			{ // Field #9 'Timestamp'
+				enc.EncodeUint64(t.Timestamp)
+			}
+			// Offset #10 'ExtraData'
+			offset10 := enc.GetPosition()
+			if canSeek {
+				enc.EncodeOffset(0)
+			} else {
+				enc.EncodeOffset(dynoff)
+				dynoff += uint32(ctx.sizeFn16(ctx, t.ExtraData))
+			}
+			{ // Field #11 'BaseFeePerGas'
+				enc.EncodeBytes(t.BaseFeePerGas[:32])
+			}
+			{ // Field #12 'BlockHash'
+				enc.EncodeBytes(t.BlockHash[:32])
+			}
+			// Offset #13 'Transactions'
+			offset13 := enc.GetPosition()
+			if canSeek {
+				enc.EncodeOffset(0)
+			} else {
+				enc.EncodeOffset(dynoff)
+				dynoff += uint32(ctx.sizeFn17(ctx, t.Transactions))
+			}
+			// Offset #14 'Withdrawals'
+			offset14 := enc.GetPosition()
+			if canSeek {
+				enc.EncodeOffset(0)
+			} else {
+				enc.EncodeOffset(dynoff)
+				dynoff += uint32(ctx.sizeFn18(ctx, t.Withdrawals))
+			}
+			{ // Field #15 'BlobGasUsed'
+				enc.EncodeUint64(t.BlobGasUsed)
+			}
+			{ // Field #16 'ExcessBlobGas'
+				enc.EncodeUint64(t.ExcessBlobGas)
+			}
+			{ // Dynamic Field #10 'ExtraData'
+				if canSeek {
+					enc.EncodeOffsetAt(offset10, uint32(enc.GetPosition()-dstlen))
+				}
+				vlen := len(t.ExtraData)
+				if vlen > int(ctx.exprs[8]) {
+					return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[8])), "Body.ExecutionPayload.ExtraData")
+				}
+				enc.EncodeBytes(t.ExtraData[:])
+			}
+			{ // Dynamic Field #13 'Transactions'
+				if canSeek {
+					enc.EncodeOffsetAt(offset13, uint32(enc.GetPosition()-dstlen))
+				}
+				t := t.Transactions
+				vlen := len(t)
+				if vlen > int(ctx.exprs[9]) {
+					return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[9])), "Body.ExecutionPayload.Transactions")
+				}
+				dstlen := enc.GetPosition()
+				if canSeek {
+					enc.EncodeZeroPadding(vlen * 4)
+				} else if vlen > 0 {
+					offset := vlen * 4
+					enc.EncodeOffset(uint32(offset))
+					for i := range vlen-1 {
+						offset += ctx.sizeFn19(ctx, t[i])
+						enc.EncodeOffset(uint32(offset))
+					}
+				}
+				for idx1 := range vlen {
+					if canSeek {
+						enc.EncodeOffsetAt(dstlen+(idx1*4), uint32(enc.GetPosition()-dstlen))
+					}
+					vlen := len(t[idx1])
+					if vlen > int(ctx.exprs[10]) {
+						return sszutils.ErrorWithPathf(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[10])), "Body.ExecutionPayload.Transactions[%d]", idx1)
+					}
+					enc.EncodeBytes(t[idx1][:])
+				}
+			}
+			{ // Dynamic Field #14 'Withdrawals'
+				if canSeek {
+					enc.EncodeOffsetAt(offset14, uint32(enc.GetPosition()-dstlen))
+				}
+				t := t.Withdrawals
+				vlen := len(t)
+				if vlen > int(ctx.exprs[11]) {
+					return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[11])), "Body.ExecutionPayload.Withdrawals")
+				}
+				for idx1 := range vlen {
+					t := t[idx1]
+					if t == nil {
+						t = new(Withdrawal)
+					}
+					{ // Field #0 'Index'
+						enc.EncodeUint64(uint64(t.Index))
+					}
+					{ // Field #1 'ValidatorIndex'
+						enc.EncodeUint64(uint64(t.ValidatorIndex))
+					}
+					{ // Field #2 'Address'
+						enc.EncodeBytes(t.Address[:20])
+					}
+					{ // Field #3 'Amount'
+						enc.EncodeUint64(uint64(t.Amount))
+					}
+				}
+			}
+		}
+		{ // Dynamic Field #10 'BLSToExecutionChanges'
+			if canSeek {
+				enc.EncodeOffsetAt(offset10, uint32(enc.GetPosition()-dstlen))
+			}
+			t := t.BLSToExecutionChanges
+			vlen := len(t)
+			if vlen > int(ctx.exprs[12]) {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[12])), "Body.BLSToExecutionChanges")
+			}
+			for idx1 := range vlen {
+				t := t[idx1]
+				if t == nil {
+					t = new(SignedBLSToExecutionChange)
+				}
+				{ // Field #0 'Message'
+					t := t.Message
+					if t == nil {
+						t = new(BLSToExecutionChange)
+					}
+					{ // Field #0 'ValidatorIndex'
+						enc.EncodeUint64(uint64(t.ValidatorIndex))
+					}
+					{ // Field #1 'FromBLSPubkey'
+						enc.EncodeBytes(t.FromBLSPubkey[:48])
+					}
+					{ // Field #2 'ToExecutionAddress'
+						enc.EncodeBytes(t.ToExecutionAddress[:20])
+					}
+				}
+				{ // Field #1 'Signature'
+					enc.EncodeBytes(t.Signature[:96])
+				}
+			}
+		}
+		{ // Dynamic Field #11 'BlobKZGCommitments'
+			if canSeek {
+				enc.EncodeOffsetAt(offset11, uint32(enc.GetPosition()-dstlen))
+			}
+			t := t.BlobKZGCommitments
+			vlen := len(t)
+			if vlen > int(ctx.exprs[13]) {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[13])), "Body.BlobKZGCommitments")
+			}
+			for idx1 := range vlen {
+				enc.EncodeBytes(t[idx1][:48])
+			}
+		}
+		{ // Dynamic Field #12 'ExecutionRequests'
+			if canSeek {
+				enc.EncodeOffsetAt(offset12, uint32(enc.GetPosition()-dstlen))
+			}
+			t := t.ExecutionRequests
+			if t == nil {
+				t = new(ExecutionRequests)
+			}
+			dstlen := enc.GetPosition()
+			dynoff := uint32(12)
+			// Offset #0 'Deposits'
+			offset0 := enc.GetPosition()
+			if canSeek {
+				enc.EncodeOffset(0)
+			} else {
+				enc.EncodeOffset(dynoff)
+				dynoff += uint32(ctx.sizeFn20(ctx, t.Deposits))
+			}
+			// Offset #1 'Withdrawals'
+			offset1 := enc.GetPosition()
+			if canSeek {
+				enc.EncodeOffset(0)
+			} else {
+				enc.EncodeOffset(dynoff)
+				dynoff += uint32(ctx.sizeFn21(ctx, t.Withdrawals))
+			}
+			// Offset #2 'Consolidations'
+			offset2 := enc.GetPosition()
+			if canSeek {
+				enc.EncodeOffset(0)
+			} else {
+				enc.EncodeOffset(dynoff)
+				dynoff += uint32(ctx.sizeFn22(ctx, t.Consolidations))
+			}
+			{ // Dynamic Field #0 'Deposits'
+				if canSeek {
+					enc.EncodeOffsetAt(offset0, uint32(enc.GetPosition()-dstlen))
+				}
+				t := t.Deposits
+				vlen := len(t)
+				if vlen > int(ctx.exprs[14]) {
+					return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[14])), "Body.ExecutionRequests.Deposits")
+				}
+				for idx1 := range vlen {
+					t := t[idx1]
+					if t == nil {
+						t = new(DepositRequest)
+					}
+					{ // Field #0 'Pubkey'
+						enc.EncodeBytes(t.Pubkey[:48])
+					}
+					{ // Field #1 'WithdrawalCredentials'
+						enc.EncodeBytes(t.WithdrawalCredentials[:32])
+					}
+					{ // Field #2 'Amount'
+						enc.EncodeUint64(uint64(t.Amount))
+					}
+					{ // Field #3 'Signature'
+						enc.EncodeBytes(t.Signature[:96])
+					}
+					{ // Field #4 'Index'
+						enc.EncodeUint64(t.Index)
+					}
+				}
+			}
+			{ // Dynamic Field #1 'Withdrawals'
+				if canSeek {
+					enc.EncodeOffsetAt(offset1, uint32(enc.GetPosition()-dstlen))
+				}
+				t := t.Withdrawals
+				vlen := len(t)
+				if vlen > int(ctx.exprs[15]) {
+					return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[15])), "Body.ExecutionRequests.Withdrawals")
+				}
+				for idx1 := range vlen {
+					t := t[idx1]
+					if t == nil {
+						t = new(WithdrawalRequest)
+					}
+					{ // Field #0 'SourceAddress'
+						enc.EncodeBytes(t.SourceAddress[:20])
+					}
+					{ // Field #1 'ValidatorPubkey'
+						enc.EncodeBytes(t.ValidatorPubkey[:48])
+					}
+					{ // Field #2 'Amount'
+						enc.EncodeUint64(uint64(t.Amount))
+					}
+				}
+			}
+			{ // Dynamic Field #2 'Consolidations'
+				if canSeek {
+					enc.EncodeOffsetAt(offset2, uint32(enc.GetPosition()-dstlen))
+				}
+				t := t.Consolidations
+				vlen := len(t)
+				if vlen > int(ctx.exprs[16]) {
+					return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[16])), "Body.ExecutionRequests.Consolidations")
+				}
+				for idx1 := range vlen {
+					t := t[idx1]
+					if t == nil {
+						t = new(ConsolidationRequest)
+					}
+					{ // Field #0 'SourceAddress'
+						enc.EncodeBytes(t.SourceAddress[:20])
+					}
+					{ // Field #1 'SourcePubkey'
+						enc.EncodeBytes(t.SourcePubkey[:48])
+					}
+					{ // Field #2 'TargetPubkey'
+						enc.EncodeBytes(t.TargetPubkey[:48])
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// UnmarshalSSZ unmarshals the *BeaconBlockElectra from SSZ-encoded bytes.
+func (t *BeaconBlockElectra) UnmarshalSSZ(buf []byte) (err error) {
+	return t.UnmarshalSSZDyn(dynssz.GetGlobalDynSsz(), buf)
+}
+
+// UnmarshalSSZDyn unmarshals the *BeaconBlockElectra from SSZ-encoded bytes using dynamic specifications.
+func (t *BeaconBlockElectra) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err error) {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE/8", 64)
+	if err != nil {
+		return err
+	}
+	expr1, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_PROPOSER_SLASHINGS", 16)
+	if err != nil {
+		return err
+	}
+	expr2, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_ATTESTER_SLASHINGS_ELECTRA", 1)
+	if err != nil {
+		return err
+	}
+	expr3, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_VALIDATORS_PER_COMMITTEE*MAX_COMMITTEES_PER_SLOT", 131072)
+	if err != nil {
+		return err
+	}
+	expr4, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_ATTESTATIONS_ELECTRA", 8)
+	if err != nil {
+		return err
+	}
+	expr5, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_COMMITTEES_PER_SLOT/8", 8)
+	if err != nil {
+		return err
+	}
+	expr6, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_DEPOSITS", 16)
+	if err != nil {
+		return err
+	}
+	expr7, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_VOLUNTARY_EXITS", 16)
+	if err != nil {
+		return err
+	}
+	expr8, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_EXTRA_DATA_BYTES", 32)
+	if err != nil {
+		return err
+	}
+	expr9, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_TRANSACTIONS_PER_PAYLOAD", 1048576)
+	if err != nil {
+		return err
+	}
+	expr10, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_BYTES_PER_TRANSACTION", 1073741824)
+	if err != nil {
+		return err
+	}
+	expr11, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_WITHDRAWALS_PER_PAYLOAD", 16)
+	if err != nil {
+		return err
+	}
+	expr12, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_BLS_TO_EXECUTION_CHANGES", 16)
+	if err != nil {
+		return err
+	}
+	expr13, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_BLOB_COMMITMENTS_PER_BLOCK", 4096)
+	if err != nil {
+		return err
+	}
+	expr14, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_DEPOSIT_REQUESTS_PER_PAYLOAD", 8192)
+	if err != nil {
+		return err
+	}
+	expr15, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_WITHDRAWAL_REQUESTS_PER_PAYLOAD", 16)
+	if err != nil {
+		return err
+	}
+	expr16, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_CONSOLIDATION_REQUESTS_PER_PAYLOAD", 2)
+	if err != nil {
+		return err
+	}
+	size2 := 1 * int(expr0)
+	size1 := size2 + 96 // size expression for '*SyncAggregate'
+	size3 := 1 * int(expr5)
+	buflen := len(buf)
+	if buflen < 84 {
+		return sszutils.ErrFixedFieldsEOFFn(buflen, 84)
+	}
+	{ // Field #0 'Slot' (static)
+		buf := buf[0:8]
+		t.Slot = Slot(binary.LittleEndian.Uint64(buf))
+	}
+	{ // Field #1 'ProposerIndex' (static)
+		buf := buf[8:16]
+		t.ProposerIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf))
+	}
+	{ // Field #2 'ParentRoot' (static)
+		buf := buf[16:48]
+		copy(t.ParentRoot[:], buf)
+	}
+	{ // Field #3 'StateRoot' (static)
+		buf := buf[48:80]
+		copy(t.StateRoot[:], buf)
+	}
+	// Field #4 'Body' (offset)
+	offset4 := int(binary.LittleEndian.Uint32(buf[80:84]))
+	if offset4 != 84 {
+		return sszutils.ErrorWithPath(sszutils.ErrFirstOffsetMismatchFn(offset4, 84), "Body:o")
+	}
+	{ // Field #4 'Body' (dynamic)
+		buf := buf[offset4:]
+		val1 := t.Body
+		if val1 == nil {
+			val1 = new(BeaconBlockBodyElectra)
+		}
+		exproffset := 0
+		totalSize := size1+236
+		buflen := len(buf)
+		if buflen < totalSize {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(buflen, totalSize), "Body")
+		}
+		{ // Field #0 'RANDAOReveal' (static)
+			buf := buf[0:96]
+			copy(val1.RANDAOReveal[:], buf)
+		}
+		{ // Field #1 'ETH1Data' (static)
+			buf := buf[96:168]
+			val2 := val1.ETH1Data
+			if val2 == nil {
+				val2 = new(ETH1Data)
+			}
+			buflen := len(buf)
+			if buflen < 72 {
+				return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(buflen, 72), "Body.ETH1Data")
+			}
+			if buflen > 72 {
+				return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(buflen - 72), "Body.ETH1Data")
+			}
+			{ // Field #0 'DepositRoot' (static)
+				buf := buf[0:32]
+				copy(val2.DepositRoot[:], buf)
+			}
+			{ // Field #1 'DepositCount' (static)
+				buf := buf[32:40]
+				val2.DepositCount = binary.LittleEndian.Uint64(buf)
+			}
+			{ // Field #2 'BlockHash' (static)
+				buf := buf[40:72]
+				copy(val2.BlockHash[:], buf)
+			}
+			val1.ETH1Data = val2
+		}
+		{ // Field #2 'Graffiti' (static)
+			buf := buf[168:200]
+			copy(val1.Graffiti[:], buf)
+		}
+		// Field #3 'ProposerSlashings' (offset)
+		offset3 := int(binary.LittleEndian.Uint32(buf[200:204]))
+		if offset3 != totalSize {
+			return sszutils.ErrorWithPath(sszutils.ErrFirstOffsetMismatchFn(offset3, totalSize), "Body.ProposerSlashings:o")
+		}
+		// Field #4 'AttesterSlashings' (offset)
+		offset4 := int(binary.LittleEndian.Uint32(buf[204:208]))
+		if offset4 < offset3 || offset4 > buflen {
+			return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset4, offset3, buflen), "Body.AttesterSlashings:o")
+		}
+		// Field #5 'Attestations' (offset)
+		offset5 := int(binary.LittleEndian.Uint32(buf[208:212]))
+		if offset5 < offset4 || offset5 > buflen {
+			return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset5, offset4, buflen), "Body.Attestations:o")
+		}
+		// Field #6 'Deposits' (offset)
+		offset6 := int(binary.LittleEndian.Uint32(buf[212:216]))
+		if offset6 < offset5 || offset6 > buflen {
+			return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset6, offset5, buflen), "Body.Deposits:o")
+		}
+		// Field #7 'VoluntaryExits' (offset)
+		offset7 := int(binary.LittleEndian.Uint32(buf[216:220]))
+		if offset7 < offset6 || offset7 > buflen {
+			return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset7, offset6, buflen), "Body.VoluntaryExits:o")
+		}
+		{ // Field #8 'SyncAggregate' (static)
+			buf := buf[220 : size1+220]
+			exproffset += int(size1)
+			val3 := val1.SyncAggregate
+			if val3 == nil {
+				val3 = new(SyncAggregate)
+			}
+			exproffset := 0
+			totalSize := size2+96
+			buflen := len(buf)
+			if buflen < totalSize {
+				return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(buflen, totalSize), "Body.SyncAggregate")
+			}
+			if buflen > totalSize {
+				return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(buflen - totalSize), "Body.SyncAggregate")
+			}
+			{ // Field #0 'SyncCommitteeBits' (static)
+				buf := buf[0 : size2+0]
+				exproffset += int(size2)
+				val3.SyncCommitteeBits = sszutils.ExpandSlice(val3.SyncCommitteeBits, int(expr0))
+				copy(val3.SyncCommitteeBits[:], buf)
+			}
+			{ // Field #1 'SyncCommitteeSignature' (static)
+				buf := buf[exproffset+0 : exproffset+96]
+				copy(val3.SyncCommitteeSignature[:], buf)
+			}
+			val1.SyncAggregate = val3
+		}
+		// Field #9 'ExecutionPayload' (offset)
+		offset9 := int(binary.LittleEndian.Uint32(buf[exproffset+220 : exproffset+224]))
+		if offset9 < offset7 || offset9 > buflen {
+			return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset9, offset7, buflen), "Body.ExecutionPayload:o")
+		}
+		// Field #10 'BLSToExecutionChanges' (offset)
+		offset10 := int(binary.LittleEndian.Uint32(buf[exproffset+224 : exproffset+228]))
+		if offset10 < offset9 || offset10 > buflen {
+			return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset10, offset9, buflen), "Body.BLSToExecutionChanges:o")
+		}
+		// Field #11 'BlobKZGCommitments' (offset)
+		offset11 := int(binary.LittleEndian.Uint32(buf[exproffset+228 : exproffset+232]))
+		if offset11 < offset10 || offset11 > buflen {
+			return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset11, offset10, buflen), "Body.BlobKZGCommitments:o")
+		}
+		// Field #12 'ExecutionRequests' (offset)
+		offset12 := int(binary.LittleEndian.Uint32(buf[exproffset+232 : exproffset+236]))
+		if offset12 < offset11 || offset12 > buflen {
+			return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset12, offset11, buflen), "Body.ExecutionRequests:o")
+		}
+		{ // Field #3 'ProposerSlashings' (dynamic)
+			buf := buf[offset3:offset4]
+			val4 := val1.ProposerSlashings
+			itemCount := len(buf) / 416
+			if len(buf)%416 != 0 {
+				return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(len(buf), 416), "Body.ProposerSlashings")
+			}
+			if itemCount > int(expr1) {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr1)), "Body.ProposerSlashings")
+			}
+			val4 = sszutils.ExpandSlice(val4, itemCount)
+			for idx1 := range itemCount {
+				val5 := val4[idx1]
+				if val5 == nil {
+					val5 = new(ProposerSlashing)
+				}
+				buf := buf[416*idx1 : 416*(idx1+1)]
+				buflen := len(buf)
+				if buflen < 416 {
+					return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 416), "Body.ProposerSlashings[%d]", idx1)
+				}
+				if buflen > 416 {
+					return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 416), "Body.ProposerSlashings[%d]", idx1)
+				}
+				{ // Field #0 'SignedHeader1' (static)
+					buf := buf[0:208]
+					val6 := val5.SignedHeader1
+					if val6 == nil {
+						val6 = new(SignedBeaconBlockHeader)
+					}
+					buflen := len(buf)
+					if buflen < 208 {
+						return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 208), "Body.ProposerSlashings[%d].SignedHeader1", idx1)
+					}
+					if buflen > 208 {
+						return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 208), "Body.ProposerSlashings[%d].SignedHeader1", idx1)
+					}
+					{ // Field #0 'Message' (static)
+						buf := buf[0:112]
+						val7 := val6.Message
+						if val7 == nil {
+							val7 = new(BeaconBlockHeader)
+						}
+						buflen := len(buf)
+						if buflen < 112 {
+							return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 112), "Body.ProposerSlashings[%d].SignedHeader1.Message", idx1)
+						}
+						if buflen > 112 {
+							return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 112), "Body.ProposerSlashings[%d].SignedHeader1.Message", idx1)
+						}
+						{ // Field #0 'Slot' (static)
+							buf := buf[0:8]
+							val7.Slot = Slot(binary.LittleEndian.Uint64(buf))
+						}
+						{ // Field #1 'ProposerIndex' (static)
+							buf := buf[8:16]
+							val7.ProposerIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf))
+						}
+						{ // Field #2 'ParentRoot' (static)
+							buf := buf[16:48]
+							copy(val7.ParentRoot[:], buf)
+						}
+						{ // Field #3 'StateRoot' (static)
+							buf := buf[48:80]
+							copy(val7.StateRoot[:], buf)
+						}
+						{ // Field #4 'BodyRoot' (static)
+							buf := buf[80:112]
+							copy(val7.BodyRoot[:], buf)
+						}
+						val6.Message = val7
+					}
+					{ // Field #1 'Signature' (static)
+						buf := buf[112:208]
+						copy(val6.Signature[:], buf)
+					}
+					val5.SignedHeader1 = val6
+				}
+				{ // Field #1 'SignedHeader2' (static)
+					buf := buf[208:416]
+					val8 := val5.SignedHeader2
+					if val8 == nil {
+						val8 = new(SignedBeaconBlockHeader)
+					}
+					buflen := len(buf)
+					if buflen < 208 {
+						return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 208), "Body.ProposerSlashings[%d].SignedHeader2", idx1)
+					}
+					if buflen > 208 {
+						return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 208), "Body.ProposerSlashings[%d].SignedHeader2", idx1)
+					}
+					{ // Field #0 'Message' (static)
+						buf := buf[0:112]
+						val9 := val8.Message
+						if val9 == nil {
+							val9 = new(BeaconBlockHeader)
+						}
+						buflen := len(buf)
+						if buflen < 112 {
+							return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 112), "Body.ProposerSlashings[%d].SignedHeader2.Message", idx1)
+						}
+						if buflen > 112 {
+							return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 112), "Body.ProposerSlashings[%d].SignedHeader2.Message", idx1)
+						}
+						{ // Field #0 'Slot' (static)
+							buf := buf[0:8]
+							val9.Slot = Slot(binary.LittleEndian.Uint64(buf))
+						}
+						{ // Field #1 'ProposerIndex' (static)
+							buf := buf[8:16]
+							val9.ProposerIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf))
+						}
+						{ // Field #2 'ParentRoot' (static)
+							buf := buf[16:48]
+							copy(val9.ParentRoot[:], buf)
+						}
+						{ // Field #3 'StateRoot' (static)
+							buf := buf[48:80]
+							copy(val9.StateRoot[:], buf)
+						}
+						{ // Field #4 'BodyRoot' (static)
+							buf := buf[80:112]
+							copy(val9.BodyRoot[:], buf)
+						}
+						val8.Message = val9
+					}
+					{ // Field #1 'Signature' (static)
+						buf := buf[112:208]
+						copy(val8.Signature[:], buf)
+					}
+					val5.SignedHeader2 = val8
+				}
+				val4[idx1] = val5
+			}
+			val1.ProposerSlashings = val4
+		}
+		{ // Field #4 'AttesterSlashings' (dynamic)
+			buf := buf[offset4:offset5]
+			val10 := val1.AttesterSlashings
+			startOffset := int(0)
+			if len(buf) != 0 {
+				if len(buf) < 4 {
+					return sszutils.ErrorWithPath(sszutils.ErrListOffsetsEOFFn(len(buf), 4), "Body.AttesterSlashings")
+				}
+				startOffset = int(binary.LittleEndian.Uint32(buf[0:4]))
+			}
+			itemCount := startOffset / 4
+			if startOffset%4 != 0 || len(buf) < startOffset || (len(buf) != 0 && startOffset == 0) {
+				return sszutils.ErrorWithPath(sszutils.ErrInvalidListStartOffsetFn(startOffset, len(buf)), "Body.AttesterSlashings")
+			}
+			if itemCount > int(expr2) {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr2)), "Body.AttesterSlashings")
+			}
+			val10 = sszutils.ExpandSlice(val10, itemCount)
+			for idx1 := range itemCount {
+				var endOffset int
+				if idx1 < itemCount-1 {
+					endOffset = int(binary.LittleEndian.Uint32(buf[(idx1+1)*4 : (idx1+2)*4]))
+				} else {
+					endOffset = len(buf)
+				}
+				if endOffset < startOffset || endOffset > len(buf) {
+					return sszutils.ErrorWithPathf(sszutils.ErrElementOffsetOutOfRangeFn(endOffset, startOffset, len(buf)), "Body.AttesterSlashings[%d]", idx1)
+				}
+				buf := buf[startOffset:endOffset]
+				startOffset = endOffset
+				val11 := val10[idx1]
+				if val11 == nil {
+					val11 = new(AttesterSlashingElectra)
+				}
+				buflen := len(buf)
+				if buflen < 8 {
+					return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 8), "Body.AttesterSlashings[%d]", idx1)
+				}
+				// Field #0 'Attestation1' (offset)
+				offset0 := int(binary.LittleEndian.Uint32(buf[0:4]))
+				if offset0 != 8 {
+					return sszutils.ErrorWithPathf(sszutils.ErrFirstOffsetMismatchFn(offset0, 8), "Body.AttesterSlashings[%d].Attestation1:o", idx1)
+				}
+				// Field #1 'Attestation2' (offset)
+				offset1 := int(binary.LittleEndian.Uint32(buf[4:8]))
+				if offset1 < offset0 || offset1 > buflen {
+					return sszutils.ErrorWithPathf(sszutils.ErrOffsetOutOfRangeFn(offset1, offset0, buflen), "Body.AttesterSlashings[%d].Attestation2:o", idx1)
+				}
+				{ // Field #0 'Attestation1' (dynamic)
+					buf := buf[offset0:offset1]
+					val12 := val11.Attestation1
+					if val12 == nil {
+						val12 = new(IndexedAttestationElectra)
+					}
+					buflen := len(buf)
+					if buflen < 228 {
+						return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 228), "Body.AttesterSlashings[%d].Attestation1", idx1)
+					}
+					// Field #0 'AttestingIndices' (offset)
+					offset0 := int(binary.LittleEndian.Uint32(buf[0:4]))
+					if offset0 != 228 {
+						return sszutils.ErrorWithPathf(sszutils.ErrFirstOffsetMismatchFn(offset0, 228), "Body.AttesterSlashings[%d].Attestation1.AttestingIndices:o", idx1)
+					}
+					{ // Field #1 'Data' (static)
+						buf := buf[4:132]
+						val13 := val12.Data
+						if val13 == nil {
+							val13 = new(AttestationData)
+						}
+						buflen := len(buf)
+						if buflen < 128 {
+							return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 128), "Body.AttesterSlashings[%d].Attestation1.Data", idx1)
+						}
+						if buflen > 128 {
+							return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 128), "Body.AttesterSlashings[%d].Attestation1.Data", idx1)
+						}
+						{ // Field #0 'Slot' (static)
+							buf := buf[0:8]
+							val13.Slot = Slot(binary.LittleEndian.Uint64(buf))
+						}
+						{ // Field #1 'Index' (static)
+							buf := buf[8:16]
+							val13.Index = binary.LittleEndian.Uint64(buf)
+						}
+						{ // Field #2 'BeaconBlockRoot' (static)
+							buf := buf[16:48]
+							copy(val13.BeaconBlockRoot[:], buf)
+						}
+						{ // Field #3 'Source' (static)
+							buf := buf[48:88]
+							val14 := val13.Source
+							if val14 == nil {
+								val14 = new(Checkpoint)
+							}
+							buflen := len(buf)
+							if buflen < 40 {
+								return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 40), "Body.AttesterSlashings[%d].Attestation1.Data.Source", idx1)
+							}
+							if buflen > 40 {
+								return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 40), "Body.AttesterSlashings[%d].Attestation1.Data.Source", idx1)
+							}
+							{ // Field #0 'Epoch' (static)
+								buf := buf[0:8]
+								val14.Epoch = Epoch(binary.LittleEndian.Uint64(buf))
+							}
+							{ // Field #1 'Root' (static)
+								buf := buf[8:40]
+								copy(val14.Root[:], buf)
+							}
+							val13.Source = val14
+						}
+						{ // Field #4 'Target' (static)
+							buf := buf[88:128]
+							val15 := val13.Target
+							if val15 == nil {
+								val15 = new(Checkpoint)
+							}
+							buflen := len(buf)
+							if buflen < 40 {
+								return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 40), "Body.AttesterSlashings[%d].Attestation1.Data.Target", idx1)
+							}
+							if buflen > 40 {
+								return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 40), "Body.AttesterSlashings[%d].Attestation1.Data.Target", idx1)
+							}
+							{ // Field #0 'Epoch' (static)
+								buf := buf[0:8]
+								val15.Epoch = Epoch(binary.LittleEndian.Uint64(buf))
+							}
+							{ // Field #1 'Root' (static)
+								buf := buf[8:40]
+								copy(val15.Root[:], buf)
+							}
+							val13.Target = val15
+						}
+						val12.Data = val13
+					}
+					{ // Field #2 'Signature' (static)
+						buf := buf[132:228]
+						copy(val12.Signature[:], buf)
+					}
+					{ // Field #0 'AttestingIndices' (dynamic)
+						buf := buf[offset0:]
+						val16 := val12.AttestingIndices
+						itemCount := len(buf) / 8
+						if len(buf)%8 != 0 {
+							return sszutils.ErrorWithPathf(sszutils.ErrListNotAlignedFn(len(buf), 8), "Body.AttesterSlashings[%d].Attestation1.AttestingIndices", idx1)
+						}
+						if itemCount > int(expr3) {
+							return sszutils.ErrorWithPathf(sszutils.ErrListLengthFn(itemCount, int(expr3)), "Body.AttesterSlashings[%d].Attestation1.AttestingIndices", idx1)
+						}
+						val16 = sszutils.ExpandSlice(val16, itemCount)
+						sszutils.UnmarshalUint64Slice(val16, buf)
+						val12.AttestingIndices = val16
+					}
+					val11.Attestation1 = val12
+				}
+				{ // Field #1 'Attestation2' (dynamic)
+					buf := buf[offset1:]
+					val17 := val11.Attestation2
+					if val17 == nil {
+						val17 = new(IndexedAttestationElectra)
+					}
+					buflen := len(buf)
+					if buflen < 228 {
+						return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 228), "Body.AttesterSlashings[%d].Attestation2", idx1)
+					}
+					// Field #0 'AttestingIndices' (offset)
+					offset0 := int(binary.LittleEndian.Uint32(buf[0:4]))
+					if offset0 != 228 {
+						return sszutils.ErrorWithPathf(sszutils.ErrFirstOffsetMismatchFn(offset0, 228), "Body.AttesterSlashings[%d].Attestation2.AttestingIndices:o", idx1)
+					}
+					{ // Field #1 'Data' (static)
+						buf := buf[4:132]
+						val18 := val17.Data
+						if val18 == nil {
+							val18 = new(AttestationData)
+						}
+						buflen := len(buf)
+						if buflen < 128 {
+							return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 128), "Body.AttesterSlashings[%d].Attestation2.Data", idx1)
+						}
+						if buflen > 128 {
+							return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 128), "Body.AttesterSlashings[%d].Attestation2.Data", idx1)
+						}
+						{ // Field #0 'Slot' (static)
+							buf := buf[0:8]
+							val18.Slot = Slot(binary.LittleEndian.Uint64(buf))
+						}
+						{ // Field #1 'Index' (static)
+							buf := buf[8:16]
+							val18.Index = binary.LittleEndian.Uint64(buf)
+						}
+						{ // Field #2 'BeaconBlockRoot' (static)
+							buf := buf[16:48]
+							copy(val18.BeaconBlockRoot[:], buf)
+						}
+						{ // Field #3 'Source' (static)
+							buf := buf[48:88]
+							val19 := val18.Source
+							if val19 == nil {
+								val19 = new(Checkpoint)
+							}
+							buflen := len(buf)
+							if buflen < 40 {
+								return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 40), "Body.AttesterSlashings[%d].Attestation2.Data.Source", idx1)
+							}
+							if buflen > 40 {
+								return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 40), "Body.AttesterSlashings[%d].Attestation2.Data.Source", idx1)
+							}
+							{ // Field #0 'Epoch' (static)
+								buf := buf[0:8]
+								val19.Epoch = Epoch(binary.LittleEndian.Uint64(buf))
+							}
+							{ // Field #1 'Root' (static)
+								buf := buf[8:40]
+								copy(val19.Root[:], buf)
+							}
+							val18.Source = val19
+						}
+						{ // Field #4 'Target' (static)
+							buf := buf[88:128]
+							val20 := val18.Target
+							if val20 == nil {
+								val20 = new(Checkpoint)
+							}
+							buflen := len(buf)
+							if buflen < 40 {
+								return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 40), "Body.AttesterSlashings[%d].Attestation2.Data.Target", idx1)
+							}
+							if buflen > 40 {
+								return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 40), "Body.AttesterSlashings[%d].Attestation2.Data.Target", idx1)
+							}
+							{ // Field #0 'Epoch' (static)
+								buf := buf[0:8]
+								val20.Epoch = Epoch(binary.LittleEndian.Uint64(buf))
+							}
+							{ // Field #1 'Root' (static)
+								buf := buf[8:40]
+								copy(val20.Root[:], buf)
+							}
+							val18.Target = val20
+						}
+						val17.Data = val18
+					}
+					{ // Field #2 'Signature' (static)
+						buf := buf[132:228]
+						copy(val17.Signature[:], buf)
+					}
+					{ // Field #0 'AttestingIndices' (dynamic)
+						buf := buf[offset0:]
+						val21 := val17.AttestingIndices
+						itemCount := len(buf) / 8
+						if len(buf)%8 != 0 {
+							return sszutils.ErrorWithPathf(sszutils.ErrListNotAlignedFn(len(buf), 8), "Body.AttesterSlashings[%d].Attestation2.AttestingIndices", idx1)
+						}
+						if itemCount > int(expr3) {
+							return sszutils.ErrorWithPathf(sszutils.ErrListLengthFn(itemCount, int(expr3)), "Body.AttesterSlashings[%d].Attestation2.AttestingIndices", idx1)
+						}
+						val21 = sszutils.ExpandSlice(val21, itemCount)
+						sszutils.UnmarshalUint64Slice(val21, buf)
+						val17.AttestingIndices = val21
+					}
+					val11.Attestation2 = val17
+				}
+				val10[idx1] = val11
+			}
+			val1.AttesterSlashings = val10
+		}
+		{ // Field #5 'Attestations' (dynamic)
+			buf := buf[offset5:offset6]
+			val22 := val1.Attestations
+			startOffset := int(0)
+			if len(buf) != 0 {
+				if len(buf) < 4 {
+					return sszutils.ErrorWithPath(sszutils.ErrListOffsetsEOFFn(len(buf), 4), "Body.Attestations")
+				}
+				startOffset = int(binary.LittleEndian.Uint32(buf[0:4]))
+			}
+			itemCount := startOffset / 4
+			if startOffset%4 != 0 || len(buf) < startOffset || (len(buf) != 0 && startOffset == 0) {
+				return sszutils.ErrorWithPath(sszutils.ErrInvalidListStartOffsetFn(startOffset, len(buf)), "Body.Attestations")
+			}
+			if itemCount > int(expr4) {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr4)), "Body.Attestations")
+			}
+			val22 = sszutils.ExpandSlice(val22, itemCount)
+			for idx1 := range itemCount {
+				var endOffset int
+				if idx1 < itemCount-1 {
+					endOffset = int(binary.LittleEndian.Uint32(buf[(idx1+1)*4 : (idx1+2)*4]))
+				} else {
+					endOffset = len(buf)
+				}
+				if endOffset < startOffset || endOffset > len(buf) {
+					return sszutils.ErrorWithPathf(sszutils.ErrElementOffsetOutOfRangeFn(endOffset, startOffset, len(buf)), "Body.Attestations[%d]", idx1)
+				}
+				buf := buf[startOffset:endOffset]
+				startOffset = endOffset
+				val23 := val22[idx1]
+				if val23 == nil {
+					val23 = new(AttestationElectra)
+				}
+				exproffset := 0
+				totalSize := size3+228
+				buflen := len(buf)
+				if buflen < totalSize {
+					return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, totalSize), "Body.Attestations[%d]", idx1)
+				}
+				// Field #0 'AggregationBits' (offset)
+				offset0 := int(binary.LittleEndian.Uint32(buf[0:4]))
+				if offset0 != totalSize {
+					return sszutils.ErrorWithPathf(sszutils.ErrFirstOffsetMismatchFn(offset0, totalSize), "Body.Attestations[%d].AggregationBits:o", idx1)
+				}
+				{ // Field #1 'Data' (static)
+					buf := buf[4:132]
+					val24 := val23.Data
+					if val24 == nil {
+						val24 = new(AttestationData)
+					}
+					buflen := len(buf)
+					if buflen < 128 {
+						return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 128), "Body.Attestations[%d].Data", idx1)
+					}
+					if buflen > 128 {
+						return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 128), "Body.Attestations[%d].Data", idx1)
+					}
+					{ // Field #0 'Slot' (static)
+						buf := buf[0:8]
+						val24.Slot = Slot(binary.LittleEndian.Uint64(buf))
+					}
+					{ // Field #1 'Index' (static)
+						buf := buf[8:16]
+						val24.Index = binary.LittleEndian.Uint64(buf)
+					}
+					{ // Field #2 'BeaconBlockRoot' (static)
+						buf := buf[16:48]
+						copy(val24.BeaconBlockRoot[:], buf)
+					}
+					{ // Field #3 'Source' (static)
+						buf := buf[48:88]
+						val25 := val24.Source
+						if val25 == nil {
+							val25 = new(Checkpoint)
+						}
+						buflen := len(buf)
+						if buflen < 40 {
+							return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 40), "Body.Attestations[%d].Data.Source", idx1)
+						}
+						if buflen > 40 {
+							return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 40), "Body.Attestations[%d].Data.Source", idx1)
+						}
+						{ // Field #0 'Epoch' (static)
+							buf := buf[0:8]
+							val25.Epoch = Epoch(binary.LittleEndian.Uint64(buf))
+						}
+						{ // Field #1 'Root' (static)
+							buf := buf[8:40]
+							copy(val25.Root[:], buf)
+						}
+						val24.Source = val25
+					}
+					{ // Field #4 'Target' (static)
+						buf := buf[88:128]
+						val26 := val24.Target
+						if val26 == nil {
+							val26 = new(Checkpoint)
+						}
+						buflen := len(buf)
+						if buflen < 40 {
+							return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 40), "Body.Attestations[%d].Data.Target", idx1)
+						}
+						if buflen > 40 {
+							return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 40), "Body.Attestations[%d].Data.Target", idx1)
+						}
+						{ // Field #0 'Epoch' (static)
+							buf := buf[0:8]
+							val26.Epoch = Epoch(binary.LittleEndian.Uint64(buf))
+						}
+						{ // Field #1 'Root' (static)
+							buf := buf[8:40]
+							copy(val26.Root[:], buf)
+						}
+						val24.Target = val26
+					}
+					val23.Data = val24
+				}
+				{ // Field #2 'Signature' (static)
+					buf := buf[132:228]
+					copy(val23.Signature[:], buf)
+				}
+				{ // Field #3 'CommitteeBits' (static)
+					buf := buf[228 : size3+228]
+					exproffset += int(size3)
+					val23.CommitteeBits = sszutils.ExpandSlice(val23.CommitteeBits, int(expr5))
+					copy(val23.CommitteeBits[:], buf)
+				}
+				{ // Field #0 'AggregationBits' (dynamic)
+					buf := buf[offset0:]
+					val27 := val23.AggregationBits
+					blen := len(buf)
+					if blen == 0 || buf[blen-1] == 0x00 {
+						return sszutils.ErrorWithPathf(sszutils.ErrBitlistNotTerminatedFn(), "Body.Attestations[%d].AggregationBits", idx1)
+					}
+					bitCount := 8*(blen-1) + int(bits.Len8(buf[blen-1])) - 1
+					if bitCount > int(expr3) {
+						return sszutils.ErrorWithPathf(sszutils.ErrBitlistLengthFn(bitCount, int(expr3)), "Body.Attestations[%d].AggregationBits", idx1)
+					}
+					val27 = sszutils.ExpandSlice(val27, blen)
+					copy(val27[:], buf)
+					val23.AggregationBits = val27
+				}
+				val22[idx1] = val23
+			}
+			val1.Attestations = val22
+		}
+		{ // Field #6 'Deposits' (dynamic)
+			buf := buf[offset6:offset7]
+			val28 := val1.Deposits
+			itemCount := len(buf) / 1240
+			if len(buf)%1240 != 0 {
+				return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(len(buf), 1240), "Body.Deposits")
+			}
+			if itemCount > int(expr6) {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr6)), "Body.Deposits")
+			}
+			val28 = sszutils.ExpandSlice(val28, itemCount)
+			for idx1 := range itemCount {
+				val29 := val28[idx1]
+				if val29 == nil {
+					val29 = new(Deposit)
+				}
+				buf := buf[1240*idx1 : 1240*(idx1+1)]
+				buflen := len(buf)
+				if buflen < 1240 {
+					return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 1240), "Body.Deposits[%d]", idx1)
+				}
+				if buflen > 1240 {
+					return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 1240), "Body.Deposits[%d]", idx1)
+				}
+				{ // Field #0 'Proof' (static)
+					buf := buf[0:1056]
+					val30 := val29.Proof
+					val30 = sszutils.ExpandSlice(val30, 33)
+					for idx2 := range 33 {
+						buf := buf[32*idx2 : 32*(idx2+1)]
+						val30[idx2] = sszutils.ExpandSlice(val30[idx2], 32)
+						copy(val30[idx2][:], buf)
+					}
+					val29.Proof = val30
+				}
+				{ // Field #1 'Data' (static)
+					buf := buf[1056:1240]
+					val31 := val29.Data
+					if val31 == nil {
+						val31 = new(DepositData)
+					}
+					buflen := len(buf)
+					if buflen < 184 {
+						return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 184), "Body.Deposits[%d].Data", idx1)
+					}
+					if buflen > 184 {
+						return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 184), "Body.Deposits[%d].Data", idx1)
+					}
+					{ // Field #0 'Pubkey' (static)
+						buf := buf[0:48]
+						copy(val31.Pubkey[:], buf)
+					}
+					{ // Field #1 'WithdrawalCredentials' (static)
+						buf := buf[48:80]
+						copy(val31.WithdrawalCredentials[:], buf)
+					}
+					{ // Field #2 'Amount' (static)
+						buf := buf[80:88]
+						val31.Amount = Gwei(binary.LittleEndian.Uint64(buf))
+					}
+					{ // Field #3 'Signature' (static)
+						buf := buf[88:184]
+						copy(val31.Signature[:], buf)
+					}
+					val29.Data = val31
+				}
+				val28[idx1] = val29
+			}
+			val1.Deposits = val28
+		}
+		{ // Field #7 'VoluntaryExits' (dynamic)
+			buf := buf[offset7:offset9]
+			val32 := val1.VoluntaryExits
+			itemCount := len(buf) / 112
+			if len(buf)%112 != 0 {
+				return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(len(buf), 112), "Body.VoluntaryExits")
+			}
+			if itemCount > int(expr7) {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr7)), "Body.VoluntaryExits")
+			}
+			val32 = sszutils.ExpandSlice(val32, itemCount)
+			for idx1 := range itemCount {
+				val33 := val32[idx1]
+				if val33 == nil {
+					val33 = new(SignedVoluntaryExit)
+				}
+				buf := buf[112*idx1 : 112*(idx1+1)]
+				buflen := len(buf)
+				if buflen < 112 {
+					return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 112), "Body.VoluntaryExits[%d]", idx1)
+				}
+				if buflen > 112 {
+					return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 112), "Body.VoluntaryExits[%d]", idx1)
+				}
+				{ // Field #0 'Message' (static)
+					buf := buf[0:16]
+					val34 := val33.Message
+					if val34 == nil {
+						val34 = new(VoluntaryExit)
+					}
+					buflen := len(buf)
+					if buflen < 16 {
+						return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 16), "Body.VoluntaryExits[%d].Message", idx1)
+					}
+					if buflen > 16 {
+						return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 16), "Body.VoluntaryExits[%d].Message", idx1)
+					}
+					{ // Field #0 'Epoch' (static)
+						buf := buf[0:8]
+						val34.Epoch = Epoch(binary.LittleEndian.Uint64(buf))
+					}
+					{ // Field #1 'ValidatorIndex' (static)
+						buf := buf[8:16]
+						val34.ValidatorIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf))
+					}
+					val33.Message = val34
+				}
+				{ // Field #1 'Signature' (static)
+					buf := buf[16:112]
+					copy(val33.Signature[:], buf)
+				}
+				val32[idx1] = val33
+			}
+			val1.VoluntaryExits = val32
+		}
+		{ // Field #9 'ExecutionPayload' (dynamic)
+			buf := buf[offset9:offset10]
+			val35 := val1.ExecutionPayload
+			if val35 == nil {
+				val35 = new(ExecutionPayload)
+			}
+			buflen := len(buf)
+			if buflen < 528 {
+				return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(buflen, 528), "Body.ExecutionPayload")
+			}
+			{ // Field #0 'ParentHash' (static)
+				buf := buf[0:32]
+				copy(val35.ParentHash[:], buf)
+			}
+			{ // Field #1 'FeeRecipient' (static)
+				buf := buf[32:52]
+				copy(val35.FeeRecipient[:], buf)
+			}
+			{ // Field #2 'StateRoot' (static)
+				buf := buf[52:84]
+				copy(val35.StateRoot[:], buf)
+			}
+			{ // Field #3 'ReceiptsRoot' (static)
+				buf := buf[84:116]
+				copy(val35.ReceiptsRoot[:], buf)
+			}
+			{ // Field #4 'LogsBloom' (static)
+				buf := buf[116:372]
+				copy(val35.LogsBloom[:], buf)
+			}
+			{ // Field #5 'PrevRandao' (static)
+				buf := buf[372:404]
+				copy(val35.PrevRandao[:], buf)
+			}
+			{ // Field #6 'BlockNumber' (static)
+				buf := buf[404:412]
+				val35.BlockNumber = binary.LittleEndian.Uint64(buf)
+			}
+			{ // Field #7 'GasLimit' (static)
+				buf := buf[412:420]
+				val35.GasLimit = binary.LittleEndian.Uint64(buf)
+			}
+			{ // Field #8 'GasUsed' (static)
+				buf := buf[420:428]
+				val35.GasUsed = binary.LittleEndian.Uint64(buf)
+			}
+			{ // Field #9 'Timestamp' (static)
+				buf := buf[428:436]
+				val35.Timestamp = binary.LittleEndian.Uint64(buf)
+			}
+			// Field #10 'ExtraData' (offset)
+			offset10 := int(binary.LittleEndian.Uint32(buf[436:440]))
+			if offset10 != 528 {
+				return sszutils.ErrorWithPath(sszutils.ErrFirstOffsetMismatchFn(offset10, 528), "Body.ExecutionPayload.ExtraData:o")
+			}
+			{ // Field #11 'BaseFeePerGas' (static)
+				buf := buf[440:472]
+				copy(val35.BaseFeePerGas[:], buf)
+			}
+			{ // Field #12 'BlockHash' (static)
+				buf := buf[472:504]
+				copy(val35.BlockHash[:], buf)
+			}
+			// Field #13 'Transactions' (offset)
+			offset13 := int(binary.LittleEndian.Uint32(buf[504:508]))
+			if offset13 < offset10 || offset13 > buflen {
+				return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset13, offset10, buflen), "Body.ExecutionPayload.Transactions:o")
+			}
+			// Field #14 'Withdrawals' (offset)
+			offset14 := int(binary.LittleEndian.Uint32(buf[508:512]))
+			if offset14 < offset13 || offset14 > buflen {
+				return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset14, offset13, buflen), "Body.ExecutionPayload.Withdrawals:o")
+			}
+			{ // Field #15 'BlobGasUsed' (static)
+				buf := buf[512:520]
+				val35.BlobGasUsed = binary.LittleEndian.Uint64(buf)
+			}
+			{ // Field #16 'ExcessBlobGas' (static)
+				buf := buf[520:528]
+				val35.ExcessBlobGas = binary.LittleEndian.Uint64(buf)
+			}
+			{ // Field #10 'ExtraData' (dynamic)
+				buf := buf[offset10:offset13]
+				if len(buf) > int(expr8) {
+					return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(len(buf), int(expr8)), "Body.ExecutionPayload.ExtraData")
+				}
+				val35.ExtraData = sszutils.ExpandSlice(val35.ExtraData, len(buf))
+				copy(val35.ExtraData[:], buf)
+			}
+			{ // Field #13 'Transactions' (dynamic)
+				buf := buf[offset13:offset14]
+				val36 := val35.Transactions
+				startOffset := int(0)
+				if len(buf) != 0 {
+					if len(buf) < 4 {
+						return sszutils.ErrorWithPath(sszutils.ErrListOffsetsEOFFn(len(buf), 4), "Body.ExecutionPayload.Transactions")
+					}
+					startOffset = int(binary.LittleEndian.Uint32(buf[0:4]))
+				}
+				itemCount := startOffset / 4
+				if startOffset%4 != 0 || len(buf) < startOffset || (len(buf) != 0 && startOffset == 0) {
+					return sszutils.ErrorWithPath(sszutils.ErrInvalidListStartOffsetFn(startOffset, len(buf)), "Body.ExecutionPayload.Transactions")
+				}
+				if itemCount > int(expr9) {
+					return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr9)), "Body.ExecutionPayload.Transactions")
+				}
+				val36 = sszutils.ExpandSlice(val36, itemCount)
+				for idx1 := range itemCount {
+					var endOffset int
+					if idx1 < itemCount-1 {
+						endOffset = int(binary.LittleEndian.Uint32(buf[(idx1+1)*4 : (idx1+2)*4]))
+					} else {
+						endOffset = len(buf)
+					}
+					if endOffset < startOffset || endOffset > len(buf) {
+						return sszutils.ErrorWithPathf(sszutils.ErrElementOffsetOutOfRangeFn(endOffset, startOffset, len(buf)), "Body.ExecutionPayload.Transactions[%d]", idx1)
+					}
+					buf := buf[startOffset:endOffset]
+					startOffset = endOffset
+					val37 := val36[idx1]
+					if len(buf) > int(expr10) {
+						return sszutils.ErrorWithPathf(sszutils.ErrListLengthFn(len(buf), int(expr10)), "Body.ExecutionPayload.Transactions[%d]", idx1)
+					}
+					val37 = sszutils.ExpandSlice(val37, len(buf))
+					copy(val37[:], buf)
+					val36[idx1] = val37
+				}
+				val35.Transactions = val36
+			}
+			{ // Field #14 'Withdrawals' (dynamic)
+				buf := buf[offset14:]
+				val38 := val35.Withdrawals
+				itemCount := len(buf) / 44
+				if len(buf)%44 != 0 {
+					return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(len(buf), 44), "Body.ExecutionPayload.Withdrawals")
+				}
+				if itemCount > int(expr11) {
+					return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr11)), "Body.ExecutionPayload.Withdrawals")
+				}
+				val38 = sszutils.ExpandSlice(val38, itemCount)
+				for idx1 := range itemCount {
+					val39 := val38[idx1]
+					if val39 == nil {
+						val39 = new(Withdrawal)
+					}
+					buf := buf[44*idx1 : 44*(idx1+1)]
+					buflen := len(buf)
+					if buflen < 44 {
+						return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 44), "Body.ExecutionPayload.Withdrawals[%d]", idx1)
+					}
+					if buflen > 44 {
+						return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 44), "Body.ExecutionPayload.Withdrawals[%d]", idx1)
+					}
+					{ // Field #0 'Index' (static)
+						buf := buf[0:8]
+						val39.Index = WithdrawalIndex(binary.LittleEndian.Uint64(buf))
+					}
+					{ // Field #1 'ValidatorIndex' (static)
+						buf := buf[8:16]
+						val39.ValidatorIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf))
+					}
+					{ // Field #2 'Address' (static)
+						buf := buf[16:36]
+						copy(val39.Address[:], buf)
+					}
+					{ // Field #3 'Amount' (static)
+						buf := buf[36:44]
+						val39.Amount = Gwei(binary.LittleEndian.Uint64(buf))
+					}
+					val38[idx1] = val39
+				}
+				val35.Withdrawals = val38
+			}
+			val1.ExecutionPayload = val35
+		}
+		{ // Field #10 'BLSToExecutionChanges' (dynamic)
+			buf := buf[offset10:offset11]
+			val40 := val1.BLSToExecutionChanges
+			itemCount := len(buf) / 172
+			if len(buf)%172 != 0 {
+				return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(len(buf), 172), "Body.BLSToExecutionChanges")
+			}
+			if itemCount > int(expr12) {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr12)), "Body.BLSToExecutionChanges")
+			}
+			val40 = sszutils.ExpandSlice(val40, itemCount)
+			for idx1 := range itemCount {
+				val41 := val40[idx1]
+				if val41 == nil {
+					val41 = new(SignedBLSToExecutionChange)
+				}
+				buf := buf[172*idx1 : 172*(idx1+1)]
+				buflen := len(buf)
+				if buflen < 172 {
+					return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 172), "Body.BLSToExecutionChanges[%d]", idx1)
+				}
+				if buflen > 172 {
+					return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 172), "Body.BLSToExecutionChanges[%d]", idx1)
+				}
+				{ // Field #0 'Message' (static)
+					buf := buf[0:76]
+					val42 := val41.Message
+					if val42 == nil {
+						val42 = new(BLSToExecutionChange)
+					}
+					buflen := len(buf)
+					if buflen < 76 {
+						return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 76), "Body.BLSToExecutionChanges[%d].Message", idx1)
+					}
+					if buflen > 76 {
+						return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 76), "Body.BLSToExecutionChanges[%d].Message", idx1)
+					}
+					{ // Field #0 'ValidatorIndex' (static)
+						buf := buf[0:8]
+						val42.ValidatorIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf))
+					}
+					{ // Field #1 'FromBLSPubkey' (static)
+						buf := buf[8:56]
+						copy(val42.FromBLSPubkey[:], buf)
+					}
+					{ // Field #2 'ToExecutionAddress' (static)
+						buf := buf[56:76]
+						copy(val42.ToExecutionAddress[:], buf)
+					}
+					val41.Message = val42
+				}
+				{ // Field #1 'Signature' (static)
+					buf := buf[76:172]
+					copy(val41.Signature[:], buf)
+				}
+				val40[idx1] = val41
+			}
+			val1.BLSToExecutionChanges = val40
+		}
+		{ // Field #11 'BlobKZGCommitments' (dynamic)
+			buf := buf[offset11:offset12]
+			val43 := val1.BlobKZGCommitments
+			itemCount := len(buf) / 48
+			if len(buf)%48 != 0 {
+				return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(len(buf), 48), "Body.BlobKZGCommitments")
+			}
+			if itemCount > int(expr13) {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr13)), "Body.BlobKZGCommitments")
+			}
+			val43 = sszutils.ExpandSlice(val43, itemCount)
+			sszutils.UnmarshalFixedBytesSlice(val43[:itemCount], buf)
+			val1.BlobKZGCommitments = val43
+		}
+		{ // Field #12 'ExecutionRequests' (dynamic)
+			buf := buf[offset12:]
+			val44 := val1.ExecutionRequests
+			if val44 == nil {
+				val44 = new(ExecutionRequests)
+			}
+			buflen := len(buf)
+			if buflen < 12 {
+				return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(buflen, 12), "Body.ExecutionRequests")
+			}
+			// Field #0 'Deposits' (offset)
+			offset0 := int(binary.LittleEndian.Uint32(buf[0:4]))
+			if offset0 != 12 {
+				return sszutils.ErrorWithPath(sszutils.ErrFirstOffsetMismatchFn(offset0, 12), "Body.ExecutionRequests.Deposits:o")
+			}
+			// Field #1 'Withdrawals' (offset)
+			offset1 := int(binary.LittleEndian.Uint32(buf[4:8]))
+			if offset1 < offset0 || offset1 > buflen {
+				return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset1, offset0, buflen), "Body.ExecutionRequests.Withdrawals:o")
+			}
+			// Field #2 'Consolidations' (offset)
+			offset2 := int(binary.LittleEndian.Uint32(buf[8:12]))
+			if offset2 < offset1 || offset2 > buflen {
+				return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset2, offset1, buflen), "Body.ExecutionRequests.Consolidations:o")
+			}
+			{ // Field #0 'Deposits' (dynamic)
+				buf := buf[offset0:offset1]
+				val45 := val44.Deposits
+				itemCount := len(buf) / 192
+				if len(buf)%192 != 0 {
+					return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(len(buf), 192), "Body.ExecutionRequests.Deposits")
+				}
+				if itemCount > int(expr14) {
+					return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr14)), "Body.ExecutionRequests.Deposits")
+				}
+				val45 = sszutils.ExpandSlice(val45, itemCount)
+				for idx1 := range itemCount {
+					val46 := val45[idx1]
+					if val46 == nil {
+						val46 = new(DepositRequest)
+					}
+					buf := buf[192*idx1 : 192*(idx1+1)]
+					buflen := len(buf)
+					if buflen < 192 {
+						return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 192), "Body.ExecutionRequests.Deposits[%d]", idx1)
+					}
+					if buflen > 192 {
+						return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 192), "Body.ExecutionRequests.Deposits[%d]", idx1)
+					}
+					{ // Field #0 'Pubkey' (static)
+						buf := buf[0:48]
+						copy(val46.Pubkey[:], buf)
+					}
+					{ // Field #1 'WithdrawalCredentials' (static)
+						buf := buf[48:80]
+						copy(val46.WithdrawalCredentials[:], buf)
+					}
+					{ // Field #2 'Amount' (static)
+						buf := buf[80:88]
+						val46.Amount = Gwei(binary.LittleEndian.Uint64(buf))
+					}
+					{ // Field #3 'Signature' (static)
+						buf := buf[88:184]
+						copy(val46.Signature[:], buf)
+					}
+					{ // Field #4 'Index' (static)
+						buf := buf[184:192]
+						val46.Index = binary.LittleEndian.Uint64(buf)
+					}
+					val45[idx1] = val46
+				}
+				val44.Deposits = val45
+			}
+			{ // Field #1 'Withdrawals' (dynamic)
+				buf := buf[offset1:offset2]
+				val47 := val44.Withdrawals
+				itemCount := len(buf) / 76
+				if len(buf)%76 != 0 {
+					return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(len(buf), 76), "Body.ExecutionRequests.Withdrawals")
+				}
+				if itemCount > int(expr15) {
+					return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr15)), "Body.ExecutionRequests.Withdrawals")
+				}
+				val47 = sszutils.ExpandSlice(val47, itemCount)
+				for idx1 := range itemCount {
+					val48 := val47[idx1]
+					if val48 == nil {
+						val48 = new(WithdrawalRequest)
+					}
+					buf := buf[76*idx1 : 76*(idx1+1)]
+					buflen := len(buf)
+					if buflen < 76 {
+						return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 76), "Body.ExecutionRequests.Withdrawals[%d]", idx1)
+					}
+					if buflen > 76 {
+						return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 76), "Body.ExecutionRequests.Withdrawals[%d]", idx1)
+					}
+					{ // Field #0 'SourceAddress' (static)
+						buf := buf[0:20]
+						copy(val48.SourceAddress[:], buf)
+					}
+					{ // Field #1 'ValidatorPubkey' (static)
+						buf := buf[20:68]
+						copy(val48.ValidatorPubkey[:], buf)
+					}
+					{ // Field #2 'Amount' (static)
+						buf := buf[68:76]
+						val48.Amount = Gwei(binary.LittleEndian.Uint64(buf))
+					}
+					val47[idx1] = val48
+				}
+				val44.Withdrawals = val47
+			}
+			{ // Field #2 'Consolidations' (dynamic)
+				buf := buf[offset2:]
+				val49 := val44.Consolidations
+				itemCount := len(buf) / 116
+				if len(buf)%116 != 0 {
+					return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(len(buf), 116), "Body.ExecutionRequests.Consolidations")
+				}
+				if itemCount > int(expr16) {
+					return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr16)), "Body.ExecutionRequests.Consolidations")
+				}
+				val49 = sszutils.ExpandSlice(val49, itemCount)
+				for idx1 := range itemCount {
+					val50 := val49[idx1]
+					if val50 == nil {
+						val50 = new(ConsolidationRequest)
+					}
+					buf := buf[116*idx1 : 116*(idx1+1)]
+					buflen := len(buf)
+					if buflen < 116 {
+						return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 116), "Body.ExecutionRequests.Consolidations[%d]", idx1)
+					}
+					if buflen > 116 {
+						return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 116), "Body.ExecutionRequests.Consolidations[%d]", idx1)
+					}
+					{ // Field #0 'SourceAddress' (static)
+						buf := buf[0:20]
+						copy(val50.SourceAddress[:], buf)
+					}
+					{ // Field #1 'SourcePubkey' (static)
+						buf := buf[20:68]
+						copy(val50.SourcePubkey[:], buf)
+					}
+					{ // Field #2 'TargetPubkey' (static)
+						buf := buf[68:116]
+						copy(val50.TargetPubkey[:], buf)
+					}
+					val49[idx1] = val50
+				}
+				val44.Consolidations = val49
+			}
+			val1.ExecutionRequests = val44
+		}
+		t.Body = val1
+	}
+	return nil
+}
+
+// UnmarshalSSZDecoder unmarshals the *BeaconBlockElectra from the given SSZ decoder using dynamic specifications.
+func (t *BeaconBlockElectra) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils.Decoder) (err error) {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE/8", 64)
+	if err != nil {
+		return err
+	}
+	expr1, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_PROPOSER_SLASHINGS", 16)
+	if err != nil {
+		return err
+	}
+	expr2, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_ATTESTER_SLASHINGS_ELECTRA", 1)
+	if err != nil {
+		return err
+	}
+	expr3, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_VALIDATORS_PER_COMMITTEE*MAX_COMMITTEES_PER_SLOT", 131072)
+	if err != nil {
+		return err
+	}
+	expr4, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_ATTESTATIONS_ELECTRA", 8)
+	if err != nil {
+		return err
+	}
+	expr5, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_COMMITTEES_PER_SLOT/8", 8)
+	if err != nil {
+		return err
+	}
+	expr6, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_DEPOSITS", 16)
+	if err != nil {
+		return err
+	}
+	expr7, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_VOLUNTARY_EXITS", 16)
+	if err != nil {
+		return err
+	}
+	expr8, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_EXTRA_DATA_BYTES", 32)
+	if err != nil {
+		return err
+	}
+	expr9, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_TRANSACTIONS_PER_PAYLOAD", 1048576)
+	if err != nil {
+		return err
+	}
+	expr10, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_BYTES_PER_TRANSACTION", 1073741824)
+	if err != nil {
+		return err
+	}
+	expr11, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_WITHDRAWALS_PER_PAYLOAD", 16)
+	if err != nil {
+		return err
+	}
+	expr12, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_BLS_TO_EXECUTION_CHANGES", 16)
+	if err != nil {
+		return err
+	}
+	expr13, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_BLOB_COMMITMENTS_PER_BLOCK", 4096)
+	if err != nil {
+		return err
+	}
+	expr14, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_DEPOSIT_REQUESTS_PER_PAYLOAD", 8192)
+	if err != nil {
+		return err
+	}
+	expr15, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_WITHDRAWAL_REQUESTS_PER_PAYLOAD", 16)
+	if err != nil {
+		return err
+	}
+	expr16, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_CONSOLIDATION_REQUESTS_PER_PAYLOAD", 2)
+	if err != nil {
+		return err
+	}
+	size2 := 1 * int(expr0)
+	size1 := size2 + 96 // size expression for '*SyncAggregate'
+	size3 := 1 * int(expr5)
+	canSeek := dec.Seekable()
+	offsetSlices := [1][]uint32{
+		sszutils.GetOffsetSlice(0),
+	}
+	defer func() {
+		sszutils.PutOffsetSlice(offsetSlices[0])
+	}()
+	maxOffset := uint32(dec.GetLength())
+	startPos0 := dec.GetPosition()
+	if maxOffset < uint32(84) {
+		return sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(84))
+	}
+	// Field #0 'Slot' (static)
+	if val, err := dec.DecodeUint64(); err != nil {
+		return sszutils.ErrorWithPath(err, "Slot")
+	} else {
+		t.Slot = Slot(val)
+	}
+	// Field #1 'ProposerIndex' (static)
+	if val, err := dec.DecodeUint64(); err != nil {
+		return sszutils.ErrorWithPath(err, "ProposerIndex")
+	} else {
+		t.ProposerIndex = ValidatorIndex(val)
+	}
+	// Field #2 'ParentRoot' (static)
+	if _, err = dec.DecodeBytes(t.ParentRoot[:32]); err != nil {
+		return err
+	}
+	// Field #3 'StateRoot' (static)
+	if _, err = dec.DecodeBytes(t.StateRoot[:32]); err != nil {
+		return err
+	}
+	// Field #4 'Body' (offset)
+	offset4, err := dec.DecodeOffset()
+	if err != nil {
+		return sszutils.ErrorWithPath(err, "Body")
+	}
+	if offset4 != uint32(84) {
+		return sszutils.ErrorWithPath(sszutils.ErrFirstOffsetMismatchFn(offset4, 84), "Body")
+	}
+	{ // Field #4 'Body' (dynamic)
+		if dec.GetPosition() != startPos0+int(offset4) {
+			return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos0+int(offset4)), "Body")
+		}
+		dec.PushLimit(int(maxOffset - offset4))
+		val1 := t.Body
+		if val1 == nil {
+			val1 = new(BeaconBlockBodyElectra)
+		}
+		totalSize := size1+236
+		maxOffset := uint32(dec.GetLength())
+		startPos1 := dec.GetPosition()
+		if maxOffset < uint32(totalSize) {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(totalSize)), "Body")
+		}
+		// Field #0 'RANDAOReveal' (static)
+		if _, err = dec.DecodeBytes(val1.RANDAOReveal[:96]); err != nil {
+			return err
+		}
+		{ // Field #1 'ETH1Data' (static)
+			val2 := val1.ETH1Data
+			if val2 == nil {
+				val2 = new(ETH1Data)
+			}
+			maxOffset := uint32(dec.GetLength())
+			if maxOffset < uint32(72) {
+				return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(72)), "Body.ETH1Data")
+			}
+			// Field #0 'DepositRoot' (static)
+			if _, err = dec.DecodeBytes(val2.DepositRoot[:32]); err != nil {
+				return err
+			}
+			// Field #1 'DepositCount' (static)
+			if val, err := dec.DecodeUint64(); err != nil {
+				return sszutils.ErrorWithPath(err, "Body.ETH1Data.DepositCount")
+			} else {
+				val2.DepositCount = val
+			}
+			// Field #2 'BlockHash' (static)
+			if _, err = dec.DecodeBytes(val2.BlockHash[:32]); err != nil {
+				return err
+			}
+			val1.ETH1Data = val2
+		}
+		// Field #2 'Graffiti' (static)
+		if _, err = dec.DecodeBytes(val1.Graffiti[:32]); err != nil {
+			return err
+		}
+		// Field #3 'ProposerSlashings' (offset)
+		offset3, err := dec.DecodeOffset()
+		if err != nil {
+			return sszutils.ErrorWithPath(err, "Body.ProposerSlashings")
+		}
+		if offset3 != uint32(totalSize) {
+			return sszutils.ErrorWithPath(sszutils.ErrFirstOffsetMismatchFn(offset3, totalSize), "Body.ProposerSlashings")
+		}
+		// Field #4 'AttesterSlashings' (offset)
+		offset4, err := dec.DecodeOffset()
+		if err != nil {
+			return sszutils.ErrorWithPath(err, "Body.AttesterSlashings")
+		}
+		if offset4 < offset3 || offset4 > maxOffset {
+			return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset4, offset3, maxOffset), "Body.AttesterSlashings")
+		}
+		// Field #5 'Attestations' (offset)
+		offset5, err := dec.DecodeOffset()
+		if err != nil {
+			return sszutils.ErrorWithPath(err, "Body.Attestations")
+		}
+		if offset5 < offset4 || offset5 > maxOffset {
+			return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset5, offset4, maxOffset), "Body.Attestations")
+		}
+		// Field #6 'Deposits' (offset)
+		offset6, err := dec.DecodeOffset()
+		if err != nil {
+			return sszutils.ErrorWithPath(err, "Body.Deposits")
+		}
+		if offset6 < offset5 || offset6 > maxOffset {
+			return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset6, offset5, maxOffset), "Body.Deposits")
+		}
+		// Field #7 'VoluntaryExits' (offset)
+		offset7, err := dec.DecodeOffset()
+		if err != nil {
+			return sszutils.ErrorWithPath(err, "Body.VoluntaryExits")
+		}
+		if offset7 < offset6 || offset7 > maxOffset {
+			return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset7, offset6, maxOffset), "Body.VoluntaryExits")
+		}
+		{ // Field #8 'SyncAggregate' (static)
+			val3 := val1.SyncAggregate
+			if val3 == nil {
+				val3 = new(SyncAggregate)
+			}
+			totalSize := size2+96
+			maxOffset := uint32(dec.GetLength())
+			if maxOffset < uint32(totalSize) {
+				return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(totalSize)), "Body.SyncAggregate")
+			}
+			// Field #0 'SyncCommitteeBits' (static)
+			val3.SyncCommitteeBits = sszutils.ExpandSlice(val3.SyncCommitteeBits, int(expr0))
+			if _, err = dec.DecodeBytes(val3.SyncCommitteeBits[:int(expr0)]); err != nil {
+				return err
+			}
+			// Field #1 'SyncCommitteeSignature' (static)
+			if _, err = dec.DecodeBytes(val3.SyncCommitteeSignature[:96]); err != nil {
+				return err
+			}
+			val1.SyncAggregate = val3
+		}
+		// Field #9 'ExecutionPayload' (offset)
+		offset9, err := dec.DecodeOffset()
+		if err != nil {
+			return sszutils.ErrorWithPath(err, "Body.ExecutionPayload")
+		}
+		if offset9 < offset7 || offset9 > maxOffset {
+			return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset9, offset7, maxOffset), "Body.ExecutionPayload")
+		}
+		// Field #10 'BLSToExecutionChanges' (offset)
+		offset10, err := dec.DecodeOffset()
+		if err != nil {
+			return sszutils.ErrorWithPath(err, "Body.BLSToExecutionChanges")
+		}
+		if offset10 < offset9 || offset10 > maxOffset {
+			return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset10, offset9, maxOffset), "Body.BLSToExecutionChanges")
+		}
+		// Field #11 'BlobKZGCommitments' (offset)
+		offset11, err := dec.DecodeOffset()
+		if err != nil {
+			return sszutils.ErrorWithPath(err, "Body.BlobKZGCommitments")
+		}
+		if offset11 < offset10 || offset11 > maxOffset {
+			return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset11, offset10, maxOffset), "Body.BlobKZGCommitments")
+		}
+		// Field #12 'ExecutionRequests' (offset)
+		offset12, err := dec.DecodeOffset()
+		if err != nil {
+			return sszutils.ErrorWithPath(err, "Body.ExecutionRequests")
+		}
+		if offset12 < offset11 || offset12 > maxOffset {
+			return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset12, offset11, maxOffset), "Body.ExecutionRequests")
+		}
+		{ // Field #3 'ProposerSlashings' (dynamic)
+			if dec.GetPosition() != startPos1+int(offset3) {
+				return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos1+int(offset3)), "Body.ProposerSlashings")
+			}
+			dec.PushLimit(int(offset4 - offset3))
+			val4 := val1.ProposerSlashings
+			sszLen := dec.GetLength()
+			itemCount := sszLen / 416
+			if sszLen%416 != 0 {
+				return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(sszLen, 416), "Body.ProposerSlashings")
+			}
+			if itemCount > int(expr1) {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr1)), "Body.ProposerSlashings")
+			}
+			val4 = sszutils.ExpandSlice(val4, itemCount)
+			startPos2 := dec.GetPosition()
+			for idx1 := range itemCount {
+				val5 := val4[idx1]
+				if val5 == nil {
+					val5 = new(ProposerSlashing)
+				}
+				maxOffset := uint32(dec.GetLength())
+				if maxOffset < uint32(416) {
+					return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(416)), "Body.ProposerSlashings[%d]", idx1)
+				}
+				{ // Field #0 'SignedHeader1' (static)
+					val6 := val5.SignedHeader1
+					if val6 == nil {
+						val6 = new(SignedBeaconBlockHeader)
+					}
+					maxOffset := uint32(dec.GetLength())
+					if maxOffset < uint32(208) {
+						return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(208)), "Body.ProposerSlashings[%d].SignedHeader1", idx1)
+					}
+					{ // Field #0 'Message' (static)
+						val7 := val6.Message
+						if val7 == nil {
+							val7 = new(BeaconBlockHeader)
+						}
+						maxOffset := uint32(dec.GetLength())
+						if maxOffset < uint32(112) {
+							return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(112)), "Body.ProposerSlashings[%d].SignedHeader1.Message", idx1)
+						}
+						// Field #0 'Slot' (static)
+						if val, err := dec.DecodeUint64(); err != nil {
+							return sszutils.ErrorWithPathf(err, "Body.ProposerSlashings[%d].SignedHeader1.Message.Slot", idx1)
+						} else {
+							val7.Slot = Slot(val)
+						}
+						// Field #1 'ProposerIndex' (static)
+						if val, err := dec.DecodeUint64(); err != nil {
+							return sszutils.ErrorWithPathf(err, "Body.ProposerSlashings[%d].SignedHeader1.Message.ProposerIndex", idx1)
+						} else {
+							val7.ProposerIndex = ValidatorIndex(val)
+						}
+						// Field #2 'ParentRoot' (static)
+						if _, err = dec.DecodeBytes(val7.ParentRoot[:32]); err != nil {
+							return err
+						}
+						// Field #3 'StateRoot' (static)
+						if _, err = dec.DecodeBytes(val7.StateRoot[:32]); err != nil {
+							return err
+						}
+						// Field #4 'BodyRoot' (static)
+						if _, err = dec.DecodeBytes(val7.BodyRoot[:32]); err != nil {
+							return err
+						}
+						val6.Message = val7
+					}
+					// Field #1 'Signature' (static)
+					if _, err = dec.DecodeBytes(val6.Signature[:96]); err != nil {
+						return err
+					}
+					val5.SignedHeader1 = val6
+				}
+				{ // Field #1 'SignedHeader2' (static)
+					val8 := val5.SignedHeader2
+					if val8 == nil {
+						val8 = new(SignedBeaconBlockHeader)
+					}
+					maxOffset := uint32(dec.GetLength())
+					if maxOffset < uint32(208) {
+						return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(208)), "Body.ProposerSlashings[%d].SignedHeader2", idx1)
+					}
+					{ // Field #0 'Message' (static)
+						val9 := val8.Message
+						if val9 == nil {
+							val9 = new(BeaconBlockHeader)
+						}
+						maxOffset := uint32(dec.GetLength())
+						if maxOffset < uint32(112) {
+							return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(112)), "Body.ProposerSlashings[%d].SignedHeader2.Message", idx1)
+						}
+						// Field #0 'Slot' (static)
+						if val, err := dec.DecodeUint64(); err != nil {
+							return sszutils.ErrorWithPathf(err, "Body.ProposerSlashings[%d].SignedHeader2.Message.Slot", idx1)
+						} else {
+							val9.Slot = Slot(val)
+						}
+						// Field #1 'ProposerIndex' (static)
+						if val, err := dec.DecodeUint64(); err != nil {
+							return sszutils.ErrorWithPathf(err, "Body.ProposerSlashings[%d].SignedHeader2.Message.ProposerIndex", idx1)
+						} else {
+							val9.ProposerIndex = ValidatorIndex(val)
+						}
+						// Field #2 'ParentRoot' (static)
+						if _, err = dec.DecodeBytes(val9.ParentRoot[:32]); err != nil {
+							return err
+						}
+						// Field #3 'StateRoot' (static)
+						if _, err = dec.DecodeBytes(val9.StateRoot[:32]); err != nil {
+							return err
+						}
+						// Field #4 'BodyRoot' (static)
+						if _, err = dec.DecodeBytes(val9.BodyRoot[:32]); err != nil {
+							return err
+						}
+						val8.Message = val9
+					}
+					// Field #1 'Signature' (static)
+					if _, err = dec.DecodeBytes(val8.Signature[:96]); err != nil {
+						return err
+					}
+					val5.SignedHeader2 = val8
+				}
+				if dec.GetPosition() != startPos2+int(416*(idx1+1)) {
+					return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos2+int(416*(idx1+1))), "Body.ProposerSlashings[%d]", idx1)
+				}
+				val4[idx1] = val5
+			}
+			if diff := dec.PopLimit(); diff != 0 {
+				return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "Body.ProposerSlashings")
+			}
+			val1.ProposerSlashings = val4
+		}
+		{ // Field #4 'AttesterSlashings' (dynamic)
+			if dec.GetPosition() != startPos1+int(offset4) {
+				return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos1+int(offset4)), "Body.AttesterSlashings")
+			}
+			dec.PushLimit(int(offset5 - offset4))
+			val10 := val1.AttesterSlashings
+			startOffset := uint32(0)
+			startPos3 := dec.GetPosition()
+			sszLen := uint32(dec.GetLength())
+			if sszLen > 0 {
+				startOffset, err = dec.DecodeOffset()
+				if err != nil {
+					return sszutils.ErrorWithPath(err, "Body.AttesterSlashings")
+				}
+			}
+			itemCount := int(startOffset / 4)
+			var offsets []uint32
+			if canSeek {
+				dec.SkipBytes((itemCount - 1) * 4)
+			} else if itemCount > 1 {
+				offsetSlices[0] = sszutils.ExpandSlice(offsetSlices[0], itemCount-1)
+				offsets = offsetSlices[0]
+				for idx1 := range itemCount-1 {
+					offset, err := dec.DecodeOffset()
+					if err != nil {
+						return sszutils.ErrorWithPathf(err, "Body.AttesterSlashings[%d:o]", idx1)
+					}
+					offsets[idx1] = offset
+				}
+			}
+			if startOffset%4 != 0 || uint32(sszLen) < startOffset {
+				return sszutils.ErrorWithPath(sszutils.ErrInvalidListStartOffsetFn(startOffset, sszLen), "Body.AttesterSlashings")
+			}
+			if itemCount > int(expr2) {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr2)), "Body.AttesterSlashings")
+			}
+			val10 = sszutils.ExpandSlice(val10, itemCount)
+			for idx1 := range itemCount {
+				var endOffset uint32
+				if idx1 < itemCount-1 {
+					if canSeek {
+						endOffset = dec.DecodeOffsetAt(startPos3 + int((idx1+1)*4))
+					} else {
+						endOffset = offsets[idx1]
+					}
+				} else {
+					endOffset = uint32(sszLen)
+				}
+				if endOffset < startOffset || endOffset > uint32(sszLen) {
+					return sszutils.ErrorWithPathf(sszutils.ErrElementOffsetOutOfRangeFn(endOffset, startOffset, sszLen), "Body.AttesterSlashings[%d]", idx1)
+				}
+				itemSize := endOffset - startOffset
+				dec.PushLimit(int(itemSize))
+				startOffset = endOffset
+				val11 := val10[idx1]
+				if val11 == nil {
+					val11 = new(AttesterSlashingElectra)
+				}
+				maxOffset := uint32(dec.GetLength())
+				startPos4 := dec.GetPosition()
+				if maxOffset < uint32(8) {
+					return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(8)), "Body.AttesterSlashings[%d]", idx1)
+				}
+				// Field #0 'Attestation1' (offset)
+				offset0, err := dec.DecodeOffset()
+				if err != nil {
+					return sszutils.ErrorWithPathf(err, "Body.AttesterSlashings[%d].Attestation1", idx1)
+				}
+				if offset0 != uint32(8) {
+					return sszutils.ErrorWithPathf(sszutils.ErrFirstOffsetMismatchFn(offset0, 8), "Body.AttesterSlashings[%d].Attestation1", idx1)
+				}
+				// Field #1 'Attestation2' (offset)
+				offset1, err := dec.DecodeOffset()
+				if err != nil {
+					return sszutils.ErrorWithPathf(err, "Body.AttesterSlashings[%d].Attestation2", idx1)
+				}
+				if offset1 < offset0 || offset1 > maxOffset {
+					return sszutils.ErrorWithPathf(sszutils.ErrOffsetOutOfRangeFn(offset1, offset0, maxOffset), "Body.AttesterSlashings[%d].Attestation2", idx1)
+				}
+				{ // Field #0 'Attestation1' (dynamic)
+					if dec.GetPosition() != startPos4+int(offset0) {
+						return sszutils.ErrorWithPathf(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos4+int(offset0)), "Body.AttesterSlashings[%d].Attestation1", idx1)
+					}
+					dec.PushLimit(int(offset1 - offset0))
+					val12 := val11.Attestation1
+					if val12 == nil {
+						val12 = new(IndexedAttestationElectra)
+					}
+					maxOffset := uint32(dec.GetLength())
+					startPos5 := dec.GetPosition()
+					if maxOffset < uint32(228) {
+						return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(228)), "Body.AttesterSlashings[%d].Attestation1", idx1)
+					}
+					// Field #0 'AttestingIndices' (offset)
+					offset0, err := dec.DecodeOffset()
+					if err != nil {
+						return sszutils.ErrorWithPathf(err, "Body.AttesterSlashings[%d].Attestation1.AttestingIndices", idx1)
+					}
+					if offset0 != uint32(228) {
+						return sszutils.ErrorWithPathf(sszutils.ErrFirstOffsetMismatchFn(offset0, 228), "Body.AttesterSlashings[%d].Attestation1.AttestingIndices", idx1)
+					}
+					{ // Field #1 'Data' (static)
+						val13 := val12.Data
+						if val13 == nil {
+							val13 = new(AttestationData)
+						}
+						maxOffset := uint32(dec.GetLength())
+						if maxOffset < uint32(128) {
+							return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(128)), "Body.AttesterSlashings[%d].Attestation1.Data", idx1)
+						}
+						// Field #0 'Slot' (static)
+						if val, err := dec.DecodeUint64(); err != nil {
+							return sszutils.ErrorWithPathf(err, "Body.AttesterSlashings[%d].Attestation1.Data.Slot", idx1)
+						} else {
+							val13.Slot = Slot(val)
+						}
+						// Field #1 'Index' (static)
+						if val, err := dec.DecodeUint64(); err != nil {
+							return sszutils.ErrorWithPathf(err, "Body.AttesterSlashings[%d].Attestation1.Data.Index", idx1)
+						} else {
+							val13.Index = val
+						}
+						// Field #2 'BeaconBlockRoot' (static)
+						if _, err = dec.DecodeBytes(val13.BeaconBlockRoot[:32]); err != nil {
+							return err
+						}
+						{ // Field #3 'Source' (static)
+							val14 := val13.Source
+							if val14 == nil {
+								val14 = new(Checkpoint)
+							}
+							maxOffset := uint32(dec.GetLength())
+							if maxOffset < uint32(40) {
+								return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(40)), "Body.AttesterSlashings[%d].Attestation1.Data.Source", idx1)
+							}
+							// Field #0 'Epoch' (static)
+							if val, err := dec.DecodeUint64(); err != nil {
+								return sszutils.ErrorWithPathf(err, "Body.AttesterSlashings[%d].Attestation1.Data.Source.Epoch", idx1)
+							} else {
+								val14.Epoch = Epoch(val)
+							}
+							// Field #1 'Root' (static)
+							if _, err = dec.DecodeBytes(val14.Root[:32]); err != nil {
+								return err
+							}
+							val13.Source = val14
+						}
+						{ // Field #4 'Target' (static)
+							val15 := val13.Target
+							if val15 == nil {
+								val15 = new(Checkpoint)
+							}
+							maxOffset := uint32(dec.GetLength())
+							if maxOffset < uint32(40) {
+								return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(40)), "Body.AttesterSlashings[%d].Attestation1.Data.Target", idx1)
+							}
+							// Field #0 'Epoch' (static)
+							if val, err := dec.DecodeUint64(); err != nil {
+								return sszutils.ErrorWithPathf(err, "Body.AttesterSlashings[%d].Attestation1.Data.Target.Epoch", idx1)
+							} else {
+								val15.Epoch = Epoch(val)
+							}
+							// Field #1 'Root' (static)
+							if _, err = dec.DecodeBytes(val15.Root[:32]); err != nil {
+								return err
+							}
+							val13.Target = val15
+						}
+						val12.Data = val13
+					}
+					// Field #2 'Signature' (static)
+					if _, err = dec.DecodeBytes(val12.Signature[:96]); err != nil {
+						return err
+					}
+					{ // Field #0 'AttestingIndices' (dynamic)
+						if dec.GetPosition() != startPos5+int(offset0) {
+							return sszutils.ErrorWithPathf(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos5+int(offset0)), "Body.AttesterSlashings[%d].Attestation1.AttestingIndices", idx1)
+						}
+						dec.PushLimit(int(maxOffset - offset0))
+						val16 := val12.AttestingIndices
+						sszLen := dec.GetLength()
+						itemCount := sszLen / 8
+						if sszLen%8 != 0 {
+							return sszutils.ErrorWithPathf(sszutils.ErrListNotAlignedFn(sszLen, 8), "Body.AttesterSlashings[%d].Attestation1.AttestingIndices", idx1)
+						}
+						if itemCount > int(expr3) {
+							return sszutils.ErrorWithPathf(sszutils.ErrListLengthFn(itemCount, int(expr3)), "Body.AttesterSlashings[%d].Attestation1.AttestingIndices", idx1)
+						}
+						val16 = sszutils.ExpandSlice(val16, itemCount)
+						if err = sszutils.DecodeUint64Slice(dec, val16); err != nil {
+							return sszutils.ErrorWithPathf(err, "Body.AttesterSlashings[%d].Attestation1.AttestingIndices", idx1)
+						}
+						if diff := dec.PopLimit(); diff != 0 {
+							return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(diff), "Body.AttesterSlashings[%d].Attestation1.AttestingIndices", idx1)
+						}
+						val12.AttestingIndices = val16
+					}
+					if diff := dec.PopLimit(); diff != 0 {
+						return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(diff), "Body.AttesterSlashings[%d].Attestation1", idx1)
+					}
+					val11.Attestation1 = val12
+				}
+				{ // Field #1 'Attestation2' (dynamic)
+					if dec.GetPosition() != startPos4+int(offset1) {
+						return sszutils.ErrorWithPathf(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos4+int(offset1)), "Body.AttesterSlashings[%d].Attestation2", idx1)
+					}
+					dec.PushLimit(int(maxOffset - offset1))
+					val17 := val11.Attestation2
+					if val17 == nil {
+						val17 = new(IndexedAttestationElectra)
+					}
+					maxOffset := uint32(dec.GetLength())
+					startPos6 := dec.GetPosition()
+					if maxOffset < uint32(228) {
+						return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(228)), "Body.AttesterSlashings[%d].Attestation2", idx1)
+					}
+					// Field #0 'AttestingIndices' (offset)
+					offset0, err := dec.DecodeOffset()
+					if err != nil {
+						return sszutils.ErrorWithPathf(err, "Body.AttesterSlashings[%d].Attestation2.AttestingIndices", idx1)
+					}
+					if offset0 != uint32(228) {
+						return sszutils.ErrorWithPathf(sszutils.ErrFirstOffsetMismatchFn(offset0, 228), "Body.AttesterSlashings[%d].Attestation2.AttestingIndices", idx1)
+					}
+					{ // Field #1 'Data' (static)
+						val18 := val17.Data
+						if val18 == nil {
+							val18 = new(AttestationData)
+						}
+						maxOffset := uint32(dec.GetLength())
+						if maxOffset < uint32(128) {
+							return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(128)), "Body.AttesterSlashings[%d].Attestation2.Data", idx1)
+						}
+						// Field #0 'Slot' (static)
+						if val, err := dec.DecodeUint64(); err != nil {
+							return sszutils.ErrorWithPathf(err, "Body.AttesterSlashings[%d].Attestation2.Data.Slot", idx1)
+						} else {
+							val18.Slot = Slot(val)
+						}
+						// Field #1 'Index' (static)
+						if val, err := dec.DecodeUint64(); err != nil {
+							return sszutils.ErrorWithPathf(err, "Body.AttesterSlashings[%d].Attestation2.Data.Index", idx1)
+						} else {
+							val18.Index = val
+						}
+						// Field #2 'BeaconBlockRoot' (static)
+						if _, err = dec.DecodeBytes(val18.BeaconBlockRoot[:32]); err != nil {
+							return err
+						}
+						{ // Field #3 'Source' (static)
+							val19 := val18.Source
+							if val19 == nil {
+								val19 = new(Checkpoint)
+							}
+							maxOffset := uint32(dec.GetLength())
+							if maxOffset < uint32(40) {
+								return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(40)), "Body.AttesterSlashings[%d].Attestation2.Data.Source", idx1)
+							}
+							// Field #0 'Epoch' (static)
+							if val, err := dec.DecodeUint64(); err != nil {
+								return sszutils.ErrorWithPathf(err, "Body.AttesterSlashings[%d].Attestation2.Data.Source.Epoch", idx1)
+							} else {
+								val19.Epoch = Epoch(val)
+							}
+							// Field #1 'Root' (static)
+							if _, err = dec.DecodeBytes(val19.Root[:32]); err != nil {
+								return err
+							}
+							val18.Source = val19
+						}
+						{ // Field #4 'Target' (static)
+							val20 := val18.Target
+							if val20 == nil {
+								val20 = new(Checkpoint)
+							}
+							maxOffset := uint32(dec.GetLength())
+							if maxOffset < uint32(40) {
+								return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(40)), "Body.AttesterSlashings[%d].Attestation2.Data.Target", idx1)
+							}
+							// Field #0 'Epoch' (static)
+							if val, err := dec.DecodeUint64(); err != nil {
+								return sszutils.ErrorWithPathf(err, "Body.AttesterSlashings[%d].Attestation2.Data.Target.Epoch", idx1)
+							} else {
+								val20.Epoch = Epoch(val)
+							}
+							// Field #1 'Root' (static)
+							if _, err = dec.DecodeBytes(val20.Root[:32]); err != nil {
+								return err
+							}
+							val18.Target = val20
+						}
+						val17.Data = val18
+					}
+					// Field #2 'Signature' (static)
+					if _, err = dec.DecodeBytes(val17.Signature[:96]); err != nil {
+						return err
+					}
+					{ // Field #0 'AttestingIndices' (dynamic)
+						if dec.GetPosition() != startPos6+int(offset0) {
+							return sszutils.ErrorWithPathf(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos6+int(offset0)), "Body.AttesterSlashings[%d].Attestation2.AttestingIndices", idx1)
+						}
+						dec.PushLimit(int(maxOffset - offset0))
+						val21 := val17.AttestingIndices
+						sszLen := dec.GetLength()
+						itemCount := sszLen / 8
+						if sszLen%8 != 0 {
+							return sszutils.ErrorWithPathf(sszutils.ErrListNotAlignedFn(sszLen, 8), "Body.AttesterSlashings[%d].Attestation2.AttestingIndices", idx1)
+						}
+						if itemCount > int(expr3) {
+							return sszutils.ErrorWithPathf(sszutils.ErrListLengthFn(itemCount, int(expr3)), "Body.AttesterSlashings[%d].Attestation2.AttestingIndices", idx1)
+						}
+						val21 = sszutils.ExpandSlice(val21, itemCount)
+						if err = sszutils.DecodeUint64Slice(dec, val21); err != nil {
+							return sszutils.ErrorWithPathf(err, "Body.AttesterSlashings[%d].Attestation2.AttestingIndices", idx1)
+						}
+						if diff := dec.PopLimit(); diff != 0 {
+							return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(diff), "Body.AttesterSlashings[%d].Attestation2.AttestingIndices", idx1)
+						}
+						val17.AttestingIndices = val21
+					}
+					if diff := dec.PopLimit(); diff != 0 {
+						return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(diff), "Body.AttesterSlashings[%d].Attestation2", idx1)
+					}
+					val11.Attestation2 = val17
+				}
+				if diff := dec.PopLimit(); diff != 0 {
+					return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(diff), "Body.AttesterSlashings[%d]", idx1)
+				}
+				val10[idx1] = val11
+			}
+			if diff := dec.PopLimit(); diff != 0 {
+				return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "Body.AttesterSlashings")
+			}
+			val1.AttesterSlashings = val10
+		}
+		{ // Field #5 'Attestations' (dynamic)
+			if dec.GetPosition() != startPos1+int(offset5) {
+				return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos1+int(offset5)), "Body.Attestations")
+			}
+			dec.PushLimit(int(offset6 - offset5))
+			val22 := val1.Attestations
+			startOffset := uint32(0)
+			startPos7 := dec.GetPosition()
+			sszLen := uint32(dec.GetLength())
+			if sszLen > 0 {
+				startOffset, err = dec.DecodeOffset()
+				if err != nil {
+					return sszutils.ErrorWithPath(err, "Body.Attestations")
+				}
+			}
+			itemCount := int(startOffset / 4)
+			var offsets []uint32
+			if canSeek {
+				dec.SkipBytes((itemCount - 1) * 4)
+			} else if itemCount > 1 {
+				offsetSlices[0] = sszutils.ExpandSlice(offsetSlices[0], itemCount-1)
+				offsets = offsetSlices[0]
+				for idx1 := range itemCount-1 {
+					offset, err := dec.DecodeOffset()
+					if err != nil {
+						return sszutils.ErrorWithPathf(err, "Body.Attestations[%d:o]", idx1)
+					}
+					offsets[idx1] = offset
+				}
+			}
+			if startOffset%4 != 0 || uint32(sszLen) < startOffset {
+				return sszutils.ErrorWithPath(sszutils.ErrInvalidListStartOffsetFn(startOffset, sszLen), "Body.Attestations")
+			}
+			if itemCount > int(expr4) {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr4)), "Body.Attestations")
+			}
+			val22 = sszutils.ExpandSlice(val22, itemCount)
+			for idx1 := range itemCount {
+				var endOffset uint32
+				if idx1 < itemCount-1 {
+					if canSeek {
+						endOffset = dec.DecodeOffsetAt(startPos7 + int((idx1+1)*4))
+					} else {
+						endOffset = offsets[idx1]
+					}
+				} else {
+					endOffset = uint32(sszLen)
+				}
+				if endOffset < startOffset || endOffset > uint32(sszLen) {
+					return sszutils.ErrorWithPathf(sszutils.ErrElementOffsetOutOfRangeFn(endOffset, startOffset, sszLen), "Body.Attestations[%d]", idx1)
+				}
+				itemSize := endOffset - startOffset
+				dec.PushLimit(int(itemSize))
+				startOffset = endOffset
+				val23 := val22[idx1]
+				if val23 == nil {
+					val23 = new(AttestationElectra)
+				}
+				totalSize := size3+228
+				maxOffset := uint32(dec.GetLength())
+				startPos8 := dec.GetPosition()
+				if maxOffset < uint32(totalSize) {
+					return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(totalSize)), "Body.Attestations[%d]", idx1)
+				}
+				// Field #0 'AggregationBits' (offset)
+				offset0, err := dec.DecodeOffset()
+				if err != nil {
+					return sszutils.ErrorWithPathf(err, "Body.Attestations[%d].AggregationBits", idx1)
+				}
+				if offset0 != uint32(totalSize) {
+					return sszutils.ErrorWithPathf(sszutils.ErrFirstOffsetMismatchFn(offset0, totalSize), "Body.Attestations[%d].AggregationBits", idx1)
+				}
+				{ // Field #1 'Data' (static)
+					val24 := val23.Data
+					if val24 == nil {
+						val24 = new(AttestationData)
+					}
+					maxOffset := uint32(dec.GetLength())
+					if maxOffset < uint32(128) {
+						return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(128)), "Body.Attestations[%d].Data", idx1)
+					}
+					// Field #0 'Slot' (static)
+					if val, err := dec.DecodeUint64(); err != nil {
+						return sszutils.ErrorWithPathf(err, "Body.Attestations[%d].Data.Slot", idx1)
+					} else {
+						val24.Slot = Slot(val)
+					}
+					// Field #1 'Index' (static)
+					if val, err := dec.DecodeUint64(); err != nil {
+						return sszutils.ErrorWithPathf(err, "Body.Attestations[%d].Data.Index", idx1)
+					} else {
+						val24.Index = val
+					}
+					// Field #2 'BeaconBlockRoot' (static)
+					if _, err = dec.DecodeBytes(val24.BeaconBlockRoot[:32]); err != nil {
+						return err
+					}
+					{ // Field #3 'Source' (static)
+						val25 := val24.Source
+						if val25 == nil {
+							val25 = new(Checkpoint)
+						}
+						maxOffset := uint32(dec.GetLength())
+						if maxOffset < uint32(40) {
+							return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(40)), "Body.Attestations[%d].Data.Source", idx1)
+						}
+						// Field #0 'Epoch' (static)
+						if val, err := dec.DecodeUint64(); err != nil {
+							return sszutils.ErrorWithPathf(err, "Body.Attestations[%d].Data.Source.Epoch", idx1)
+						} else {
+							val25.Epoch = Epoch(val)
+						}
+						// Field #1 'Root' (static)
+						if _, err = dec.DecodeBytes(val25.Root[:32]); err != nil {
+							return err
+						}
+						val24.Source = val25
+					}
+					{ // Field #4 'Target' (static)
+						val26 := val24.Target
+						if val26 == nil {
+							val26 = new(Checkpoint)
+						}
+						maxOffset := uint32(dec.GetLength())
+						if maxOffset < uint32(40) {
+							return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(40)), "Body.Attestations[%d].Data.Target", idx1)
+						}
+						// Field #0 'Epoch' (static)
+						if val, err := dec.DecodeUint64(); err != nil {
+							return sszutils.ErrorWithPathf(err, "Body.Attestations[%d].Data.Target.Epoch", idx1)
+						} else {
+							val26.Epoch = Epoch(val)
+						}
+						// Field #1 'Root' (static)
+						if _, err = dec.DecodeBytes(val26.Root[:32]); err != nil {
+							return err
+						}
+						val24.Target = val26
+					}
+					val23.Data = val24
+				}
+				// Field #2 'Signature' (static)
+				if _, err = dec.DecodeBytes(val23.Signature[:96]); err != nil {
+					return err
+				}
+				// Field #3 'CommitteeBits' (static)
+				val23.CommitteeBits = sszutils.ExpandSlice(val23.CommitteeBits, int(expr5))
+				if _, err = dec.DecodeBytes(val23.CommitteeBits[:int(expr5)]); err != nil {
+					return err
+				}
+				{ // Field #0 'AggregationBits' (dynamic)
+					if dec.GetPosition() != startPos8+int(offset0) {
+						return sszutils.ErrorWithPathf(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos8+int(offset0)), "Body.Attestations[%d].AggregationBits", idx1)
+					}
+					dec.PushLimit(int(maxOffset - offset0))
+					val27 := val23.AggregationBits
+					blen := dec.GetLength()
+					val27 = sszutils.ExpandSlice(val27, blen)
+					if _, err = dec.DecodeBytes(val27[:blen]); err != nil {
+						return sszutils.ErrorWithPathf(err, "Body.Attestations[%d].AggregationBits", idx1)
+					}
+					if blen == 0 || val27[blen-1] == 0x00 {
+						return sszutils.ErrorWithPathf(sszutils.ErrBitlistNotTerminatedFn(), "Body.Attestations[%d].AggregationBits", idx1)
+					}
+					bitCount := 8*(blen-1) + int(bits.Len8(val27[blen-1])) - 1
+					if bitCount > int(expr3) {
+						return sszutils.ErrorWithPathf(sszutils.ErrBitlistLengthFn(bitCount, int(expr3)), "Body.Attestations[%d].AggregationBits", idx1)
+					}
+					if diff := dec.PopLimit(); diff != 0 {
+						return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(diff), "Body.Attestations[%d].AggregationBits", idx1)
+					}
+					val23.AggregationBits = val27
+				}
+				if diff := dec.PopLimit(); diff != 0 {
+					return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(diff), "Body.Attestations[%d]", idx1)
+				}
+				val22[idx1] = val23
+			}
+			if diff := dec.PopLimit(); diff != 0 {
+				return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "Body.Attestations")
+			}
+			val1.Attestations = val22
+		}
+		{ // Field #6 'Deposits' (dynamic)
+			if dec.GetPosition() != startPos1+int(offset6) {
+				return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos1+int(offset6)), "Body.Deposits")
+			}
+			dec.PushLimit(int(offset7 - offset6))
+			val28 := val1.Deposits
+			sszLen := dec.GetLength()
+			itemCount := sszLen / 1240
+			if sszLen%1240 != 0 {
+				return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(sszLen, 1240), "Body.Deposits")
+			}
+			if itemCount > int(expr6) {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr6)), "Body.Deposits")
+			}
+			val28 = sszutils.ExpandSlice(val28, itemCount)
+			startPos9 := dec.GetPosition()
+			for idx1 := range itemCount {
+				val29 := val28[idx1]
+				if val29 == nil {
+					val29 = new(Deposit)
+				}
+				maxOffset := uint32(dec.GetLength())
+				if maxOffset < uint32(1240) {
+					return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(1240)), "Body.Deposits[%d]", idx1)
+				}
+				{ // Field #0 'Proof' (static)
+					val30 := val29.Proof
+					val30 = sszutils.ExpandSlice(val30, 33)
+					startPos10 := dec.GetPosition()
+					for idx2 := range 33 {
+						val30[idx2] = sszutils.ExpandSlice(val30[idx2], 32)
+						if _, err = dec.DecodeBytes(val30[idx2][:32]); err != nil {
+							return err
+						}
+						if dec.GetPosition() != startPos10+int(32*(idx2+1)) {
+							return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos10+int(32*(idx2+1))), "Body.Deposits[%d].Proof[%d]", idx1, idx2)
+						}
+					}
+					val29.Proof = val30
+				}
+				{ // Field #1 'Data' (static)
+					val31 := val29.Data
+					if val31 == nil {
+						val31 = new(DepositData)
+					}
+					maxOffset := uint32(dec.GetLength())
+					if maxOffset < uint32(184) {
+						return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(184)), "Body.Deposits[%d].Data", idx1)
+					}
+					// Field #0 'Pubkey' (static)
+					if _, err = dec.DecodeBytes(val31.Pubkey[:48]); err != nil {
+						return err
+					}
+					// Field #1 'WithdrawalCredentials' (static)
+					if _, err = dec.DecodeBytes(val31.WithdrawalCredentials[:32]); err != nil {
+						return err
+					}
+					// Field #2 'Amount' (static)
+					if val, err := dec.DecodeUint64(); err != nil {
+						return sszutils.ErrorWithPathf(err, "Body.Deposits[%d].Data.Amount", idx1)
+					} else {
+						val31.Amount = Gwei(val)
+					}
+					// Field #3 'Signature' (static)
+					if _, err = dec.DecodeBytes(val31.Signature[:96]); err != nil {
+						return err
+					}
+					val29.Data = val31
+				}
+				if dec.GetPosition() != startPos9+int(1240*(idx1+1)) {
+					return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos9+int(1240*(idx1+1))), "Body.Deposits[%d]", idx1)
+				}
+				val28[idx1] = val29
+			}
+			if diff := dec.PopLimit(); diff != 0 {
+				return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "Body.Deposits")
+			}
+			val1.Deposits = val28
+		}
+		{ // Field #7 'VoluntaryExits' (dynamic)
+			if dec.GetPosition() != startPos1+int(offset7) {
+				return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos1+int(offset7)), "Body.VoluntaryExits")
+			}
+			dec.PushLimit(int(offset9 - offset7))
+			val32 := val1.VoluntaryExits
+			sszLen := dec.GetLength()
+			itemCount := sszLen / 112
+			if sszLen%112 != 0 {
+				return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(sszLen, 112), "Body.VoluntaryExits")
+			}
+			if itemCount > int(expr7) {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr7)), "Body.VoluntaryExits")
+			}
+			val32 = sszutils.ExpandSlice(val32, itemCount)
+			startPos11 := dec.GetPosition()
+			for idx1 := range itemCount {
+				val33 := val32[idx1]
+				if val33 == nil {
+					val33 = new(SignedVoluntaryExit)
+				}
+				maxOffset := uint32(dec.GetLength())
+				if maxOffset < uint32(112) {
+					return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(112)), "Body.VoluntaryExits[%d]", idx1)
+				}
+				{ // Field #0 'Message' (static)
+					val34 := val33.Message
+					if val34 == nil {
+						val34 = new(VoluntaryExit)
+					}
+					maxOffset := uint32(dec.GetLength())
+					if maxOffset < uint32(16) {
+						return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(16)), "Body.VoluntaryExits[%d].Message", idx1)
+					}
+					// Field #0 'Epoch' (static)
+					if val, err := dec.DecodeUint64(); err != nil {
+						return sszutils.ErrorWithPathf(err, "Body.VoluntaryExits[%d].Message.Epoch", idx1)
+					} else {
+						val34.Epoch = Epoch(val)
+					}
+					// Field #1 'ValidatorIndex' (static)
+					if val, err := dec.DecodeUint64(); err != nil {
+						return sszutils.ErrorWithPathf(err, "Body.VoluntaryExits[%d].Message.ValidatorIndex", idx1)
+					} else {
+						val34.ValidatorIndex = ValidatorIndex(val)
+					}
+					val33.Message = val34
+				}
+				// Field #1 'Signature' (static)
+				if _, err = dec.DecodeBytes(val33.Signature[:96]); err != nil {
+					return err
+				}
+				if dec.GetPosition() != startPos11+int(112*(idx1+1)) {
+					return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos11+int(112*(idx1+1))), "Body.VoluntaryExits[%d]", idx1)
+				}
+				val32[idx1] = val33
+			}
+			if diff := dec.PopLimit(); diff != 0 {
+				return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "Body.VoluntaryExits")
+			}
+			val1.VoluntaryExits = val32
+		}
+		{ // Field #9 'ExecutionPayload' (dynamic)
+			if dec.GetPosition() != startPos1+int(offset9) {
+				return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos1+int(offset9)), "Body.ExecutionPayload")
+			}
+			dec.PushLimit(int(offset10 - offset9))
+			val35 := val1.ExecutionPayload
+			if val35 == nil {
+				val35 = new(ExecutionPayload)
+			}
+			maxOffset := uint32(dec.GetLength())
+			startPos12 := dec.GetPosition()
+			if maxOffset < uint32(528) {
+				return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(528)), "Body.ExecutionPayload")
+			}
+			// Field #0 'ParentHash' (static)
+			if _, err = dec.DecodeBytes(val35.ParentHash[:32]); err != nil {
+				return err
+			}
+			// Field #1 'FeeRecipient' (static)
+			if _, err = dec.DecodeBytes(val35.FeeRecipient[:20]); err != nil {
+				return err
+			}
+			// Field #2 'StateRoot' (static)
+			if _, err = dec.DecodeBytes(val35.StateRoot[:32]); err != nil {
+				return err
+			}
+			// Field #3 'ReceiptsRoot' (static)
+			if _, err = dec.DecodeBytes(val35.ReceiptsRoot[:32]); err != nil {
+				return err
+			}
+			// Field #4 'LogsBloom' (static)
+			if _, err = dec.DecodeBytes(val35.LogsBloom[:256]); err != nil {
+				return err
+			}
+			// Field #5 'PrevRandao' (static)
+			if _, err = dec.DecodeBytes(val35.PrevRandao[:32]); err != nil {
+				return err
+			}
+			// Field #6 'BlockNumber' (static)
+			if val, err := dec.DecodeUint64(); err != nil {
+				return sszutils.ErrorWithPath(err, "Body.ExecutionPayload.BlockNumber")
+			} else {
+				val35.BlockNumber = val
+			}
+			// Field #7 'GasLimit' (static)
+			if val, err := dec.DecodeUint64(); err != nil {
+				return sszutils.ErrorWithPath(err, "Body.ExecutionPayload.GasLimit")
+			} else {
+				val35.GasLimit = val
+			}
+			// Field #8 'GasUsed' (static)
+			if val, err := dec.DecodeUint64(); err != nil {
+				return sszutils.ErrorWithPath(err, "Body.ExecutionPayload.GasUsed")
+			} else {
+				val35.GasUsed = val
+			}
+			// Field #9 'Timestamp' (static)
+			if val, err := dec.DecodeUint64(); err != nil {
+				return sszutils.ErrorWithPath(err, "Body.ExecutionPayload.Timestamp")
+			} else {
+				val35.Timestamp = val
+			}
+			// Field #10 'ExtraData' (offset)
+			offset10, err := dec.DecodeOffset()
+			if err != nil {
+				return sszutils.ErrorWithPath(err, "Body.ExecutionPayload.ExtraData")
+			}
+			if offset10 != uint32(528) {
+				return sszutils.ErrorWithPath(sszutils.ErrFirstOffsetMismatchFn(offset10, 528), "Body.ExecutionPayload.ExtraData")
+			}
+			// Field #11 'BaseFeePerGas' (static)
+			if _, err = dec.DecodeBytes(val35.BaseFeePerGas[:32]); err != nil {
+				return err
+			}
+			// Field #12 'BlockHash' (static)
+			if _, err = dec.DecodeBytes(val35.BlockHash[:32]); err != nil {
+				return err
+			}
+			// Field #13 'Transactions' (offset)
+			offset13, err := dec.DecodeOffset()
+			if err != nil {
+				return sszutils.ErrorWithPath(err, "Body.ExecutionPayload.Transactions")
+			}
+			if offset13 < offset10 || offset13 > maxOffset {
+				return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset13, offset10, maxOffset), "Body.ExecutionPayload.Transactions")
+			}
+			// Field #14 'Withdrawals' (offset)
+			offset14, err := dec.DecodeOffset()
+			if err != nil {
+				return sszutils.ErrorWithPath(err, "Body.ExecutionPayload.Withdrawals")
+			}
+			if offset14 < offset13 || offset14 > maxOffset {
+				return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset14, offset13, maxOffset), "Body.ExecutionPayload.Withdrawals")
+			}
+			// Field #15 'BlobGasUsed' (static)
+			if val, err := dec.DecodeUint64(); err != nil {
+				return sszutils.ErrorWithPath(err, "Body.ExecutionPayload.BlobGasUsed")
+			} else {
+				val35.BlobGasUsed = val
+			}
+			// Field #16 'ExcessBlobGas' (static)
+			if val, err := dec.DecodeUint64(); err != nil {
+				return sszutils.ErrorWithPath(err, "Body.ExecutionPayload.ExcessBlobGas")
+			} else {
+				val35.ExcessBlobGas = val
+			}
+			{ // Field #10 'ExtraData' (dynamic)
+				if dec.GetPosition() != startPos12+int(offset10) {
+					return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos12+int(offset10)), "Body.ExecutionPayload.ExtraData")
+				}
+				dec.PushLimit(int(offset13 - offset10))
+				val36 := val35.ExtraData
+				if dec.GetLength() > int(expr8) {
+					return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(dec.GetLength(), int(expr8)), "Body.ExecutionPayload.ExtraData")
+				}
+				listLen := dec.GetLength()
+				val36 = sszutils.ExpandSlice(val36, listLen)
+				if _, err = dec.DecodeBytes(val36[:listLen]); err != nil {
+					return sszutils.ErrorWithPath(err, "Body.ExecutionPayload.ExtraData")
+				}
+				if diff := dec.PopLimit(); diff != 0 {
+					return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "Body.ExecutionPayload.ExtraData")
+				}
+				val35.ExtraData = val36
+			}
+			{ // Field #13 'Transactions' (dynamic)
+				if dec.GetPosition() != startPos12+int(offset13) {
+					return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos12+int(offset13)), "Body.ExecutionPayload.Transactions")
+				}
+				dec.PushLimit(int(offset14 - offset13))
+				val37 := val35.Transactions
+				startOffset := uint32(0)
+				startPos13 := dec.GetPosition()
+				sszLen := uint32(dec.GetLength())
+				if sszLen > 0 {
+					startOffset, err = dec.DecodeOffset()
+					if err != nil {
+						return sszutils.ErrorWithPath(err, "Body.ExecutionPayload.Transactions")
+					}
+				}
+				itemCount := int(startOffset / 4)
+				var offsets []uint32
+				if canSeek {
+					dec.SkipBytes((itemCount - 1) * 4)
+				} else if itemCount > 1 {
+					offsetSlices[0] = sszutils.ExpandSlice(offsetSlices[0], itemCount-1)
+					offsets = offsetSlices[0]
+					for idx1 := range itemCount-1 {
+						offset, err := dec.DecodeOffset()
+						if err != nil {
+							return sszutils.ErrorWithPathf(err, "Body.ExecutionPayload.Transactions[%d:o]", idx1)
+						}
+						offsets[idx1] = offset
+					}
+				}
+				if startOffset%4 != 0 || uint32(sszLen) < startOffset {
+					return sszutils.ErrorWithPath(sszutils.ErrInvalidListStartOffsetFn(startOffset, sszLen), "Body.ExecutionPayload.Transactions")
+				}
+				if itemCount > int(expr9) {
+					return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr9)), "Body.ExecutionPayload.Transactions")
+				}
+				val37 = sszutils.ExpandSlice(val37, itemCount)
+				for idx1 := range itemCount {
+					var endOffset uint32
+					if idx1 < itemCount-1 {
+						if canSeek {
+							endOffset = dec.DecodeOffsetAt(startPos13 + int((idx1+1)*4))
+						} else {
+							endOffset = offsets[idx1]
+						}
+					} else {
+						endOffset = uint32(sszLen)
+					}
+					if endOffset < startOffset || endOffset > uint32(sszLen) {
+						return sszutils.ErrorWithPathf(sszutils.ErrElementOffsetOutOfRangeFn(endOffset, startOffset, sszLen), "Body.ExecutionPayload.Transactions[%d]", idx1)
+					}
+					itemSize := endOffset - startOffset
+					dec.PushLimit(int(itemSize))
+					startOffset = endOffset
+					val38 := val37[idx1]
+					if dec.GetLength() > int(expr10) {
+						return sszutils.ErrorWithPathf(sszutils.ErrListLengthFn(dec.GetLength(), int(expr10)), "Body.ExecutionPayload.Transactions[%d]", idx1)
+					}
+					listLen := dec.GetLength()
+					val38 = sszutils.ExpandSlice(val38, listLen)
+					if _, err = dec.DecodeBytes(val38[:listLen]); err != nil {
+						return sszutils.ErrorWithPathf(err, "Body.ExecutionPayload.Transactions[%d]", idx1)
+					}
+					if diff := dec.PopLimit(); diff != 0 {
+						return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(diff), "Body.ExecutionPayload.Transactions[%d]", idx1)
+					}
+					val37[idx1] = val38
+				}
+				if diff := dec.PopLimit(); diff != 0 {
+					return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "Body.ExecutionPayload.Transactions")
+				}
+				val35.Transactions = val37
+			}
+			{ // Field #14 'Withdrawals' (dynamic)
+				if dec.GetPosition() != startPos12+int(offset14) {
+					return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos12+int(offset14)), "Body.ExecutionPayload.Withdrawals")
+				}
+				dec.PushLimit(int(maxOffset - offset14))
+				val39 := val35.Withdrawals
+				sszLen := dec.GetLength()
+				itemCount := sszLen / 44
+				if sszLen%44 != 0 {
+					return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(sszLen, 44), "Body.ExecutionPayload.Withdrawals")
+				}
+				if itemCount > int(expr11) {
+					return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr11)), "Body.ExecutionPayload.Withdrawals")
+				}
+				val39 = sszutils.ExpandSlice(val39, itemCount)
+				startPos14 := dec.GetPosition()
+				for idx1 := range itemCount {
+					val40 := val39[idx1]
+					if val40 == nil {
+						val40 = new(Withdrawal)
+					}
+					maxOffset := uint32(dec.GetLength())
+					if maxOffset < uint32(44) {
+						return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(44)), "Body.ExecutionPayload.Withdrawals[%d]", idx1)
+					}
+					// Field #0 'Index' (static)
+					if val, err := dec.DecodeUint64(); err != nil {
+						return sszutils.ErrorWithPathf(err, "Body.ExecutionPayload.Withdrawals[%d].Index", idx1)
+					} else {
+						val40.Index = WithdrawalIndex(val)
+					}
+					// Field #1 'ValidatorIndex' (static)
+					if val, err := dec.DecodeUint64(); err != nil {
+						return sszutils.ErrorWithPathf(err, "Body.ExecutionPayload.Withdrawals[%d].ValidatorIndex", idx1)
+					} else {
+						val40.ValidatorIndex = ValidatorIndex(val)
+					}
+					// Field #2 'Address' (static)
+					if _, err = dec.DecodeBytes(val40.Address[:20]); err != nil {
+						return err
+					}
+					// Field #3 'Amount' (static)
+					if val, err := dec.DecodeUint64(); err != nil {
+						return sszutils.ErrorWithPathf(err, "Body.ExecutionPayload.Withdrawals[%d].Amount", idx1)
+					} else {
+						val40.Amount = Gwei(val)
+					}
+					if dec.GetPosition() != startPos14+int(44*(idx1+1)) {
+						return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos14+int(44*(idx1+1))), "Body.ExecutionPayload.Withdrawals[%d]", idx1)
+					}
+					val39[idx1] = val40
+				}
+				if diff := dec.PopLimit(); diff != 0 {
+					return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "Body.ExecutionPayload.Withdrawals")
+				}
+				val35.Withdrawals = val39
+			}
+			if diff := dec.PopLimit(); diff != 0 {
+				return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "Body.ExecutionPayload")
+			}
+			val1.ExecutionPayload = val35
+		}
+		{ // Field #10 'BLSToExecutionChanges' (dynamic)
+			if dec.GetPosition() != startPos1+int(offset10) {
+				return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos1+int(offset10)), "Body.BLSToExecutionChanges")
+			}
+			dec.PushLimit(int(offset11 - offset10))
+			val41 := val1.BLSToExecutionChanges
+			sszLen := dec.GetLength()
+			itemCount := sszLen / 172
+			if sszLen%172 != 0 {
+				return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(sszLen, 172), "Body.BLSToExecutionChanges")
+			}
+			if itemCount > int(expr12) {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr12)), "Body.BLSToExecutionChanges")
+			}
+			val41 = sszutils.ExpandSlice(val41, itemCount)
+			startPos15 := dec.GetPosition()
+			for idx1 := range itemCount {
+				val42 := val41[idx1]
+				if val42 == nil {
+					val42 = new(SignedBLSToExecutionChange)
+				}
+				maxOffset := uint32(dec.GetLength())
+				if maxOffset < uint32(172) {
+					return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(172)), "Body.BLSToExecutionChanges[%d]", idx1)
+				}
+				{ // Field #0 'Message' (static)
+					val43 := val42.Message
+					if val43 == nil {
+						val43 = new(BLSToExecutionChange)
+					}
+					maxOffset := uint32(dec.GetLength())
+					if maxOffset < uint32(76) {
+						return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(76)), "Body.BLSToExecutionChanges[%d].Message", idx1)
+					}
+					// Field #0 'ValidatorIndex' (static)
+					if val, err := dec.DecodeUint64(); err != nil {
+						return sszutils.ErrorWithPathf(err, "Body.BLSToExecutionChanges[%d].Message.ValidatorIndex", idx1)
+					} else {
+						val43.ValidatorIndex = ValidatorIndex(val)
+					}
+					// Field #1 'FromBLSPubkey' (static)
+					if _, err = dec.DecodeBytes(val43.FromBLSPubkey[:48]); err != nil {
+						return err
+					}
+					// Field #2 'ToExecutionAddress' (static)
+					if _, err = dec.DecodeBytes(val43.ToExecutionAddress[:20]); err != nil {
+						return err
+					}
+					val42.Message = val43
+				}
+				// Field #1 'Signature' (static)
+				if _, err = dec.DecodeBytes(val42.Signature[:96]); err != nil {
+					return err
+				}
+				if dec.GetPosition() != startPos15+int(172*(idx1+1)) {
+					return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos15+int(172*(idx1+1))), "Body.BLSToExecutionChanges[%d]", idx1)
+				}
+				val41[idx1] = val42
+			}
+			if diff := dec.PopLimit(); diff != 0 {
+				return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "Body.BLSToExecutionChanges")
+			}
+			val1.BLSToExecutionChanges = val41
+		}
+		{ // Field #11 'BlobKZGCommitments' (dynamic)
+			if dec.GetPosition() != startPos1+int(offset11) {
+				return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos1+int(offset11)), "Body.BlobKZGCommitments")
+			}
+			dec.PushLimit(int(offset12 - offset11))
+			val44 := val1.BlobKZGCommitments
+			sszLen := dec.GetLength()
+			itemCount := sszLen / 48
+			if sszLen%48 != 0 {
+				return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(sszLen, 48), "Body.BlobKZGCommitments")
+			}
+			if itemCount > int(expr13) {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr13)), "Body.BlobKZGCommitments")
+			}
+			val44 = sszutils.ExpandSlice(val44, itemCount)
+			startPos16 := dec.GetPosition()
+			for idx1 := range itemCount {
+				if _, err = dec.DecodeBytes(val44[idx1][:48]); err != nil {
+					return err
+				}
+				if dec.GetPosition() != startPos16+int(48*(idx1+1)) {
+					return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos16+int(48*(idx1+1))), "Body.BlobKZGCommitments[%d]", idx1)
+				}
+			}
+			if diff := dec.PopLimit(); diff != 0 {
+				return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "Body.BlobKZGCommitments")
+			}
+			val1.BlobKZGCommitments = val44
+		}
+		{ // Field #12 'ExecutionRequests' (dynamic)
+			if dec.GetPosition() != startPos1+int(offset12) {
+				return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos1+int(offset12)), "Body.ExecutionRequests")
+			}
+			dec.PushLimit(int(maxOffset - offset12))
+			val45 := val1.ExecutionRequests
+			if val45 == nil {
+				val45 = new(ExecutionRequests)
+			}
+			maxOffset := uint32(dec.GetLength())
+			startPos17 := dec.GetPosition()
+			if maxOffset < uint32(12) {
+				return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(12)), "Body.ExecutionRequests")
+			}
+			// Field #0 'Deposits' (offset)
+			offset0, err := dec.DecodeOffset()
+			if err != nil {
+				return sszutils.ErrorWithPath(err, "Body.ExecutionRequests.Deposits")
+			}
+			if offset0 != uint32(12) {
+				return sszutils.ErrorWithPath(sszutils.ErrFirstOffsetMismatchFn(offset0, 12), "Body.ExecutionRequests.Deposits")
+			}
+			// Field #1 'Withdrawals' (offset)
+			offset1, err := dec.DecodeOffset()
+			if err != nil {
+				return sszutils.ErrorWithPath(err, "Body.ExecutionRequests.Withdrawals")
+			}
+			if offset1 < offset0 || offset1 > maxOffset {
+				return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset1, offset0, maxOffset), "Body.ExecutionRequests.Withdrawals")
+			}
+			// Field #2 'Consolidations' (offset)
+			offset2, err := dec.DecodeOffset()
+			if err != nil {
+				return sszutils.ErrorWithPath(err, "Body.ExecutionRequests.Consolidations")
+			}
+			if offset2 < offset1 || offset2 > maxOffset {
+				return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset2, offset1, maxOffset), "Body.ExecutionRequests.Consolidations")
+			}
+			{ // Field #0 'Deposits' (dynamic)
+				if dec.GetPosition() != startPos17+int(offset0) {
+					return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos17+int(offset0)), "Body.ExecutionRequests.Deposits")
+				}
+				dec.PushLimit(int(offset1 - offset0))
+				val46 := val45.Deposits
+				sszLen := dec.GetLength()
+				itemCount := sszLen / 192
+				if sszLen%192 != 0 {
+					return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(sszLen, 192), "Body.ExecutionRequests.Deposits")
+				}
+				if itemCount > int(expr14) {
+					return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr14)), "Body.ExecutionRequests.Deposits")
+				}
+				val46 = sszutils.ExpandSlice(val46, itemCount)
+				startPos18 := dec.GetPosition()
+				for idx1 := range itemCount {
+					val47 := val46[idx1]
+					if val47 == nil {
+						val47 = new(DepositRequest)
+					}
+					maxOffset := uint32(dec.GetLength())
+					if maxOffset < uint32(192) {
+						return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(192)), "Body.ExecutionRequests.Deposits[%d]", idx1)
+					}
+					// Field #0 'Pubkey' (static)
+					if _, err = dec.DecodeBytes(val47.Pubkey[:48]); err != nil {
+						return err
+					}
+					// Field #1 'WithdrawalCredentials' (static)
+					if _, err = dec.DecodeBytes(val47.WithdrawalCredentials[:32]); err != nil {
+						return err
+					}
+					// Field #2 'Amount' (static)
+					if val, err := dec.DecodeUint64(); err != nil {
+						return sszutils.ErrorWithPathf(err, "Body.ExecutionRequests.Deposits[%d].Amount", idx1)
+					} else {
+						val47.Amount = Gwei(val)
+					}
+					// Field #3 'Signature' (static)
+					if _, err = dec.DecodeBytes(val47.Signature[:96]); err != nil {
+						return err
+					}
+					// Field #4 'Index' (static)
+					if val, err := dec.DecodeUint64(); err != nil {
+						return sszutils.ErrorWithPathf(err, "Body.ExecutionRequests.Deposits[%d].Index", idx1)
+					} else {
+						val47.Index = val
+					}
+					if dec.GetPosition() != startPos18+int(192*(idx1+1)) {
+						return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos18+int(192*(idx1+1))), "Body.ExecutionRequests.Deposits[%d]", idx1)
+					}
+					val46[idx1] = val47
+				}
+				if diff := dec.PopLimit(); diff != 0 {
+					return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "Body.ExecutionRequests.Deposits")
+				}
+				val45.Deposits = val46
+			}
+			{ // Field #1 'Withdrawals' (dynamic)
+				if dec.GetPosition() != startPos17+int(offset1) {
+					return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos17+int(offset1)), "Body.ExecutionRequests.Withdrawals")
+				}
+				dec.PushLimit(int(offset2 - offset1))
+				val48 := val45.Withdrawals
+				sszLen := dec.GetLength()
+				itemCount := sszLen / 76
+				if sszLen%76 != 0 {
+					return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(sszLen, 76), "Body.ExecutionRequests.Withdrawals")
+				}
+				if itemCount > int(expr15) {
+					return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr15)), "Body.ExecutionRequests.Withdrawals")
+				}
+				val48 = sszutils.ExpandSlice(val48, itemCount)
+				startPos19 := dec.GetPosition()
+				for idx1 := range itemCount {
+					val49 := val48[idx1]
+					if val49 == nil {
+						val49 = new(WithdrawalRequest)
+					}
+					maxOffset := uint32(dec.GetLength())
+					if maxOffset < uint32(76) {
+						return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(76)), "Body.ExecutionRequests.Withdrawals[%d]", idx1)
+					}
+					// Field #0 'SourceAddress' (static)
+					if _, err = dec.DecodeBytes(val49.SourceAddress[:20]); err != nil {
+						return err
+					}
+					// Field #1 'ValidatorPubkey' (static)
+					if _, err = dec.DecodeBytes(val49.ValidatorPubkey[:48]); err != nil {
+						return err
+					}
+					// Field #2 'Amount' (static)
+					if val, err := dec.DecodeUint64(); err != nil {
+						return sszutils.ErrorWithPathf(err, "Body.ExecutionRequests.Withdrawals[%d].Amount", idx1)
+					} else {
+						val49.Amount = Gwei(val)
+					}
+					if dec.GetPosition() != startPos19+int(76*(idx1+1)) {
+						return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos19+int(76*(idx1+1))), "Body.ExecutionRequests.Withdrawals[%d]", idx1)
+					}
+					val48[idx1] = val49
+				}
+				if diff := dec.PopLimit(); diff != 0 {
+					return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "Body.ExecutionRequests.Withdrawals")
+				}
+				val45.Withdrawals = val48
+			}
+			{ // Field #2 'Consolidations' (dynamic)
+				if dec.GetPosition() != startPos17+int(offset2) {
+					return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos17+int(offset2)), "Body.ExecutionRequests.Consolidations")
+				}
+				dec.PushLimit(int(maxOffset - offset2))
+				val50 := val45.Consolidations
+				sszLen := dec.GetLength()
+				itemCount := sszLen / 116
+				if sszLen%116 != 0 {
+					return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(sszLen, 116), "Body.ExecutionRequests.Consolidations")
+				}
+				if itemCount > int(expr16) {
+					return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr16)), "Body.ExecutionRequests.Consolidations")
+				}
+				val50 = sszutils.ExpandSlice(val50, itemCount)
+				startPos20 := dec.GetPosition()
+				for idx1 := range itemCount {
+					val51 := val50[idx1]
+					if val51 == nil {
+						val51 = new(ConsolidationRequest)
+					}
+					maxOffset := uint32(dec.GetLength())
+					if maxOffset < uint32(116) {
+						return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(116)), "Body.ExecutionRequests.Consolidations[%d]", idx1)
+					}
+					// Field #0 'SourceAddress' (static)
+					if _, err = dec.DecodeBytes(val51.SourceAddress[:20]); err != nil {
+						return err
+					}
+					// Field #1 'SourcePubkey' (static)
+					if _, err = dec.DecodeBytes(val51.SourcePubkey[:48]); err != nil {
+						return err
+					}
+					// Field #2 'TargetPubkey' (static)
+					if _, err = dec.DecodeBytes(val51.TargetPubkey[:48]); err != nil {
+						return err
+					}
+					if dec.GetPosition() != startPos20+int(116*(idx1+1)) {
+						return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos20+int(116*(idx1+1))), "Body.ExecutionRequests.Consolidations[%d]", idx1)
+					}
+					val50[idx1] = val51
+				}
+				if diff := dec.PopLimit(); diff != 0 {
+					return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "Body.ExecutionRequests.Consolidations")
+				}
+				val45.Consolidations = val50
+			}
+			if diff := dec.PopLimit(); diff != 0 {
+				return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "Body.ExecutionRequests")
+			}
+			val1.ExecutionRequests = val45
+		}
+		if diff := dec.PopLimit(); diff != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "Body")
+		}
+		t.Body = val1
+	}
+	return nil
+}
+
+// SizeSSZ returns the SSZ encoded size of the *BeaconBlockElectra.
+func (t *BeaconBlockElectra) SizeSSZ() (size int) {
+	return t.SizeSSZDyn(dynssz.GetGlobalDynSsz())
+}
+
+// SizeSSZDyn returns the SSZ encoded size of the *BeaconBlockElectra using dynamic specifications.
+func (t *BeaconBlockElectra) SizeSSZDyn(ds sszutils.DynamicSpecs) (size int) {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE/8", 64)
+	if err != nil {
+		return 0
+	}
+	expr1, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_COMMITTEES_PER_SLOT/8", 8)
+	if err != nil {
+		return 0
+	}
+	if t == nil {
+		t = new(BeaconBlockElectra)
+	}
+	// Field #0 'Slot' static (8 bytes)
+	// Field #1 'ProposerIndex' static (8 bytes)
+	// Field #2 'ParentRoot' static (32 bytes)
+	// Field #3 'StateRoot' static (32 bytes)
+	// Field #4 'Body' offset (4 bytes)
+	size += 84
+	{ // Dynamic field #4 'Body'
+		t := t.Body
+		if t == nil {
+			t = new(BeaconBlockBodyElectra)
+		}
+		// Field #0 'RANDAOReveal' static (96 bytes)
+		// Field #1 'ETH1Data' static (72 bytes)
+		// Field #2 'Graffiti' static (32 bytes)
+		// Field #3 'ProposerSlashings' offset (4 bytes)
+		// Field #4 'AttesterSlashings' offset (4 bytes)
+		// Field #5 'Attestations' offset (4 bytes)
+		// Field #6 'Deposits' offset (4 bytes)
+		// Field #7 'VoluntaryExits' offset (4 bytes)
+		// Field #9 'ExecutionPayload' offset (4 bytes)
+		// Field #10 'BLSToExecutionChanges' offset (4 bytes)
+		// Field #11 'BlobKZGCommitments' offset (4 bytes)
+		// Field #12 'ExecutionRequests' offset (4 bytes)
+		size += 236
+		{ // Field #8 'SyncAggregate'
+			t := t.SyncAggregate
+			if t == nil {
+				t = new(SyncAggregate)
+			}
+			// Field #1 'SyncCommitteeSignature' static (96 bytes)
+			size += 96
+			{ // Field #0 'SyncCommitteeBits'
+				size += int(expr0)
+			}
+		}
+		{ // Dynamic field #3 'ProposerSlashings'
+			size += len(t.ProposerSlashings) * 416
+		}
+		{ // Dynamic field #4 'AttesterSlashings'
+			t := t.AttesterSlashings
+			vlen := len(t)
+			size += vlen * 4 // Offsets
+			for i1 := range vlen {
+				t := t[i1]
+				if t == nil {
+					t = new(AttesterSlashingElectra)
+				}
+				// Field #0 'Attestation1' offset (4 bytes)
+				// Field #1 'Attestation2' offset (4 bytes)
+				size += 8
+				{ // Dynamic field #0 'Attestation1'
+					t := t.Attestation1
+					if t == nil {
+						t = new(IndexedAttestationElectra)
+					}
+					// Field #0 'AttestingIndices' offset (4 bytes)
+					// Field #1 'Data' static (128 bytes)
+					// Field #2 'Signature' static (96 bytes)
+					size += 228
+					{ // Dynamic field #0 'AttestingIndices'
+						size += len(t.AttestingIndices) * 8
+					}
+				}
+				{ // Dynamic field #1 'Attestation2'
+					t := t.Attestation2
+					if t == nil {
+						t = new(IndexedAttestationElectra)
+					}
+					// Field #0 'AttestingIndices' offset (4 bytes)
+					// Field #1 'Data' static (128 bytes)
+					// Field #2 'Signature' static (96 bytes)
+					size += 228
+					{ // Dynamic field #0 'AttestingIndices'
+						size += len(t.AttestingIndices) * 8
+					}
+				}
+			}
+		}
+		{ // Dynamic field #5 'Attestations'
+			t := t.Attestations
+			vlen := len(t)
+			size += vlen * 4 // Offsets
+			for i2 := range vlen {
+				t := t[i2]
+				if t == nil {
+					t = new(AttestationElectra)
+				}
+				// Field #0 'AggregationBits' offset (4 bytes)
+				// Field #1 'Data' static (128 bytes)
+				// Field #2 'Signature' static (96 bytes)
+				size += 228
+				{ // Field #3 'CommitteeBits'
+					size += int(expr1)
+				}
+				{ // Dynamic field #0 'AggregationBits'
+					if len(t.AggregationBits) == 0 {
+						size += 1
+					} else {
+						size += len(t.AggregationBits)
+					}
+				}
+			}
+		}
+		{ // Dynamic field #6 'Deposits'
+			size += len(t.Deposits) * 1240
+		}
+		{ // Dynamic field #7 'VoluntaryExits'
+			size += len(t.VoluntaryExits) * 112
+		}
+		{ // Dynamic field #9 'ExecutionPayload'
+			t := t.ExecutionPayload
+			if t == nil {
+				t = new(ExecutionPayload)
+			}
+			// Field #0 'ParentHash' static (32 bytes)
+			// Field #1 'FeeRecipient' static (20 bytes)
+			// Field #2 'StateRoot' static (32 bytes)
+			// Field #3 'ReceiptsRoot' static (32 bytes)
+			// Field #4 'LogsBloom' static (256 bytes)
+			// Field #5 'PrevRandao' static (32 bytes)
+			// Field #6 'BlockNumber' static (8 bytes)
+			// Field #7 'GasLimit' static (8 bytes)
+			// Field #8 'GasUsed' static (8 bytes)
+			// Field #9 'Timestamp' static (8 bytes)
+			// Field #10 'ExtraData' offset (4 bytes)
+			// Field #11 'BaseFeePerGas' static (32 bytes)
+			// Field #12 'BlockHash' static (32 bytes)
+			// Field #13 'Transactions' offset (4 bytes)
+			// Field #14 'Withdrawals' offset (4 bytes)
+			// Field #15 'BlobGasUsed' static (8 bytes)
+			// Field #16 'ExcessBlobGas' static (8 bytes)
+			size += 528
+			{ // Dynamic field #10 'ExtraData'
+				size += len(t.ExtraData)
+			}
+			{ // Dynamic field #13 'Transactions'
+				t := t.Transactions
+				vlen := len(t)
+				size += vlen * 4 // Offsets
+				for i3 := range vlen {
+					size += len(t[i3])
+				}
+			}
+			{ // Dynamic field #14 'Withdrawals'
+				size += len(t.Withdrawals) * 44
+			}
+		}
+		{ // Dynamic field #10 'BLSToExecutionChanges'
+			size += len(t.BLSToExecutionChanges) * 172
+		}
+		{ // Dynamic field #11 'BlobKZGCommitments'
+			size += len(t.BlobKZGCommitments) * 48
+		}
+		{ // Dynamic field #12 'ExecutionRequests'
+			t := t.ExecutionRequests
+			if t == nil {
+				t = new(ExecutionRequests)
+			}
+			// Field #0 'Deposits' offset (4 bytes)
+			// Field #1 'Withdrawals' offset (4 bytes)
+			// Field #2 'Consolidations' offset (4 bytes)
+			size += 12
+			{ // Dynamic field #0 'Deposits'
+				size += len(t.Deposits) * 192
+			}
+			{ // Dynamic field #1 'Withdrawals'
+				size += len(t.Withdrawals) * 76
+			}
+			{ // Dynamic field #2 'Consolidations'
+				size += len(t.Consolidations) * 116
+			}
+		}
+	}
+	return size
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the *BeaconBlockElectra.
+func (t *BeaconBlockElectra) HashTreeRoot() (root [32]byte, err error) {
+	err = hasher.WithDefaultHasher(func(hh sszutils.HashWalker) (err error) {
+		err = t.HashTreeRootWith(hh)
+		if err == nil {
+			root, err = hh.HashRoot()
+		}
+		return
+	})
+	return
+}
+
+// HashTreeRootWith computes the SSZ hash tree root of the *BeaconBlockElectra using the given hash walker.
+func (t *BeaconBlockElectra) HashTreeRootWith(hh sszutils.HashWalker) error {
+	return t.HashTreeRootWithDyn(dynssz.GetGlobalDynSsz(), hh)
+}
+
+// HashTreeRootDyn computes the SSZ hash tree root of the *BeaconBlockElectra using dynamic specifications.
+func (t *BeaconBlockElectra) HashTreeRootDyn(ds sszutils.DynamicSpecs) (root [32]byte, err error) {
+	err = hasher.WithDefaultHasher(func(hh sszutils.HashWalker) (err error) {
+		err = t.HashTreeRootWithDyn(ds, hh)
+		if err == nil {
+			root, err = hh.HashRoot()
+		}
+		return
+	})
+	return
+}
+
+// HashTreeRootWithDyn computes the SSZ hash tree root of the *BeaconBlockElectra using dynamic specifications and the given hash walker.
+func (t *BeaconBlockElectra) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.HashWalker) error {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_PROPOSER_SLASHINGS", 16)
+	if err != nil {
+		return err
+	}
+	expr1, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_ATTESTER_SLASHINGS_ELECTRA", 1)
+	if err != nil {
+		return err
+	}
+	expr2, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_VALIDATORS_PER_COMMITTEE*MAX_COMMITTEES_PER_SLOT", 131072)
+	if err != nil {
+		return err
+	}
+	expr3, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_ATTESTATIONS_ELECTRA", 8)
+	if err != nil {
+		return err
+	}
+	expr4, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_COMMITTEES_PER_SLOT/8", 8)
+	if err != nil {
+		return err
+	}
+	expr5, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_DEPOSITS", 16)
+	if err != nil {
+		return err
+	}
+	expr6, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_VOLUNTARY_EXITS", 16)
+	if err != nil {
+		return err
+	}
+	expr7, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE/8", 64)
+	if err != nil {
+		return err
+	}
+	expr8, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_EXTRA_DATA_BYTES", 32)
+	if err != nil {
+		return err
+	}
+	expr9, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_TRANSACTIONS_PER_PAYLOAD", 1048576)
+	if err != nil {
+		return err
+	}
+	expr10, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_BYTES_PER_TRANSACTION", 1073741824)
+	if err != nil {
+		return err
+	}
+	expr11, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_WITHDRAWALS_PER_PAYLOAD", 16)
+	if err != nil {
+		return err
+	}
+	expr12, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_BLS_TO_EXECUTION_CHANGES", 16)
+	if err != nil {
+		return err
+	}
+	expr13, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_BLOB_COMMITMENTS_PER_BLOCK", 4096)
+	if err != nil {
+		return err
+	}
+	expr14, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_DEPOSIT_REQUESTS_PER_PAYLOAD", 8192)
+	if err != nil {
+		return err
+	}
+	expr15, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_WITHDRAWAL_REQUESTS_PER_PAYLOAD", 16)
+	if err != nil {
+		return err
+	}
+	expr16, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_CONSOLIDATION_REQUESTS_PER_PAYLOAD", 2)
+	if err != nil {
+		return err
+	}
+	if t == nil {
+		t = new(BeaconBlockElectra)
+	}
+	idx := hh.StartTree(sszutils.TreeTypeNone)
+	{ // Field #0 'Slot'
+		hh.PutUint64(uint64(t.Slot))
+	}
+	{ // Field #1 'ProposerIndex'
+		hh.PutUint64(uint64(t.ProposerIndex))
+	}
+	{ // Field #2 'ParentRoot'
+		hh.PutBytes(t.ParentRoot[:32])
+	}
+	{ // Field #3 'StateRoot'
+		hh.PutBytes(t.StateRoot[:32])
+	}
+	{ // Field #4 'Body'
+		t := t.Body
+		if t == nil {
+			t = new(BeaconBlockBodyElectra)
+		}
+		idx := hh.StartTree(sszutils.TreeTypeNone)
+		{ // Field #0 'RANDAOReveal'
+			hh.PutBytes(t.RANDAOReveal[:96])
+		}
+		{ // Field #1 'ETH1Data'
+			t := t.ETH1Data
+			if t == nil {
+				t = new(ETH1Data)
+			}
+			idx := hh.StartTree(sszutils.TreeTypeNone)
+			{ // Field #0 'DepositRoot'
+				hh.PutBytes(t.DepositRoot[:32])
+			}
+			{ // Field #1 'DepositCount'
+				hh.PutUint64(t.DepositCount)
+			}
+			{ // Field #2 'BlockHash'
+				hh.PutBytes(t.BlockHash[:32])
+			}
+			hh.Merkleize(idx)
+		}
+		{ // Field #2 'Graffiti'
+			hh.PutBytes(t.Graffiti[:32])
+		}
+		{ // Field #3 'ProposerSlashings'
+			t := t.ProposerSlashings
+			vlen := uint64(len(t))
+			if vlen > expr0 {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, expr0), "Body.ProposerSlashings")
+			}
+			idx := hh.StartTree(sszutils.TreeTypeBinary)
+			for idx1 := range int(vlen) {
+				t := t[idx1]
+				if t == nil {
+					t = new(ProposerSlashing)
+				}
+				idx := hh.StartTree(sszutils.TreeTypeNone)
+				{ // Field #0 'SignedHeader1'
+					t := t.SignedHeader1
+					if t == nil {
+						t = new(SignedBeaconBlockHeader)
+					}
+					idx := hh.StartTree(sszutils.TreeTypeNone)
+					{ // Field #0 'Message'
+						t := t.Message
+						if t == nil {
+							t = new(BeaconBlockHeader)
+						}
+						idx := hh.StartTree(sszutils.TreeTypeNone)
+						{ // Field #0 'Slot'
+							hh.PutUint64(uint64(t.Slot))
+						}
+						{ // Field #1 'ProposerIndex'
+							hh.PutUint64(uint64(t.ProposerIndex))
+						}
+						{ // Field #2 'ParentRoot'
+							hh.PutBytes(t.ParentRoot[:32])
+						}
+						{ // Field #3 'StateRoot'
+							hh.PutBytes(t.StateRoot[:32])
+						}
+						{ // Field #4 'BodyRoot'
+							hh.PutBytes(t.BodyRoot[:32])
+						}
+						hh.Merkleize(idx)
+					}
+					{ // Field #1 'Signature'
+						hh.PutBytes(t.Signature[:96])
+					}
+					hh.Merkleize(idx)
+				}
+				{ // Field #1 'SignedHeader2'
+					t := t.SignedHeader2
+					if t == nil {
+						t = new(SignedBeaconBlockHeader)
+					}
+					idx := hh.StartTree(sszutils.TreeTypeNone)
+					{ // Field #0 'Message'
+						t := t.Message
+						if t == nil {
+							t = new(BeaconBlockHeader)
+						}
+						idx := hh.StartTree(sszutils.TreeTypeNone)
+						{ // Field #0 'Slot'
+							hh.PutUint64(uint64(t.Slot))
+						}
+						{ // Field #1 'ProposerIndex'
+							hh.PutUint64(uint64(t.ProposerIndex))
+						}
+						{ // Field #2 'ParentRoot'
+							hh.PutBytes(t.ParentRoot[:32])
+						}
+						{ // Field #3 'StateRoot'
+							hh.PutBytes(t.StateRoot[:32])
+						}
+						{ // Field #4 'BodyRoot'
+							hh.PutBytes(t.BodyRoot[:32])
+						}
+						hh.Merkleize(idx)
+					}
+					{ // Field #1 'Signature'
+						hh.PutBytes(t.Signature[:96])
+					}
+					hh.Merkleize(idx)
+				}
+				hh.Merkleize(idx)
+				if (idx1+1)%256 == 0 {
+					hh.Collapse()
+				}
+			}
+			hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr0, vlen, 32))
+		}
+		{ // Field #4 'AttesterSlashings'
+			t := t.AttesterSlashings
+			vlen := uint64(len(t))
+			if vlen > expr1 {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, expr1), "Body.AttesterSlashings")
+			}
+			idx := hh.StartTree(sszutils.TreeTypeBinary)
+			for idx1 := range int(vlen) {
+				t := t[idx1]
+				if t == nil {
+					t = new(AttesterSlashingElectra)
+				}
+				idx := hh.StartTree(sszutils.TreeTypeNone)
+				{ // Field #0 'Attestation1'
+					t := t.Attestation1
+					if t == nil {
+						t = new(IndexedAttestationElectra)
+					}
+					idx := hh.StartTree(sszutils.TreeTypeNone)
+					{ // Field #0 'AttestingIndices'
+						t := t.AttestingIndices
+						vlen := uint64(len(t))
+						if vlen > expr2 {
+							return sszutils.ErrorWithPathf(sszutils.ErrListLengthFn(vlen, expr2), "Body.AttesterSlashings[%d].Attestation1.AttestingIndices", idx1)
+						}
+						idx := hh.StartTree(sszutils.TreeTypeBinary)
+						sszutils.HashUint64Slice(hh, t)
+						hh.FillUpTo32()
+						hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr2, vlen, 8))
+					}
+					{ // Field #1 'Data'
+						t := t.Data
+						if t == nil {
+							t = new(AttestationData)
+						}
+						idx := hh.StartTree(sszutils.TreeTypeNone)
+						{ // Field #0 'Slot'
+							hh.PutUint64(uint64(t.Slot))
+						}
+						{ // Field #1 'Index'
+							hh.PutUint64(t.Index)
+						}
+						{ // Field #2 'BeaconBlockRoot'
+							hh.PutBytes(t.BeaconBlockRoot[:32])
+						}
+						{ // Field #3 'Source'
+							t := t.Source
+							if t == nil {
+								t = new(Checkpoint)
+							}
+							idx := hh.StartTree(sszutils.TreeTypeNone)
+							{ // Field #0 'Epoch'
+								hh.PutUint64(uint64(t.Epoch))
+							}
+							{ // Field #1 'Root'
+								hh.PutBytes(t.Root[:32])
+							}
+							hh.Merkleize(idx)
+						}
+						{ // Field #4 'Target'
+							t := t.Target
+							if t == nil {
+								t = new(Checkpoint)
+							}
+							idx := hh.StartTree(sszutils.TreeTypeNone)
+							{ // Field #0 'Epoch'
+								hh.PutUint64(uint64(t.Epoch))
+							}
+							{ // Field #1 'Root'
+								hh.PutBytes(t.Root[:32])
+							}
+							hh.Merkleize(idx)
+						}
+						hh.Merkleize(idx)
+					}
+					{ // Field #2 'Signature'
+						hh.PutBytes(t.Signature[:96])
+					}
+					hh.Merkleize(idx)
+				}
+				{ // Field #1 'Attestation2'
+					t := t.Attestation2
+					if t == nil {
+						t = new(IndexedAttestationElectra)
+					}
+					idx := hh.StartTree(sszutils.TreeTypeNone)
+					{ // Field #0 'AttestingIndices'
+						t := t.AttestingIndices
+						vlen := uint64(len(t))
+						if vlen > expr2 {
+							return sszutils.ErrorWithPathf(sszutils.ErrListLengthFn(vlen, expr2), "Body.AttesterSlashings[%d].Attestation2.AttestingIndices", idx1)
+						}
+						idx := hh.StartTree(sszutils.TreeTypeBinary)
+						sszutils.HashUint64Slice(hh, t)
+						hh.FillUpTo32()
+						hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr2, vlen, 8))
+					}
+					{ // Field #1 'Data'
+						t := t.Data
+						if t == nil {
+							t = new(AttestationData)
+						}
+						idx := hh.StartTree(sszutils.TreeTypeNone)
+						{ // Field #0 'Slot'
+							hh.PutUint64(uint64(t.Slot))
+						}
+						{ // Field #1 'Index'
+							hh.PutUint64(t.Index)
+						}
+						{ // Field #2 'BeaconBlockRoot'
+							hh.PutBytes(t.BeaconBlockRoot[:32])
+						}
+						{ // Field #3 'Source'
+							t := t.Source
+							if t == nil {
+								t = new(Checkpoint)
+							}
+							idx := hh.StartTree(sszutils.TreeTypeNone)
+							{ // Field #0 'Epoch'
+								hh.PutUint64(uint64(t.Epoch))
+							}
+							{ // Field #1 'Root'
+								hh.PutBytes(t.Root[:32])
+							}
+							hh.Merkleize(idx)
+						}
+						{ // Field #4 'Target'
+							t := t.Target
+							if t == nil {
+								t = new(Checkpoint)
+							}
+							idx := hh.StartTree(sszutils.TreeTypeNone)
+							{ // Field #0 'Epoch'
+								hh.PutUint64(uint64(t.Epoch))
+							}
+							{ // Field #1 'Root'
+								hh.PutBytes(t.Root[:32])
+							}
+							hh.Merkleize(idx)
+						}
+						hh.Merkleize(idx)
+					}
+					{ // Field #2 'Signature'
+						hh.PutBytes(t.Signature[:96])
+					}
+					hh.Merkleize(idx)
+				}
+				hh.Merkleize(idx)
+				if (idx1+1)%256 == 0 {
+					hh.Collapse()
+				}
+			}
+			hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr1, vlen, 32))
+		}
+		{ // Field #5 'Attestations'
+			t := t.Attestations
+			vlen := uint64(len(t))
+			if vlen > expr3 {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, expr3), "Body.Attestations")
+			}
+			idx := hh.StartTree(sszutils.TreeTypeBinary)
+			for idx1 := range int(vlen) {
+				t := t[idx1]
+				if t == nil {
+					t = new(AttestationElectra)
+				}
+				idx := hh.StartTree(sszutils.TreeTypeNone)
+				{ // Field #0 'AggregationBits'
+					t := t.AggregationBits
+					if l := len(t); l > 0 && t[l-1] == 0x00 {
+						return sszutils.ErrorWithPathf(sszutils.ErrBitlistNotTerminatedFn(), "Body.Attestations[%d].AggregationBits", idx1)
+					}
+					idx := hh.StartTree(sszutils.TreeTypeNone)
+					bitlist, size := hasher.ParseBitlistWithHasher(hh, t[:])
+					if size > expr2 {
+						return sszutils.ErrorWithPathf(sszutils.ErrBitlistLengthFn(size, expr2), "Body.Attestations[%d].AggregationBits", idx1)
+					}
+					hh.AppendBytes32(bitlist)
+					hh.MerkleizeWithMixin(idx, size, sszutils.CalculateBitlistLimit(expr2))
+				}
+				{ // Field #1 'Data'
+					t := t.Data
+					if t == nil {
+						t = new(AttestationData)
+					}
+					idx := hh.StartTree(sszutils.TreeTypeNone)
+					{ // Field #0 'Slot'
+						hh.PutUint64(uint64(t.Slot))
+					}
+					{ // Field #1 'Index'
+						hh.PutUint64(t.Index)
+					}
+					{ // Field #2 'BeaconBlockRoot'
+						hh.PutBytes(t.BeaconBlockRoot[:32])
+					}
+					{ // Field #3 'Source'
+						t := t.Source
+						if t == nil {
+							t = new(Checkpoint)
+						}
+						idx := hh.StartTree(sszutils.TreeTypeNone)
+						{ // Field #0 'Epoch'
+							hh.PutUint64(uint64(t.Epoch))
+						}
+						{ // Field #1 'Root'
+							hh.PutBytes(t.Root[:32])
+						}
+						hh.Merkleize(idx)
+					}
+					{ // Field #4 'Target'
+						t := t.Target
+						if t == nil {
+							t = new(Checkpoint)
+						}
+						idx := hh.StartTree(sszutils.TreeTypeNone)
+						{ // Field #0 'Epoch'
+							hh.PutUint64(uint64(t.Epoch))
+						}
+						{ // Field #1 'Root'
+							hh.PutBytes(t.Root[:32])
+						}
+						hh.Merkleize(idx)
+					}
+					hh.Merkleize(idx)
+				}
+				{ // Field #2 'Signature'
+					hh.PutBytes(t.Signature[:96])
+				}
+				{ // Field #3 'CommitteeBits'
+					vlen := len(t.CommitteeBits)
+					if vlen > int(expr4) {
+						return sszutils.ErrorWithPathf(sszutils.ErrVectorLengthFn(vlen, int(expr4)), "Body.Attestations[%d].CommitteeBits", idx1)
+					}
+					val := t.CommitteeBits[:]
+					if vlen < int(expr4) {
+						val = sszutils.AppendZeroPadding(val, (int(expr4)-vlen)*1)
+					}
+					hh.PutBytes(val[:int(expr4)])
+				}
+				hh.Merkleize(idx)
+				if (idx1+1)%256 == 0 {
+					hh.Collapse()
+				}
+			}
+			hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr3, vlen, 32))
+		}
+		{ // Field #6 'Deposits'
+			t := t.Deposits
+			vlen := uint64(len(t))
+			if vlen > expr5 {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, expr5), "Body.Deposits")
+			}
+			idx := hh.StartTree(sszutils.TreeTypeBinary)
+			for idx1 := range int(vlen) {
+				t := t[idx1]
+				if t == nil {
+					t = new(Deposit)
+				}
+				idx := hh.StartTree(sszutils.TreeTypeNone)
+				{ // Field #0 'Proof'
+					t := t.Proof
+					vlen := len(t)
+					if vlen > 33 {
+						return sszutils.ErrorWithPathf(sszutils.ErrVectorLengthFn(vlen, 33), "Body.Deposits[%d].Proof", idx1)
+					}
+					idx := hh.StartTree(sszutils.TreeTypeBinary)
+					var val1, val1Empty []byte
+					for idx2 := range 33 {
+						if idx2 < vlen {
+							val1 = t[idx2]
+						} else if idx2 == vlen {
+							val1 = val1Empty
+						}
+						vlen := len(val1)
+						if vlen > 32 {
+							return sszutils.ErrorWithPathf(sszutils.ErrVectorLengthFn(vlen, 32), "Body.Deposits[%d].Proof[%d]", idx1, idx2)
+						}
+						val := val1[:]
+						if vlen < 32 {
+							val = sszutils.AppendZeroPadding(val, (32-vlen)*1)
+						}
+						hh.PutBytes(val[:32])
+						if (idx2+1)%256 == 0 {
+							hh.Collapse()
+						}
+					}
+					hh.Merkleize(idx)
+				}
+				{ // Field #1 'Data'
+					t := t.Data
+					if t == nil {
+						t = new(DepositData)
+					}
+					idx := hh.StartTree(sszutils.TreeTypeNone)
+					{ // Field #0 'Pubkey'
+						hh.PutBytes(t.Pubkey[:48])
+					}
+					{ // Field #1 'WithdrawalCredentials'
+						hh.PutBytes(t.WithdrawalCredentials[:32])
+					}
+					{ // Field #2 'Amount'
+						hh.PutUint64(uint64(t.Amount))
+					}
+					{ // Field #3 'Signature'
+						hh.PutBytes(t.Signature[:96])
+					}
+					hh.Merkleize(idx)
+				}
+				hh.Merkleize(idx)
+				if (idx1+1)%256 == 0 {
+					hh.Collapse()
+				}
+			}
+			hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr5, vlen, 32))
+		}
+		{ // Field #7 'VoluntaryExits'
+			t := t.VoluntaryExits
+			vlen := uint64(len(t))
+			if vlen > expr6 {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, expr6), "Body.VoluntaryExits")
+			}
+			idx := hh.StartTree(sszutils.TreeTypeBinary)
+			for idx1 := range int(vlen) {
+				t := t[idx1]
+				if t == nil {
+					t = new(SignedVoluntaryExit)
+				}
+				idx := hh.StartTree(sszutils.TreeTypeNone)
+				{ // Field #0 'Message'
+					t := t.Message
+					if t == nil {
+						t = new(VoluntaryExit)
+					}
+					idx := hh.StartTree(sszutils.TreeTypeNone)
+					{ // Field #0 'Epoch'
+						hh.PutUint64(uint64(t.Epoch))
+					}
+					{ // Field #1 'ValidatorIndex'
+						hh.PutUint64(uint64(t.ValidatorIndex))
+					}
+					hh.Merkleize(idx)
+				}
+				{ // Field #1 'Signature'
+					hh.PutBytes(t.Signature[:96])
+				}
+				hh.Merkleize(idx)
+				if (idx1+1)%256 == 0 {
+					hh.Collapse()
+				}
+			}
+			hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr6, vlen, 32))
+		}
+		{ // Field #8 'SyncAggregate'
+			t := t.SyncAggregate
+			if t == nil {
+				t = new(SyncAggregate)
+			}
+			idx := hh.StartTree(sszutils.TreeTypeNone)
+			{ // Field #0 'SyncCommitteeBits'
+				vlen := len(t.SyncCommitteeBits)
+				if vlen > int(expr7) {
+					return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr7)), "Body.SyncAggregate.SyncCommitteeBits")
+				}
+				val := t.SyncCommitteeBits[:]
+				if vlen < int(expr7) {
+					val = sszutils.AppendZeroPadding(val, (int(expr7)-vlen)*1)
+				}
+				hh.PutBytes(val[:int(expr7)])
+			}
+			{ // Field #1 'SyncCommitteeSignature'
+				hh.PutBytes(t.SyncCommitteeSignature[:96])
+			}
+			hh.Merkleize(idx)
+		}
+		{ // Field #9 'ExecutionPayload'
+			t := t.ExecutionPayload
+			if t == nil {
+				t = new(ExecutionPayload)
+			}
+			idx := hh.StartTree(sszutils.TreeTypeNone)
+			{ // Field #0 'ParentHash'
+				hh.PutBytes(t.ParentHash[:32])
+			}
+			{ // Field #1 'FeeRecipient'
+				hh.PutBytes(t.FeeRecipient[:20])
+			}
+			{ // Field #2 'StateRoot'
+				hh.PutBytes(t.StateRoot[:32])
+			}
+			{ // Field #3 'ReceiptsRoot'
+				hh.PutBytes(t.ReceiptsRoot[:32])
+			}
+			{ // Field #4 'LogsBloom'
+				hh.PutBytes(t.LogsBloom[:256])
+			}
+			{ // Field #5 'PrevRandao'
+				hh.PutBytes(t.PrevRandao[:32])
+			}
+			{ // Field #6 'BlockNumber'
+				hh.PutUint64(t.BlockNumber)
+			}
+			{ // Field #7 'GasLimit'
+				hh.PutUint64(t.GasLimit)
+			}
+			{ // Field #8 'GasUsed'
+				hh.PutUint64(t.GasUsed)
+			}
+			{ // Field #9 'Timestamp'
+				hh.PutUint64(t.Timestamp)
+			}
+			{ // Field #10 'ExtraData'
+				vlen := uint64(len(t.ExtraData))
+				if vlen > expr8 {
+					return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, expr8), "Body.ExecutionPayload.ExtraData")
+				}
+				idx := hh.StartTree(sszutils.TreeTypeBinary)
+				hh.AppendBytes32(t.ExtraData[:])
+				hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr8, vlen, 1))
+			}
+			{ // Field #11 'BaseFeePerGas'
+				hh.PutBytes(t.BaseFeePerGas[:32])
+			}
+			{ // Field #12 'BlockHash'
+				hh.PutBytes(t.BlockHash[:32])
+			}
+			{ // Field #13 'Transactions'
+				t := t.Transactions
+				vlen := uint64(len(t))
+				if vlen > expr9 {
+					return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, expr9), "Body.ExecutionPayload.Transactions")
+				}
+				idx := hh.StartTree(sszutils.TreeTypeBinary)
+				for idx1 := range int(vlen) {
+					vlen := uint64(len(t[idx1]))
+					if vlen > expr10 {
+						return sszutils.ErrorWithPathf(sszutils.ErrListLengthFn(vlen, expr10), "Body.ExecutionPayload.Transactions[%d]", idx1)
+					}
+					idx := hh.StartTree(sszutils.TreeTypeBinary)
+					hh.AppendBytes32(t[idx1][:])
+					hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr10, vlen, 1))
+					if (idx1+1)%256 == 0 {
+						hh.Collapse()
+					}
+				}
+				hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr9, vlen, 32))
+			}
+			{ // Field #14 'Withdrawals'
+				t := t.Withdrawals
+				vlen := uint64(len(t))
+				if vlen > expr11 {
+					return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, expr11), "Body.ExecutionPayload.Withdrawals")
+				}
+				idx := hh.StartTree(sszutils.TreeTypeBinary)
+				for idx1 := range int(vlen) {
+					t := t[idx1]
+					if t == nil {
+						t = new(Withdrawal)
+					}
+					idx := hh.StartTree(sszutils.TreeTypeNone)
+					{ // Field #0 'Index'
+						hh.PutUint64(uint64(t.Index))
+					}
+					{ // Field #1 'ValidatorIndex'
+						hh.PutUint64(uint64(t.ValidatorIndex))
+					}
+					{ // Field #2 'Address'
+						hh.PutBytes(t.Address[:20])
+					}
+					{ // Field #3 'Amount'
+						hh.PutUint64(uint64(t.Amount))
+					}
+					hh.Merkleize(idx)
+					if (idx1+1)%256 == 0 {
+						hh.Collapse()
+					}
+				}
+				hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr11, vlen, 32))
+			}
+			{ // Field #15 'BlobGasUsed'
+				hh.PutUint64(t.BlobGasUsed)
+			}
+			{ // Field #16 'ExcessBlobGas'
+				hh.PutUint64(t.ExcessBlobGas)
+			}
+			hh.Merkleize(idx)
+		}
+		{ // Field #10 'BLSToExecutionChanges'
+			t := t.BLSToExecutionChanges
+			vlen := uint64(len(t))
+			if vlen > expr12 {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, expr12), "Body.BLSToExecutionChanges")
+			}
+			idx := hh.StartTree(sszutils.TreeTypeBinary)
+			for idx1 := range int(vlen) {
+				t := t[idx1]
+				if t == nil {
+					t = new(SignedBLSToExecutionChange)
+				}
+				idx := hh.StartTree(sszutils.TreeTypeNone)
+				{ // Field #0 'Message'
+					t := t.Message
+					if t == nil {
+						t = new(BLSToExecutionChange)
+					}
+					idx := hh.StartTree(sszutils.TreeTypeNone)
+					{ // Field #0 'ValidatorIndex'
+						hh.PutUint64(uint64(t.ValidatorIndex))
+					}
+					{ // Field #1 'FromBLSPubkey'
+						hh.PutBytes(t.FromBLSPubkey[:48])
+					}
+					{ // Field #2 'ToExecutionAddress'
+						hh.PutBytes(t.ToExecutionAddress[:20])
+					}
+					hh.Merkleize(idx)
+				}
+				{ // Field #1 'Signature'
+					hh.PutBytes(t.Signature[:96])
+				}
+				hh.Merkleize(idx)
+				if (idx1+1)%256 == 0 {
+					hh.Collapse()
+				}
+			}
+			hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr12, vlen, 32))
+		}
+		{ // Field #11 'BlobKZGCommitments'
+			t := t.BlobKZGCommitments
+			vlen := uint64(len(t))
+			if vlen > expr13 {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, expr13), "Body.BlobKZGCommitments")
+			}
+			idx := hh.StartTree(sszutils.TreeTypeBinary)
+			for idx1 := range int(vlen) {
+				hh.PutBytes(t[idx1][:48])
+				if (idx1+1)%256 == 0 {
+					hh.Collapse()
+				}
+			}
+			hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr13, vlen, 32))
+		}
+		{ // Field #12 'ExecutionRequests'
+			t := t.ExecutionRequests
+			if t == nil {
+				t = new(ExecutionRequests)
+			}
+			idx := hh.StartTree(sszutils.TreeTypeNone)
+			{ // Field #0 'Deposits'
+				t := t.Deposits
+				vlen := uint64(len(t))
+				if vlen > expr14 {
+					return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, expr14), "Body.ExecutionRequests.Deposits")
+				}
+				idx := hh.StartTree(sszutils.TreeTypeBinary)
+				for idx1 := range int(vlen) {
+					t := t[idx1]
+					if t == nil {
+						t = new(DepositRequest)
+					}
+					idx := hh.StartTree(sszutils.TreeTypeNone)
+					{ // Field #0 'Pubkey'
+						hh.PutBytes(t.Pubkey[:48])
+					}
+					{ // Field #1 'WithdrawalCredentials'
+						hh.PutBytes(t.WithdrawalCredentials[:32])
+					}
+					{ // Field #2 'Amount'
+						hh.PutUint64(uint64(t.Amount))
+					}
+					{ // Field #3 'Signature'
+						hh.PutBytes(t.Signature[:96])
+					}
+					{ // Field #4 'Index'
+						hh.PutUint64(t.Index)
+					}
+					hh.Merkleize(idx)
+					if (idx1+1)%256 == 0 {
+						hh.Collapse()
+					}
+				}
+				hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr14, vlen, 32))
+			}
+			{ // Field #1 'Withdrawals'
+				t := t.Withdrawals
+				vlen := uint64(len(t))
+				if vlen > expr15 {
+					return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, expr15), "Body.ExecutionRequests.Withdrawals")
+				}
+				idx := hh.StartTree(sszutils.TreeTypeBinary)
+				for idx1 := range int(vlen) {
+					t := t[idx1]
+					if t == nil {
+						t = new(WithdrawalRequest)
+					}
+					idx := hh.StartTree(sszutils.TreeTypeNone)
+					{ // Field #0 'SourceAddress'
+						hh.PutBytes(t.SourceAddress[:20])
+					}
+					{ // Field #1 'ValidatorPubkey'
+						hh.PutBytes(t.ValidatorPubkey[:48])
+					}
+					{ // Field #2 'Amount'
+						hh.PutUint64(uint64(t.Amount))
+					}
+					hh.Merkleize(idx)
+					if (idx1+1)%256 == 0 {
+						hh.Collapse()
+					}
+				}
+				hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr15, vlen, 32))
+			}
+			{ // Field #2 'Consolidations'
+				t := t.Consolidations
+				vlen := uint64(len(t))
+				if vlen > expr16 {
+					return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, expr16), "Body.ExecutionRequests.Consolidations")
+				}
+				idx := hh.StartTree(sszutils.TreeTypeBinary)
+				for idx1 := range int(vlen) {
+					t := t[idx1]
+					if t == nil {
+						t = new(ConsolidationRequest)
+					}
+					idx := hh.StartTree(sszutils.TreeTypeNone)
+					{ // Field #0 'SourceAddress'
+						hh.PutBytes(t.SourceAddress[:20])
+					}
+					{ // Field #1 'SourcePubkey'
+						hh.PutBytes(t.SourcePubkey[:48])
+					}
+					{ // Field #2 'TargetPubkey'
+						hh.PutBytes(t.TargetPubkey[:48])
+					}
+					hh.Merkleize(idx)
+					if (idx1+1)%256 == 0 {
+						hh.Collapse()
+					}
+				}
+				hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr16, vlen, 32))
+			}
+			hh.Merkleize(idx)
+		}
+		hh.Merkleize(idx)
+	}
+	hh.Merkleize(idx)
+	return nil
+}
+
+// MarshalSSZ marshals the *BeaconStateElectra to SSZ-encoded bytes.
+func (t *BeaconStateElectra) MarshalSSZ() ([]byte, error) {
+	return dynssz.GetGlobalDynSsz().MarshalSSZ(t)
+}
+
+// MarshalSSZTo marshals the *BeaconStateElectra to SSZ-encoded bytes, appending to the provided buffer.
+func (t *BeaconStateElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	return t.MarshalSSZDyn(dynssz.GetGlobalDynSsz(), buf)
+}
+
+// MarshalSSZDyn marshals the *BeaconStateElectra to SSZ-encoded bytes using dynamic specifications.
+func (t *BeaconStateElectra) MarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (dst []byte, err error) {
+	dst = buf
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SLOTS_PER_HISTORICAL_ROOT", 8192)
+	if err != nil {
+		return dst, err
+	}
+	expr1, err := sszutils.ResolveSpecValueWithDefault(ds, "EPOCHS_PER_HISTORICAL_VECTOR", 65536)
+	if err != nil {
+		return dst, err
+	}
+	expr2, err := sszutils.ResolveSpecValueWithDefault(ds, "EPOCHS_PER_SLASHINGS_VECTOR", 8192)
+	if err != nil {
+		return dst, err
+	}
+	expr3, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE", 512)
+	if err != nil {
+		return dst, err
+	}
+	expr4, err := sszutils.ResolveSpecValueWithDefault(ds, "HISTORICAL_ROOTS_LIMIT", 16777216)
+	if err != nil {
+		return dst, err
+	}
+	expr5, err := sszutils.ResolveSpecValueWithDefault(ds, "EPOCHS_PER_ETH1_VOTING_PERIOD*SLOTS_PER_EPOCH", 2048)
+	if err != nil {
+		return dst, err
+	}
+	expr6, err := sszutils.ResolveSpecValueWithDefault(ds, "VALIDATOR_REGISTRY_LIMIT", 1099511627776)
+	if err != nil {
+		return dst, err
+	}
+	expr7, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_EXTRA_DATA_BYTES", 32)
+	if err != nil {
+		return dst, err
+	}
+	expr8, err := sszutils.ResolveSpecValueWithDefault(ds, "PENDING_DEPOSITS_LIMIT", 134217728)
+	if err != nil {
+		return dst, err
+	}
+	expr9, err := sszutils.ResolveSpecValueWithDefault(ds, "PENDING_PARTIAL_WITHDRAWALS_LIMIT", 134217728)
+	if err != nil {
+		return dst, err
+	}
+	expr10, err := sszutils.ResolveSpecValueWithDefault(ds, "PENDING_CONSOLIDATIONS_LIMIT", 262144)
+	if err != nil {
+		return dst, err
+	}
+	zeroBytes := sszutils.ZeroBytes()
+	if t == nil {
+		t = new(BeaconStateElectra)
+	}
+	dstlen := len(dst)
+	{ // Static Field #0 'GenesisTime'
+		dst = binary.LittleEndian.AppendUint64(dst, t.GenesisTime)
+	}
+	{ // Static Field #1 'GenesisValidatorsRoot'
+		dst = append(dst, t.GenesisValidatorsRoot[:32]...)
+	}
+	{ // Static Field #2 'Slot'
+		dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Slot))
+	}
+	{ // Static Field #3 'Fork'
+		t := t.Fork
+		if t == nil {
+			t = new(Fork)
+		}
+		{ // Static Field #0 'PreviousVersion'
+			dst = append(dst, t.PreviousVersion[:4]...)
+		}
+		{ // Static Field #1 'CurrentVersion'
+			dst = append(dst, t.CurrentVersion[:4]...)
+		}
+		{ // Static Field #2 'Epoch'
+			dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Epoch))
+		}
+	}
+	{ // Static Field #4 'LatestBlockHeader'
+		t := t.LatestBlockHeader
+		if t == nil {
+			t = new(BeaconBlockHeader)
+		}
+		{ // Static Field #0 'Slot'
+			dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Slot))
+		}
+		{ // Static Field #1 'ProposerIndex'
+			dst = binary.LittleEndian.AppendUint64(dst, uint64(t.ProposerIndex))
+		}
+		{ // Static Field #2 'ParentRoot'
+			dst = append(dst, t.ParentRoot[:32]...)
+		}
+		{ // Static Field #3 'StateRoot'
+			dst = append(dst, t.StateRoot[:32]...)
+		}
+		{ // Static Field #4 'BodyRoot'
+			dst = append(dst, t.BodyRoot[:32]...)
+		}
+	}
+	{ // Static Field #5 'BlockRoots'
+		t := t.BlockRoots
+		vlen := len(t)
+		if vlen > int(expr0) {
+			return nil, sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr0)), "BlockRoots")
+		}
+		dst = sszutils.MarshalFixedBytesSlice(dst, t[:vlen])
+		if vlen < int(expr0) {
+			dst = sszutils.AppendZeroPadding(dst, (int(expr0)-vlen)*32)
+		}
+	}
+	{ // Static Field #6 'StateRoots'
+		t := t.StateRoots
+		vlen := len(t)
+		if vlen > int(expr0) {
+			return nil, sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr0)), "StateRoots")
+		}
+		dst = sszutils.MarshalFixedBytesSlice(dst, t[:vlen])
+		if vlen < int(expr0) {
+			dst = sszutils.AppendZeroPadding(dst, (int(expr0)-vlen)*32)
+		}
+	}
+	// Offset Field #7 'HistoricalRoots'
+	offset7 := len(dst)
+	dst = append(dst, 0, 0, 0, 0)
+	{ // Static Field #8 'ETH1Data'
+		t := t.ETH1Data
+		if t == nil {
+			t = new(ETH1Data)
+		}
+		{ // Static Field #0 'DepositRoot'
+			dst = append(dst, t.DepositRoot[:32]...)
+		}
+		{ // Static Field #1 'DepositCount'
+			dst = binary.LittleEndian.AppendUint64(dst, t.DepositCount)
+		}
+		{ // Static Field #2 'BlockHash'
+			dst = append(dst, t.BlockHash[:32]...)
+		}
+	}
+	// Offset Field #9 'ETH1DataVotes'
+	dst = append(dst, 0, 0, 0, 0)
+	{ // Static Field #10 'ETH1DepositIndex'
+		dst = binary.LittleEndian.AppendUint64(dst, t.ETH1DepositIndex)
+	}
+	// Offset Field #11 'Validators'
+	// Offset Field #12 'Balances'
+	dst = append(dst, 0, 0, 0, 0, 0, 0, 0, 0)
+	{ // Static Field #13 'RANDAOMixes'
+		t := t.RANDAOMixes
+		vlen := len(t)
+		if vlen > int(expr1) {
+			return nil, sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr1)), "RANDAOMixes")
+		}
+		dst = sszutils.MarshalFixedBytesSlice(dst, t[:vlen])
+		if vlen < int(expr1) {
+			dst = sszutils.AppendZeroPadding(dst, (int(expr1)-vlen)*32)
+		}
+	}
+	{ // Static Field #14 'Slashings'
+		t := t.Slashings
+		vlen := len(t)
+		if vlen > int(expr2) {
+			return nil, sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr2)), "Slashings")
+		}
+		dst = sszutils.MarshalUint64Slice(dst, t[:vlen])
+		if vlen < int(expr2) {
+			dst = sszutils.AppendZeroPadding(dst, (int(expr2)-vlen)*8)
+		}
+	}
+	// Offset Field #15 'PreviousEpochParticipation'
+	offset15 := len(dst)
+	// Offset Field #16 'CurrentEpochParticipation'
+	dst = append(dst, 0, 0, 0, 0, 0, 0, 0, 0)
+	{ // Static Field #17 'JustificationBits'
+		vlen := len(t.JustificationBits)
+		if vlen > 1 {
+			return nil, sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, 1), "JustificationBits")
+		}
+		dst = append(dst, t.JustificationBits[:vlen]...)
+		if vlen < 1 {
+			dst = sszutils.AppendZeroPadding(dst, (1-vlen)*1)
+		}
+	}
+	{ // Static Field #18 'PreviousJustifiedCheckpoint'
+		t := t.PreviousJustifiedCheckpoint
+		if t == nil {
+			t = new(Checkpoint)
+		}
+		{ // Static Field #0 'Epoch'
+			dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Epoch))
+		}
+		{ // Static Field #1 'Root'
+			dst = append(dst, t.Root[:32]...)
+		}
+	}
+	{ // Static Field #19 'CurrentJustifiedCheckpoint'
+		t := t.CurrentJustifiedCheckpoint
+		if t == nil {
+			t = new(Checkpoint)
+		}
+		{ // Static Field #0 'Epoch'
+			dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Epoch))
+		}
+		{ // Static Field #1 'Root'
+			dst = append(dst, t.Root[:32]...)
+		}
+	}
+	{ // Static Field #20 'FinalizedCheckpoint'
+		t := t.FinalizedCheckpoint
+		if t == nil {
+			t = new(Checkpoint)
+		}
+		{ // Static Field #0 'Epoch'
+			dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Epoch))
+		}
+		{ // Static Field #1 'Root'
+			dst = append(dst, t.Root[:32]...)
+		}
+	}
+	// Offset Field #21 'InactivityScores'
+	dst = append(dst, 0, 0, 0, 0)
+	{ // Static Field #22 'CurrentSyncCommittee'
+		t := t.CurrentSyncCommittee
+		if t == nil {
+			t = new(SyncCommittee)
+		}
+		{ // Static Field #0 'Pubkeys'
+			t := t.Pubkeys
+			vlen := len(t)
+			if vlen > int(expr3) {
+				return nil, sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr3)), "CurrentSyncCommittee.Pubkeys")
+			}
+			dst = sszutils.MarshalFixedBytesSlice(dst, t[:vlen])
+			if vlen < int(expr3) {
+				dst = sszutils.AppendZeroPadding(dst, (int(expr3)-vlen)*48)
+			}
+		}
+		{ // Static Field #1 'AggregatePubkey'
+			dst = append(dst, t.AggregatePubkey[:48]...)
+		}
+	}
+	{ // Static Field #23 'NextSyncCommittee'
+		t := t.NextSyncCommittee
+		if t == nil {
+			t = new(SyncCommittee)
+		}
+		{ // Static Field #0 'Pubkeys'
+			t := t.Pubkeys
+			vlen := len(t)
+			if vlen > int(expr3) {
+				return nil, sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr3)), "NextSyncCommittee.Pubkeys")
+			}
+			dst = sszutils.MarshalFixedBytesSlice(dst, t[:vlen])
+			if vlen < int(expr3) {
+				dst = sszutils.AppendZeroPadding(dst, (int(expr3)-vlen)*48)
+			}
+		}
+		{ // Static Field #1 'AggregatePubkey'
+			dst = append(dst, t.AggregatePubkey[:48]...)
+		}
+	}
+	// Offset Field #24 'LatestExecutionPayloadHeader'
+	offset24 := len(dst)
+	dst = append(dst, 0, 0, 0, 0)
+	{ // Static Field #25 'NextWithdrawalIndex'
+		dst = binary.LittleEndian.AppendUint64(dst, uint64(t.NextWithdrawalIndex))
+	}
+	{ // Static Field #26 'NextWithdrawalValidatorIndex'
+		dst = binary.LittleEndian.AppendUint64(dst, uint64(t.NextWithdrawalValidatorIndex))
+	}
+	// Offset Field #27 'HistoricalSummaries'
+	dst = append(dst, 0, 0, 0, 0)
+	{ // Static Field #28 'DepositRequestsStartIndex'
+		dst = binary.LittleEndian.AppendUint64(dst, t.DepositRequestsStartIndex)
+	}
+	{ // Static Field #29 'DepositBalanceToConsume'
+		dst = binary.LittleEndian.AppendUint64(dst, uint64(t.DepositBalanceToConsume))
+	}
+	{ // Static Field #30 'ExitBalanceToConsume'
+		dst = binary.LittleEndian.AppendUint64(dst, uint64(t.ExitBalanceToConsume))
+	}
+	{ // Static Field #31 'EarliestExitEpoch'
+		dst = binary.LittleEndian.AppendUint64(dst, uint64(t.EarliestExitEpoch))
+	}
+	{ // Static Field #32 'ConsolidationBalanceToConsume'
+		dst = binary.LittleEndian.AppendUint64(dst, uint64(t.ConsolidationBalanceToConsume))
+	}
+	{ // Static Field #33 'EarliestConsolidationEpoch'
+		dst = binary.LittleEndian.AppendUint64(dst, uint64(t.EarliestConsolidationEpoch))
+	}
+	// Offset Field #34 'PendingDeposits'
+	// Offset Field #35 'PendingPartialWithdrawals'
+	// Offset Field #36 'PendingConsolidations'
+	dst = append(dst, zeroBytes[:12]...)
+	{ // Dynamic Field #7 'HistoricalRoots'
+		binary.LittleEndian.PutUint32(dst[offset7:], uint32(len(dst)-dstlen))
+		t := t.HistoricalRoots
+		vlen := len(t)
+		if vlen > int(expr4) {
+			return nil, sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(expr4)), "HistoricalRoots")
+		}
+		dst = sszutils.MarshalFixedBytesSlice(dst, t[:vlen])
+	}
+	{ // Dynamic Field #9 'ETH1DataVotes'
+		binary.LittleEndian.PutUint32(dst[offset7+76:], uint32(len(dst)-dstlen))
+		t := t.ETH1DataVotes
+		vlen := len(t)
+		if vlen > int(expr5) {
+			return nil, sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(expr5)), "ETH1DataVotes")
+		}
+		for idx1 := range vlen {
+			t := t[idx1]
+			if t == nil {
+				t = new(ETH1Data)
+			}
+			{ // Static Field #0 'DepositRoot'
+				dst = append(dst, t.DepositRoot[:32]...)
+			}
+			{ // Static Field #1 'DepositCount'
+				dst = binary.LittleEndian.AppendUint64(dst, t.DepositCount)
+			}
+			{ // Static Field #2 'BlockHash'
+				dst = append(dst, t.BlockHash[:32]...)
+			}
+		}
+	}
+	{ // Dynamic Field #11 'Validators'
+		binary.LittleEndian.PutUint32(dst[offset7+88:], uint32(len(dst)-dstlen))
+		t := t.Validators
+		vlen := len(t)
+		if vlen > int(expr6) {
+			return nil, sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(expr6)), "Validators")
+		}
+		for idx1 := range vlen {
+			t := t[idx1]
+			if t == nil {
+				t = new(Validator)
+			}
+			{ // Static Field #0 'Pubkey'
+				dst = append(dst, t.Pubkey[:48]...)
+			}
+			{ // Static Field #1 'WithdrawalCredentials'
+				dst = append(dst, t.WithdrawalCredentials[:32]...)
+			}
+			{ // Static Field #2 'EffectiveBalance'
+				dst = binary.LittleEndian.AppendUint64(dst, uint64(t.EffectiveBalance))
+			}
+			{ // Static Field #3 'Slashed'
+				dst = sszutils.MarshalBool(dst, t.Slashed)
+			}
+			{ // Static Field #4 'ActivationEligibilityEpoch'
+				dst = binary.LittleEndian.AppendUint64(dst, uint64(t.ActivationEligibilityEpoch))
+			}
+			{ // Static Field #5 'ActivationEpoch'
+				dst = binary.LittleEndian.AppendUint64(dst, uint64(t.ActivationEpoch))
+			}
+			{ // Static Field #6 'ExitEpoch'
+				dst = binary.LittleEndian.AppendUint64(dst, uint64(t.ExitEpoch))
+			}
+			{ // Static Field #7 'WithdrawableEpoch'
+				dst = binary.LittleEndian.AppendUint64(dst, uint64(t.WithdrawableEpoch))
+			}
+		}
+	}
+	{ // Dynamic Field #12 'Balances'
+		binary.LittleEndian.PutUint32(dst[offset7+92:], uint32(len(dst)-dstlen))
+		t := t.Balances
+		vlen := len(t)
+		if vlen > int(expr6) {
+			return nil, sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(expr6)), "Balances")
+		}
+		dst = sszutils.MarshalUint64Slice(dst, t[:vlen])
+	}
+	{ // Dynamic Field #15 'PreviousEpochParticipation'
+		binary.LittleEndian.PutUint32(dst[offset15:], uint32(len(dst)-dstlen))
+		vlen := len(t.PreviousEpochParticipation)
+		if vlen > int(expr6) {
+			return nil, sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(expr6)), "PreviousEpochParticipation")
+		}
+		dst = append(dst, t.PreviousEpochParticipation[:]...)
+	}
+	{ // Dynamic Field #16 'CurrentEpochParticipation'
+		binary.LittleEndian.PutUint32(dst[offset15+4:], uint32(len(dst)-dstlen))
+		vlen := len(t.CurrentEpochParticipation)
+		if vlen > int(expr6) {
+			return nil, sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(expr6)), "CurrentEpochParticipation")
+		}
+		dst = append(dst, t.CurrentEpochParticipation[:]...)
+	}
+	{ // Dynamic Field #21 'InactivityScores'
+		binary.LittleEndian.PutUint32(dst[offset15+129:], uint32(len(dst)-dstlen))
+		t := t.InactivityScores
+		vlen := len(t)
+		if vlen > int(expr6) {
+			return nil, sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(expr6)), "InactivityScores")
+		}
+		dst = sszutils.MarshalUint64Slice(dst, t[:vlen])
+	}
+	{ // Dynamic Field #24 'LatestExecutionPayloadHeader'
+		binary.LittleEndian.PutUint32(dst[offset24:], uint32(len(dst)-dstlen))
+		t := t.LatestExecutionPayloadHeader
+		if t == nil {
+			t = new(ExecutionPayloadHeader)
+		}
+		dstlen := len(dst)
+		{ // Static Field #0 'ParentHash'
+			dst = append(dst, t.ParentHash[:32]...)
+		}
+		{ // Static Field #1 'FeeRecipient'
+			dst = append(dst, t.FeeRecipient[:20]...)
+		}
+		{ // Static Field #2 'StateRoot'
+			dst = append(dst, t.StateRoot[:32]...)
+		}
+		{ // Static Field #3 'ReceiptsRoot'
+			dst = append(dst, t.ReceiptsRoot[:32]...)
+		}
+		{ // Static Field #4 'LogsBloom'
+			dst = append(dst, t.LogsBloom[:256]...)
+		}
+		{ // Static Field #5 'PrevRandao'
+			dst = append(dst, t.PrevRandao[:32]...)
+		}
+		{ // Static Field #6 'BlockNumber'
+			dst = binary.LittleEndian.AppendUint64(dst, t.BlockNumber)
+		}
+		{ // Static Field #7 'GasLimit'
+			dst = binary.LittleEndian.AppendUint64(dst, t.GasLimit)
+		}
+		{ // Static Field #8 'GasUsed'
+			dst = binary.LittleEndian.AppendUint64(dst, t.GasUsed)
+		}
+		{ // Static Field #9 'Timestamp'
+			dst = binary.LittleEndian.AppendUint64(dst, t.Timestamp)
+		}
+		// Offset Field #10 'ExtraData'
+		dst = append(dst, 0, 0, 0, 0)
+		{ // Static Field #11 'BaseFeePerGas'
+			dst = append(dst, t.BaseFeePerGas[:32]...)
+		}
+		{ // Static Field #12 'BlockHash'
+			dst = append(dst, t.BlockHash[:32]...)
+		}
+		{ // Static Field #13 'TransactionsRoot'
+			dst = append(dst, t.TransactionsRoot[:32]...)
+		}
+		{ // Static Field #14 'WithdrawalsRoot'
+			dst = append(dst, t.WithdrawalsRoot[:32]...)
+		}
+		{ // Static Field #15 'BlobGasUsed'
+			dst = binary.LittleEndian.AppendUint64(dst, t.BlobGasUsed)
+		}
+		{ // Static Field #16 'ExcessBlobGas'
+			dst = binary.LittleEndian.AppendUint64(dst, t.ExcessBlobGas)
+		}
+		{ // Dynamic Field #10 'ExtraData'
+			binary.LittleEndian.PutUint32(dst[dstlen+436:], uint32(len(dst)-dstlen))
+			vlen := len(t.ExtraData)
+			if vlen > int(expr7) {
+				return nil, sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(expr7)), "LatestExecutionPayloadHeader.ExtraData")
+			}
+			dst = append(dst, t.ExtraData[:]...)
+		}
+	}
+	{ // Dynamic Field #27 'HistoricalSummaries'
+		binary.LittleEndian.PutUint32(dst[offset24+20:], uint32(len(dst)-dstlen))
+		t := t.HistoricalSummaries
+		vlen := len(t)
+		if vlen > int(expr4) {
+			return nil, sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(expr4)), "HistoricalSummaries")
+		}
+		for idx1 := range vlen {
+			t := t[idx1]
+			if t == nil {
+				t = new(HistoricalSummary)
+			}
+			{ // Static Field #0 'BlockSummaryRoot'
+				dst = append(dst, t.BlockSummaryRoot[:32]...)
+			}
+			{ // Static Field #1 'StateSummaryRoot'
+				dst = append(dst, t.StateSummaryRoot[:32]...)
+			}
+		}
+	}
+	{ // Dynamic Field #34 'PendingDeposits'
+		binary.LittleEndian.PutUint32(dst[offset24+72:], uint32(len(dst)-dstlen))
+		t := t.PendingDeposits
+		vlen := len(t)
+		if vlen > int(expr8) {
+			return nil, sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(expr8)), "PendingDeposits")
+		}
+		for idx1 := range vlen {
+			t := t[idx1]
+			if t == nil {
+				t = new(PendingDeposit)
+			}
+			{ // Static Field #0 'Pubkey'
+				dst = append(dst, t.Pubkey[:48]...)
+			}
+			{ // Static Field #1 'WithdrawalCredentials'
+				dst = append(dst, t.WithdrawalCredentials[:32]...)
+			}
+			{ // Static Field #2 'Amount'
+				dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Amount))
+			}
+			{ // Static Field #3 'Signature'
+				dst = append(dst, t.Signature[:96]...)
+			}
+			{ // Static Field #4 'Slot'
+				dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Slot))
+			}
+		}
+	}
+	{ // Dynamic Field #35 'PendingPartialWithdrawals'
+		binary.LittleEndian.PutUint32(dst[offset24+76:], uint32(len(dst)-dstlen))
+		t := t.PendingPartialWithdrawals
+		vlen := len(t)
+		if vlen > int(expr9) {
+			return nil, sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(expr9)), "PendingPartialWithdrawals")
+		}
+		for idx1 := range vlen {
+			t := t[idx1]
+			if t == nil {
+				t = new(PendingPartialWithdrawal)
+			}
+			{ // Static Field #0 'ValidatorIndex'
+				dst = binary.LittleEndian.AppendUint64(dst, uint64(t.ValidatorIndex))
+			}
+			{ // Static Field #1 'Amount'
+				dst = binary.LittleEndian.AppendUint64(dst, uint64(t.Amount))
+			}
+			{ // Static Field #2 'WithdrawableEpoch'
+				dst = binary.LittleEndian.AppendUint64(dst, uint64(t.WithdrawableEpoch))
+			}
+		}
+	}
+	{ // Dynamic Field #36 'PendingConsolidations'
+		binary.LittleEndian.PutUint32(dst[offset24+80:], uint32(len(dst)-dstlen))
+		t := t.PendingConsolidations
+		vlen := len(t)
+		if vlen > int(expr10) {
+			return nil, sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(expr10)), "PendingConsolidations")
+		}
+		for idx1 := range vlen {
+			t := t[idx1]
+			if t == nil {
+				t = new(PendingConsolidation)
+			}
+			{ // Static Field #0 'SourceIndex'
+				dst = binary.LittleEndian.AppendUint64(dst, uint64(t.SourceIndex))
+			}
+			{ // Static Field #1 'TargetIndex'
+				dst = binary.LittleEndian.AppendUint64(dst, uint64(t.TargetIndex))
+			}
+		}
+	}
+	return dst, nil
+}
+
+// MarshalSSZEncoder marshals the *BeaconStateElectra to the given SSZ encoder using dynamic specifications.
+func (t *BeaconStateElectra) MarshalSSZEncoder(ds sszutils.DynamicSpecs, enc sszutils.Encoder) (err error) {
+	type encoderCtx struct {
+		ds       sszutils.DynamicSpecs
+		exprs    [11]uint64
+		sizeFn1  func(ctx *encoderCtx, t []Root) (size int)
+		sizeFn10 func(ctx *encoderCtx, t []*PendingDeposit) (size int)
+		sizeFn11 func(ctx *encoderCtx, t []*PendingPartialWithdrawal) (size int)
+		sizeFn12 func(ctx *encoderCtx, t []*PendingConsolidation) (size int)
+		sizeFn13 func(ctx *encoderCtx, t []byte) (size int)
+		sizeFn2  func(ctx *encoderCtx, t []*ETH1Data) (size int)
+		sizeFn3  func(ctx *encoderCtx, t []*Validator) (size int)
+		sizeFn4  func(ctx *encoderCtx, t []Gwei) (size int)
+		sizeFn5  func(ctx *encoderCtx, t []ParticipationFlags) (size int)
+		sizeFn6  func(ctx *encoderCtx, t []ParticipationFlags) (size int)
+		sizeFn7  func(ctx *encoderCtx, t []uint64) (size int)
+		sizeFn8  func(ctx *encoderCtx, t *ExecutionPayloadHeader) (size int)
+		sizeFn9  func(ctx *encoderCtx, t []*HistoricalSummary) (size int)
+	}
+	ctx := &encoderCtx{ds: ds}
+	canSeek := enc.Seekable()
+	ctx.exprs[0], err = sszutils.ResolveSpecValueWithDefault(ds, "SLOTS_PER_HISTORICAL_ROOT", 8192)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[1], err = sszutils.ResolveSpecValueWithDefault(ds, "EPOCHS_PER_HISTORICAL_VECTOR", 65536)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[2], err = sszutils.ResolveSpecValueWithDefault(ds, "EPOCHS_PER_SLASHINGS_VECTOR", 8192)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[3], err = sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE", 512)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[4], err = sszutils.ResolveSpecValueWithDefault(ds, "HISTORICAL_ROOTS_LIMIT", 16777216)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[5], err = sszutils.ResolveSpecValueWithDefault(ds, "EPOCHS_PER_ETH1_VOTING_PERIOD*SLOTS_PER_EPOCH", 2048)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[6], err = sszutils.ResolveSpecValueWithDefault(ds, "VALIDATOR_REGISTRY_LIMIT", 1099511627776)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[7], err = sszutils.ResolveSpecValueWithDefault(ds, "MAX_EXTRA_DATA_BYTES", 32)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[8], err = sszutils.ResolveSpecValueWithDefault(ds, "PENDING_DEPOSITS_LIMIT", 134217728)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[9], err = sszutils.ResolveSpecValueWithDefault(ds, "PENDING_PARTIAL_WITHDRAWALS_LIMIT", 134217728)
+	if err != nil {
+		return err
+	}
+	ctx.exprs[10], err = sszutils.ResolveSpecValueWithDefault(ds, "PENDING_CONSOLIDATIONS_LIMIT", 262144)
+	if err != nil {
+		return err
+	}
+	// size for []Root
+	ctx.sizeFn1 = func(ctx *encoderCtx, t []Root) (size int) {
+		size += len(t) * 32
+		return size
+	}
+	// size for []*ETH1Data
+	ctx.sizeFn2 = func(ctx *encoderCtx, t []*ETH1Data) (size int) {
+		size += len(t) * 72
+		return size
+	}
+	// size for []*Validator
+	ctx.sizeFn3 = func(ctx *encoderCtx, t []*Validator) (size int) {
+		size += len(t) * 121
+		return size
+	}
+	// size for []Gwei
+	ctx.sizeFn4 = func(ctx *encoderCtx, t []Gwei) (size int) {
+		size += len(t) * 8
+		return size
+	}
+	// size for []ParticipationFlags
+	ctx.sizeFn5 = func(ctx *encoderCtx, t []ParticipationFlags) (size int) {
+		size += len(t)
+		return size
+	}
+	// size for []ParticipationFlags
+	ctx.sizeFn6 = func(ctx *encoderCtx, t []ParticipationFlags) (size int) {
+		size += len(t)
+		return size
+	}
+	// size for []uint64
+	ctx.sizeFn7 = func(ctx *encoderCtx, t []uint64) (size int) {
+		size += len(t) * 8
+		return size
+	}
+	// size for *ExecutionPayloadHeader
+	ctx.sizeFn8 = func(ctx *encoderCtx, t *ExecutionPayloadHeader) (size int) {
+		if t == nil {
+			t = new(ExecutionPayloadHeader)
+		}
+		// Field #0 'ParentHash' static (32 bytes)
+		// Field #1 'FeeRecipient' static (20 bytes)
+		// Field #2 'StateRoot' static (32 bytes)
+		// Field #3 'ReceiptsRoot' static (32 bytes)
+		// Field #4 'LogsBloom' static (256 bytes)
+		// Field #5 'PrevRandao' static (32 bytes)
+		// Field #6 'BlockNumber' static (8 bytes)
+		// Field #7 'GasLimit' static (8 bytes)
+		// Field #8 'GasUsed' static (8 bytes)
+		// Field #9 'Timestamp' static (8 bytes)
+		// Field #10 'ExtraData' offset (4 bytes)
+		// Field #11 'BaseFeePerGas' static (32 bytes)
+		// Field #12 'BlockHash' static (32 bytes)
+		// Field #13 'TransactionsRoot' static (32 bytes)
+		// Field #14 'WithdrawalsRoot' static (32 bytes)
+		// Field #15 'BlobGasUsed' static (8 bytes)
+		// Field #16 'ExcessBlobGas' static (8 bytes)
+		size += 584
+		{ // Dynamic field #10 'ExtraData'
+			size += ctx.sizeFn13(ctx, t.ExtraData)
+		}
+		return size
+	}
+	// size for []*HistoricalSummary
+	ctx.sizeFn9 = func(ctx *encoderCtx, t []*HistoricalSummary) (size int) {
+		size += len(t) * 64
+		return size
+	}
+	// size for []*PendingDeposit
+	ctx.sizeFn10 = func(ctx *encoderCtx, t []*PendingDeposit) (size int) {
+		size += len(t) * 192
+		return size
+	}
+	// size for []*PendingPartialWithdrawal
+	ctx.sizeFn11 = func(ctx *encoderCtx, t []*PendingPartialWithdrawal) (size int) {
+		size += len(t) * 24
+		return size
+	}
+	// size for []*PendingConsolidation
+	ctx.sizeFn12 = func(ctx *encoderCtx, t []*PendingConsolidation) (size int) {
+		size += len(t) * 16
+		return size
+	}
+	// size for []byte
+	ctx.sizeFn13 = func(ctx *encoderCtx, t []byte) (size int) {
+		size += len(t)
+		return size
+	}
+	size1 := 32 * int(ctx.exprs[0])
+	size2 := 32 * int(ctx.exprs[1])
+	size3 := 8 * int(ctx.exprs[2])
+	size5 := 48 * int(ctx.exprs[3])
+	size4 := size5 + 48 // size expression for '*SyncCommittee'
+	if t == nil {
+		t = new(BeaconStateElectra)
+	}
+	dstlen := enc.GetPosition()
+	dynoff := uint32(size1 + size1 + size2 + size3 + size4 + size4 + 489)
+	{ // Field #0 'GenesisTime'
+		enc.EncodeUint64(t.GenesisTime)
+	}
+	{ // Field #1 'GenesisValidatorsRoot'
+		enc.EncodeBytes(t.GenesisValidatorsRoot[:32])
+	}
+	{ // Field #2 'Slot'
+		enc.EncodeUint64(uint64(t.Slot))
+	}
+	{ // Field #3 'Fork'
+		t := t.Fork
+		if t == nil {
+			t = new(Fork)
+		}
+		{ // Field #0 'PreviousVersion'
+			enc.EncodeBytes(t.PreviousVersion[:4])
+		}
+		{ // Field #1 'CurrentVersion'
+			enc.EncodeBytes(t.CurrentVersion[:4])
+		}
+		{ // Field #2 'Epoch'
+			enc.EncodeUint64(uint64(t.Epoch))
+		}
+	}
+	{ // Field #4 'LatestBlockHeader'
+		t := t.LatestBlockHeader
+		if t == nil {
+			t = new(BeaconBlockHeader)
+		}
+		{ // Field #0 'Slot'
+			enc.EncodeUint64(uint64(t.Slot))
+		}
+		{ // Field #1 'ProposerIndex'
+			enc.EncodeUint64(uint64(t.ProposerIndex))
+		}
+		{ // Field #2 'ParentRoot'
+			enc.EncodeBytes(t.ParentRoot[:32])
+		}
+		{ // Field #3 'StateRoot'
+			enc.EncodeBytes(t.StateRoot[:32])
+		}
+		{ // Field #4 'BodyRoot'
+			enc.EncodeBytes(t.BodyRoot[:32])
+		}
+	}
+	{ // Field #5 'BlockRoots'
+		t := t.BlockRoots
+		vlen := len(t)
+		if vlen > int(ctx.exprs[0]) {
+			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(ctx.exprs[0])), "BlockRoots")
+		}
+		for idx1 := range vlen {
+			enc.EncodeBytes(t[idx1][:32])
+		}
+		if vlen < int(ctx.exprs[0]) {
+			enc.EncodeZeroPadding((int(ctx.exprs[0]) - vlen) * 32)
+		}
+	}
+	{ // Field #6 'StateRoots'
+		t := t.StateRoots
+		vlen := len(t)
+		if vlen > int(ctx.exprs[0]) {
+			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(ctx.exprs[0])), "StateRoots")
+		}
+		for idx1 := range vlen {
+			enc.EncodeBytes(t[idx1][:32])
+		}
+		if vlen < int(ctx.exprs[0]) {
+			enc.EncodeZeroPadding((int(ctx.exprs[0]) - vlen) * 32)
+		}
+	}
+	// Offset #7 'HistoricalRoots'
+	offset7 := enc.GetPosition()
+	if canSeek {
+		enc.EncodeOffset(0)
+	} else {
+		enc.EncodeOffset(dynoff)
+		dynoff += uint32(ctx.sizeFn1(ctx, t.HistoricalRoots))
+	}
+	{ // Field #8 'ETH1Data'
+		t := t.ETH1Data
+		if t == nil {
+			t = new(ETH1Data)
+		}
+		{ // Field #0 'DepositRoot'
+			enc.EncodeBytes(t.DepositRoot[:32])
+		}
+		{ // Field #1 'DepositCount'
+			enc.EncodeUint64(t.DepositCount)
+		}
+		{ // Field #2 'BlockHash'
+			enc.EncodeBytes(t.BlockHash[:32])
+		}
+	}
+	// Offset #9 'ETH1DataVotes'
+	offset9 := enc.GetPosition()
+	if canSeek {
+		enc.EncodeOffset(0)
+	} else {
+		enc.EncodeOffset(dynoff)
+		dynoff += uint32(ctx.sizeFn2(ctx, t.ETH1DataVotes))
+	}
+	{ // Field #10 'ETH1DepositIndex'
+		enc.EncodeUint64(t.ETH1DepositIndex)
+	}
+	// Offset #11 'Validators'
+	offset11 := enc.GetPosition()
+	if canSeek {
+		enc.EncodeOffset(0)
+	} else {
+		enc.EncodeOffset(dynoff)
+		dynoff += uint32(ctx.sizeFn3(ctx, t.Validators))
+	}
+	// Offset #12 'Balances'
+	offset12 := enc.GetPosition()
+	if canSeek {
+		enc.EncodeOffset(0)
+	} else {
+		enc.EncodeOffset(dynoff)
+		dynoff += uint32(ctx.sizeFn4(ctx, t.Balances))
+	}
+	{ // Field #13 'RANDAOMixes'
+		t := t.RANDAOMixes
+		vlen := len(t)
+		if vlen > int(ctx.exprs[1]) {
+			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(ctx.exprs[1])), "RANDAOMixes")
+		}
+		for idx1 := range vlen {
+			enc.EncodeBytes(t[idx1][:32])
+		}
+		if vlen < int(ctx.exprs[1]) {
+			enc.EncodeZeroPadding((int(ctx.exprs[1]) - vlen) * 32)
+		}
+	}
+	{ // Field #14 'Slashings'
+		t := t.Slashings
+		vlen := len(t)
+		if vlen > int(ctx.exprs[2]) {
+			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(ctx.exprs[2])), "Slashings")
+		}
+		sszutils.EncodeUint64Slice(enc, t[:vlen])
+		if vlen < int(ctx.exprs[2]) {
+			enc.EncodeZeroPadding((int(ctx.exprs[2]) - vlen) * 8)
+		}
+	}
+	// Offset #15 'PreviousEpochParticipation'
+	offset15 := enc.GetPosition()
+	if canSeek {
+		enc.EncodeOffset(0)
+	} else {
+		enc.EncodeOffset(dynoff)
+		dynoff += uint32(ctx.sizeFn5(ctx, t.PreviousEpochParticipation))
+	}
+	// Offset #16 'CurrentEpochParticipation'
+	offset16 := enc.GetPosition()
+	if canSeek {
+		enc.EncodeOffset(0)
+	} else {
+		enc.EncodeOffset(dynoff)
+		dynoff += uint32(ctx.sizeFn6(ctx, t.CurrentEpochParticipation))
+	}
+	{ // Field #17 'JustificationBits'
+		vlen := len(t.JustificationBits)
+		if vlen > 1 {
+			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, 1), "JustificationBits")
+		}
+		enc.EncodeBytes(t.JustificationBits[:vlen])
+		if vlen < 1 {
+			enc.EncodeZeroPadding((1 - vlen) * 1)
+		}
+	}
+	{ // Field #18 'PreviousJustifiedCheckpoint'
+		t := t.PreviousJustifiedCheckpoint
+		if t == nil {
+			t = new(Checkpoint)
+		}
+		{ // Field #0 'Epoch'
+			enc.EncodeUint64(uint64(t.Epoch))
+		}
+		{ // Field #1 'Root'
+			enc.EncodeBytes(t.Root[:32])
+		}
+	}
+	{ // Field #19 'CurrentJustifiedCheckpoint'
+		t := t.CurrentJustifiedCheckpoint
+		if t == nil {
+			t = new(Checkpoint)
+		}
+		{ // Field #0 'Epoch'
+			enc.EncodeUint64(uint64(t.Epoch))
+		}
+		{ // Field #1 'Root'
+			enc.EncodeBytes(t.Root[:32])
+		}
+	}
+	{ // Field #20 'FinalizedCheckpoint'
+		t := t.FinalizedCheckpoint
+		if t == nil {
+			t = new(Checkpoint)
+		}
+		{ // Field #0 'Epoch'
+			enc.EncodeUint64(uint64(t.Epoch))
+		}
+		{ // Field #1 'Root'
+			enc.EncodeBytes(t.Root[:32])
+		}
+	}
+	// Offset #21 'InactivityScores'
+	offset21 := enc.GetPosition()
+	if canSeek {
+		enc.EncodeOffset(0)
+	} else {
+		enc.EncodeOffset(dynoff)
+		dynoff += uint32(ctx.sizeFn7(ctx, t.InactivityScores))
+	}
+	{ // Field #22 'CurrentSyncCommittee'
+		t := t.CurrentSyncCommittee
+		if t == nil {
+			t = new(SyncCommittee)
+		}
+		{ // Field #0 'Pubkeys'
+			t := t.Pubkeys
+			vlen := len(t)
+			if vlen > int(ctx.exprs[3]) {
+				return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(ctx.exprs[3])), "CurrentSyncCommittee.Pubkeys")
+			}
+			for idx1 := range vlen {
+				enc.EncodeBytes(t[idx1][:48])
+			}
+			if vlen < int(ctx.exprs[3]) {
+				enc.EncodeZeroPadding((int(ctx.exprs[3]) - vlen) * 48)
+			}
+		}
+		{ // Field #1 'AggregatePubkey'
+			enc.EncodeBytes(t.AggregatePubkey[:48])
+		}
+	}
+	{ // Field #23 'NextSyncCommittee'
+		t := t.NextSyncCommittee
+		if t == nil {
+			t = new(SyncCommittee)
+		}
+		{ // Field #0 'Pubkeys'
+			t := t.Pubkeys
+			vlen := len(t)
+			if vlen > int(ctx.exprs[3]) {
+				return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(ctx.exprs[3])), "NextSyncCommittee.Pubkeys")
+			}
+			for idx1 := range vlen {
+				enc.EncodeBytes(t[idx1][:48])
+			}
+			if vlen < int(ctx.exprs[3]) {
+				enc.EncodeZeroPadding((int(ctx.exprs[3]) - vlen) * 48)
+			}
+		}
+		{ // Field #1 'AggregatePubkey'
+			enc.EncodeBytes(t.AggregatePubkey[:48])
+		}
+	}
+	// Offset #24 'LatestExecutionPayloadHeader'
+	offset24 := enc.GetPosition()
+	if canSeek {
+		enc.EncodeOffset(0)
+	} else {
+		enc.EncodeOffset(dynoff)
+		dynoff += uint32(ctx.sizeFn8(ctx, t.LatestExecutionPayloadHeader))
+	}
+	{ // Field #25 'NextWithdrawalIndex'
+		enc.EncodeUint64(uint64(t.NextWithdrawalIndex))
+	}
+	{ // Field #26 'NextWithdrawalValidatorIndex'
+		enc.EncodeUint64(uint64(t.NextWithdrawalValidatorIndex))
+	}
+	// Offset #27 'HistoricalSummaries'
+	offset27 := enc.GetPosition()
+	if canSeek {
+		enc.EncodeOffset(0)
+	} else {
+		enc.EncodeOffset(dynoff)
+		dynoff += uint32(ctx.sizeFn9(ctx, t.HistoricalSummaries))
+	}
+	{ // Field #28 'DepositRequestsStartIndex'
+		enc.EncodeUint64(t.DepositRequestsStartIndex)
+	}
+	{ // Field #29 'DepositBalanceToConsume'
+		enc.EncodeUint64(uint64(t.DepositBalanceToConsume))
+	}
+	{ // Field #30 'ExitBalanceToConsume'
+		enc.EncodeUint64(uint64(t.ExitBalanceToConsume))
+	}
+	{ // Field #31 'EarliestExitEpoch'
+		enc.EncodeUint64(uint64(t.EarliestExitEpoch))
+	}
+	{ // Field #32 'ConsolidationBalanceToConsume'
+		enc.EncodeUint64(uint64(t.ConsolidationBalanceToConsume))
+	}
+	{ // Field #33 'EarliestConsolidationEpoch'
+		enc.EncodeUint64(uint64(t.EarliestConsolidationEpoch))
+	}
+	// Offset #34 'PendingDeposits'
+	offset34 := enc.GetPosition()
+	if canSeek {
+		enc.EncodeOffset(0)
+	} else {
+		enc.EncodeOffset(dynoff)
+		dynoff += uint32(ctx.sizeFn10(ctx, t.PendingDeposits))
+	}
+	// Offset #35 'PendingPartialWithdrawals'
+	offset35 := enc.GetPosition()
+	if canSeek {
+		enc.EncodeOffset(0)
+	} else {
+		enc.EncodeOffset(dynoff)
+		dynoff += uint32(ctx.sizeFn11(ctx, t.PendingPartialWithdrawals))
+	}
+	// Offset #36 'PendingConsolidations'
+	offset36 := enc.GetPosition()
+	if canSeek {
+		enc.EncodeOffset(0)
+	} else {
+		enc.EncodeOffset(dynoff)
+		dynoff += uint32(ctx.sizeFn12(ctx, t.PendingConsolidations))
+	}
+	{ // Dynamic Field #7 'HistoricalRoots'
+		if canSeek {
+			enc.EncodeOffsetAt(offset7, uint32(enc.GetPosition()-dstlen))
+		}
+		t := t.HistoricalRoots
+		vlen := len(t)
+		if vlen > int(ctx.exprs[4]) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[4])), "HistoricalRoots")
+		}
+		for idx1 := range vlen {
+			enc.EncodeBytes(t[idx1][:32])
+		}
+	}
+	{ // Dynamic Field #9 'ETH1DataVotes'
+		if canSeek {
+			enc.EncodeOffsetAt(offset9, uint32(enc.GetPosition()-dstlen))
+		}
+		t := t.ETH1DataVotes
+		vlen := len(t)
+		if vlen > int(ctx.exprs[5]) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[5])), "ETH1DataVotes")
+		}
+		for idx1 := range vlen {
+			t := t[idx1]
+			if t == nil {
+				t = new(ETH1Data)
+			}
+			{ // Field #0 'DepositRoot'
+				enc.EncodeBytes(t.DepositRoot[:32])
+			}
+			{ // Field #1 'DepositCount'
+				enc.EncodeUint64(t.DepositCount)
+			}
+			{ // Field #2 'BlockHash'
+				enc.EncodeBytes(t.BlockHash[:32])
+			}
+		}
+	}
+	{ // Dynamic Field #11 'Validators'
+		if canSeek {
+			enc.EncodeOffsetAt(offset11, uint32(enc.GetPosition()-dstlen))
+		}
+		t := t.Validators
+		vlen := len(t)
+		if vlen > int(ctx.exprs[6]) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[6])), "Validators")
+		}
+		for idx1 := range vlen {
+			t := t[idx1]
+			if t == nil {
+				t = new(Validator)
+			}
+			{ // Field #0 'Pubkey'
+				enc.EncodeBytes(t.Pubkey[:48])
+			}
+			{ // Field #1 'WithdrawalCredentials'
+				enc.EncodeBytes(t.WithdrawalCredentials[:32])
+			}
+			{ // Field #2 'EffectiveBalance'
+				enc.EncodeUint64(uint64(t.EffectiveBalance))
+			}
+			{ // Field #3 'Slashed'
+				enc.EncodeBool(t.Slashed)
+			}
+			{ // Field #4 'ActivationEligibilityEpoch'
+				enc.EncodeUint64(uint64(t.ActivationEligibilityEpoch))
+			}
+			{ // Field #5 'ActivationEpoch'
+				enc.EncodeUint64(uint64(t.ActivationEpoch))
+			}
+			{ // Field #6 'ExitEpoch'
+				enc.EncodeUint64(uint64(t.ExitEpoch))
+			}
+			{ // Field #7 'WithdrawableEpoch'
+				enc.EncodeUint64(uint64(t.WithdrawableEpoch))
+			}
+		}
+	}
+	{ // Dynamic Field #12 'Balances'
+		if canSeek {
+			enc.EncodeOffsetAt(offset12, uint32(enc.GetPosition()-dstlen))
+		}
+		t := t.Balances
+		vlen := len(t)
+		if vlen > int(ctx.exprs[6]) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[6])), "Balances")
+		}
+		sszutils.EncodeUint64Slice(enc, t[:vlen])
+	}
+	{ // Dynamic Field #15 'PreviousEpochParticipation'
+		if canSeek {
+			enc.EncodeOffsetAt(offset15, uint32(enc.GetPosition()-dstlen))
+		}
+		vlen := len(t.PreviousEpochParticipation)
+		if vlen > int(ctx.exprs[6]) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[6])), "PreviousEpochParticipation")
+		}
+		enc.EncodeBytes(t.PreviousEpochParticipation[:])
+	}
+	{ // Dynamic Field #16 'CurrentEpochParticipation'
+		if canSeek {
+			enc.EncodeOffsetAt(offset16, uint32(enc.GetPosition()-dstlen))
+		}
+		vlen := len(t.CurrentEpochParticipation)
+		if vlen > int(ctx.exprs[6]) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[6])), "CurrentEpochParticipation")
+		}
+		enc.EncodeBytes(t.CurrentEpochParticipation[:])
+	}
+	{ // Dynamic Field #21 'InactivityScores'
+		if canSeek {
+			enc.EncodeOffsetAt(offset21, uint32(enc.GetPosition()-dstlen))
+		}
+		t := t.InactivityScores
+		vlen := len(t)
+		if vlen > int(ctx.exprs[6]) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[6])), "InactivityScores")
+		}
+		sszutils.EncodeUint64Slice(enc, t[:vlen])
+	}
+	{ // Dynamic Field #24 'LatestExecutionPayloadHeader'
+		if canSeek {
+			enc.EncodeOffsetAt(offset24, uint32(enc.GetPosition()-dstlen))
+		}
+		t := t.LatestExecutionPayloadHeader
+		if t == nil {
+			t = new(ExecutionPayloadHeader)
+		}
+		dstlen := enc.GetPosition()
+		dynoff := uint32(584)
+		{ // Field #0 'ParentHash'
+			enc.EncodeBytes(t.ParentHash[:32])
+		}
+		{ // Field #1 'FeeRecipient'
+			enc.EncodeBytes(t.FeeRecipient[:20])
+		}
+		{ // Field #2 'StateRoot'
+			enc.EncodeBytes(t.StateRoot[:32])
+		}
+		{ // Field #3 'ReceiptsRoot'
+			enc.EncodeBytes(t.ReceiptsRoot[:32])
+		}
+		{ // Field #4 'LogsBloom'
+			enc.EncodeBytes(t.LogsBloom[:256])
+		}
+		{ // Field #5 'PrevRandao'
+			enc.EncodeBytes(t.PrevRandao[:32])
+		}
+		{ // Field #6 'BlockNumber'
+			enc.EncodeUint64(t.BlockNumber)
+		}
+		{ // Field #7 'GasLimit'
+			enc.EncodeUint64(t.GasLimit)
+		}
+		{ // Field #8 'GasUsed'
+			enc.EncodeUint64(t.GasUsed)
+		}
+		{ // Field #9 'Timestamp'
+			enc.EncodeUint64(t.Timestamp)
+		}
+		// Offset #10 'ExtraData'
+		offset10 := enc.GetPosition()
+		if canSeek {
+			enc.EncodeOffset(0)
+		} else {
+			enc.EncodeOffset(dynoff)
+			dynoff += uint32(ctx.sizeFn13(ctx, t.ExtraData))
+		}
+		{ // Field #11 'BaseFeePerGas'
+			enc.EncodeBytes(t.BaseFeePerGas[:32])
+		}
+		{ // Field #12 'BlockHash'
+			enc.EncodeBytes(t.BlockHash[:32])
+		}
+		{ // Field #13 'TransactionsRoot'
+			enc.EncodeBytes(t.TransactionsRoot[:32])
+		}
+		{ // Field #14 'WithdrawalsRoot'
+			enc.EncodeBytes(t.WithdrawalsRoot[:32])
+		}
+		{ // Field #15 'BlobGasUsed'
+			enc.EncodeUint64(t.BlobGasUsed)
+		}
+		{ // Field #16 'ExcessBlobGas'
+			enc.EncodeUint64(t.ExcessBlobGas)
+		}
+		{ // Dynamic Field #10 'ExtraData'
+			if canSeek {
+				enc.EncodeOffsetAt(offset10, uint32(enc.GetPosition()-dstlen))
+			}
+			vlen := len(t.ExtraData)
+			if vlen > int(ctx.exprs[7]) {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[7])), "LatestExecutionPayloadHeader.ExtraData")
+			}
+			enc.EncodeBytes(t.ExtraData[:])
+		}
+	}
+	{ // Dynamic Field #27 'HistoricalSummaries'
+		if canSeek {
+			enc.EncodeOffsetAt(offset27, uint32(enc.GetPosition()-dstlen))
+		}
+		t := t.HistoricalSummaries
+		vlen := len(t)
+		if vlen > int(ctx.exprs[4]) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[4])), "HistoricalSummaries")
+		}
+		for idx1 := range vlen {
+			t := t[idx1]
+			if t == nil {
+				t = new(HistoricalSummary)
+			}
+			{ // Field #0 'BlockSummaryRoot'
+				enc.EncodeBytes(t.BlockSummaryRoot[:32])
+			}
+			{ // Field #1 'StateSummaryRoot'
+				enc.EncodeBytes(t.StateSummaryRoot[:32])
+			}
+		}
+	}
+	{ // Dynamic Field #34 'PendingDeposits'
+		if canSeek {
+			enc.EncodeOffsetAt(offset34, uint32(enc.GetPosition()-dstlen))
+		}
+		t := t.PendingDeposits
+		vlen := len(t)
+		if vlen > int(ctx.exprs[8]) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[8])), "PendingDeposits")
+		}
+		for idx1 := range vlen {
+			t := t[idx1]
+			if t == nil {
+				t = new(PendingDeposit)
+			}
+			{ // Field #0 'Pubkey'
+				enc.EncodeBytes(t.Pubkey[:48])
+			}
+			{ // Field #1 'WithdrawalCredentials'
+				enc.EncodeBytes(t.WithdrawalCredentials[:32])
+			}
+			{ // Field #2 'Amount'
+				enc.EncodeUint64(uint64(t.Amount))
+			}
+			{ // Field #3 'Signature'
+				enc.EncodeBytes(t.Signature[:96])
+			}
+			{ // Field #4 'Slot'
+				enc.EncodeUint64(uint64(t.Slot))
+			}
+		}
+	}
+	{ // Dynamic Field #35 'PendingPartialWithdrawals'
+		if canSeek {
+			enc.EncodeOffsetAt(offset35, uint32(enc.GetPosition()-dstlen))
+		}
+		t := t.PendingPartialWithdrawals
+		vlen := len(t)
+		if vlen > int(ctx.exprs[9]) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[9])), "PendingPartialWithdrawals")
+		}
+		for idx1 := range vlen {
+			t := t[idx1]
+			if t == nil {
+				t = new(PendingPartialWithdrawal)
+			}
+			{ // Field #0 'ValidatorIndex'
+				enc.EncodeUint64(uint64(t.ValidatorIndex))
+			}
+			{ // Field #1 'Amount'
+				enc.EncodeUint64(uint64(t.Amount))
+			}
+			{ // Field #2 'WithdrawableEpoch'
+				enc.EncodeUint64(uint64(t.WithdrawableEpoch))
+			}
+		}
+	}
+	{ // Dynamic Field #36 'PendingConsolidations'
+		if canSeek {
+			enc.EncodeOffsetAt(offset36, uint32(enc.GetPosition()-dstlen))
+		}
+		t := t.PendingConsolidations
+		vlen := len(t)
+		if vlen > int(ctx.exprs[10]) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, int(ctx.exprs[10])), "PendingConsolidations")
+		}
+		for idx1 := range vlen {
+			t := t[idx1]
+			if t == nil {
+				t = new(PendingConsolidation)
+			}
+			{ // Field #0 'SourceIndex'
+				enc.EncodeUint64(uint64(t.SourceIndex))
+			}
+			{ // Field #1 'TargetIndex'
+				enc.EncodeUint64(uint64(t.TargetIndex))
+			}
+		}
+	}
+	return nil
+}
+
+// UnmarshalSSZ unmarshals the *BeaconStateElectra from SSZ-encoded bytes.
+func (t *BeaconStateElectra) UnmarshalSSZ(buf []byte) (err error) {
+	return t.UnmarshalSSZDyn(dynssz.GetGlobalDynSsz(), buf)
+}
+
+// UnmarshalSSZDyn unmarshals the *BeaconStateElectra from SSZ-encoded bytes using dynamic specifications.
+func (t *BeaconStateElectra) UnmarshalSSZDyn(ds sszutils.DynamicSpecs, buf []byte) (err error) {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SLOTS_PER_HISTORICAL_ROOT", 8192)
+	if err != nil {
+		return err
+	}
+	expr1, err := sszutils.ResolveSpecValueWithDefault(ds, "EPOCHS_PER_HISTORICAL_VECTOR", 65536)
+	if err != nil {
+		return err
+	}
+	expr2, err := sszutils.ResolveSpecValueWithDefault(ds, "EPOCHS_PER_SLASHINGS_VECTOR", 8192)
+	if err != nil {
+		return err
+	}
+	expr3, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE", 512)
+	if err != nil {
+		return err
+	}
+	expr4, err := sszutils.ResolveSpecValueWithDefault(ds, "HISTORICAL_ROOTS_LIMIT", 16777216)
+	if err != nil {
+		return err
+	}
+	expr5, err := sszutils.ResolveSpecValueWithDefault(ds, "EPOCHS_PER_ETH1_VOTING_PERIOD*SLOTS_PER_EPOCH", 2048)
+	if err != nil {
+		return err
+	}
+	expr6, err := sszutils.ResolveSpecValueWithDefault(ds, "VALIDATOR_REGISTRY_LIMIT", 1099511627776)
+	if err != nil {
+		return err
+	}
+	expr7, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_EXTRA_DATA_BYTES", 32)
+	if err != nil {
+		return err
+	}
+	expr8, err := sszutils.ResolveSpecValueWithDefault(ds, "PENDING_DEPOSITS_LIMIT", 134217728)
+	if err != nil {
+		return err
+	}
+	expr9, err := sszutils.ResolveSpecValueWithDefault(ds, "PENDING_PARTIAL_WITHDRAWALS_LIMIT", 134217728)
+	if err != nil {
+		return err
+	}
+	expr10, err := sszutils.ResolveSpecValueWithDefault(ds, "PENDING_CONSOLIDATIONS_LIMIT", 262144)
+	if err != nil {
+		return err
+	}
+	size1 := 32 * int(expr0)
+	size2 := 32 * int(expr1)
+	size3 := 8 * int(expr2)
+	size5 := 48 * int(expr3)
+	size4 := size5 + 48 // size expression for '*SyncCommittee'
+	exproffset := 0
+	totalSize := size1+size1+size2+size3+size4+size4+489
+	buflen := len(buf)
+	if buflen < totalSize {
+		return sszutils.ErrFixedFieldsEOFFn(buflen, totalSize)
+	}
+	{ // Field #0 'GenesisTime' (static)
+		buf := buf[0:8]
+		t.GenesisTime = binary.LittleEndian.Uint64(buf)
+	}
+	{ // Field #1 'GenesisValidatorsRoot' (static)
+		buf := buf[8:40]
+		copy(t.GenesisValidatorsRoot[:], buf)
+	}
+	{ // Field #2 'Slot' (static)
+		buf := buf[40:48]
+		t.Slot = Slot(binary.LittleEndian.Uint64(buf))
+	}
+	{ // Field #3 'Fork' (static)
+		buf := buf[48:64]
+		val1 := t.Fork
+		if val1 == nil {
+			val1 = new(Fork)
+		}
+		buflen := len(buf)
+		if buflen < 16 {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(buflen, 16), "Fork")
+		}
+		if buflen > 16 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(buflen - 16), "Fork")
+		}
+		{ // Field #0 'PreviousVersion' (static)
+			buf := buf[0:4]
+			copy(val1.PreviousVersion[:], buf)
+		}
+		{ // Field #1 'CurrentVersion' (static)
+			buf := buf[4:8]
+			copy(val1.CurrentVersion[:], buf)
+		}
+		{ // Field #2 'Epoch' (static)
+			buf := buf[8:16]
+			val1.Epoch = Epoch(binary.LittleEndian.Uint64(buf))
+		}
+		t.Fork = val1
+	}
+	{ // Field #4 'LatestBlockHeader' (static)
+		buf := buf[64:176]
+		val2 := t.LatestBlockHeader
+		if val2 == nil {
+			val2 = new(BeaconBlockHeader)
+		}
+		buflen := len(buf)
+		if buflen < 112 {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(buflen, 112), "LatestBlockHeader")
+		}
+		if buflen > 112 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(buflen - 112), "LatestBlockHeader")
+		}
+		{ // Field #0 'Slot' (static)
+			buf := buf[0:8]
+			val2.Slot = Slot(binary.LittleEndian.Uint64(buf))
+		}
+		{ // Field #1 'ProposerIndex' (static)
+			buf := buf[8:16]
+			val2.ProposerIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf))
+		}
+		{ // Field #2 'ParentRoot' (static)
+			buf := buf[16:48]
+			copy(val2.ParentRoot[:], buf)
+		}
+		{ // Field #3 'StateRoot' (static)
+			buf := buf[48:80]
+			copy(val2.StateRoot[:], buf)
+		}
+		{ // Field #4 'BodyRoot' (static)
+			buf := buf[80:112]
+			copy(val2.BodyRoot[:], buf)
+		}
+		t.LatestBlockHeader = val2
+	}
+	{ // Field #5 'BlockRoots' (static)
+		buf := buf[176 : size1+176]
+		exproffset += int(size1)
+		val3 := t.BlockRoots
+		val3 = sszutils.ExpandSlice(val3, int(expr0))
+		sszutils.UnmarshalFixedBytesSlice(val3[:int(expr0)], buf)
+		t.BlockRoots = val3
+	}
+	{ // Field #6 'StateRoots' (static)
+		buf := buf[exproffset+176 : exproffset+size1+176]
+		exproffset += int(size1)
+		val4 := t.StateRoots
+		val4 = sszutils.ExpandSlice(val4, int(expr0))
+		sszutils.UnmarshalFixedBytesSlice(val4[:int(expr0)], buf)
+		t.StateRoots = val4
+	}
+	// Field #7 'HistoricalRoots' (offset)
+	offset7 := int(binary.LittleEndian.Uint32(buf[exproffset+176 : exproffset+180]))
+	if offset7 != totalSize {
+		return sszutils.ErrorWithPath(sszutils.ErrFirstOffsetMismatchFn(offset7, totalSize), "HistoricalRoots:o")
+	}
+	{ // Field #8 'ETH1Data' (static)
+		buf := buf[exproffset+180 : exproffset+252]
+		val5 := t.ETH1Data
+		if val5 == nil {
+			val5 = new(ETH1Data)
+		}
+		buflen := len(buf)
+		if buflen < 72 {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(buflen, 72), "ETH1Data")
+		}
+		if buflen > 72 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(buflen - 72), "ETH1Data")
+		}
+		{ // Field #0 'DepositRoot' (static)
+			buf := buf[0:32]
+			copy(val5.DepositRoot[:], buf)
+		}
+		{ // Field #1 'DepositCount' (static)
+			buf := buf[32:40]
+			val5.DepositCount = binary.LittleEndian.Uint64(buf)
+		}
+		{ // Field #2 'BlockHash' (static)
+			buf := buf[40:72]
+			copy(val5.BlockHash[:], buf)
+		}
+		t.ETH1Data = val5
+	}
+	// Field #9 'ETH1DataVotes' (offset)
+	offset9 := int(binary.LittleEndian.Uint32(buf[exproffset+252 : exproffset+256]))
+	if offset9 < offset7 || offset9 > buflen {
+		return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset9, offset7, buflen), "ETH1DataVotes:o")
+	}
+	{ // Field #10 'ETH1DepositIndex' (static)
+		buf := buf[exproffset+256 : exproffset+264]
+		t.ETH1DepositIndex = binary.LittleEndian.Uint64(buf)
+	}
+	// Field #11 'Validators' (offset)
+	offset11 := int(binary.LittleEndian.Uint32(buf[exproffset+264 : exproffset+268]))
+	if offset11 < offset9 || offset11 > buflen {
+		return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset11, offset9, buflen), "Validators:o")
+	}
+	// Field #12 'Balances' (offset)
+	offset12 := int(binary.LittleEndian.Uint32(buf[exproffset+268 : exproffset+272]))
+	if offset12 < offset11 || offset12 > buflen {
+		return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset12, offset11, buflen), "Balances:o")
+	}
+	{ // Field #13 'RANDAOMixes' (static)
+		buf := buf[exproffset+272 : exproffset+size2+272]
+		exproffset += int(size2)
+		val6 := t.RANDAOMixes
+		val6 = sszutils.ExpandSlice(val6, int(expr1))
+		sszutils.UnmarshalFixedBytesSlice(val6[:int(expr1)], buf)
+		t.RANDAOMixes = val6
+	}
+	{ // Field #14 'Slashings' (static)
+		buf := buf[exproffset+272 : exproffset+size3+272]
+		exproffset += int(size3)
+		val7 := t.Slashings
+		val7 = sszutils.ExpandSlice(val7, int(expr2))
+		sszutils.UnmarshalUint64Slice(val7[:int(expr2)], buf)
+		t.Slashings = val7
+	}
+	// Field #15 'PreviousEpochParticipation' (offset)
+	offset15 := int(binary.LittleEndian.Uint32(buf[exproffset+272 : exproffset+276]))
+	if offset15 < offset12 || offset15 > buflen {
+		return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset15, offset12, buflen), "PreviousEpochParticipation:o")
+	}
+	// Field #16 'CurrentEpochParticipation' (offset)
+	offset16 := int(binary.LittleEndian.Uint32(buf[exproffset+276 : exproffset+280]))
+	if offset16 < offset15 || offset16 > buflen {
+		return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset16, offset15, buflen), "CurrentEpochParticipation:o")
+	}
+	{ // Field #17 'JustificationBits' (static)
+		buf := buf[exproffset+280 : exproffset+281]
+		t.JustificationBits = sszutils.ExpandSlice(t.JustificationBits, 1)
+		copy(t.JustificationBits[:], buf)
+	}
+	{ // Field #18 'PreviousJustifiedCheckpoint' (static)
+		buf := buf[exproffset+281 : exproffset+321]
+		val8 := t.PreviousJustifiedCheckpoint
+		if val8 == nil {
+			val8 = new(Checkpoint)
+		}
+		buflen := len(buf)
+		if buflen < 40 {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(buflen, 40), "PreviousJustifiedCheckpoint")
+		}
+		if buflen > 40 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(buflen - 40), "PreviousJustifiedCheckpoint")
+		}
+		{ // Field #0 'Epoch' (static)
+			buf := buf[0:8]
+			val8.Epoch = Epoch(binary.LittleEndian.Uint64(buf))
+		}
+		{ // Field #1 'Root' (static)
+			buf := buf[8:40]
+			copy(val8.Root[:], buf)
+		}
+		t.PreviousJustifiedCheckpoint = val8
+	}
+	{ // Field #19 'CurrentJustifiedCheckpoint' (static)
+		buf := buf[exproffset+321 : exproffset+361]
+		val9 := t.CurrentJustifiedCheckpoint
+		if val9 == nil {
+			val9 = new(Checkpoint)
+		}
+		buflen := len(buf)
+		if buflen < 40 {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(buflen, 40), "CurrentJustifiedCheckpoint")
+		}
+		if buflen > 40 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(buflen - 40), "CurrentJustifiedCheckpoint")
+		}
+		{ // Field #0 'Epoch' (static)
+			buf := buf[0:8]
+			val9.Epoch = Epoch(binary.LittleEndian.Uint64(buf))
+		}
+		{ // Field #1 'Root' (static)
+			buf := buf[8:40]
+			copy(val9.Root[:], buf)
+		}
+		t.CurrentJustifiedCheckpoint = val9
+	}
+	{ // Field #20 'FinalizedCheckpoint' (static)
+		buf := buf[exproffset+361 : exproffset+401]
+		val10 := t.FinalizedCheckpoint
+		if val10 == nil {
+			val10 = new(Checkpoint)
+		}
+		buflen := len(buf)
+		if buflen < 40 {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(buflen, 40), "FinalizedCheckpoint")
+		}
+		if buflen > 40 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(buflen - 40), "FinalizedCheckpoint")
+		}
+		{ // Field #0 'Epoch' (static)
+			buf := buf[0:8]
+			val10.Epoch = Epoch(binary.LittleEndian.Uint64(buf))
+		}
+		{ // Field #1 'Root' (static)
+			buf := buf[8:40]
+			copy(val10.Root[:], buf)
+		}
+		t.FinalizedCheckpoint = val10
+	}
+	// Field #21 'InactivityScores' (offset)
+	offset21 := int(binary.LittleEndian.Uint32(buf[exproffset+401 : exproffset+405]))
+	if offset21 < offset16 || offset21 > buflen {
+		return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset21, offset16, buflen), "InactivityScores:o")
+	}
+	{ // Field #22 'CurrentSyncCommittee' (static)
+		buf := buf[exproffset+405 : exproffset+size4+405]
+		exproffset += int(size4)
+		val11 := t.CurrentSyncCommittee
+		if val11 == nil {
+			val11 = new(SyncCommittee)
+		}
+		exproffset := 0
+		totalSize := size5+48
+		buflen := len(buf)
+		if buflen < totalSize {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(buflen, totalSize), "CurrentSyncCommittee")
+		}
+		if buflen > totalSize {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(buflen - totalSize), "CurrentSyncCommittee")
+		}
+		{ // Field #0 'Pubkeys' (static)
+			buf := buf[0 : size5+0]
+			exproffset += int(size5)
+			val12 := val11.Pubkeys
+			val12 = sszutils.ExpandSlice(val12, int(expr3))
+			sszutils.UnmarshalFixedBytesSlice(val12[:int(expr3)], buf)
+			val11.Pubkeys = val12
+		}
+		{ // Field #1 'AggregatePubkey' (static)
+			buf := buf[exproffset+0 : exproffset+48]
+			copy(val11.AggregatePubkey[:], buf)
+		}
+		t.CurrentSyncCommittee = val11
+	}
+	{ // Field #23 'NextSyncCommittee' (static)
+		buf := buf[exproffset+405 : exproffset+size4+405]
+		exproffset += int(size4)
+		val13 := t.NextSyncCommittee
+		if val13 == nil {
+			val13 = new(SyncCommittee)
+		}
+		exproffset := 0
+		totalSize := size5+48
+		buflen := len(buf)
+		if buflen < totalSize {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(buflen, totalSize), "NextSyncCommittee")
+		}
+		if buflen > totalSize {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(buflen - totalSize), "NextSyncCommittee")
+		}
+		{ // Field #0 'Pubkeys' (static)
+			buf := buf[0 : size5+0]
+			exproffset += int(size5)
+			val14 := val13.Pubkeys
+			val14 = sszutils.ExpandSlice(val14, int(expr3))
+			sszutils.UnmarshalFixedBytesSlice(val14[:int(expr3)], buf)
+			val13.Pubkeys = val14
+		}
+		{ // Field #1 'AggregatePubkey' (static)
+			buf := buf[exproffset+0 : exproffset+48]
+			copy(val13.AggregatePubkey[:], buf)
+		}
+		t.NextSyncCommittee = val13
+	}
+	// Field #24 'LatestExecutionPayloadHeader' (offset)
+	offset24 := int(binary.LittleEndian.Uint32(buf[exproffset+405 : exproffset+409]))
+	if offset24 < offset21 || offset24 > buflen {
+		return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset24, offset21, buflen), "LatestExecutionPayloadHeader:o")
+	}
+	{ // Field #25 'NextWithdrawalIndex' (static)
+		buf := buf[exproffset+409 : exproffset+417]
+		t.NextWithdrawalIndex = WithdrawalIndex(binary.LittleEndian.Uint64(buf))
+	}
+	{ // Field #26 'NextWithdrawalValidatorIndex' (static)
+		buf := buf[exproffset+417 : exproffset+425]
+		t.NextWithdrawalValidatorIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf))
+	}
+	// Field #27 'HistoricalSummaries' (offset)
+	offset27 := int(binary.LittleEndian.Uint32(buf[exproffset+425 : exproffset+429]))
+	if offset27 < offset24 || offset27 > buflen {
+		return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset27, offset24, buflen), "HistoricalSummaries:o")
+	}
+	{ // Field #28 'DepositRequestsStartIndex' (static)
+		buf := buf[exproffset+429 : exproffset+437]
+		t.DepositRequestsStartIndex = binary.LittleEndian.Uint64(buf)
+	}
+	{ // Field #29 'DepositBalanceToConsume' (static)
+		buf := buf[exproffset+437 : exproffset+445]
+		t.DepositBalanceToConsume = Gwei(binary.LittleEndian.Uint64(buf))
+	}
+	{ // Field #30 'ExitBalanceToConsume' (static)
+		buf := buf[exproffset+445 : exproffset+453]
+		t.ExitBalanceToConsume = Gwei(binary.LittleEndian.Uint64(buf))
+	}
+	{ // Field #31 'EarliestExitEpoch' (static)
+		buf := buf[exproffset+453 : exproffset+461]
+		t.EarliestExitEpoch = Epoch(binary.LittleEndian.Uint64(buf))
+	}
+	{ // Field #32 'ConsolidationBalanceToConsume' (static)
+		buf := buf[exproffset+461 : exproffset+469]
+		t.ConsolidationBalanceToConsume = Gwei(binary.LittleEndian.Uint64(buf))
+	}
+	{ // Field #33 'EarliestConsolidationEpoch' (static)
+		buf := buf[exproffset+469 : exproffset+477]
+		t.EarliestConsolidationEpoch = Epoch(binary.LittleEndian.Uint64(buf))
+	}
+	// Field #34 'PendingDeposits' (offset)
+	offset34 := int(binary.LittleEndian.Uint32(buf[exproffset+477 : exproffset+481]))
+	if offset34 < offset27 || offset34 > buflen {
+		return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset34, offset27, buflen), "PendingDeposits:o")
+	}
+	// Field #35 'PendingPartialWithdrawals' (offset)
+	offset35 := int(binary.LittleEndian.Uint32(buf[exproffset+481 : exproffset+485]))
+	if offset35 < offset34 || offset35 > buflen {
+		return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset35, offset34, buflen), "PendingPartialWithdrawals:o")
+	}
+	// Field #36 'PendingConsolidations' (offset)
+	offset36 := int(binary.LittleEndian.Uint32(buf[exproffset+485 : exproffset+489]))
+	if offset36 < offset35 || offset36 > buflen {
+		return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset36, offset35, buflen), "PendingConsolidations:o")
+	}
+	{ // Field #7 'HistoricalRoots' (dynamic)
+		buf := buf[offset7:offset9]
+		val15 := t.HistoricalRoots
+		itemCount := len(buf) / 32
+		if len(buf)%32 != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(len(buf), 32), "HistoricalRoots")
+		}
+		if itemCount > int(expr4) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr4)), "HistoricalRoots")
+		}
+		val15 = sszutils.ExpandSlice(val15, itemCount)
+		sszutils.UnmarshalFixedBytesSlice(val15[:itemCount], buf)
+		t.HistoricalRoots = val15
+	}
+	{ // Field #9 'ETH1DataVotes' (dynamic)
+		buf := buf[offset9:offset11]
+		val16 := t.ETH1DataVotes
+		itemCount := len(buf) / 72
+		if len(buf)%72 != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(len(buf), 72), "ETH1DataVotes")
+		}
+		if itemCount > int(expr5) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr5)), "ETH1DataVotes")
+		}
+		val16 = sszutils.ExpandSlice(val16, itemCount)
+		for idx1 := range itemCount {
+			val17 := val16[idx1]
+			if val17 == nil {
+				val17 = new(ETH1Data)
+			}
+			buf := buf[72*idx1 : 72*(idx1+1)]
+			buflen := len(buf)
+			if buflen < 72 {
+				return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 72), "ETH1DataVotes[%d]", idx1)
+			}
+			if buflen > 72 {
+				return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 72), "ETH1DataVotes[%d]", idx1)
+			}
+			{ // Field #0 'DepositRoot' (static)
+				buf := buf[0:32]
+				copy(val17.DepositRoot[:], buf)
+			}
+			{ // Field #1 'DepositCount' (static)
+				buf := buf[32:40]
+				val17.DepositCount = binary.LittleEndian.Uint64(buf)
+			}
+			{ // Field #2 'BlockHash' (static)
+				buf := buf[40:72]
+				copy(val17.BlockHash[:], buf)
+			}
+			val16[idx1] = val17
+		}
+		t.ETH1DataVotes = val16
+	}
+	{ // Field #11 'Validators' (dynamic)
+		buf := buf[offset11:offset12]
+		val18 := t.Validators
+		itemCount := len(buf) / 121
+		if len(buf)%121 != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(len(buf), 121), "Validators")
+		}
+		if itemCount > int(expr6) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr6)), "Validators")
+		}
+		val18 = sszutils.ExpandSlice(val18, itemCount)
+		for idx1 := range itemCount {
+			val19 := val18[idx1]
+			if val19 == nil {
+				val19 = new(Validator)
+			}
+			buf := buf[121*idx1 : 121*(idx1+1)]
+			buflen := len(buf)
+			if buflen < 121 {
+				return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 121), "Validators[%d]", idx1)
+			}
+			if buflen > 121 {
+				return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 121), "Validators[%d]", idx1)
+			}
+			{ // Field #0 'Pubkey' (static)
+				buf := buf[0:48]
+				copy(val19.Pubkey[:], buf)
+			}
+			{ // Field #1 'WithdrawalCredentials' (static)
+				buf := buf[48:80]
+				copy(val19.WithdrawalCredentials[:], buf)
+			}
+			{ // Field #2 'EffectiveBalance' (static)
+				buf := buf[80:88]
+				val19.EffectiveBalance = Gwei(binary.LittleEndian.Uint64(buf))
+			}
+			{ // Field #3 'Slashed' (static)
+				buf := buf[88:89]
+				if buf[0] != 1 && buf[0] != 0 {
+					return sszutils.ErrInvalidBoolValueFn()
+				}
+				val19.Slashed = buf[0] == 1
+			}
+			{ // Field #4 'ActivationEligibilityEpoch' (static)
+				buf := buf[89:97]
+				val19.ActivationEligibilityEpoch = Epoch(binary.LittleEndian.Uint64(buf))
+			}
+			{ // Field #5 'ActivationEpoch' (static)
+				buf := buf[97:105]
+				val19.ActivationEpoch = Epoch(binary.LittleEndian.Uint64(buf))
+			}
+			{ // Field #6 'ExitEpoch' (static)
+				buf := buf[105:113]
+				val19.ExitEpoch = Epoch(binary.LittleEndian.Uint64(buf))
+			}
+			{ // Field #7 'WithdrawableEpoch' (static)
+				buf := buf[113:121]
+				val19.WithdrawableEpoch = Epoch(binary.LittleEndian.Uint64(buf))
+			}
+			val18[idx1] = val19
+		}
+		t.Validators = val18
+	}
+	{ // Field #12 'Balances' (dynamic)
+		buf := buf[offset12:offset15]
+		val20 := t.Balances
+		itemCount := len(buf) / 8
+		if len(buf)%8 != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(len(buf), 8), "Balances")
+		}
+		if itemCount > int(expr6) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr6)), "Balances")
+		}
+		val20 = sszutils.ExpandSlice(val20, itemCount)
+		sszutils.UnmarshalUint64Slice(val20, buf)
+		t.Balances = val20
+	}
+	{ // Field #15 'PreviousEpochParticipation' (dynamic)
+		buf := buf[offset15:offset16]
+		if len(buf) > int(expr6) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(len(buf), int(expr6)), "PreviousEpochParticipation")
+		}
+		t.PreviousEpochParticipation = sszutils.ExpandSlice(t.PreviousEpochParticipation, len(buf))
+		copy(t.PreviousEpochParticipation[:], buf)
+	}
+	{ // Field #16 'CurrentEpochParticipation' (dynamic)
+		buf := buf[offset16:offset21]
+		if len(buf) > int(expr6) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(len(buf), int(expr6)), "CurrentEpochParticipation")
+		}
+		t.CurrentEpochParticipation = sszutils.ExpandSlice(t.CurrentEpochParticipation, len(buf))
+		copy(t.CurrentEpochParticipation[:], buf)
+	}
+	{ // Field #21 'InactivityScores' (dynamic)
+		buf := buf[offset21:offset24]
+		val21 := t.InactivityScores
+		itemCount := len(buf) / 8
+		if len(buf)%8 != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(len(buf), 8), "InactivityScores")
+		}
+		if itemCount > int(expr6) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr6)), "InactivityScores")
+		}
+		val21 = sszutils.ExpandSlice(val21, itemCount)
+		sszutils.UnmarshalUint64Slice(val21, buf)
+		t.InactivityScores = val21
+	}
+	{ // Field #24 'LatestExecutionPayloadHeader' (dynamic)
+		buf := buf[offset24:offset27]
+		val22 := t.LatestExecutionPayloadHeader
+		if val22 == nil {
+			val22 = new(ExecutionPayloadHeader)
+		}
+		buflen := len(buf)
+		if buflen < 584 {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(buflen, 584), "LatestExecutionPayloadHeader")
+		}
+		{ // Field #0 'ParentHash' (static)
+			buf := buf[0:32]
+			copy(val22.ParentHash[:], buf)
+		}
+		{ // Field #1 'FeeRecipient' (static)
+			buf := buf[32:52]
+			copy(val22.FeeRecipient[:], buf)
+		}
+		{ // Field #2 'StateRoot' (static)
+			buf := buf[52:84]
+			copy(val22.StateRoot[:], buf)
+		}
+		{ // Field #3 'ReceiptsRoot' (static)
+			buf := buf[84:116]
+			copy(val22.ReceiptsRoot[:], buf)
+		}
+		{ // Field #4 'LogsBloom' (static)
+			buf := buf[116:372]
+			copy(val22.LogsBloom[:], buf)
+		}
+		{ // Field #5 'PrevRandao' (static)
+			buf := buf[372:404]
+			copy(val22.PrevRandao[:], buf)
+		}
+		{ // Field #6 'BlockNumber' (static)
+			buf := buf[404:412]
+			val22.BlockNumber = binary.LittleEndian.Uint64(buf)
+		}
+		{ // Field #7 'GasLimit' (static)
+			buf := buf[412:420]
+			val22.GasLimit = binary.LittleEndian.Uint64(buf)
+		}
+		{ // Field #8 'GasUsed' (static)
+			buf := buf[420:428]
+			val22.GasUsed = binary.LittleEndian.Uint64(buf)
+		}
+		{ // Field #9 'Timestamp' (static)
+			buf := buf[428:436]
+			val22.Timestamp = binary.LittleEndian.Uint64(buf)
+		}
+		// Field #10 'ExtraData' (offset)
+		offset10 := int(binary.LittleEndian.Uint32(buf[436:440]))
+		if offset10 != 584 {
+			return sszutils.ErrorWithPath(sszutils.ErrFirstOffsetMismatchFn(offset10, 584), "LatestExecutionPayloadHeader.ExtraData:o")
+		}
+		{ // Field #11 'BaseFeePerGas' (static)
+			buf := buf[440:472]
+			copy(val22.BaseFeePerGas[:], buf)
+		}
+		{ // Field #12 'BlockHash' (static)
+			buf := buf[472:504]
+			copy(val22.BlockHash[:], buf)
+		}
+		{ // Field #13 'TransactionsRoot' (static)
+			buf := buf[504:536]
+			copy(val22.TransactionsRoot[:], buf)
+		}
+		{ // Field #14 'WithdrawalsRoot' (static)
+			buf := buf[536:568]
+			copy(val22.WithdrawalsRoot[:], buf)
+		}
+		{ // Field #15 'BlobGasUsed' (static)
+			buf := buf[568:576]
+			val22.BlobGasUsed = binary.LittleEndian.Uint64(buf)
+		}
+		{ // Field #16 'ExcessBlobGas' (static)
+			buf := buf[576:584]
+			val22.ExcessBlobGas = binary.LittleEndian.Uint64(buf)
+		}
+		{ // Field #10 'ExtraData' (dynamic)
+			buf := buf[offset10:]
+			if len(buf) > int(expr7) {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(len(buf), int(expr7)), "LatestExecutionPayloadHeader.ExtraData")
+			}
+			val22.ExtraData = sszutils.ExpandSlice(val22.ExtraData, len(buf))
+			copy(val22.ExtraData[:], buf)
+		}
+		t.LatestExecutionPayloadHeader = val22
+	}
+	{ // Field #27 'HistoricalSummaries' (dynamic)
+		buf := buf[offset27:offset34]
+		val23 := t.HistoricalSummaries
+		itemCount := len(buf) / 64
+		if len(buf)%64 != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(len(buf), 64), "HistoricalSummaries")
+		}
+		if itemCount > int(expr4) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr4)), "HistoricalSummaries")
+		}
+		val23 = sszutils.ExpandSlice(val23, itemCount)
+		for idx1 := range itemCount {
+			val24 := val23[idx1]
+			if val24 == nil {
+				val24 = new(HistoricalSummary)
+			}
+			buf := buf[64*idx1 : 64*(idx1+1)]
+			buflen := len(buf)
+			if buflen < 64 {
+				return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 64), "HistoricalSummaries[%d]", idx1)
+			}
+			if buflen > 64 {
+				return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 64), "HistoricalSummaries[%d]", idx1)
+			}
+			{ // Field #0 'BlockSummaryRoot' (static)
+				buf := buf[0:32]
+				copy(val24.BlockSummaryRoot[:], buf)
+			}
+			{ // Field #1 'StateSummaryRoot' (static)
+				buf := buf[32:64]
+				copy(val24.StateSummaryRoot[:], buf)
+			}
+			val23[idx1] = val24
+		}
+		t.HistoricalSummaries = val23
+	}
+	{ // Field #34 'PendingDeposits' (dynamic)
+		buf := buf[offset34:offset35]
+		val25 := t.PendingDeposits
+		itemCount := len(buf) / 192
+		if len(buf)%192 != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(len(buf), 192), "PendingDeposits")
+		}
+		if itemCount > int(expr8) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr8)), "PendingDeposits")
+		}
+		val25 = sszutils.ExpandSlice(val25, itemCount)
+		for idx1 := range itemCount {
+			val26 := val25[idx1]
+			if val26 == nil {
+				val26 = new(PendingDeposit)
+			}
+			buf := buf[192*idx1 : 192*(idx1+1)]
+			buflen := len(buf)
+			if buflen < 192 {
+				return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 192), "PendingDeposits[%d]", idx1)
+			}
+			if buflen > 192 {
+				return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 192), "PendingDeposits[%d]", idx1)
+			}
+			{ // Field #0 'Pubkey' (static)
+				buf := buf[0:48]
+				copy(val26.Pubkey[:], buf)
+			}
+			{ // Field #1 'WithdrawalCredentials' (static)
+				buf := buf[48:80]
+				copy(val26.WithdrawalCredentials[:], buf)
+			}
+			{ // Field #2 'Amount' (static)
+				buf := buf[80:88]
+				val26.Amount = Gwei(binary.LittleEndian.Uint64(buf))
+			}
+			{ // Field #3 'Signature' (static)
+				buf := buf[88:184]
+				copy(val26.Signature[:], buf)
+			}
+			{ // Field #4 'Slot' (static)
+				buf := buf[184:192]
+				val26.Slot = Slot(binary.LittleEndian.Uint64(buf))
+			}
+			val25[idx1] = val26
+		}
+		t.PendingDeposits = val25
+	}
+	{ // Field #35 'PendingPartialWithdrawals' (dynamic)
+		buf := buf[offset35:offset36]
+		val27 := t.PendingPartialWithdrawals
+		itemCount := len(buf) / 24
+		if len(buf)%24 != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(len(buf), 24), "PendingPartialWithdrawals")
+		}
+		if itemCount > int(expr9) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr9)), "PendingPartialWithdrawals")
+		}
+		val27 = sszutils.ExpandSlice(val27, itemCount)
+		for idx1 := range itemCount {
+			val28 := val27[idx1]
+			if val28 == nil {
+				val28 = new(PendingPartialWithdrawal)
+			}
+			buf := buf[24*idx1 : 24*(idx1+1)]
+			buflen := len(buf)
+			if buflen < 24 {
+				return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 24), "PendingPartialWithdrawals[%d]", idx1)
+			}
+			if buflen > 24 {
+				return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 24), "PendingPartialWithdrawals[%d]", idx1)
+			}
+			{ // Field #0 'ValidatorIndex' (static)
+				buf := buf[0:8]
+				val28.ValidatorIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf))
+			}
+			{ // Field #1 'Amount' (static)
+				buf := buf[8:16]
+				val28.Amount = Gwei(binary.LittleEndian.Uint64(buf))
+			}
+			{ // Field #2 'WithdrawableEpoch' (static)
+				buf := buf[16:24]
+				val28.WithdrawableEpoch = Epoch(binary.LittleEndian.Uint64(buf))
+			}
+			val27[idx1] = val28
+		}
+		t.PendingPartialWithdrawals = val27
+	}
+	{ // Field #36 'PendingConsolidations' (dynamic)
+		buf := buf[offset36:]
+		val29 := t.PendingConsolidations
+		itemCount := len(buf) / 16
+		if len(buf)%16 != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(len(buf), 16), "PendingConsolidations")
+		}
+		if itemCount > int(expr10) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr10)), "PendingConsolidations")
+		}
+		val29 = sszutils.ExpandSlice(val29, itemCount)
+		for idx1 := range itemCount {
+			val30 := val29[idx1]
+			if val30 == nil {
+				val30 = new(PendingConsolidation)
+			}
+			buf := buf[16*idx1 : 16*(idx1+1)]
+			buflen := len(buf)
+			if buflen < 16 {
+				return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(buflen, 16), "PendingConsolidations[%d]", idx1)
+			}
+			if buflen > 16 {
+				return sszutils.ErrorWithPathf(sszutils.ErrTrailingDataFn(buflen - 16), "PendingConsolidations[%d]", idx1)
+			}
+			{ // Field #0 'SourceIndex' (static)
+				buf := buf[0:8]
+				val30.SourceIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf))
+			}
+			{ // Field #1 'TargetIndex' (static)
+				buf := buf[8:16]
+				val30.TargetIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf))
+			}
+			val29[idx1] = val30
+		}
+		t.PendingConsolidations = val29
+	}
+	return nil
+}
+
+// UnmarshalSSZDecoder unmarshals the *BeaconStateElectra from the given SSZ decoder using dynamic specifications.
+func (t *BeaconStateElectra) UnmarshalSSZDecoder(ds sszutils.DynamicSpecs, dec sszutils.Decoder) (err error) {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SLOTS_PER_HISTORICAL_ROOT", 8192)
+	if err != nil {
+		return err
+	}
+	expr1, err := sszutils.ResolveSpecValueWithDefault(ds, "EPOCHS_PER_HISTORICAL_VECTOR", 65536)
+	if err != nil {
+		return err
+	}
+	expr2, err := sszutils.ResolveSpecValueWithDefault(ds, "EPOCHS_PER_SLASHINGS_VECTOR", 8192)
+	if err != nil {
+		return err
+	}
+	expr3, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE", 512)
+	if err != nil {
+		return err
+	}
+	expr4, err := sszutils.ResolveSpecValueWithDefault(ds, "HISTORICAL_ROOTS_LIMIT", 16777216)
+	if err != nil {
+		return err
+	}
+	expr5, err := sszutils.ResolveSpecValueWithDefault(ds, "EPOCHS_PER_ETH1_VOTING_PERIOD*SLOTS_PER_EPOCH", 2048)
+	if err != nil {
+		return err
+	}
+	expr6, err := sszutils.ResolveSpecValueWithDefault(ds, "VALIDATOR_REGISTRY_LIMIT", 1099511627776)
+	if err != nil {
+		return err
+	}
+	expr7, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_EXTRA_DATA_BYTES", 32)
+	if err != nil {
+		return err
+	}
+	expr8, err := sszutils.ResolveSpecValueWithDefault(ds, "PENDING_DEPOSITS_LIMIT", 134217728)
+	if err != nil {
+		return err
+	}
+	expr9, err := sszutils.ResolveSpecValueWithDefault(ds, "PENDING_PARTIAL_WITHDRAWALS_LIMIT", 134217728)
+	if err != nil {
+		return err
+	}
+	expr10, err := sszutils.ResolveSpecValueWithDefault(ds, "PENDING_CONSOLIDATIONS_LIMIT", 262144)
+	if err != nil {
+		return err
+	}
+	size1 := 32 * int(expr0)
+	size2 := 32 * int(expr1)
+	size3 := 8 * int(expr2)
+	size5 := 48 * int(expr3)
+	size4 := size5 + 48 // size expression for '*SyncCommittee'
+	totalSize := size1+size1+size2+size3+size4+size4+489
+	maxOffset := uint32(dec.GetLength())
+	startPos0 := dec.GetPosition()
+	if maxOffset < uint32(totalSize) {
+		return sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(totalSize))
+	}
+	// Field #0 'GenesisTime' (static)
+	if val, err := dec.DecodeUint64(); err != nil {
+		return sszutils.ErrorWithPath(err, "GenesisTime")
+	} else {
+		t.GenesisTime = val
+	}
+	// Field #1 'GenesisValidatorsRoot' (static)
+	if _, err = dec.DecodeBytes(t.GenesisValidatorsRoot[:32]); err != nil {
+		return err
+	}
+	// Field #2 'Slot' (static)
+	if val, err := dec.DecodeUint64(); err != nil {
+		return sszutils.ErrorWithPath(err, "Slot")
+	} else {
+		t.Slot = Slot(val)
+	}
+	{ // Field #3 'Fork' (static)
+		val1 := t.Fork
+		if val1 == nil {
+			val1 = new(Fork)
+		}
+		maxOffset := uint32(dec.GetLength())
+		if maxOffset < uint32(16) {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(16)), "Fork")
+		}
+		// Field #0 'PreviousVersion' (static)
+		if _, err = dec.DecodeBytes(val1.PreviousVersion[:4]); err != nil {
+			return err
+		}
+		// Field #1 'CurrentVersion' (static)
+		if _, err = dec.DecodeBytes(val1.CurrentVersion[:4]); err != nil {
+			return err
+		}
+		// Field #2 'Epoch' (static)
+		if val, err := dec.DecodeUint64(); err != nil {
+			return sszutils.ErrorWithPath(err, "Fork.Epoch")
+		} else {
+			val1.Epoch = Epoch(val)
+		}
+		t.Fork = val1
+	}
+	{ // Field #4 'LatestBlockHeader' (static)
+		val2 := t.LatestBlockHeader
+		if val2 == nil {
+			val2 = new(BeaconBlockHeader)
+		}
+		maxOffset := uint32(dec.GetLength())
+		if maxOffset < uint32(112) {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(112)), "LatestBlockHeader")
+		}
+		// Field #0 'Slot' (static)
+		if val, err := dec.DecodeUint64(); err != nil {
+			return sszutils.ErrorWithPath(err, "LatestBlockHeader.Slot")
+		} else {
+			val2.Slot = Slot(val)
+		}
+		// Field #1 'ProposerIndex' (static)
+		if val, err := dec.DecodeUint64(); err != nil {
+			return sszutils.ErrorWithPath(err, "LatestBlockHeader.ProposerIndex")
+		} else {
+			val2.ProposerIndex = ValidatorIndex(val)
+		}
+		// Field #2 'ParentRoot' (static)
+		if _, err = dec.DecodeBytes(val2.ParentRoot[:32]); err != nil {
+			return err
+		}
+		// Field #3 'StateRoot' (static)
+		if _, err = dec.DecodeBytes(val2.StateRoot[:32]); err != nil {
+			return err
+		}
+		// Field #4 'BodyRoot' (static)
+		if _, err = dec.DecodeBytes(val2.BodyRoot[:32]); err != nil {
+			return err
+		}
+		t.LatestBlockHeader = val2
+	}
+	{ // Field #5 'BlockRoots' (static)
+		val3 := t.BlockRoots
+		val3 = sszutils.ExpandSlice(val3, int(expr0))
+		startPos1 := dec.GetPosition()
+		for idx1 := range int(expr0) {
+			if _, err = dec.DecodeBytes(val3[idx1][:32]); err != nil {
+				return err
+			}
+			if dec.GetPosition() != startPos1+int(32*(idx1+1)) {
+				return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos1+int(32*(idx1+1))), "BlockRoots[%d]", idx1)
+			}
+		}
+		t.BlockRoots = val3
+	}
+	{ // Field #6 'StateRoots' (static)
+		val4 := t.StateRoots
+		val4 = sszutils.ExpandSlice(val4, int(expr0))
+		startPos2 := dec.GetPosition()
+		for idx1 := range int(expr0) {
+			if _, err = dec.DecodeBytes(val4[idx1][:32]); err != nil {
+				return err
+			}
+			if dec.GetPosition() != startPos2+int(32*(idx1+1)) {
+				return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos2+int(32*(idx1+1))), "StateRoots[%d]", idx1)
+			}
+		}
+		t.StateRoots = val4
+	}
+	// Field #7 'HistoricalRoots' (offset)
+	offset7, err := dec.DecodeOffset()
+	if err != nil {
+		return sszutils.ErrorWithPath(err, "HistoricalRoots")
+	}
+	if offset7 != uint32(totalSize) {
+		return sszutils.ErrorWithPath(sszutils.ErrFirstOffsetMismatchFn(offset7, totalSize), "HistoricalRoots")
+	}
+	{ // Field #8 'ETH1Data' (static)
+		val5 := t.ETH1Data
+		if val5 == nil {
+			val5 = new(ETH1Data)
+		}
+		maxOffset := uint32(dec.GetLength())
+		if maxOffset < uint32(72) {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(72)), "ETH1Data")
+		}
+		// Field #0 'DepositRoot' (static)
+		if _, err = dec.DecodeBytes(val5.DepositRoot[:32]); err != nil {
+			return err
+		}
+		// Field #1 'DepositCount' (static)
+		if val, err := dec.DecodeUint64(); err != nil {
+			return sszutils.ErrorWithPath(err, "ETH1Data.DepositCount")
+		} else {
+			val5.DepositCount = val
+		}
+		// Field #2 'BlockHash' (static)
+		if _, err = dec.DecodeBytes(val5.BlockHash[:32]); err != nil {
+			return err
+		}
+		t.ETH1Data = val5
+	}
+	// Field #9 'ETH1DataVotes' (offset)
+	offset9, err := dec.DecodeOffset()
+	if err != nil {
+		return sszutils.ErrorWithPath(err, "ETH1DataVotes")
+	}
+	if offset9 < offset7 || offset9 > maxOffset {
+		return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset9, offset7, maxOffset), "ETH1DataVotes")
+	}
+	// Field #10 'ETH1DepositIndex' (static)
+	if val, err := dec.DecodeUint64(); err != nil {
+		return sszutils.ErrorWithPath(err, "ETH1DepositIndex")
+	} else {
+		t.ETH1DepositIndex = val
+	}
+	// Field #11 'Validators' (offset)
+	offset11, err := dec.DecodeOffset()
+	if err != nil {
+		return sszutils.ErrorWithPath(err, "Validators")
+	}
+	if offset11 < offset9 || offset11 > maxOffset {
+		return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset11, offset9, maxOffset), "Validators")
+	}
+	// Field #12 'Balances' (offset)
+	offset12, err := dec.DecodeOffset()
+	if err != nil {
+		return sszutils.ErrorWithPath(err, "Balances")
+	}
+	if offset12 < offset11 || offset12 > maxOffset {
+		return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset12, offset11, maxOffset), "Balances")
+	}
+	{ // Field #13 'RANDAOMixes' (static)
+		val6 := t.RANDAOMixes
+		val6 = sszutils.ExpandSlice(val6, int(expr1))
+		startPos3 := dec.GetPosition()
+		for idx1 := range int(expr1) {
+			if _, err = dec.DecodeBytes(val6[idx1][:32]); err != nil {
+				return err
+			}
+			if dec.GetPosition() != startPos3+int(32*(idx1+1)) {
+				return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos3+int(32*(idx1+1))), "RANDAOMixes[%d]", idx1)
+			}
+		}
+		t.RANDAOMixes = val6
+	}
+	{ // Field #14 'Slashings' (static)
+		val7 := t.Slashings
+		val7 = sszutils.ExpandSlice(val7, int(expr2))
+		if err = sszutils.DecodeUint64Slice(dec, val7[:int(expr2)]); err != nil {
+			return sszutils.ErrorWithPath(err, "Slashings")
+		}
+		t.Slashings = val7
+	}
+	// Field #15 'PreviousEpochParticipation' (offset)
+	offset15, err := dec.DecodeOffset()
+	if err != nil {
+		return sszutils.ErrorWithPath(err, "PreviousEpochParticipation")
+	}
+	if offset15 < offset12 || offset15 > maxOffset {
+		return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset15, offset12, maxOffset), "PreviousEpochParticipation")
+	}
+	// Field #16 'CurrentEpochParticipation' (offset)
+	offset16, err := dec.DecodeOffset()
+	if err != nil {
+		return sszutils.ErrorWithPath(err, "CurrentEpochParticipation")
+	}
+	if offset16 < offset15 || offset16 > maxOffset {
+		return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset16, offset15, maxOffset), "CurrentEpochParticipation")
+	}
+	// Field #17 'JustificationBits' (static)
+	t.JustificationBits = sszutils.ExpandSlice(t.JustificationBits, 1)
+	if _, err = dec.DecodeBytes(t.JustificationBits[:1]); err != nil {
+		return err
+	}
+	{ // Field #18 'PreviousJustifiedCheckpoint' (static)
+		val8 := t.PreviousJustifiedCheckpoint
+		if val8 == nil {
+			val8 = new(Checkpoint)
+		}
+		maxOffset := uint32(dec.GetLength())
+		if maxOffset < uint32(40) {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(40)), "PreviousJustifiedCheckpoint")
+		}
+		// Field #0 'Epoch' (static)
+		if val, err := dec.DecodeUint64(); err != nil {
+			return sszutils.ErrorWithPath(err, "PreviousJustifiedCheckpoint.Epoch")
+		} else {
+			val8.Epoch = Epoch(val)
+		}
+		// Field #1 'Root' (static)
+		if _, err = dec.DecodeBytes(val8.Root[:32]); err != nil {
+			return err
+		}
+		t.PreviousJustifiedCheckpoint = val8
+	}
+	{ // Field #19 'CurrentJustifiedCheckpoint' (static)
+		val9 := t.CurrentJustifiedCheckpoint
+		if val9 == nil {
+			val9 = new(Checkpoint)
+		}
+		maxOffset := uint32(dec.GetLength())
+		if maxOffset < uint32(40) {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(40)), "CurrentJustifiedCheckpoint")
+		}
+		// Field #0 'Epoch' (static)
+		if val, err := dec.DecodeUint64(); err != nil {
+			return sszutils.ErrorWithPath(err, "CurrentJustifiedCheckpoint.Epoch")
+		} else {
+			val9.Epoch = Epoch(val)
+		}
+		// Field #1 'Root' (static)
+		if _, err = dec.DecodeBytes(val9.Root[:32]); err != nil {
+			return err
+		}
+		t.CurrentJustifiedCheckpoint = val9
+	}
+	{ // Field #20 'FinalizedCheckpoint' (static)
+		val10 := t.FinalizedCheckpoint
+		if val10 == nil {
+			val10 = new(Checkpoint)
+		}
+		maxOffset := uint32(dec.GetLength())
+		if maxOffset < uint32(40) {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(40)), "FinalizedCheckpoint")
+		}
+		// Field #0 'Epoch' (static)
+		if val, err := dec.DecodeUint64(); err != nil {
+			return sszutils.ErrorWithPath(err, "FinalizedCheckpoint.Epoch")
+		} else {
+			val10.Epoch = Epoch(val)
+		}
+		// Field #1 'Root' (static)
+		if _, err = dec.DecodeBytes(val10.Root[:32]); err != nil {
+			return err
+		}
+		t.FinalizedCheckpoint = val10
+	}
+	// Field #21 'InactivityScores' (offset)
+	offset21, err := dec.DecodeOffset()
+	if err != nil {
+		return sszutils.ErrorWithPath(err, "InactivityScores")
+	}
+	if offset21 < offset16 || offset21 > maxOffset {
+		return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset21, offset16, maxOffset), "InactivityScores")
+	}
+	{ // Field #22 'CurrentSyncCommittee' (static)
+		val11 := t.CurrentSyncCommittee
+		if val11 == nil {
+			val11 = new(SyncCommittee)
+		}
+		totalSize := size5+48
+		maxOffset := uint32(dec.GetLength())
+		if maxOffset < uint32(totalSize) {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(totalSize)), "CurrentSyncCommittee")
+		}
+		{ // Field #0 'Pubkeys' (static)
+			val12 := val11.Pubkeys
+			val12 = sszutils.ExpandSlice(val12, int(expr3))
+			startPos4 := dec.GetPosition()
+			for idx1 := range int(expr3) {
+				if _, err = dec.DecodeBytes(val12[idx1][:48]); err != nil {
+					return err
+				}
+				if dec.GetPosition() != startPos4+int(48*(idx1+1)) {
+					return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos4+int(48*(idx1+1))), "CurrentSyncCommittee.Pubkeys[%d]", idx1)
+				}
+			}
+			val11.Pubkeys = val12
+		}
+		// Field #1 'AggregatePubkey' (static)
+		if _, err = dec.DecodeBytes(val11.AggregatePubkey[:48]); err != nil {
+			return err
+		}
+		t.CurrentSyncCommittee = val11
+	}
+	{ // Field #23 'NextSyncCommittee' (static)
+		val13 := t.NextSyncCommittee
+		if val13 == nil {
+			val13 = new(SyncCommittee)
+		}
+		totalSize := size5+48
+		maxOffset := uint32(dec.GetLength())
+		if maxOffset < uint32(totalSize) {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(totalSize)), "NextSyncCommittee")
+		}
+		{ // Field #0 'Pubkeys' (static)
+			val14 := val13.Pubkeys
+			val14 = sszutils.ExpandSlice(val14, int(expr3))
+			startPos5 := dec.GetPosition()
+			for idx1 := range int(expr3) {
+				if _, err = dec.DecodeBytes(val14[idx1][:48]); err != nil {
+					return err
+				}
+				if dec.GetPosition() != startPos5+int(48*(idx1+1)) {
+					return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos5+int(48*(idx1+1))), "NextSyncCommittee.Pubkeys[%d]", idx1)
+				}
+			}
+			val13.Pubkeys = val14
+		}
+		// Field #1 'AggregatePubkey' (static)
+		if _, err = dec.DecodeBytes(val13.AggregatePubkey[:48]); err != nil {
+			return err
+		}
+		t.NextSyncCommittee = val13
+	}
+	// Field #24 'LatestExecutionPayloadHeader' (offset)
+	offset24, err := dec.DecodeOffset()
+	if err != nil {
+		return sszutils.ErrorWithPath(err, "LatestExecutionPayloadHeader")
+	}
+	if offset24 < offset21 || offset24 > maxOffset {
+		return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset24, offset21, maxOffset), "LatestExecutionPayloadHeader")
+	}
+	// Field #25 'NextWithdrawalIndex' (static)
+	if val, err := dec.DecodeUint64(); err != nil {
+		return sszutils.ErrorWithPath(err, "NextWithdrawalIndex")
+	} else {
+		t.NextWithdrawalIndex = WithdrawalIndex(val)
+	}
+	// Field #26 'NextWithdrawalValidatorIndex' (static)
+	if val, err := dec.DecodeUint64(); err != nil {
+		return sszutils.ErrorWithPath(err, "NextWithdrawalValidatorIndex")
+	} else {
+		t.NextWithdrawalValidatorIndex = ValidatorIndex(val)
+	}
+	// Field #27 'HistoricalSummaries' (offset)
+	offset27, err := dec.DecodeOffset()
+	if err != nil {
+		return sszutils.ErrorWithPath(err, "HistoricalSummaries")
+	}
+	if offset27 < offset24 || offset27 > maxOffset {
+		return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset27, offset24, maxOffset), "HistoricalSummaries")
+	}
+	// Field #28 'DepositRequestsStartIndex' (static)
+	if val, err := dec.DecodeUint64(); err != nil {
+		return sszutils.ErrorWithPath(err, "DepositRequestsStartIndex")
+	} else {
+		t.DepositRequestsStartIndex = val
+	}
+	// Field #29 'DepositBalanceToConsume' (static)
+	if val, err := dec.DecodeUint64(); err != nil {
+		return sszutils.ErrorWithPath(err, "DepositBalanceToConsume")
+	} else {
+		t.DepositBalanceToConsume = Gwei(val)
+	}
+	// Field #30 'ExitBalanceToConsume' (static)
+	if val, err := dec.DecodeUint64(); err != nil {
+		return sszutils.ErrorWithPath(err, "ExitBalanceToConsume")
+	} else {
+		t.ExitBalanceToConsume = Gwei(val)
+	}
+	// Field #31 'EarliestExitEpoch' (static)
+	if val, err := dec.DecodeUint64(); err != nil {
+		return sszutils.ErrorWithPath(err, "EarliestExitEpoch")
+	} else {
+		t.EarliestExitEpoch = Epoch(val)
+	}
+	// Field #32 'ConsolidationBalanceToConsume' (static)
+	if val, err := dec.DecodeUint64(); err != nil {
+		return sszutils.ErrorWithPath(err, "ConsolidationBalanceToConsume")
+	} else {
+		t.ConsolidationBalanceToConsume = Gwei(val)
+	}
+	// Field #33 'EarliestConsolidationEpoch' (static)
+	if val, err := dec.DecodeUint64(); err != nil {
+		return sszutils.ErrorWithPath(err, "EarliestConsolidationEpoch")
+	} else {
+		t.EarliestConsolidationEpoch = Epoch(val)
+	}
+	// Field #34 'PendingDeposits' (offset)
+	offset34, err := dec.DecodeOffset()
+	if err != nil {
+		return sszutils.ErrorWithPath(err, "PendingDeposits")
+	}
+	if offset34 < offset27 || offset34 > maxOffset {
+		return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset34, offset27, maxOffset), "PendingDeposits")
+	}
+	// Field #35 'PendingPartialWithdrawals' (offset)
+	offset35, err := dec.DecodeOffset()
+	if err != nil {
+		return sszutils.ErrorWithPath(err, "PendingPartialWithdrawals")
+	}
+	if offset35 < offset34 || offset35 > maxOffset {
+		return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset35, offset34, maxOffset), "PendingPartialWithdrawals")
+	}
+	// Field #36 'PendingConsolidations' (offset)
+	offset36, err := dec.DecodeOffset()
+	if err != nil {
+		return sszutils.ErrorWithPath(err, "PendingConsolidations")
+	}
+	if offset36 < offset35 || offset36 > maxOffset {
+		return sszutils.ErrorWithPath(sszutils.ErrOffsetOutOfRangeFn(offset36, offset35, maxOffset), "PendingConsolidations")
+	}
+	{ // Field #7 'HistoricalRoots' (dynamic)
+		if dec.GetPosition() != startPos0+int(offset7) {
+			return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos0+int(offset7)), "HistoricalRoots")
+		}
+		dec.PushLimit(int(offset9 - offset7))
+		val15 := t.HistoricalRoots
+		sszLen := dec.GetLength()
+		itemCount := sszLen / 32
+		if sszLen%32 != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(sszLen, 32), "HistoricalRoots")
+		}
+		if itemCount > int(expr4) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr4)), "HistoricalRoots")
+		}
+		val15 = sszutils.ExpandSlice(val15, itemCount)
+		startPos6 := dec.GetPosition()
+		for idx1 := range itemCount {
+			if _, err = dec.DecodeBytes(val15[idx1][:32]); err != nil {
+				return err
+			}
+			if dec.GetPosition() != startPos6+int(32*(idx1+1)) {
+				return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos6+int(32*(idx1+1))), "HistoricalRoots[%d]", idx1)
+			}
+		}
+		if diff := dec.PopLimit(); diff != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "HistoricalRoots")
+		}
+		t.HistoricalRoots = val15
+	}
+	{ // Field #9 'ETH1DataVotes' (dynamic)
+		if dec.GetPosition() != startPos0+int(offset9) {
+			return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos0+int(offset9)), "ETH1DataVotes")
+		}
+		dec.PushLimit(int(offset11 - offset9))
+		val16 := t.ETH1DataVotes
+		sszLen := dec.GetLength()
+		itemCount := sszLen / 72
+		if sszLen%72 != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(sszLen, 72), "ETH1DataVotes")
+		}
+		if itemCount > int(expr5) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr5)), "ETH1DataVotes")
+		}
+		val16 = sszutils.ExpandSlice(val16, itemCount)
+		startPos7 := dec.GetPosition()
+		for idx1 := range itemCount {
+			val17 := val16[idx1]
+			if val17 == nil {
+				val17 = new(ETH1Data)
+			}
+			maxOffset := uint32(dec.GetLength())
+			if maxOffset < uint32(72) {
+				return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(72)), "ETH1DataVotes[%d]", idx1)
+			}
+			// Field #0 'DepositRoot' (static)
+			if _, err = dec.DecodeBytes(val17.DepositRoot[:32]); err != nil {
+				return err
+			}
+			// Field #1 'DepositCount' (static)
+			if val, err := dec.DecodeUint64(); err != nil {
+				return sszutils.ErrorWithPathf(err, "ETH1DataVotes[%d].DepositCount", idx1)
+			} else {
+				val17.DepositCount = val
+			}
+			// Field #2 'BlockHash' (static)
+			if _, err = dec.DecodeBytes(val17.BlockHash[:32]); err != nil {
+				return err
+			}
+			if dec.GetPosition() != startPos7+int(72*(idx1+1)) {
+				return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos7+int(72*(idx1+1))), "ETH1DataVotes[%d]", idx1)
+			}
+			val16[idx1] = val17
+		}
+		if diff := dec.PopLimit(); diff != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "ETH1DataVotes")
+		}
+		t.ETH1DataVotes = val16
+	}
+	{ // Field #11 'Validators' (dynamic)
+		if dec.GetPosition() != startPos0+int(offset11) {
+			return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos0+int(offset11)), "Validators")
+		}
+		dec.PushLimit(int(offset12 - offset11))
+		val18 := t.Validators
+		sszLen := dec.GetLength()
+		itemCount := sszLen / 121
+		if sszLen%121 != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(sszLen, 121), "Validators")
+		}
+		if itemCount > int(expr6) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr6)), "Validators")
+		}
+		val18 = sszutils.ExpandSlice(val18, itemCount)
+		startPos8 := dec.GetPosition()
+		for idx1 := range itemCount {
+			val19 := val18[idx1]
+			if val19 == nil {
+				val19 = new(Validator)
+			}
+			maxOffset := uint32(dec.GetLength())
+			if maxOffset < uint32(121) {
+				return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(121)), "Validators[%d]", idx1)
+			}
+			// Field #0 'Pubkey' (static)
+			if _, err = dec.DecodeBytes(val19.Pubkey[:48]); err != nil {
+				return err
+			}
+			// Field #1 'WithdrawalCredentials' (static)
+			if _, err = dec.DecodeBytes(val19.WithdrawalCredentials[:32]); err != nil {
+				return err
+			}
+			// Field #2 'EffectiveBalance' (static)
+			if val, err := dec.DecodeUint64(); err != nil {
+				return sszutils.ErrorWithPathf(err, "Validators[%d].EffectiveBalance", idx1)
+			} else {
+				val19.EffectiveBalance = Gwei(val)
+			}
+			// Field #3 'Slashed' (static)
+			if val, err := dec.DecodeBool(); err != nil {
+				return sszutils.ErrorWithPathf(err, "Validators[%d].Slashed", idx1)
+			} else {
+				val19.Slashed = val
+			}
+			// Field #4 'ActivationEligibilityEpoch' (static)
+			if val, err := dec.DecodeUint64(); err != nil {
+				return sszutils.ErrorWithPathf(err, "Validators[%d].ActivationEligibilityEpoch", idx1)
+			} else {
+				val19.ActivationEligibilityEpoch = Epoch(val)
+			}
+			// Field #5 'ActivationEpoch' (static)
+			if val, err := dec.DecodeUint64(); err != nil {
+				return sszutils.ErrorWithPathf(err, "Validators[%d].ActivationEpoch", idx1)
+			} else {
+				val19.ActivationEpoch = Epoch(val)
+			}
+			// Field #6 'ExitEpoch' (static)
+			if val, err := dec.DecodeUint64(); err != nil {
+				return sszutils.ErrorWithPathf(err, "Validators[%d].ExitEpoch", idx1)
+			} else {
+				val19.ExitEpoch = Epoch(val)
+			}
+			// Field #7 'WithdrawableEpoch' (static)
+			if val, err := dec.DecodeUint64(); err != nil {
+				return sszutils.ErrorWithPathf(err, "Validators[%d].WithdrawableEpoch", idx1)
+			} else {
+				val19.WithdrawableEpoch = Epoch(val)
+			}
+			if dec.GetPosition() != startPos8+int(121*(idx1+1)) {
+				return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos8+int(121*(idx1+1))), "Validators[%d]", idx1)
+			}
+			val18[idx1] = val19
+		}
+		if diff := dec.PopLimit(); diff != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "Validators")
+		}
+		t.Validators = val18
+	}
+	{ // Field #12 'Balances' (dynamic)
+		if dec.GetPosition() != startPos0+int(offset12) {
+			return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos0+int(offset12)), "Balances")
+		}
+		dec.PushLimit(int(offset15 - offset12))
+		val20 := t.Balances
+		sszLen := dec.GetLength()
+		itemCount := sszLen / 8
+		if sszLen%8 != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(sszLen, 8), "Balances")
+		}
+		if itemCount > int(expr6) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr6)), "Balances")
+		}
+		val20 = sszutils.ExpandSlice(val20, itemCount)
+		if err = sszutils.DecodeUint64Slice(dec, val20); err != nil {
+			return sszutils.ErrorWithPath(err, "Balances")
+		}
+		if diff := dec.PopLimit(); diff != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "Balances")
+		}
+		t.Balances = val20
+	}
+	{ // Field #15 'PreviousEpochParticipation' (dynamic)
+		if dec.GetPosition() != startPos0+int(offset15) {
+			return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos0+int(offset15)), "PreviousEpochParticipation")
+		}
+		dec.PushLimit(int(offset16 - offset15))
+		val21 := t.PreviousEpochParticipation
+		if dec.GetLength() > int(expr6) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(dec.GetLength(), int(expr6)), "PreviousEpochParticipation")
+		}
+		listLen := dec.GetLength()
+		val21 = sszutils.ExpandSlice(val21, listLen)
+		if _, err = dec.DecodeBytes(val21[:listLen]); err != nil {
+			return sszutils.ErrorWithPath(err, "PreviousEpochParticipation")
+		}
+		if diff := dec.PopLimit(); diff != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "PreviousEpochParticipation")
+		}
+		t.PreviousEpochParticipation = val21
+	}
+	{ // Field #16 'CurrentEpochParticipation' (dynamic)
+		if dec.GetPosition() != startPos0+int(offset16) {
+			return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos0+int(offset16)), "CurrentEpochParticipation")
+		}
+		dec.PushLimit(int(offset21 - offset16))
+		val22 := t.CurrentEpochParticipation
+		if dec.GetLength() > int(expr6) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(dec.GetLength(), int(expr6)), "CurrentEpochParticipation")
+		}
+		listLen := dec.GetLength()
+		val22 = sszutils.ExpandSlice(val22, listLen)
+		if _, err = dec.DecodeBytes(val22[:listLen]); err != nil {
+			return sszutils.ErrorWithPath(err, "CurrentEpochParticipation")
+		}
+		if diff := dec.PopLimit(); diff != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "CurrentEpochParticipation")
+		}
+		t.CurrentEpochParticipation = val22
+	}
+	{ // Field #21 'InactivityScores' (dynamic)
+		if dec.GetPosition() != startPos0+int(offset21) {
+			return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos0+int(offset21)), "InactivityScores")
+		}
+		dec.PushLimit(int(offset24 - offset21))
+		val23 := t.InactivityScores
+		sszLen := dec.GetLength()
+		itemCount := sszLen / 8
+		if sszLen%8 != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(sszLen, 8), "InactivityScores")
+		}
+		if itemCount > int(expr6) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr6)), "InactivityScores")
+		}
+		val23 = sszutils.ExpandSlice(val23, itemCount)
+		if err = sszutils.DecodeUint64Slice(dec, val23); err != nil {
+			return sszutils.ErrorWithPath(err, "InactivityScores")
+		}
+		if diff := dec.PopLimit(); diff != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "InactivityScores")
+		}
+		t.InactivityScores = val23
+	}
+	{ // Field #24 'LatestExecutionPayloadHeader' (dynamic)
+		if dec.GetPosition() != startPos0+int(offset24) {
+			return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos0+int(offset24)), "LatestExecutionPayloadHeader")
+		}
+		dec.PushLimit(int(offset27 - offset24))
+		val24 := t.LatestExecutionPayloadHeader
+		if val24 == nil {
+			val24 = new(ExecutionPayloadHeader)
+		}
+		maxOffset := uint32(dec.GetLength())
+		startPos9 := dec.GetPosition()
+		if maxOffset < uint32(584) {
+			return sszutils.ErrorWithPath(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(584)), "LatestExecutionPayloadHeader")
+		}
+		// Field #0 'ParentHash' (static)
+		if _, err = dec.DecodeBytes(val24.ParentHash[:32]); err != nil {
+			return err
+		}
+		// Field #1 'FeeRecipient' (static)
+		if _, err = dec.DecodeBytes(val24.FeeRecipient[:20]); err != nil {
+			return err
+		}
+		// Field #2 'StateRoot' (static)
+		if _, err = dec.DecodeBytes(val24.StateRoot[:32]); err != nil {
+			return err
+		}
+		// Field #3 'ReceiptsRoot' (static)
+		if _, err = dec.DecodeBytes(val24.ReceiptsRoot[:32]); err != nil {
+			return err
+		}
+		// Field #4 'LogsBloom' (static)
+		if _, err = dec.DecodeBytes(val24.LogsBloom[:256]); err != nil {
+			return err
+		}
+		// Field #5 'PrevRandao' (static)
+		if _, err = dec.DecodeBytes(val24.PrevRandao[:32]); err != nil {
+			return err
+		}
+		// Field #6 'BlockNumber' (static)
+		if val, err := dec.DecodeUint64(); err != nil {
+			return sszutils.ErrorWithPath(err, "LatestExecutionPayloadHeader.BlockNumber")
+		} else {
+			val24.BlockNumber = val
+		}
+		// Field #7 'GasLimit' (static)
+		if val, err := dec.DecodeUint64(); err != nil {
+			return sszutils.ErrorWithPath(err, "LatestExecutionPayloadHeader.GasLimit")
+		} else {
+			val24.GasLimit = val
+		}
+		// Field #8 'GasUsed' (static)
+		if val, err := dec.DecodeUint64(); err != nil {
+			return sszutils.ErrorWithPath(err, "LatestExecutionPayloadHeader.GasUsed")
+		} else {
+			val24.GasUsed = val
+		}
+		// Field #9 'Timestamp' (static)
+		if val, err := dec.DecodeUint64(); err != nil {
+			return sszutils.ErrorWithPath(err, "LatestExecutionPayloadHeader.Timestamp")
+		} else {
+			val24.Timestamp = val
+		}
+		// Field #10 'ExtraData' (offset)
+		offset10, err := dec.DecodeOffset()
+		if err != nil {
+			return sszutils.ErrorWithPath(err, "LatestExecutionPayloadHeader.ExtraData")
+		}
+		if offset10 != uint32(584) {
+			return sszutils.ErrorWithPath(sszutils.ErrFirstOffsetMismatchFn(offset10, 584), "LatestExecutionPayloadHeader.ExtraData")
+		}
+		// Field #11 'BaseFeePerGas' (static)
+		if _, err = dec.DecodeBytes(val24.BaseFeePerGas[:32]); err != nil {
+			return err
+		}
+		// Field #12 'BlockHash' (static)
+		if _, err = dec.DecodeBytes(val24.BlockHash[:32]); err != nil {
+			return err
+		}
+		// Field #13 'TransactionsRoot' (static)
+		if _, err = dec.DecodeBytes(val24.TransactionsRoot[:32]); err != nil {
+			return err
+		}
+		// Field #14 'WithdrawalsRoot' (static)
+		if _, err = dec.DecodeBytes(val24.WithdrawalsRoot[:32]); err != nil {
+			return err
+		}
+		// Field #15 'BlobGasUsed' (static)
+		if val, err := dec.DecodeUint64(); err != nil {
+			return sszutils.ErrorWithPath(err, "LatestExecutionPayloadHeader.BlobGasUsed")
+		} else {
+			val24.BlobGasUsed = val
+		}
+		// Field #16 'ExcessBlobGas' (static)
+		if val, err := dec.DecodeUint64(); err != nil {
+			return sszutils.ErrorWithPath(err, "LatestExecutionPayloadHeader.ExcessBlobGas")
+		} else {
+			val24.ExcessBlobGas = val
+		}
+		{ // Field #10 'ExtraData' (dynamic)
+			if dec.GetPosition() != startPos9+int(offset10) {
+				return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos9+int(offset10)), "LatestExecutionPayloadHeader.ExtraData")
+			}
+			dec.PushLimit(int(maxOffset - offset10))
+			val25 := val24.ExtraData
+			if dec.GetLength() > int(expr7) {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(dec.GetLength(), int(expr7)), "LatestExecutionPayloadHeader.ExtraData")
+			}
+			listLen := dec.GetLength()
+			val25 = sszutils.ExpandSlice(val25, listLen)
+			if _, err = dec.DecodeBytes(val25[:listLen]); err != nil {
+				return sszutils.ErrorWithPath(err, "LatestExecutionPayloadHeader.ExtraData")
+			}
+			if diff := dec.PopLimit(); diff != 0 {
+				return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "LatestExecutionPayloadHeader.ExtraData")
+			}
+			val24.ExtraData = val25
+		}
+		if diff := dec.PopLimit(); diff != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "LatestExecutionPayloadHeader")
+		}
+		t.LatestExecutionPayloadHeader = val24
+	}
+	{ // Field #27 'HistoricalSummaries' (dynamic)
+		if dec.GetPosition() != startPos0+int(offset27) {
+			return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos0+int(offset27)), "HistoricalSummaries")
+		}
+		dec.PushLimit(int(offset34 - offset27))
+		val26 := t.HistoricalSummaries
+		sszLen := dec.GetLength()
+		itemCount := sszLen / 64
+		if sszLen%64 != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(sszLen, 64), "HistoricalSummaries")
+		}
+		if itemCount > int(expr4) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr4)), "HistoricalSummaries")
+		}
+		val26 = sszutils.ExpandSlice(val26, itemCount)
+		startPos10 := dec.GetPosition()
+		for idx1 := range itemCount {
+			val27 := val26[idx1]
+			if val27 == nil {
+				val27 = new(HistoricalSummary)
+			}
+			maxOffset := uint32(dec.GetLength())
+			if maxOffset < uint32(64) {
+				return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(64)), "HistoricalSummaries[%d]", idx1)
+			}
+			// Field #0 'BlockSummaryRoot' (static)
+			if _, err = dec.DecodeBytes(val27.BlockSummaryRoot[:32]); err != nil {
+				return err
+			}
+			// Field #1 'StateSummaryRoot' (static)
+			if _, err = dec.DecodeBytes(val27.StateSummaryRoot[:32]); err != nil {
+				return err
+			}
+			if dec.GetPosition() != startPos10+int(64*(idx1+1)) {
+				return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos10+int(64*(idx1+1))), "HistoricalSummaries[%d]", idx1)
+			}
+			val26[idx1] = val27
+		}
+		if diff := dec.PopLimit(); diff != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "HistoricalSummaries")
+		}
+		t.HistoricalSummaries = val26
+	}
+	{ // Field #34 'PendingDeposits' (dynamic)
+		if dec.GetPosition() != startPos0+int(offset34) {
+			return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos0+int(offset34)), "PendingDeposits")
+		}
+		dec.PushLimit(int(offset35 - offset34))
+		val28 := t.PendingDeposits
+		sszLen := dec.GetLength()
+		itemCount := sszLen / 192
+		if sszLen%192 != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(sszLen, 192), "PendingDeposits")
+		}
+		if itemCount > int(expr8) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr8)), "PendingDeposits")
+		}
+		val28 = sszutils.ExpandSlice(val28, itemCount)
+		startPos11 := dec.GetPosition()
+		for idx1 := range itemCount {
+			val29 := val28[idx1]
+			if val29 == nil {
+				val29 = new(PendingDeposit)
+			}
+			maxOffset := uint32(dec.GetLength())
+			if maxOffset < uint32(192) {
+				return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(192)), "PendingDeposits[%d]", idx1)
+			}
+			// Field #0 'Pubkey' (static)
+			if _, err = dec.DecodeBytes(val29.Pubkey[:48]); err != nil {
+				return err
+			}
+			// Field #1 'WithdrawalCredentials' (static)
+			if _, err = dec.DecodeBytes(val29.WithdrawalCredentials[:32]); err != nil {
+				return err
+			}
+			// Field #2 'Amount' (static)
+			if val, err := dec.DecodeUint64(); err != nil {
+				return sszutils.ErrorWithPathf(err, "PendingDeposits[%d].Amount", idx1)
+			} else {
+				val29.Amount = Gwei(val)
+			}
+			// Field #3 'Signature' (static)
+			if _, err = dec.DecodeBytes(val29.Signature[:96]); err != nil {
+				return err
+			}
+			// Field #4 'Slot' (static)
+			if val, err := dec.DecodeUint64(); err != nil {
+				return sszutils.ErrorWithPathf(err, "PendingDeposits[%d].Slot", idx1)
+			} else {
+				val29.Slot = Slot(val)
+			}
+			if dec.GetPosition() != startPos11+int(192*(idx1+1)) {
+				return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos11+int(192*(idx1+1))), "PendingDeposits[%d]", idx1)
+			}
+			val28[idx1] = val29
+		}
+		if diff := dec.PopLimit(); diff != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "PendingDeposits")
+		}
+		t.PendingDeposits = val28
+	}
+	{ // Field #35 'PendingPartialWithdrawals' (dynamic)
+		if dec.GetPosition() != startPos0+int(offset35) {
+			return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos0+int(offset35)), "PendingPartialWithdrawals")
+		}
+		dec.PushLimit(int(offset36 - offset35))
+		val30 := t.PendingPartialWithdrawals
+		sszLen := dec.GetLength()
+		itemCount := sszLen / 24
+		if sszLen%24 != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(sszLen, 24), "PendingPartialWithdrawals")
+		}
+		if itemCount > int(expr9) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr9)), "PendingPartialWithdrawals")
+		}
+		val30 = sszutils.ExpandSlice(val30, itemCount)
+		startPos12 := dec.GetPosition()
+		for idx1 := range itemCount {
+			val31 := val30[idx1]
+			if val31 == nil {
+				val31 = new(PendingPartialWithdrawal)
+			}
+			maxOffset := uint32(dec.GetLength())
+			if maxOffset < uint32(24) {
+				return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(24)), "PendingPartialWithdrawals[%d]", idx1)
+			}
+			// Field #0 'ValidatorIndex' (static)
+			if val, err := dec.DecodeUint64(); err != nil {
+				return sszutils.ErrorWithPathf(err, "PendingPartialWithdrawals[%d].ValidatorIndex", idx1)
+			} else {
+				val31.ValidatorIndex = ValidatorIndex(val)
+			}
+			// Field #1 'Amount' (static)
+			if val, err := dec.DecodeUint64(); err != nil {
+				return sszutils.ErrorWithPathf(err, "PendingPartialWithdrawals[%d].Amount", idx1)
+			} else {
+				val31.Amount = Gwei(val)
+			}
+			// Field #2 'WithdrawableEpoch' (static)
+			if val, err := dec.DecodeUint64(); err != nil {
+				return sszutils.ErrorWithPathf(err, "PendingPartialWithdrawals[%d].WithdrawableEpoch", idx1)
+			} else {
+				val31.WithdrawableEpoch = Epoch(val)
+			}
+			if dec.GetPosition() != startPos12+int(24*(idx1+1)) {
+				return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos12+int(24*(idx1+1))), "PendingPartialWithdrawals[%d]", idx1)
+			}
+			val30[idx1] = val31
+		}
+		if diff := dec.PopLimit(); diff != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "PendingPartialWithdrawals")
+		}
+		t.PendingPartialWithdrawals = val30
+	}
+	{ // Field #36 'PendingConsolidations' (dynamic)
+		if dec.GetPosition() != startPos0+int(offset36) {
+			return sszutils.ErrorWithPath(sszutils.ErrFieldNotConsumedFn(dec.GetPosition(), startPos0+int(offset36)), "PendingConsolidations")
+		}
+		dec.PushLimit(int(maxOffset - offset36))
+		val32 := t.PendingConsolidations
+		sszLen := dec.GetLength()
+		itemCount := sszLen / 16
+		if sszLen%16 != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrListNotAlignedFn(sszLen, 16), "PendingConsolidations")
+		}
+		if itemCount > int(expr10) {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(itemCount, int(expr10)), "PendingConsolidations")
+		}
+		val32 = sszutils.ExpandSlice(val32, itemCount)
+		startPos13 := dec.GetPosition()
+		for idx1 := range itemCount {
+			val33 := val32[idx1]
+			if val33 == nil {
+				val33 = new(PendingConsolidation)
+			}
+			maxOffset := uint32(dec.GetLength())
+			if maxOffset < uint32(16) {
+				return sszutils.ErrorWithPathf(sszutils.ErrFixedFieldsEOFFn(maxOffset, uint32(16)), "PendingConsolidations[%d]", idx1)
+			}
+			// Field #0 'SourceIndex' (static)
+			if val, err := dec.DecodeUint64(); err != nil {
+				return sszutils.ErrorWithPathf(err, "PendingConsolidations[%d].SourceIndex", idx1)
+			} else {
+				val33.SourceIndex = ValidatorIndex(val)
+			}
+			// Field #1 'TargetIndex' (static)
+			if val, err := dec.DecodeUint64(); err != nil {
+				return sszutils.ErrorWithPathf(err, "PendingConsolidations[%d].TargetIndex", idx1)
+			} else {
+				val33.TargetIndex = ValidatorIndex(val)
+			}
+			if dec.GetPosition() != startPos13+int(16*(idx1+1)) {
+				return sszutils.ErrorWithPathf(sszutils.ErrStaticElementNotConsumedFn(dec.GetPosition(), startPos13+int(16*(idx1+1))), "PendingConsolidations[%d]", idx1)
+			}
+			val32[idx1] = val33
+		}
+		if diff := dec.PopLimit(); diff != 0 {
+			return sszutils.ErrorWithPath(sszutils.ErrTrailingDataFn(diff), "PendingConsolidations")
+		}
+		t.PendingConsolidations = val32
+	}
+	return nil
+}
+
+// SizeSSZ returns the SSZ encoded size of the *BeaconStateElectra.
+func (t *BeaconStateElectra) SizeSSZ() (size int) {
+	return t.SizeSSZDyn(dynssz.GetGlobalDynSsz())
+}
+
+// SizeSSZDyn returns the SSZ encoded size of the *BeaconStateElectra using dynamic specifications.
+func (t *BeaconStateElectra) SizeSSZDyn(ds sszutils.DynamicSpecs) (size int) {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SLOTS_PER_HISTORICAL_ROOT", 8192)
+	if err != nil {
+		return 0
+	}
+	expr1, err := sszutils.ResolveSpecValueWithDefault(ds, "EPOCHS_PER_HISTORICAL_VECTOR", 65536)
+	if err != nil {
+		return 0
+	}
+	expr2, err := sszutils.ResolveSpecValueWithDefault(ds, "EPOCHS_PER_SLASHINGS_VECTOR", 8192)
+	if err != nil {
+		return 0
+	}
+	expr3, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE", 512)
+	if err != nil {
+		return 0
+	}
+	if t == nil {
+		t = new(BeaconStateElectra)
+	}
+	// Field #0 'GenesisTime' static (8 bytes)
+	// Field #1 'GenesisValidatorsRoot' static (32 bytes)
+	// Field #2 'Slot' static (8 bytes)
+	// Field #3 'Fork' static (16 bytes)
+	// Field #4 'LatestBlockHeader' static (112 bytes)
+	// Field #7 'HistoricalRoots' offset (4 bytes)
+	// Field #8 'ETH1Data' static (72 bytes)
+	// Field #9 'ETH1DataVotes' offset (4 bytes)
+	// Field #10 'ETH1DepositIndex' static (8 bytes)
+	// Field #11 'Validators' offset (4 bytes)
+	// Field #12 'Balances' offset (4 bytes)
+	// Field #15 'PreviousEpochParticipation' offset (4 bytes)
+	// Field #16 'CurrentEpochParticipation' offset (4 bytes)
+	// Field #17 'JustificationBits' static (1 bytes)
+	// Field #18 'PreviousJustifiedCheckpoint' static (40 bytes)
+	// Field #19 'CurrentJustifiedCheckpoint' static (40 bytes)
+	// Field #20 'FinalizedCheckpoint' static (40 bytes)
+	// Field #21 'InactivityScores' offset (4 bytes)
+	// Field #24 'LatestExecutionPayloadHeader' offset (4 bytes)
+	// Field #25 'NextWithdrawalIndex' static (8 bytes)
+	// Field #26 'NextWithdrawalValidatorIndex' static (8 bytes)
+	// Field #27 'HistoricalSummaries' offset (4 bytes)
+	// Field #28 'DepositRequestsStartIndex' static (8 bytes)
+	// Field #29 'DepositBalanceToConsume' static (8 bytes)
+	// Field #30 'ExitBalanceToConsume' static (8 bytes)
+	// Field #31 'EarliestExitEpoch' static (8 bytes)
+	// Field #32 'ConsolidationBalanceToConsume' static (8 bytes)
+	// Field #33 'EarliestConsolidationEpoch' static (8 bytes)
+	// Field #34 'PendingDeposits' offset (4 bytes)
+	// Field #35 'PendingPartialWithdrawals' offset (4 bytes)
+	// Field #36 'PendingConsolidations' offset (4 bytes)
+	size += 489
+	{ // Field #5 'BlockRoots'
+		size += int(expr0) * 32
+	}
+	{ // Field #6 'StateRoots'
+		size += int(expr0) * 32
+	}
+	{ // Field #13 'RANDAOMixes'
+		size += int(expr1) * 32
+	}
+	{ // Field #14 'Slashings'
+		size += int(expr2) * 8
+	}
+	{ // Field #22 'CurrentSyncCommittee'
+		t := t.CurrentSyncCommittee
+		if t == nil {
+			t = new(SyncCommittee)
+		}
+		// Field #1 'AggregatePubkey' static (48 bytes)
+		size += 48
+		{ // Field #0 'Pubkeys'
+			size += int(expr3) * 48
+		}
+	}
+	{ // Field #23 'NextSyncCommittee'
+		t := t.NextSyncCommittee
+		if t == nil {
+			t = new(SyncCommittee)
+		}
+		// Field #1 'AggregatePubkey' static (48 bytes)
+		size += 48
+		{ // Field #0 'Pubkeys'
+			size += int(expr3) * 48
+		}
+	}
+	{ // Dynamic field #7 'HistoricalRoots'
+		size += len(t.HistoricalRoots) * 32
+	}
+	{ // Dynamic field #9 'ETH1DataVotes'
+		size += len(t.ETH1DataVotes) * 72
+	}
+	{ // Dynamic field #11 'Validators'
+		size += len(t.Validators) * 121
+	}
+	{ // Dynamic field #12 'Balances'
+		size += len(t.Balances) * 8
+	}
+	{ // Dynamic field #15 'PreviousEpochParticipation'
+		size += len(t.PreviousEpochParticipation)
+	}
+	{ // Dynamic field #16 'CurrentEpochParticipation'
+		size += len(t.CurrentEpochParticipation)
+	}
+	{ // Dynamic field #21 'InactivityScores'
+		size += len(t.InactivityScores) * 8
+	}
+	{ // Dynamic field #24 'LatestExecutionPayloadHeader'
+		t := t.LatestExecutionPayloadHeader
+		if t == nil {
+			t = new(ExecutionPayloadHeader)
+		}
+		// Field #0 'ParentHash' static (32 bytes)
+		// Field #1 'FeeRecipient' static (20 bytes)
+		// Field #2 'StateRoot' static (32 bytes)
+		// Field #3 'ReceiptsRoot' static (32 bytes)
+		// Field #4 'LogsBloom' static (256 bytes)
+		// Field #5 'PrevRandao' static (32 bytes)
+		// Field #6 'BlockNumber' static (8 bytes)
+		// Field #7 'GasLimit' static (8 bytes)
+		// Field #8 'GasUsed' static (8 bytes)
+		// Field #9 'Timestamp' static (8 bytes)
+		// Field #10 'ExtraData' offset (4 bytes)
+		// Field #11 'BaseFeePerGas' static (32 bytes)
+		// Field #12 'BlockHash' static (32 bytes)
+		// Field #13 'TransactionsRoot' static (32 bytes)
+		// Field #14 'WithdrawalsRoot' static (32 bytes)
+		// Field #15 'BlobGasUsed' static (8 bytes)
+		// Field #16 'ExcessBlobGas' static (8 bytes)
+		size += 584
+		{ // Dynamic field #10 'ExtraData'
+			size += len(t.ExtraData)
+		}
+	}
+	{ // Dynamic field #27 'HistoricalSummaries'
+		size += len(t.HistoricalSummaries) * 64
+	}
+	{ // Dynamic field #34 'PendingDeposits'
+		size += len(t.PendingDeposits) * 192
+	}
+	{ // Dynamic field #35 'PendingPartialWithdrawals'
+		size += len(t.PendingPartialWithdrawals) * 24
+	}
+	{ // Dynamic field #36 'PendingConsolidations'
+		size += len(t.PendingConsolidations) * 16
+	}
+	return size
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the *BeaconStateElectra.
+func (t *BeaconStateElectra) HashTreeRoot() (root [32]byte, err error) {
+	err = hasher.WithDefaultHasher(func(hh sszutils.HashWalker) (err error) {
+		err = t.HashTreeRootWith(hh)
+		if err == nil {
+			root, err = hh.HashRoot()
+		}
+		return
+	})
+	return
+}
+
+// HashTreeRootWith computes the SSZ hash tree root of the *BeaconStateElectra using the given hash walker.
+func (t *BeaconStateElectra) HashTreeRootWith(hh sszutils.HashWalker) error {
+	return t.HashTreeRootWithDyn(dynssz.GetGlobalDynSsz(), hh)
+}
+
+// HashTreeRootDyn computes the SSZ hash tree root of the *BeaconStateElectra using dynamic specifications.
+func (t *BeaconStateElectra) HashTreeRootDyn(ds sszutils.DynamicSpecs) (root [32]byte, err error) {
+	err = hasher.WithDefaultHasher(func(hh sszutils.HashWalker) (err error) {
+		err = t.HashTreeRootWithDyn(ds, hh)
+		if err == nil {
+			root, err = hh.HashRoot()
+		}
+		return
+	})
+	return
+}
+
+// HashTreeRootWithDyn computes the SSZ hash tree root of the *BeaconStateElectra using dynamic specifications and the given hash walker.
+func (t *BeaconStateElectra) HashTreeRootWithDyn(ds sszutils.DynamicSpecs, hh sszutils.HashWalker) error {
+	expr0, err := sszutils.ResolveSpecValueWithDefault(ds, "SLOTS_PER_HISTORICAL_ROOT", 8192)
+	if err != nil {
+		return err
+	}
+	expr1, err := sszutils.ResolveSpecValueWithDefault(ds, "HISTORICAL_ROOTS_LIMIT", 16777216)
+	if err != nil {
+		return err
+	}
+	expr2, err := sszutils.ResolveSpecValueWithDefault(ds, "EPOCHS_PER_ETH1_VOTING_PERIOD*SLOTS_PER_EPOCH", 2048)
+	if err != nil {
+		return err
+	}
+	expr3, err := sszutils.ResolveSpecValueWithDefault(ds, "VALIDATOR_REGISTRY_LIMIT", 1099511627776)
+	if err != nil {
+		return err
+	}
+	expr4, err := sszutils.ResolveSpecValueWithDefault(ds, "EPOCHS_PER_HISTORICAL_VECTOR", 65536)
+	if err != nil {
+		return err
+	}
+	expr5, err := sszutils.ResolveSpecValueWithDefault(ds, "EPOCHS_PER_SLASHINGS_VECTOR", 8192)
+	if err != nil {
+		return err
+	}
+	expr6, err := sszutils.ResolveSpecValueWithDefault(ds, "SYNC_COMMITTEE_SIZE", 512)
+	if err != nil {
+		return err
+	}
+	expr7, err := sszutils.ResolveSpecValueWithDefault(ds, "MAX_EXTRA_DATA_BYTES", 32)
+	if err != nil {
+		return err
+	}
+	expr8, err := sszutils.ResolveSpecValueWithDefault(ds, "PENDING_DEPOSITS_LIMIT", 134217728)
+	if err != nil {
+		return err
+	}
+	expr9, err := sszutils.ResolveSpecValueWithDefault(ds, "PENDING_PARTIAL_WITHDRAWALS_LIMIT", 134217728)
+	if err != nil {
+		return err
+	}
+	expr10, err := sszutils.ResolveSpecValueWithDefault(ds, "PENDING_CONSOLIDATIONS_LIMIT", 262144)
+	if err != nil {
+		return err
+	}
+	if t == nil {
+		t = new(BeaconStateElectra)
+	}
+	idx := hh.StartTree(sszutils.TreeTypeNone)
+	{ // Field #0 'GenesisTime'
+		hh.PutUint64(t.GenesisTime)
+	}
+	{ // Field #1 'GenesisValidatorsRoot'
+		hh.PutBytes(t.GenesisValidatorsRoot[:32])
+	}
+	{ // Field #2 'Slot'
+		hh.PutUint64(uint64(t.Slot))
+	}
+	{ // Field #3 'Fork'
+		t := t.Fork
+		if t == nil {
+			t = new(Fork)
+		}
+		idx := hh.StartTree(sszutils.TreeTypeNone)
+		{ // Field #0 'PreviousVersion'
+			hh.PutBytes(t.PreviousVersion[:4])
+		}
+		{ // Field #1 'CurrentVersion'
+			hh.PutBytes(t.CurrentVersion[:4])
+		}
+		{ // Field #2 'Epoch'
+			hh.PutUint64(uint64(t.Epoch))
+		}
+		hh.Merkleize(idx)
+	}
+	{ // Field #4 'LatestBlockHeader'
+		t := t.LatestBlockHeader
+		if t == nil {
+			t = new(BeaconBlockHeader)
+		}
+		idx := hh.StartTree(sszutils.TreeTypeNone)
+		{ // Field #0 'Slot'
+			hh.PutUint64(uint64(t.Slot))
+		}
+		{ // Field #1 'ProposerIndex'
+			hh.PutUint64(uint64(t.ProposerIndex))
+		}
+		{ // Field #2 'ParentRoot'
+			hh.PutBytes(t.ParentRoot[:32])
+		}
+		{ // Field #3 'StateRoot'
+			hh.PutBytes(t.StateRoot[:32])
+		}
+		{ // Field #4 'BodyRoot'
+			hh.PutBytes(t.BodyRoot[:32])
+		}
+		hh.Merkleize(idx)
+	}
+	{ // Field #5 'BlockRoots'
+		t := t.BlockRoots
+		vlen := len(t)
+		if vlen > int(expr0) {
+			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr0)), "BlockRoots")
+		}
+		idx := hh.StartTree(sszutils.TreeTypeBinary)
+		var val1 *Root
+		for idx1 := range int(expr0) {
+			if idx1 < vlen {
+				val1 = &t[idx1]
+			} else if idx1 == vlen {
+				val1 = new(Root)
+			}
+			hh.PutBytes(val1[:32])
+			if (idx1+1)%256 == 0 {
+				hh.Collapse()
+			}
+		}
+		hh.Merkleize(idx)
+	}
+	{ // Field #6 'StateRoots'
+		t := t.StateRoots
+		vlen := len(t)
+		if vlen > int(expr0) {
+			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr0)), "StateRoots")
+		}
+		idx := hh.StartTree(sszutils.TreeTypeBinary)
+		var val2 *Root
+		for idx1 := range int(expr0) {
+			if idx1 < vlen {
+				val2 = &t[idx1]
+			} else if idx1 == vlen {
+				val2 = new(Root)
+			}
+			hh.PutBytes(val2[:32])
+			if (idx1+1)%256 == 0 {
+				hh.Collapse()
+			}
+		}
+		hh.Merkleize(idx)
+	}
+	{ // Field #7 'HistoricalRoots'
+		t := t.HistoricalRoots
+		vlen := uint64(len(t))
+		if vlen > expr1 {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, expr1), "HistoricalRoots")
+		}
+		idx := hh.StartTree(sszutils.TreeTypeBinary)
+		for idx1 := range int(vlen) {
+			hh.PutBytes(t[idx1][:32])
+			if (idx1+1)%256 == 0 {
+				hh.Collapse()
+			}
+		}
+		hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr1, vlen, 32))
+	}
+	{ // Field #8 'ETH1Data'
+		t := t.ETH1Data
+		if t == nil {
+			t = new(ETH1Data)
+		}
+		idx := hh.StartTree(sszutils.TreeTypeNone)
+		{ // Field #0 'DepositRoot'
+			hh.PutBytes(t.DepositRoot[:32])
+		}
+		{ // Field #1 'DepositCount'
+			hh.PutUint64(t.DepositCount)
+		}
+		{ // Field #2 'BlockHash'
+			hh.PutBytes(t.BlockHash[:32])
+		}
+		hh.Merkleize(idx)
+	}
+	{ // Field #9 'ETH1DataVotes'
+		t := t.ETH1DataVotes
+		vlen := uint64(len(t))
+		if vlen > expr2 {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, expr2), "ETH1DataVotes")
+		}
+		idx := hh.StartTree(sszutils.TreeTypeBinary)
+		for idx1 := range int(vlen) {
+			t := t[idx1]
+			if t == nil {
+				t = new(ETH1Data)
+			}
+			idx := hh.StartTree(sszutils.TreeTypeNone)
+			{ // Field #0 'DepositRoot'
+				hh.PutBytes(t.DepositRoot[:32])
+			}
+			{ // Field #1 'DepositCount'
+				hh.PutUint64(t.DepositCount)
+			}
+			{ // Field #2 'BlockHash'
+				hh.PutBytes(t.BlockHash[:32])
+			}
+			hh.Merkleize(idx)
+			if (idx1+1)%256 == 0 {
+				hh.Collapse()
+			}
+		}
+		hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr2, vlen, 32))
+	}
+	{ // Field #10 'ETH1DepositIndex'
+		hh.PutUint64(t.ETH1DepositIndex)
+	}
+	{ // Field #11 'Validators'
+		t := t.Validators
+		vlen := uint64(len(t))
+		if vlen > expr3 {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, expr3), "Validators")
+		}
+		idx := hh.StartTree(sszutils.TreeTypeBinary)
+		for idx1 := range int(vlen) {
+			t := t[idx1]
+			if t == nil {
+				t = new(Validator)
+			}
+			idx := hh.StartTree(sszutils.TreeTypeNone)
+			{ // Field #0 'Pubkey'
+				hh.PutBytes(t.Pubkey[:48])
+			}
+			{ // Field #1 'WithdrawalCredentials'
+				hh.PutBytes(t.WithdrawalCredentials[:32])
+			}
+			{ // Field #2 'EffectiveBalance'
+				hh.PutUint64(uint64(t.EffectiveBalance))
+			}
+			{ // Field #3 'Slashed'
+				hh.PutBool(t.Slashed)
+			}
+			{ // Field #4 'ActivationEligibilityEpoch'
+				hh.PutUint64(uint64(t.ActivationEligibilityEpoch))
+			}
+			{ // Field #5 'ActivationEpoch'
+				hh.PutUint64(uint64(t.ActivationEpoch))
+			}
+			{ // Field #6 'ExitEpoch'
+				hh.PutUint64(uint64(t.ExitEpoch))
+			}
+			{ // Field #7 'WithdrawableEpoch'
+				hh.PutUint64(uint64(t.WithdrawableEpoch))
+			}
+			hh.Merkleize(idx)
+			if (idx1+1)%256 == 0 {
+				hh.Collapse()
+			}
+		}
+		hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr3, vlen, 32))
+	}
+	{ // Field #12 'Balances'
+		t := t.Balances
+		vlen := uint64(len(t))
+		if vlen > expr3 {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, expr3), "Balances")
+		}
+		idx := hh.StartTree(sszutils.TreeTypeBinary)
+		sszutils.HashUint64Slice(hh, t)
+		hh.FillUpTo32()
+		hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr3, vlen, 8))
+	}
+	{ // Field #13 'RANDAOMixes'
+		t := t.RANDAOMixes
+		vlen := len(t)
+		if vlen > int(expr4) {
+			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr4)), "RANDAOMixes")
+		}
+		idx := hh.StartTree(sszutils.TreeTypeBinary)
+		var val3 *Root
+		for idx1 := range int(expr4) {
+			if idx1 < vlen {
+				val3 = &t[idx1]
+			} else if idx1 == vlen {
+				val3 = new(Root)
+			}
+			hh.PutBytes(val3[:32])
+			if (idx1+1)%256 == 0 {
+				hh.Collapse()
+			}
+		}
+		hh.Merkleize(idx)
+	}
+	{ // Field #14 'Slashings'
+		t := t.Slashings
+		vlen := len(t)
+		if vlen > int(expr5) {
+			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr5)), "Slashings")
+		}
+		idx := hh.StartTree(sszutils.TreeTypeBinary)
+		var val4, val4Empty Gwei
+		for idx1 := range int(expr5) {
+			if idx1 < vlen {
+				val4 = t[idx1]
+			} else if idx1 == vlen {
+				val4 = val4Empty
+			}
+			hh.AppendUint64(uint64(val4))
+			if (idx1+1)%256 == 0 {
+				hh.Collapse()
+			}
+		}
+		hh.FillUpTo32()
+		hh.Merkleize(idx)
+	}
+	{ // Field #15 'PreviousEpochParticipation'
+		vlen := uint64(len(t.PreviousEpochParticipation))
+		if vlen > expr3 {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, expr3), "PreviousEpochParticipation")
+		}
+		idx := hh.StartTree(sszutils.TreeTypeBinary)
+		hh.AppendBytes32(t.PreviousEpochParticipation[:])
+		hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr3, vlen, 1))
+	}
+	{ // Field #16 'CurrentEpochParticipation'
+		vlen := uint64(len(t.CurrentEpochParticipation))
+		if vlen > expr3 {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, expr3), "CurrentEpochParticipation")
+		}
+		idx := hh.StartTree(sszutils.TreeTypeBinary)
+		hh.AppendBytes32(t.CurrentEpochParticipation[:])
+		hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr3, vlen, 1))
+	}
+	{ // Field #17 'JustificationBits'
+		vlen := len(t.JustificationBits)
+		if vlen > 1 {
+			return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, 1), "JustificationBits")
+		}
+		val := t.JustificationBits[:]
+		if vlen < 1 {
+			val = sszutils.AppendZeroPadding(val, (1-vlen)*1)
+		}
+		hh.PutBytes(val[:1])
+	}
+	{ // Field #18 'PreviousJustifiedCheckpoint'
+		t := t.PreviousJustifiedCheckpoint
+		if t == nil {
+			t = new(Checkpoint)
+		}
+		idx := hh.StartTree(sszutils.TreeTypeNone)
+		{ // Field #0 'Epoch'
+			hh.PutUint64(uint64(t.Epoch))
+		}
+		{ // Field #1 'Root'
+			hh.PutBytes(t.Root[:32])
+		}
+		hh.Merkleize(idx)
+	}
+	{ // Field #19 'CurrentJustifiedCheckpoint'
+		t := t.CurrentJustifiedCheckpoint
+		if t == nil {
+			t = new(Checkpoint)
+		}
+		idx := hh.StartTree(sszutils.TreeTypeNone)
+		{ // Field #0 'Epoch'
+			hh.PutUint64(uint64(t.Epoch))
+		}
+		{ // Field #1 'Root'
+			hh.PutBytes(t.Root[:32])
+		}
+		hh.Merkleize(idx)
+	}
+	{ // Field #20 'FinalizedCheckpoint'
+		t := t.FinalizedCheckpoint
+		if t == nil {
+			t = new(Checkpoint)
+		}
+		idx := hh.StartTree(sszutils.TreeTypeNone)
+		{ // Field #0 'Epoch'
+			hh.PutUint64(uint64(t.Epoch))
+		}
+		{ // Field #1 'Root'
+			hh.PutBytes(t.Root[:32])
+		}
+		hh.Merkleize(idx)
+	}
+	{ // Field #21 'InactivityScores'
+		t := t.InactivityScores
+		vlen := uint64(len(t))
+		if vlen > expr3 {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, expr3), "InactivityScores")
+		}
+		idx := hh.StartTree(sszutils.TreeTypeBinary)
+		sszutils.HashUint64Slice(hh, t)
+		hh.FillUpTo32()
+		hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr3, vlen, 8))
+	}
+	{ // Field #22 'CurrentSyncCommittee'
+		t := t.CurrentSyncCommittee
+		if t == nil {
+			t = new(SyncCommittee)
+		}
+		idx := hh.StartTree(sszutils.TreeTypeNone)
+		{ // Field #0 'Pubkeys'
+			t := t.Pubkeys
+			vlen := len(t)
+			if vlen > int(expr6) {
+				return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr6)), "CurrentSyncCommittee.Pubkeys")
+			}
+			idx := hh.StartTree(sszutils.TreeTypeBinary)
+			var val5 *BLSPubKey
+			for idx1 := range int(expr6) {
+				if idx1 < vlen {
+					val5 = &t[idx1]
+				} else if idx1 == vlen {
+					val5 = new(BLSPubKey)
+				}
+				hh.PutBytes(val5[:48])
+				if (idx1+1)%256 == 0 {
+					hh.Collapse()
+				}
+			}
+			hh.Merkleize(idx)
+		}
+		{ // Field #1 'AggregatePubkey'
+			hh.PutBytes(t.AggregatePubkey[:48])
+		}
+		hh.Merkleize(idx)
+	}
+	{ // Field #23 'NextSyncCommittee'
+		t := t.NextSyncCommittee
+		if t == nil {
+			t = new(SyncCommittee)
+		}
+		idx := hh.StartTree(sszutils.TreeTypeNone)
+		{ // Field #0 'Pubkeys'
+			t := t.Pubkeys
+			vlen := len(t)
+			if vlen > int(expr6) {
+				return sszutils.ErrorWithPath(sszutils.ErrVectorLengthFn(vlen, int(expr6)), "NextSyncCommittee.Pubkeys")
+			}
+			idx := hh.StartTree(sszutils.TreeTypeBinary)
+			var val6 *BLSPubKey
+			for idx1 := range int(expr6) {
+				if idx1 < vlen {
+					val6 = &t[idx1]
+				} else if idx1 == vlen {
+					val6 = new(BLSPubKey)
+				}
+				hh.PutBytes(val6[:48])
+				if (idx1+1)%256 == 0 {
+					hh.Collapse()
+				}
+			}
+			hh.Merkleize(idx)
+		}
+		{ // Field #1 'AggregatePubkey'
+			hh.PutBytes(t.AggregatePubkey[:48])
+		}
+		hh.Merkleize(idx)
+	}
+	{ // Field #24 'LatestExecutionPayloadHeader'
+		t := t.LatestExecutionPayloadHeader
+		if t == nil {
+			t = new(ExecutionPayloadHeader)
+		}
+		idx := hh.StartTree(sszutils.TreeTypeNone)
+		{ // Field #0 'ParentHash'
+			hh.PutBytes(t.ParentHash[:32])
+		}
+		{ // Field #1 'FeeRecipient'
+			hh.PutBytes(t.FeeRecipient[:20])
+		}
+		{ // Field #2 'StateRoot'
+			hh.PutBytes(t.StateRoot[:32])
+		}
+		{ // Field #3 'ReceiptsRoot'
+			hh.PutBytes(t.ReceiptsRoot[:32])
+		}
+		{ // Field #4 'LogsBloom'
+			hh.PutBytes(t.LogsBloom[:256])
+		}
+		{ // Field #5 'PrevRandao'
+			hh.PutBytes(t.PrevRandao[:32])
+		}
+		{ // Field #6 'BlockNumber'
+			hh.PutUint64(t.BlockNumber)
+		}
+		{ // Field #7 'GasLimit'
+			hh.PutUint64(t.GasLimit)
+		}
+		{ // Field #8 'GasUsed'
+			hh.PutUint64(t.GasUsed)
+		}
+		{ // Field #9 'Timestamp'
+			hh.PutUint64(t.Timestamp)
+		}
+		{ // Field #10 'ExtraData'
+			vlen := uint64(len(t.ExtraData))
+			if vlen > expr7 {
+				return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, expr7), "LatestExecutionPayloadHeader.ExtraData")
+			}
+			idx := hh.StartTree(sszutils.TreeTypeBinary)
+			hh.AppendBytes32(t.ExtraData[:])
+			hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr7, vlen, 1))
+		}
+		{ // Field #11 'BaseFeePerGas'
+			hh.PutBytes(t.BaseFeePerGas[:32])
+		}
+		{ // Field #12 'BlockHash'
+			hh.PutBytes(t.BlockHash[:32])
+		}
+		{ // Field #13 'TransactionsRoot'
+			hh.PutBytes(t.TransactionsRoot[:32])
+		}
+		{ // Field #14 'WithdrawalsRoot'
+			hh.PutBytes(t.WithdrawalsRoot[:32])
+		}
+		{ // Field #15 'BlobGasUsed'
+			hh.PutUint64(t.BlobGasUsed)
+		}
+		{ // Field #16 'ExcessBlobGas'
+			hh.PutUint64(t.ExcessBlobGas)
+		}
+		hh.Merkleize(idx)
+	}
+	{ // Field #25 'NextWithdrawalIndex'
+		hh.PutUint64(uint64(t.NextWithdrawalIndex))
+	}
+	{ // Field #26 'NextWithdrawalValidatorIndex'
+		hh.PutUint64(uint64(t.NextWithdrawalValidatorIndex))
+	}
+	{ // Field #27 'HistoricalSummaries'
+		t := t.HistoricalSummaries
+		vlen := uint64(len(t))
+		if vlen > expr1 {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, expr1), "HistoricalSummaries")
+		}
+		idx := hh.StartTree(sszutils.TreeTypeBinary)
+		for idx1 := range int(vlen) {
+			t := t[idx1]
+			if t == nil {
+				t = new(HistoricalSummary)
+			}
+			idx := hh.StartTree(sszutils.TreeTypeNone)
+			{ // Field #0 'BlockSummaryRoot'
+				hh.PutBytes(t.BlockSummaryRoot[:32])
+			}
+			{ // Field #1 'StateSummaryRoot'
+				hh.PutBytes(t.StateSummaryRoot[:32])
+			}
+			hh.Merkleize(idx)
+			if (idx1+1)%256 == 0 {
+				hh.Collapse()
+			}
+		}
+		hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr1, vlen, 32))
+	}
+	{ // Field #28 'DepositRequestsStartIndex'
+		hh.PutUint64(t.DepositRequestsStartIndex)
+	}
+	{ // Field #29 'DepositBalanceToConsume'
+		hh.PutUint64(uint64(t.DepositBalanceToConsume))
+	}
+	{ // Field #30 'ExitBalanceToConsume'
+		hh.PutUint64(uint64(t.ExitBalanceToConsume))
+	}
+	{ // Field #31 'EarliestExitEpoch'
+		hh.PutUint64(uint64(t.EarliestExitEpoch))
+	}
+	{ // Field #32 'ConsolidationBalanceToConsume'
+		hh.PutUint64(uint64(t.ConsolidationBalanceToConsume))
+	}
+	{ // Field #33 'EarliestConsolidationEpoch'
+		hh.PutUint64(uint64(t.EarliestConsolidationEpoch))
+	}
+	{ // Field #34 'PendingDeposits'
+		t := t.PendingDeposits
+		vlen := uint64(len(t))
+		if vlen > expr8 {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, expr8), "PendingDeposits")
+		}
+		idx := hh.StartTree(sszutils.TreeTypeBinary)
+		for idx1 := range int(vlen) {
+			t := t[idx1]
+			if t == nil {
+				t = new(PendingDeposit)
+			}
+			idx := hh.StartTree(sszutils.TreeTypeNone)
+			{ // Field #0 'Pubkey'
+				hh.PutBytes(t.Pubkey[:48])
+			}
+			{ // Field #1 'WithdrawalCredentials'
+				hh.PutBytes(t.WithdrawalCredentials[:32])
+			}
+			{ // Field #2 'Amount'
+				hh.PutUint64(uint64(t.Amount))
+			}
+			{ // Field #3 'Signature'
+				hh.PutBytes(t.Signature[:96])
+			}
+			{ // Field #4 'Slot'
+				hh.PutUint64(uint64(t.Slot))
+			}
+			hh.Merkleize(idx)
+			if (idx1+1)%256 == 0 {
+				hh.Collapse()
+			}
+		}
+		hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr8, vlen, 32))
+	}
+	{ // Field #35 'PendingPartialWithdrawals'
+		t := t.PendingPartialWithdrawals
+		vlen := uint64(len(t))
+		if vlen > expr9 {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, expr9), "PendingPartialWithdrawals")
+		}
+		idx := hh.StartTree(sszutils.TreeTypeBinary)
+		for idx1 := range int(vlen) {
+			t := t[idx1]
+			if t == nil {
+				t = new(PendingPartialWithdrawal)
+			}
+			idx := hh.StartTree(sszutils.TreeTypeNone)
+			{ // Field #0 'ValidatorIndex'
+				hh.PutUint64(uint64(t.ValidatorIndex))
+			}
+			{ // Field #1 'Amount'
+				hh.PutUint64(uint64(t.Amount))
+			}
+			{ // Field #2 'WithdrawableEpoch'
+				hh.PutUint64(uint64(t.WithdrawableEpoch))
+			}
+			hh.Merkleize(idx)
+			if (idx1+1)%256 == 0 {
+				hh.Collapse()
+			}
+		}
+		hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr9, vlen, 32))
+	}
+	{ // Field #36 'PendingConsolidations'
+		t := t.PendingConsolidations
+		vlen := uint64(len(t))
+		if vlen > expr10 {
+			return sszutils.ErrorWithPath(sszutils.ErrListLengthFn(vlen, expr10), "PendingConsolidations")
+		}
+		idx := hh.StartTree(sszutils.TreeTypeBinary)
+		for idx1 := range int(vlen) {
+			t := t[idx1]
+			if t == nil {
+				t = new(PendingConsolidation)
+			}
+			idx := hh.StartTree(sszutils.TreeTypeNone)
+			{ // Field #0 'SourceIndex'
+				hh.PutUint64(uint64(t.SourceIndex))
+			}
+			{ // Field #1 'TargetIndex'
+				hh.PutUint64(uint64(t.TargetIndex))
+			}
+			hh.Merkleize(idx)
+			if (idx1+1)%256 == 0 {
+				hh.Collapse()
+			}
+		}
+		hh.MerkleizeWithMixin(idx, vlen, sszutils.CalculateLimit(expr10, vlen, 32))
+	}
+	hh.Merkleize(idx)
+	return nil
+}
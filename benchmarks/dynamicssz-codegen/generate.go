@@ -1,3 +1,3 @@
 package dynamicssz
 
-//go:generate go run github.com/pk910/dynamic-ssz/dynssz-gen@v1.3.2 -package . -types SignedBeaconBlock,BeaconBlock,BeaconState -output gen_ssz.go -legacy -with-streaming
+//go:generate go run github.com/pk910/dynamic-ssz/dynssz-gen@v1.3.2 -package . -types SignedBeaconBlock,BeaconBlock,BeaconState,BlobSidecar,LightClientHeader,LightClientBootstrap,LightClientUpdate,LightClientFinalityUpdate,LightClientOptimisticUpdate,SignedBeaconBlockElectra,BeaconBlockElectra,BeaconStateElectra -output gen_ssz.go -legacy -with-streaming
@@ -1,13 +1,21 @@
 package dynamicsszreflection
 
 import (
+	"bufio"
 	"bytes"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"io"
 	"os"
+	"runtime"
+	"sync"
 	"testing"
 
+	"github.com/golang/snappy"
 	dynssz "github.com/pk910/dynamic-ssz"
+	"github.com/pk910/dynamic-ssz/hasher"
 	"gopkg.in/yaml.v2"
 )
 
@@ -26,6 +34,51 @@ var (
 	blockMinimalHTR [32]byte
 	stateMinimalHTR [32]byte
 
+	blockElectraMainnetData []byte
+	stateElectraMainnetData []byte
+
+	blockElectraMainnetHTR [32]byte
+	stateElectraMainnetHTR [32]byte
+
+	blockEmptyMainnetData    []byte
+	blockMaxMainnetData      []byte
+	blockBoundaryMainnetData []byte
+	blockTypedMainnetData    []byte
+
+	blockEmptyMainnetHTR    [32]byte
+	blockMaxMainnetHTR      [32]byte
+	blockBoundaryMainnetHTR [32]byte
+	blockTypedMainnetHTR    [32]byte
+
+	attestationMainnetData                 []byte
+	voluntaryExitMainnetData               []byte
+	blsChangeMainnetData                   []byte
+	syncCommitteeMainnetData               []byte
+	executionPayloadMainnetData            []byte
+	validatorMainnetData                   []byte
+	blobSidecarMainnetData                 []byte
+	lightClientBootstrapMainnetData        []byte
+	lightClientUpdateMainnetData           []byte
+	lightClientFinalityUpdateMainnetData   []byte
+	lightClientOptimisticUpdateMainnetData []byte
+
+	attestationMainnetHTR                 [32]byte
+	voluntaryExitMainnetHTR               [32]byte
+	blsChangeMainnetHTR                   [32]byte
+	syncCommitteeMainnetHTR               [32]byte
+	executionPayloadMainnetHTR            [32]byte
+	validatorMainnetHTR                   [32]byte
+	blobSidecarMainnetHTR                 [32]byte
+	lightClientBootstrapMainnetHTR        [32]byte
+	lightClientUpdateMainnetHTR           [32]byte
+	lightClientFinalityUpdateMainnetHTR   [32]byte
+	lightClientOptimisticUpdateMainnetHTR [32]byte
+
+	blockMainnetSnappyFramed []byte
+	blockMainnetSnappyBlock  []byte
+	stateMainnetSnappyFramed []byte
+	stateMainnetSnappyBlock  []byte
+
 	// Dynamic SSZ instance for mainnet (pure reflection, no fastssz)
 	dynSszMainnet *dynssz.DynSsz
 
@@ -34,31 +87,30 @@ var (
 )
 
 func init() {
-	var err error
-
 	// Load test data
-	blockMainnetData, err = os.ReadFile("../../res/block-mainnet.ssz")
-	if err != nil {
-		panic("failed to load block-mainnet.ssz: " + err.Error())
-	}
-	stateMainnetData, err = os.ReadFile("../../res/state-mainnet.ssz")
-	if err != nil {
-		panic("failed to load state-mainnet.ssz: " + err.Error())
-	}
-	blockMinimalData, err = os.ReadFile("../../res/block-minimal.ssz")
-	if err != nil {
-		panic("failed to load block-minimal.ssz: " + err.Error())
-	}
-	stateMinimalData, err = os.ReadFile("../../res/state-minimal.ssz")
-	if err != nil {
-		panic("failed to load state-minimal.ssz: " + err.Error())
-	}
-
-	// Load metadata
-	blockMainnetHTR = loadHTR("../../res/block-mainnet-meta.json")
-	stateMainnetHTR = loadHTR("../../res/state-mainnet-meta.json")
-	blockMinimalHTR = loadHTR("../../res/block-minimal-meta.json")
-	stateMinimalHTR = loadHTR("../../res/state-minimal-meta.json")
+	blockMainnetData, blockMainnetHTR = loadCorpus("block-mainnet")
+	stateMainnetData, stateMainnetHTR = loadCorpus("state-mainnet")
+	blockMinimalData, blockMinimalHTR = loadCorpus("block-minimal")
+	stateMinimalData, stateMinimalHTR = loadCorpus("state-minimal")
+	blockElectraMainnetData, blockElectraMainnetHTR = loadCorpus("block-electra-mainnet")
+	stateElectraMainnetData, stateElectraMainnetHTR = loadCorpus("state-electra-mainnet")
+	blockEmptyMainnetData, blockEmptyMainnetHTR = loadCorpus("block-empty-mainnet")
+	blockMaxMainnetData, blockMaxMainnetHTR = loadCorpus("block-max-mainnet")
+	blockBoundaryMainnetData, blockBoundaryMainnetHTR = loadCorpus("block-boundary-mainnet")
+	blockTypedMainnetData, blockTypedMainnetHTR = loadCorpus("block-typed-mainnet")
+	attestationMainnetData, attestationMainnetHTR = loadCorpus("attestation-mainnet")
+	voluntaryExitMainnetData, voluntaryExitMainnetHTR = loadCorpus("voluntary-exit-mainnet")
+	blsChangeMainnetData, blsChangeMainnetHTR = loadCorpus("bls-change-mainnet")
+	syncCommitteeMainnetData, syncCommitteeMainnetHTR = loadCorpus("sync-committee-mainnet")
+	executionPayloadMainnetData, executionPayloadMainnetHTR = loadCorpus("execution-payload-mainnet")
+	validatorMainnetData, validatorMainnetHTR = loadCorpus("validator-mainnet")
+	blobSidecarMainnetData, blobSidecarMainnetHTR = loadCorpus("blob-sidecar-mainnet")
+	lightClientBootstrapMainnetData, lightClientBootstrapMainnetHTR = loadCorpus("light-client-bootstrap-mainnet")
+	lightClientUpdateMainnetData, lightClientUpdateMainnetHTR = loadCorpus("light-client-update-mainnet")
+	lightClientFinalityUpdateMainnetData, lightClientFinalityUpdateMainnetHTR = loadCorpus("light-client-finality-update-mainnet")
+	lightClientOptimisticUpdateMainnetData, lightClientOptimisticUpdateMainnetHTR = loadCorpus("light-client-optimistic-update-mainnet")
+	blockMainnetSnappyFramed, blockMainnetSnappyBlock = loadSnappy("block-mainnet")
+	stateMainnetSnappyFramed, stateMainnetSnappyBlock = loadSnappy("state-mainnet")
 
 	// Load minimal preset
 	minimalPresetBytes, err := os.ReadFile("minimal-preset.yaml")
@@ -74,6 +126,16 @@ func init() {
 	dynSszMinimal = dynssz.NewDynSsz(minimalSpecs, dynssz.WithNoFastSsz())
 }
 
+// loadCorpus loads res/<name>.ssz together with the expected HTR from
+// res/<name>-meta.json.
+func loadCorpus(name string) ([]byte, [32]byte) {
+	data, err := os.ReadFile("../../res/" + name + ".ssz")
+	if err != nil {
+		panic("failed to load " + name + ".ssz: " + err.Error())
+	}
+	return data, loadHTR("../../res/" + name + "-meta.json")
+}
+
 func loadHTR(path string) [32]byte {
 	data, err := os.ReadFile(path)
 	if err != nil {
@@ -92,6 +154,20 @@ func loadHTR(path string) [32]byte {
 	return htr
 }
 
+// loadSnappy loads the framed (res/<name>.ssz.sz) and block
+// (res/<name>.ssz_snappy) snappy encodings of res/<name>.ssz.
+func loadSnappy(name string) ([]byte, []byte) {
+	framed, err := os.ReadFile("../../res/" + name + ".ssz.sz")
+	if err != nil {
+		panic("failed to load " + name + ".ssz.sz: " + err.Error())
+	}
+	block, err := os.ReadFile("../../res/" + name + ".ssz_snappy")
+	if err != nil {
+		panic("failed to load " + name + ".ssz_snappy: " + err.Error())
+	}
+	return framed, block
+}
+
 type TestWriter struct {
 	data []byte
 }
@@ -192,6 +268,27 @@ func BenchmarkBlockMainnet_MarshalWriter(b *testing.B) {
 	}
 }
 
+func BenchmarkBlockMainnet_MarshalTo(b *testing.B) {
+	block := new(SignedBeaconBlock)
+	if err := dynSszMainnet.UnmarshalSSZ(block, blockMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	buf := make([]byte, 0, len(blockMainnetData))
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = dynSszMainnet.MarshalSSZTo(block, buf[:0])
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, blockMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
 func BenchmarkBlockMainnet_HashTreeRoot(b *testing.B) {
 	block := new(SignedBeaconBlock)
 	if err := dynSszMainnet.UnmarshalSSZ(block, blockMainnetData); err != nil {
@@ -212,6 +309,52 @@ func BenchmarkBlockMainnet_HashTreeRoot(b *testing.B) {
 	}
 }
 
+func BenchmarkBlockMainnet_HashTreeRootWith(b *testing.B) {
+	block := new(SignedBeaconBlock)
+	if err := dynSszMainnet.UnmarshalSSZ(block, blockMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	hh := hasher.FastHasherPool.Get()
+	defer hasher.FastHasherPool.Put(hh)
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		hh.Reset()
+		if err := dynSszMainnet.HashTreeRootWith(block.Message, hh); err != nil {
+			b.Fatal(err)
+		}
+		var err error
+		htr, err = hh.HashRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != blockMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMainnetHTR)
+	}
+}
+
+func BenchmarkBlockMainnet_SizeSSZ(b *testing.B) {
+	block := new(SignedBeaconBlock)
+	if err := dynSszMainnet.UnmarshalSSZ(block, blockMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var size int
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		size, err = dynSszMainnet.SizeSSZ(block)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if size != len(blockMainnetData) {
+		b.Fatalf("size mismatch: got %d, want %d", size, len(blockMainnetData))
+	}
+}
+
 // ========================= STATE MAINNET BENCHMARKS =========================
 
 func BenchmarkStateMainnet_Unmarshal(b *testing.B) {
@@ -295,6 +438,27 @@ func BenchmarkStateMainnet_MarshalWriter(b *testing.B) {
 	}
 }
 
+func BenchmarkStateMainnet_MarshalTo(b *testing.B) {
+	state := new(BeaconState)
+	if err := dynSszMainnet.UnmarshalSSZ(state, stateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	buf := make([]byte, 0, len(stateMainnetData))
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = dynSszMainnet.MarshalSSZTo(state, buf[:0])
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, stateMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
 func BenchmarkStateMainnet_HashTreeRoot(b *testing.B) {
 	state := new(BeaconState)
 	if err := dynSszMainnet.UnmarshalSSZ(state, stateMainnetData); err != nil {
@@ -315,6 +479,52 @@ func BenchmarkStateMainnet_HashTreeRoot(b *testing.B) {
 	}
 }
 
+func BenchmarkStateMainnet_HashTreeRootWith(b *testing.B) {
+	state := new(BeaconState)
+	if err := dynSszMainnet.UnmarshalSSZ(state, stateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	hh := hasher.FastHasherPool.Get()
+	defer hasher.FastHasherPool.Put(hh)
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		hh.Reset()
+		if err := dynSszMainnet.HashTreeRootWith(state, hh); err != nil {
+			b.Fatal(err)
+		}
+		var err error
+		htr, err = hh.HashRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != stateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, stateMainnetHTR)
+	}
+}
+
+func BenchmarkStateMainnet_SizeSSZ(b *testing.B) {
+	state := new(BeaconState)
+	if err := dynSszMainnet.UnmarshalSSZ(state, stateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var size int
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		size, err = dynSszMainnet.SizeSSZ(state)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if size != len(stateMainnetData) {
+		b.Fatalf("size mismatch: got %d, want %d", size, len(stateMainnetData))
+	}
+}
+
 // ========================= BLOCK MINIMAL BENCHMARKS =========================
 
 func BenchmarkBlockMinimal_Unmarshal(b *testing.B) {
@@ -528,3 +738,2660 @@ func BenchmarkStateMinimal_HashTreeRoot(b *testing.B) {
 		b.Fatalf("HTR mismatch: got %x, want %x", htr, stateMinimalHTR)
 	}
 }
+
+// ===================== BLOCK ELECTRA MAINNET BENCHMARKS =====================
+
+func BenchmarkBlockElectraMainnet_Unmarshal(b *testing.B) {
+	var block *SignedBeaconBlockElectra
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlockElectra)
+		if err := dynSszMainnet.UnmarshalSSZ(block, blockElectraMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(block.Message)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != blockElectraMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockElectraMainnetHTR)
+	}
+}
+
+func BenchmarkBlockElectraMainnet_UnmarshalReader(b *testing.B) {
+	var block *SignedBeaconBlockElectra
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlockElectra)
+		reader := bytes.NewReader(blockElectraMainnetData)
+		if err := dynSszMainnet.UnmarshalSSZReader(block, reader, len(blockElectraMainnetData)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(block.Message)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != blockElectraMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockElectraMainnetHTR)
+	}
+}
+
+func BenchmarkBlockElectraMainnet_Marshal(b *testing.B) {
+	block := new(SignedBeaconBlockElectra)
+	if err := dynSszMainnet.UnmarshalSSZ(block, blockElectraMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = dynSszMainnet.MarshalSSZ(block)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, blockElectraMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockElectraMainnet_MarshalWriter(b *testing.B) {
+	block := new(SignedBeaconBlockElectra)
+	if err := dynSszMainnet.UnmarshalSSZ(block, blockElectraMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(blockElectraMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		err := dynSszMainnet.MarshalSSZWriter(block, writer)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, blockElectraMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockElectraMainnet_HashTreeRoot(b *testing.B) {
+	block := new(SignedBeaconBlockElectra)
+	if err := dynSszMainnet.UnmarshalSSZ(block, blockElectraMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = dynSszMainnet.HashTreeRoot(block.Message)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != blockElectraMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockElectraMainnetHTR)
+	}
+}
+
+// ===================== STATE ELECTRA MAINNET BENCHMARKS =====================
+
+func BenchmarkStateElectraMainnet_Unmarshal(b *testing.B) {
+	var state *BeaconStateElectra
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		state = new(BeaconStateElectra)
+		if err := dynSszMainnet.UnmarshalSSZ(state, stateElectraMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(state)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != stateElectraMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, stateElectraMainnetHTR)
+	}
+}
+
+func BenchmarkStateElectraMainnet_UnmarshalReader(b *testing.B) {
+	var state *BeaconStateElectra
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		state = new(BeaconStateElectra)
+		reader := bytes.NewReader(stateElectraMainnetData)
+		if err := dynSszMainnet.UnmarshalSSZReader(state, reader, len(stateElectraMainnetData)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(state)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != stateElectraMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, stateElectraMainnetHTR)
+	}
+}
+
+func BenchmarkStateElectraMainnet_Marshal(b *testing.B) {
+	state := new(BeaconStateElectra)
+	if err := dynSszMainnet.UnmarshalSSZ(state, stateElectraMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = dynSszMainnet.MarshalSSZ(state)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, stateElectraMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkStateElectraMainnet_MarshalWriter(b *testing.B) {
+	state := new(BeaconStateElectra)
+	if err := dynSszMainnet.UnmarshalSSZ(state, stateElectraMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(stateElectraMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		err := dynSszMainnet.MarshalSSZWriter(state, writer)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, stateElectraMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkStateElectraMainnet_HashTreeRoot(b *testing.B) {
+	state := new(BeaconStateElectra)
+	if err := dynSszMainnet.UnmarshalSSZ(state, stateElectraMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = dynSszMainnet.HashTreeRoot(state)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != stateElectraMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, stateElectraMainnetHTR)
+	}
+}
+
+// ====================== SUB-OBJECT MAINNET BENCHMARKS =======================
+
+func BenchmarkAttestationMainnet_Unmarshal(b *testing.B) {
+	var attestation *Attestation
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		attestation = new(Attestation)
+		if err := dynSszMainnet.UnmarshalSSZ(attestation, attestationMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(attestation)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != attestationMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, attestationMainnetHTR)
+	}
+}
+
+func BenchmarkAttestationMainnet_UnmarshalReader(b *testing.B) {
+	var attestation *Attestation
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		attestation = new(Attestation)
+		reader := bytes.NewReader(attestationMainnetData)
+		if err := dynSszMainnet.UnmarshalSSZReader(attestation, reader, len(attestationMainnetData)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(attestation)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != attestationMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, attestationMainnetHTR)
+	}
+}
+
+func BenchmarkAttestationMainnet_Marshal(b *testing.B) {
+	attestation := new(Attestation)
+	if err := dynSszMainnet.UnmarshalSSZ(attestation, attestationMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = dynSszMainnet.MarshalSSZ(attestation)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, attestationMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkAttestationMainnet_MarshalWriter(b *testing.B) {
+	attestation := new(Attestation)
+	if err := dynSszMainnet.UnmarshalSSZ(attestation, attestationMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(attestationMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		err := dynSszMainnet.MarshalSSZWriter(attestation, writer)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, attestationMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkAttestationMainnet_HashTreeRoot(b *testing.B) {
+	attestation := new(Attestation)
+	if err := dynSszMainnet.UnmarshalSSZ(attestation, attestationMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = dynSszMainnet.HashTreeRoot(attestation)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != attestationMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, attestationMainnetHTR)
+	}
+}
+
+func BenchmarkVoluntaryExitMainnet_Unmarshal(b *testing.B) {
+	var exit *SignedVoluntaryExit
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		exit = new(SignedVoluntaryExit)
+		if err := dynSszMainnet.UnmarshalSSZ(exit, voluntaryExitMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(exit)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != voluntaryExitMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, voluntaryExitMainnetHTR)
+	}
+}
+
+func BenchmarkVoluntaryExitMainnet_UnmarshalReader(b *testing.B) {
+	var exit *SignedVoluntaryExit
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		exit = new(SignedVoluntaryExit)
+		reader := bytes.NewReader(voluntaryExitMainnetData)
+		if err := dynSszMainnet.UnmarshalSSZReader(exit, reader, len(voluntaryExitMainnetData)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(exit)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != voluntaryExitMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, voluntaryExitMainnetHTR)
+	}
+}
+
+func BenchmarkVoluntaryExitMainnet_Marshal(b *testing.B) {
+	exit := new(SignedVoluntaryExit)
+	if err := dynSszMainnet.UnmarshalSSZ(exit, voluntaryExitMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = dynSszMainnet.MarshalSSZ(exit)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, voluntaryExitMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkVoluntaryExitMainnet_MarshalWriter(b *testing.B) {
+	exit := new(SignedVoluntaryExit)
+	if err := dynSszMainnet.UnmarshalSSZ(exit, voluntaryExitMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(voluntaryExitMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		err := dynSszMainnet.MarshalSSZWriter(exit, writer)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, voluntaryExitMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkVoluntaryExitMainnet_HashTreeRoot(b *testing.B) {
+	exit := new(SignedVoluntaryExit)
+	if err := dynSszMainnet.UnmarshalSSZ(exit, voluntaryExitMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = dynSszMainnet.HashTreeRoot(exit)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != voluntaryExitMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, voluntaryExitMainnetHTR)
+	}
+}
+
+func BenchmarkBLSChangeMainnet_Unmarshal(b *testing.B) {
+	var change *SignedBLSToExecutionChange
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		change = new(SignedBLSToExecutionChange)
+		if err := dynSszMainnet.UnmarshalSSZ(change, blsChangeMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(change)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != blsChangeMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blsChangeMainnetHTR)
+	}
+}
+
+func BenchmarkBLSChangeMainnet_UnmarshalReader(b *testing.B) {
+	var change *SignedBLSToExecutionChange
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		change = new(SignedBLSToExecutionChange)
+		reader := bytes.NewReader(blsChangeMainnetData)
+		if err := dynSszMainnet.UnmarshalSSZReader(change, reader, len(blsChangeMainnetData)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(change)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != blsChangeMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blsChangeMainnetHTR)
+	}
+}
+
+func BenchmarkBLSChangeMainnet_Marshal(b *testing.B) {
+	change := new(SignedBLSToExecutionChange)
+	if err := dynSszMainnet.UnmarshalSSZ(change, blsChangeMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = dynSszMainnet.MarshalSSZ(change)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, blsChangeMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBLSChangeMainnet_MarshalWriter(b *testing.B) {
+	change := new(SignedBLSToExecutionChange)
+	if err := dynSszMainnet.UnmarshalSSZ(change, blsChangeMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(blsChangeMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		err := dynSszMainnet.MarshalSSZWriter(change, writer)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, blsChangeMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBLSChangeMainnet_HashTreeRoot(b *testing.B) {
+	change := new(SignedBLSToExecutionChange)
+	if err := dynSszMainnet.UnmarshalSSZ(change, blsChangeMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = dynSszMainnet.HashTreeRoot(change)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != blsChangeMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blsChangeMainnetHTR)
+	}
+}
+
+func BenchmarkSyncCommitteeMainnet_Unmarshal(b *testing.B) {
+	var committee *SyncCommittee
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		committee = new(SyncCommittee)
+		if err := dynSszMainnet.UnmarshalSSZ(committee, syncCommitteeMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(committee)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != syncCommitteeMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, syncCommitteeMainnetHTR)
+	}
+}
+
+func BenchmarkSyncCommitteeMainnet_UnmarshalReader(b *testing.B) {
+	var committee *SyncCommittee
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		committee = new(SyncCommittee)
+		reader := bytes.NewReader(syncCommitteeMainnetData)
+		if err := dynSszMainnet.UnmarshalSSZReader(committee, reader, len(syncCommitteeMainnetData)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(committee)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != syncCommitteeMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, syncCommitteeMainnetHTR)
+	}
+}
+
+func BenchmarkSyncCommitteeMainnet_Marshal(b *testing.B) {
+	committee := new(SyncCommittee)
+	if err := dynSszMainnet.UnmarshalSSZ(committee, syncCommitteeMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = dynSszMainnet.MarshalSSZ(committee)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, syncCommitteeMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkSyncCommitteeMainnet_MarshalWriter(b *testing.B) {
+	committee := new(SyncCommittee)
+	if err := dynSszMainnet.UnmarshalSSZ(committee, syncCommitteeMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(syncCommitteeMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		err := dynSszMainnet.MarshalSSZWriter(committee, writer)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, syncCommitteeMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkSyncCommitteeMainnet_HashTreeRoot(b *testing.B) {
+	committee := new(SyncCommittee)
+	if err := dynSszMainnet.UnmarshalSSZ(committee, syncCommitteeMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = dynSszMainnet.HashTreeRoot(committee)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != syncCommitteeMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, syncCommitteeMainnetHTR)
+	}
+}
+
+func BenchmarkExecutionPayloadMainnet_Unmarshal(b *testing.B) {
+	var payload *ExecutionPayload
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		payload = new(ExecutionPayload)
+		if err := dynSszMainnet.UnmarshalSSZ(payload, executionPayloadMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(payload)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != executionPayloadMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, executionPayloadMainnetHTR)
+	}
+}
+
+func BenchmarkExecutionPayloadMainnet_UnmarshalReader(b *testing.B) {
+	var payload *ExecutionPayload
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		payload = new(ExecutionPayload)
+		reader := bytes.NewReader(executionPayloadMainnetData)
+		if err := dynSszMainnet.UnmarshalSSZReader(payload, reader, len(executionPayloadMainnetData)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(payload)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != executionPayloadMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, executionPayloadMainnetHTR)
+	}
+}
+
+func BenchmarkExecutionPayloadMainnet_Marshal(b *testing.B) {
+	payload := new(ExecutionPayload)
+	if err := dynSszMainnet.UnmarshalSSZ(payload, executionPayloadMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = dynSszMainnet.MarshalSSZ(payload)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, executionPayloadMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkExecutionPayloadMainnet_MarshalWriter(b *testing.B) {
+	payload := new(ExecutionPayload)
+	if err := dynSszMainnet.UnmarshalSSZ(payload, executionPayloadMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(executionPayloadMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		err := dynSszMainnet.MarshalSSZWriter(payload, writer)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, executionPayloadMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkExecutionPayloadMainnet_HashTreeRoot(b *testing.B) {
+	payload := new(ExecutionPayload)
+	if err := dynSszMainnet.UnmarshalSSZ(payload, executionPayloadMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = dynSszMainnet.HashTreeRoot(payload)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != executionPayloadMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, executionPayloadMainnetHTR)
+	}
+}
+
+func BenchmarkValidatorMainnet_Unmarshal(b *testing.B) {
+	var validator *Validator
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		validator = new(Validator)
+		if err := dynSszMainnet.UnmarshalSSZ(validator, validatorMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(validator)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != validatorMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, validatorMainnetHTR)
+	}
+}
+
+func BenchmarkValidatorMainnet_UnmarshalReader(b *testing.B) {
+	var validator *Validator
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		validator = new(Validator)
+		reader := bytes.NewReader(validatorMainnetData)
+		if err := dynSszMainnet.UnmarshalSSZReader(validator, reader, len(validatorMainnetData)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(validator)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != validatorMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, validatorMainnetHTR)
+	}
+}
+
+func BenchmarkValidatorMainnet_Marshal(b *testing.B) {
+	validator := new(Validator)
+	if err := dynSszMainnet.UnmarshalSSZ(validator, validatorMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = dynSszMainnet.MarshalSSZ(validator)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, validatorMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkValidatorMainnet_MarshalWriter(b *testing.B) {
+	validator := new(Validator)
+	if err := dynSszMainnet.UnmarshalSSZ(validator, validatorMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(validatorMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		err := dynSszMainnet.MarshalSSZWriter(validator, writer)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, validatorMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkValidatorMainnet_HashTreeRoot(b *testing.B) {
+	validator := new(Validator)
+	if err := dynSszMainnet.UnmarshalSSZ(validator, validatorMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = dynSszMainnet.HashTreeRoot(validator)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != validatorMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, validatorMainnetHTR)
+	}
+}
+
+func BenchmarkBlobSidecarMainnet_Unmarshal(b *testing.B) {
+	var sidecar *BlobSidecar
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sidecar = new(BlobSidecar)
+		if err := dynSszMainnet.UnmarshalSSZ(sidecar, blobSidecarMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(sidecar)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != blobSidecarMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blobSidecarMainnetHTR)
+	}
+}
+
+func BenchmarkBlobSidecarMainnet_UnmarshalReader(b *testing.B) {
+	var sidecar *BlobSidecar
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sidecar = new(BlobSidecar)
+		reader := bytes.NewReader(blobSidecarMainnetData)
+		if err := dynSszMainnet.UnmarshalSSZReader(sidecar, reader, len(blobSidecarMainnetData)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(sidecar)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != blobSidecarMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blobSidecarMainnetHTR)
+	}
+}
+
+func BenchmarkBlobSidecarMainnet_Marshal(b *testing.B) {
+	sidecar := new(BlobSidecar)
+	if err := dynSszMainnet.UnmarshalSSZ(sidecar, blobSidecarMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = dynSszMainnet.MarshalSSZ(sidecar)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, blobSidecarMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlobSidecarMainnet_MarshalWriter(b *testing.B) {
+	sidecar := new(BlobSidecar)
+	if err := dynSszMainnet.UnmarshalSSZ(sidecar, blobSidecarMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(blobSidecarMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		err := dynSszMainnet.MarshalSSZWriter(sidecar, writer)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, blobSidecarMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlobSidecarMainnet_HashTreeRoot(b *testing.B) {
+	sidecar := new(BlobSidecar)
+	if err := dynSszMainnet.UnmarshalSSZ(sidecar, blobSidecarMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = dynSszMainnet.HashTreeRoot(sidecar)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != blobSidecarMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blobSidecarMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientBootstrapMainnet_Unmarshal(b *testing.B) {
+	var bootstrap *LightClientBootstrap
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bootstrap = new(LightClientBootstrap)
+		if err := dynSszMainnet.UnmarshalSSZ(bootstrap, lightClientBootstrapMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(bootstrap)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != lightClientBootstrapMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientBootstrapMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientBootstrapMainnet_UnmarshalReader(b *testing.B) {
+	var bootstrap *LightClientBootstrap
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bootstrap = new(LightClientBootstrap)
+		reader := bytes.NewReader(lightClientBootstrapMainnetData)
+		if err := dynSszMainnet.UnmarshalSSZReader(bootstrap, reader, len(lightClientBootstrapMainnetData)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(bootstrap)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != lightClientBootstrapMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientBootstrapMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientBootstrapMainnet_Marshal(b *testing.B) {
+	bootstrap := new(LightClientBootstrap)
+	if err := dynSszMainnet.UnmarshalSSZ(bootstrap, lightClientBootstrapMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = dynSszMainnet.MarshalSSZ(bootstrap)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, lightClientBootstrapMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkLightClientBootstrapMainnet_MarshalWriter(b *testing.B) {
+	bootstrap := new(LightClientBootstrap)
+	if err := dynSszMainnet.UnmarshalSSZ(bootstrap, lightClientBootstrapMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(lightClientBootstrapMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		err := dynSszMainnet.MarshalSSZWriter(bootstrap, writer)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, lightClientBootstrapMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkLightClientBootstrapMainnet_HashTreeRoot(b *testing.B) {
+	bootstrap := new(LightClientBootstrap)
+	if err := dynSszMainnet.UnmarshalSSZ(bootstrap, lightClientBootstrapMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = dynSszMainnet.HashTreeRoot(bootstrap)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != lightClientBootstrapMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientBootstrapMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientUpdateMainnet_Unmarshal(b *testing.B) {
+	var update *LightClientUpdate
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		update = new(LightClientUpdate)
+		if err := dynSszMainnet.UnmarshalSSZ(update, lightClientUpdateMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(update)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != lightClientUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientUpdateMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientUpdateMainnet_UnmarshalReader(b *testing.B) {
+	var update *LightClientUpdate
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		update = new(LightClientUpdate)
+		reader := bytes.NewReader(lightClientUpdateMainnetData)
+		if err := dynSszMainnet.UnmarshalSSZReader(update, reader, len(lightClientUpdateMainnetData)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(update)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != lightClientUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientUpdateMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientUpdateMainnet_Marshal(b *testing.B) {
+	update := new(LightClientUpdate)
+	if err := dynSszMainnet.UnmarshalSSZ(update, lightClientUpdateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = dynSszMainnet.MarshalSSZ(update)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, lightClientUpdateMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkLightClientUpdateMainnet_MarshalWriter(b *testing.B) {
+	update := new(LightClientUpdate)
+	if err := dynSszMainnet.UnmarshalSSZ(update, lightClientUpdateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(lightClientUpdateMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		err := dynSszMainnet.MarshalSSZWriter(update, writer)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, lightClientUpdateMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkLightClientUpdateMainnet_HashTreeRoot(b *testing.B) {
+	update := new(LightClientUpdate)
+	if err := dynSszMainnet.UnmarshalSSZ(update, lightClientUpdateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = dynSszMainnet.HashTreeRoot(update)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != lightClientUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientUpdateMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientFinalityUpdateMainnet_Unmarshal(b *testing.B) {
+	var update *LightClientFinalityUpdate
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		update = new(LightClientFinalityUpdate)
+		if err := dynSszMainnet.UnmarshalSSZ(update, lightClientFinalityUpdateMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(update)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != lightClientFinalityUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientFinalityUpdateMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientFinalityUpdateMainnet_UnmarshalReader(b *testing.B) {
+	var update *LightClientFinalityUpdate
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		update = new(LightClientFinalityUpdate)
+		reader := bytes.NewReader(lightClientFinalityUpdateMainnetData)
+		if err := dynSszMainnet.UnmarshalSSZReader(update, reader, len(lightClientFinalityUpdateMainnetData)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(update)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != lightClientFinalityUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientFinalityUpdateMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientFinalityUpdateMainnet_Marshal(b *testing.B) {
+	update := new(LightClientFinalityUpdate)
+	if err := dynSszMainnet.UnmarshalSSZ(update, lightClientFinalityUpdateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = dynSszMainnet.MarshalSSZ(update)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, lightClientFinalityUpdateMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkLightClientFinalityUpdateMainnet_MarshalWriter(b *testing.B) {
+	update := new(LightClientFinalityUpdate)
+	if err := dynSszMainnet.UnmarshalSSZ(update, lightClientFinalityUpdateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(lightClientFinalityUpdateMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		err := dynSszMainnet.MarshalSSZWriter(update, writer)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, lightClientFinalityUpdateMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkLightClientFinalityUpdateMainnet_HashTreeRoot(b *testing.B) {
+	update := new(LightClientFinalityUpdate)
+	if err := dynSszMainnet.UnmarshalSSZ(update, lightClientFinalityUpdateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = dynSszMainnet.HashTreeRoot(update)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != lightClientFinalityUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientFinalityUpdateMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientOptimisticUpdateMainnet_Unmarshal(b *testing.B) {
+	var update *LightClientOptimisticUpdate
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		update = new(LightClientOptimisticUpdate)
+		if err := dynSszMainnet.UnmarshalSSZ(update, lightClientOptimisticUpdateMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(update)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != lightClientOptimisticUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientOptimisticUpdateMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientOptimisticUpdateMainnet_UnmarshalReader(b *testing.B) {
+	var update *LightClientOptimisticUpdate
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		update = new(LightClientOptimisticUpdate)
+		reader := bytes.NewReader(lightClientOptimisticUpdateMainnetData)
+		if err := dynSszMainnet.UnmarshalSSZReader(update, reader, len(lightClientOptimisticUpdateMainnetData)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(update)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != lightClientOptimisticUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientOptimisticUpdateMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientOptimisticUpdateMainnet_Marshal(b *testing.B) {
+	update := new(LightClientOptimisticUpdate)
+	if err := dynSszMainnet.UnmarshalSSZ(update, lightClientOptimisticUpdateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = dynSszMainnet.MarshalSSZ(update)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, lightClientOptimisticUpdateMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkLightClientOptimisticUpdateMainnet_MarshalWriter(b *testing.B) {
+	update := new(LightClientOptimisticUpdate)
+	if err := dynSszMainnet.UnmarshalSSZ(update, lightClientOptimisticUpdateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(lightClientOptimisticUpdateMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		err := dynSszMainnet.MarshalSSZWriter(update, writer)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, lightClientOptimisticUpdateMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkLightClientOptimisticUpdateMainnet_HashTreeRoot(b *testing.B) {
+	update := new(LightClientOptimisticUpdate)
+	if err := dynSszMainnet.UnmarshalSSZ(update, lightClientOptimisticUpdateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = dynSszMainnet.HashTreeRoot(update)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != lightClientOptimisticUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientOptimisticUpdateMainnetHTR)
+	}
+}
+
+// ====================== BLOCK EDGE-CASE BENCHMARKS =======================
+
+func BenchmarkBlockEmptyMainnet_Unmarshal(b *testing.B) {
+	var block *SignedBeaconBlock
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlock)
+		if err := dynSszMainnet.UnmarshalSSZ(block, blockEmptyMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(block.Message)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != blockEmptyMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockEmptyMainnetHTR)
+	}
+}
+
+func BenchmarkBlockEmptyMainnet_UnmarshalReader(b *testing.B) {
+	var block *SignedBeaconBlock
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlock)
+		reader := bytes.NewReader(blockEmptyMainnetData)
+		if err := dynSszMainnet.UnmarshalSSZReader(block, reader, len(blockEmptyMainnetData)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(block.Message)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != blockEmptyMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockEmptyMainnetHTR)
+	}
+}
+
+func BenchmarkBlockEmptyMainnet_Marshal(b *testing.B) {
+	block := new(SignedBeaconBlock)
+	if err := dynSszMainnet.UnmarshalSSZ(block, blockEmptyMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = dynSszMainnet.MarshalSSZ(block)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, blockEmptyMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockEmptyMainnet_MarshalWriter(b *testing.B) {
+	block := new(SignedBeaconBlock)
+	if err := dynSszMainnet.UnmarshalSSZ(block, blockEmptyMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(blockEmptyMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		err := dynSszMainnet.MarshalSSZWriter(block, writer)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, blockEmptyMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockEmptyMainnet_HashTreeRoot(b *testing.B) {
+	block := new(SignedBeaconBlock)
+	if err := dynSszMainnet.UnmarshalSSZ(block, blockEmptyMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = dynSszMainnet.HashTreeRoot(block.Message)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != blockEmptyMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockEmptyMainnetHTR)
+	}
+}
+
+func BenchmarkBlockMaxMainnet_Unmarshal(b *testing.B) {
+	var block *SignedBeaconBlock
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlock)
+		if err := dynSszMainnet.UnmarshalSSZ(block, blockMaxMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(block.Message)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != blockMaxMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMaxMainnetHTR)
+	}
+}
+
+func BenchmarkBlockMaxMainnet_UnmarshalReader(b *testing.B) {
+	var block *SignedBeaconBlock
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlock)
+		reader := bytes.NewReader(blockMaxMainnetData)
+		if err := dynSszMainnet.UnmarshalSSZReader(block, reader, len(blockMaxMainnetData)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(block.Message)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != blockMaxMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMaxMainnetHTR)
+	}
+}
+
+func BenchmarkBlockMaxMainnet_Marshal(b *testing.B) {
+	block := new(SignedBeaconBlock)
+	if err := dynSszMainnet.UnmarshalSSZ(block, blockMaxMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = dynSszMainnet.MarshalSSZ(block)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, blockMaxMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockMaxMainnet_MarshalWriter(b *testing.B) {
+	block := new(SignedBeaconBlock)
+	if err := dynSszMainnet.UnmarshalSSZ(block, blockMaxMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(blockMaxMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		err := dynSszMainnet.MarshalSSZWriter(block, writer)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, blockMaxMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockMaxMainnet_HashTreeRoot(b *testing.B) {
+	block := new(SignedBeaconBlock)
+	if err := dynSszMainnet.UnmarshalSSZ(block, blockMaxMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = dynSszMainnet.HashTreeRoot(block.Message)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != blockMaxMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMaxMainnetHTR)
+	}
+}
+
+func BenchmarkBlockBoundaryMainnet_Unmarshal(b *testing.B) {
+	var block *SignedBeaconBlock
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlock)
+		if err := dynSszMainnet.UnmarshalSSZ(block, blockBoundaryMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(block.Message)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != blockBoundaryMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockBoundaryMainnetHTR)
+	}
+}
+
+func BenchmarkBlockBoundaryMainnet_UnmarshalReader(b *testing.B) {
+	var block *SignedBeaconBlock
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlock)
+		reader := bytes.NewReader(blockBoundaryMainnetData)
+		if err := dynSszMainnet.UnmarshalSSZReader(block, reader, len(blockBoundaryMainnetData)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(block.Message)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != blockBoundaryMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockBoundaryMainnetHTR)
+	}
+}
+
+func BenchmarkBlockBoundaryMainnet_Marshal(b *testing.B) {
+	block := new(SignedBeaconBlock)
+	if err := dynSszMainnet.UnmarshalSSZ(block, blockBoundaryMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = dynSszMainnet.MarshalSSZ(block)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, blockBoundaryMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockBoundaryMainnet_MarshalWriter(b *testing.B) {
+	block := new(SignedBeaconBlock)
+	if err := dynSszMainnet.UnmarshalSSZ(block, blockBoundaryMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(blockBoundaryMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		err := dynSszMainnet.MarshalSSZWriter(block, writer)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, blockBoundaryMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockBoundaryMainnet_HashTreeRoot(b *testing.B) {
+	block := new(SignedBeaconBlock)
+	if err := dynSszMainnet.UnmarshalSSZ(block, blockBoundaryMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = dynSszMainnet.HashTreeRoot(block.Message)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != blockBoundaryMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockBoundaryMainnetHTR)
+	}
+}
+
+func BenchmarkBlockTypedMainnet_Unmarshal(b *testing.B) {
+	var block *SignedBeaconBlock
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlock)
+		if err := dynSszMainnet.UnmarshalSSZ(block, blockTypedMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(block.Message)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != blockTypedMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockTypedMainnetHTR)
+	}
+}
+
+func BenchmarkBlockTypedMainnet_UnmarshalReader(b *testing.B) {
+	var block *SignedBeaconBlock
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlock)
+		reader := bytes.NewReader(blockTypedMainnetData)
+		if err := dynSszMainnet.UnmarshalSSZReader(block, reader, len(blockTypedMainnetData)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(block.Message)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != blockTypedMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockTypedMainnetHTR)
+	}
+}
+
+func BenchmarkBlockTypedMainnet_Marshal(b *testing.B) {
+	block := new(SignedBeaconBlock)
+	if err := dynSszMainnet.UnmarshalSSZ(block, blockTypedMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = dynSszMainnet.MarshalSSZ(block)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, blockTypedMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockTypedMainnet_MarshalWriter(b *testing.B) {
+	block := new(SignedBeaconBlock)
+	if err := dynSszMainnet.UnmarshalSSZ(block, blockTypedMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(blockTypedMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		err := dynSszMainnet.MarshalSSZWriter(block, writer)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, blockTypedMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockTypedMainnet_HashTreeRoot(b *testing.B) {
+	block := new(SignedBeaconBlock)
+	if err := dynSszMainnet.UnmarshalSSZ(block, blockTypedMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = dynSszMainnet.HashTreeRoot(block.Message)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != blockTypedMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockTypedMainnetHTR)
+	}
+}
+
+// ======================= SNAPPY PIPELINE BENCHMARKS =======================
+//
+// Decompress+unmarshal and marshal+compress end-to-end, for the framed snappy
+// encoding of req/resp (_Snappy*) and the block encoding of gossip
+// (_SnappyBlock*). The framed variants decode from and encode to the snappy
+// stream directly (UnmarshalSSZReader / MarshalSSZWriter).
+
+// checkSnappyFramed decompresses a framed snappy stream and compares it with
+// the uncompressed corpus.
+func checkSnappyFramed(b *testing.B, framed []byte, want []byte) {
+	data, err := io.ReadAll(snappy.NewReader(bytes.NewReader(framed)))
+	if err != nil {
+		b.Fatal(err)
+	}
+	if !bytes.Equal(data, want) {
+		b.Fatal("decompressed data does not match original")
+	}
+}
+
+// checkSnappyBlock decompresses a snappy block and compares it with the
+// uncompressed corpus.
+func checkSnappyBlock(b *testing.B, block []byte, want []byte) {
+	data, err := snappy.Decode(nil, block)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if !bytes.Equal(data, want) {
+		b.Fatal("decompressed data does not match original")
+	}
+}
+
+func BenchmarkBlockMainnet_SnappyUnmarshal(b *testing.B) {
+	var block *SignedBeaconBlock
+	reader := snappy.NewReader(nil)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlock)
+		reader.Reset(bytes.NewReader(blockMainnetSnappyFramed))
+		if err := dynSszMainnet.UnmarshalSSZReader(block, reader, len(blockMainnetData)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(block.Message)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != blockMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMainnetHTR)
+	}
+}
+
+func BenchmarkBlockMainnet_SnappyMarshal(b *testing.B) {
+	block := new(SignedBeaconBlock)
+	if err := dynSszMainnet.UnmarshalSSZ(block, blockMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var buf bytes.Buffer
+	writer := snappy.NewBufferedWriter(nil)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+		writer.Reset(&buf)
+		if err := dynSszMainnet.MarshalSSZWriter(block, writer); err != nil {
+			b.Fatal(err)
+		}
+		if err := writer.Close(); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	checkSnappyFramed(b, buf.Bytes(), blockMainnetData)
+}
+
+func BenchmarkBlockMainnet_SnappyBlockUnmarshal(b *testing.B) {
+	var block *SignedBeaconBlock
+	buf := make([]byte, len(blockMainnetData))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlock)
+		data, err := snappy.Decode(buf, blockMainnetSnappyBlock)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if err := dynSszMainnet.UnmarshalSSZ(block, data); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(block.Message)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != blockMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMainnetHTR)
+	}
+}
+
+func BenchmarkBlockMainnet_SnappyBlockMarshal(b *testing.B) {
+	block := new(SignedBeaconBlock)
+	if err := dynSszMainnet.UnmarshalSSZ(block, blockMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	buf := make([]byte, snappy.MaxEncodedLen(len(blockMainnetData)))
+	var compressed []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		data, err := dynSszMainnet.MarshalSSZ(block)
+		if err != nil {
+			b.Fatal(err)
+		}
+		compressed = snappy.Encode(buf, data)
+	}
+	b.StopTimer()
+	checkSnappyBlock(b, compressed, blockMainnetData)
+}
+
+func BenchmarkStateMainnet_SnappyUnmarshal(b *testing.B) {
+	var state *BeaconState
+	reader := snappy.NewReader(nil)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		state = new(BeaconState)
+		reader.Reset(bytes.NewReader(stateMainnetSnappyFramed))
+		if err := dynSszMainnet.UnmarshalSSZReader(state, reader, len(stateMainnetData)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(state)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != stateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, stateMainnetHTR)
+	}
+}
+
+func BenchmarkStateMainnet_SnappyMarshal(b *testing.B) {
+	state := new(BeaconState)
+	if err := dynSszMainnet.UnmarshalSSZ(state, stateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var buf bytes.Buffer
+	writer := snappy.NewBufferedWriter(nil)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+		writer.Reset(&buf)
+		if err := dynSszMainnet.MarshalSSZWriter(state, writer); err != nil {
+			b.Fatal(err)
+		}
+		if err := writer.Close(); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	checkSnappyFramed(b, buf.Bytes(), stateMainnetData)
+}
+
+func BenchmarkStateMainnet_SnappyBlockUnmarshal(b *testing.B) {
+	var state *BeaconState
+	buf := make([]byte, len(stateMainnetData))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		state = new(BeaconState)
+		data, err := snappy.Decode(buf, stateMainnetSnappyBlock)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if err := dynSszMainnet.UnmarshalSSZ(state, data); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := dynSszMainnet.HashTreeRoot(state)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != stateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, stateMainnetHTR)
+	}
+}
+
+func BenchmarkStateMainnet_SnappyBlockMarshal(b *testing.B) {
+	state := new(BeaconState)
+	if err := dynSszMainnet.UnmarshalSSZ(state, stateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	buf := make([]byte, snappy.MaxEncodedLen(len(stateMainnetData)))
+	var compressed []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		data, err := dynSszMainnet.MarshalSSZ(state)
+		if err != nil {
+			b.Fatal(err)
+		}
+		compressed = snappy.Encode(buf, data)
+	}
+	b.StopTimer()
+	checkSnappyBlock(b, compressed, stateMainnetData)
+}
+
+// =========================== ERA FILE BENCHMARKS ===========================
+//
+// Decode a whole era file (e2store records with snappy compressed blocks and
+// the boundary state) the way archive and sync tooling reads it. Records do
+// not store their uncompressed size, so each is decompressed into a reused
+// buffer and decoded from there.
+
+// eraManifest mirrors the manifest.json written by `generator era`
+type eraManifest struct {
+	Entries []*eraEntry `json:"entries"`
+}
+
+type eraEntry struct {
+	File         string `json:"file"`
+	Preset       string `json:"preset"`
+	Blocks       int    `json:"blocks"`
+	LastBlockHTR string `json:"last_block_htr"`
+	StateHTR     string `json:"state_htr"`
+}
+
+// runEra reads the era file of preset listed in res/era/manifest.json b.N
+// times and reports the decoded blocks per second, plus the peak heap growth
+// while reading it from an extra untimed pass. It skips when no era corpus
+// has been generated and returns the manifest entry for the HTR checks.
+func runEra(b *testing.B, preset string, decodeBlock func(data []byte) error, decodeState func(data []byte) error) *eraEntry {
+	manifestData, err := os.ReadFile("../../res/era/manifest.json")
+	if err != nil {
+		b.Skip("no era corpus (see scripts/generate-era.sh): " + err.Error())
+	}
+	var manifest eraManifest
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		b.Fatal(err)
+	}
+	var entry *eraEntry
+	for _, e := range manifest.Entries {
+		if e.Preset == preset {
+			entry = e
+		}
+	}
+	if entry == nil {
+		b.Skip("no " + preset + " era in res/era/manifest.json")
+	}
+	path := "../../res/era/" + entry.File
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		blocks, err := readEra(path, decodeBlock, decodeState, nil)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if blocks != entry.Blocks {
+			b.Fatalf("decoded %d blocks, want %d", blocks, entry.Blocks)
+		}
+	}
+	b.StopTimer()
+	b.ReportMetric(float64(entry.Blocks*b.N)/b.Elapsed().Seconds(), "blocks/s")
+
+	var baseline, stats runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&baseline)
+	peak := baseline.HeapAlloc
+	_, err = readEra(path, decodeBlock, decodeState, func() {
+		runtime.ReadMemStats(&stats)
+		peak = max(peak, stats.HeapAlloc)
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportMetric(float64(peak-baseline.HeapAlloc), "peak-heap-B")
+	return entry
+}
+
+// readEra reads an era file record by record, decoding the compressed blocks
+// and the state and skipping all other records. sample, if set, runs after
+// every decoded record. It returns the number of blocks.
+func readEra(path string, decodeBlock func(data []byte) error, decodeState func(data []byte) error, sample func()) (int, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	reader := bufio.NewReaderSize(file, 1<<20)
+	snappyReader := snappy.NewReader(nil)
+	var header [8]byte
+	var buf bytes.Buffer
+	blocks := 0
+	for {
+		if _, err := io.ReadFull(reader, header[:]); err == io.EOF {
+			return blocks, nil
+		} else if err != nil {
+			return 0, err
+		}
+		length := int64(binary.LittleEndian.Uint32(header[2:6]))
+
+		var decode func(data []byte) error
+		switch {
+		case header[0] == 0x01 && header[1] == 0x00:
+			decode = decodeBlock
+			blocks++
+		case header[0] == 0x02 && header[1] == 0x00:
+			decode = decodeState
+		default:
+			if _, err := reader.Discard(int(length)); err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		snappyReader.Reset(io.LimitReader(reader, length))
+		buf.Reset()
+		if _, err := buf.ReadFrom(snappyReader); err != nil {
+			return 0, err
+		}
+		if err := decode(buf.Bytes()); err != nil {
+			return 0, err
+		}
+		if sample != nil {
+			sample()
+		}
+	}
+}
+
+func BenchmarkEraMainnet_Decode(b *testing.B) {
+	var block *SignedBeaconBlock
+	var state *BeaconState
+	era := runEra(b, "mainnet", func(data []byte) error {
+		block = new(SignedBeaconBlock)
+		return dynSszMainnet.UnmarshalSSZ(block, data)
+	}, func(data []byte) error {
+		state = new(BeaconState)
+		return dynSszMainnet.UnmarshalSSZ(state, data)
+	})
+	htr, err := dynSszMainnet.HashTreeRoot(block.Message)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if hex.EncodeToString(htr[:]) != era.LastBlockHTR {
+		b.Fatalf("HTR mismatch: got %x, want %s", htr, era.LastBlockHTR)
+	}
+	htr, err = dynSszMainnet.HashTreeRoot(state)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if hex.EncodeToString(htr[:]) != era.StateHTR {
+		b.Fatalf("HTR mismatch: got %x, want %s", htr, era.StateHTR)
+	}
+}
+
+func BenchmarkEraMinimal_Decode(b *testing.B) {
+	var block *SignedBeaconBlock
+	var state *BeaconState
+	era := runEra(b, "minimal", func(data []byte) error {
+		block = new(SignedBeaconBlock)
+		return dynSszMinimal.UnmarshalSSZ(block, data)
+	}, func(data []byte) error {
+		state = new(BeaconState)
+		return dynSszMinimal.UnmarshalSSZ(state, data)
+	})
+	htr, err := dynSszMinimal.HashTreeRoot(block.Message)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if hex.EncodeToString(htr[:]) != era.LastBlockHTR {
+		b.Fatalf("HTR mismatch: got %x, want %s", htr, era.LastBlockHTR)
+	}
+	htr, err = dynSszMinimal.HashTreeRoot(state)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if hex.EncodeToString(htr[:]) != era.StateHTR {
+		b.Fatalf("HTR mismatch: got %x, want %s", htr, era.StateHTR)
+	}
+}
+
+// ========================= STATE SWEEP BENCHMARKS =========================
+
+// sweepManifest mirrors the manifest.json written by `generator sweep`
+type sweepManifest struct {
+	Entries []struct {
+		File       string `json:"file"`
+		Validators int    `json:"validators"`
+		Preset     string `json:"preset"`
+		Fork       string `json:"fork"`
+		HTR        string `json:"htr"`
+	} `json:"entries"`
+}
+
+// runSweep runs fn as a sub-benchmark for every mainnet deneb state listed in
+// res/sweep/manifest.json. It skips when no sweep has been generated.
+func runSweep(b *testing.B, fn func(b *testing.B, stateData []byte, stateHTR [32]byte)) {
+	manifestData, err := os.ReadFile("../../res/sweep/manifest.json")
+	if err != nil {
+		b.Skip("no sweep corpus (see scripts/generate-sweep.sh): " + err.Error())
+	}
+	var manifest sweepManifest
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		b.Fatal(err)
+	}
+	for _, entry := range manifest.Entries {
+		if entry.Preset != "mainnet" || entry.Fork != "deneb" {
+			continue
+		}
+		stateData, err := os.ReadFile("../../res/sweep/" + entry.File)
+		if err != nil {
+			b.Fatal(err)
+		}
+		htrBytes, err := hex.DecodeString(entry.HTR)
+		if err != nil {
+			b.Fatal(err)
+		}
+		var stateHTR [32]byte
+		copy(stateHTR[:], htrBytes)
+		b.Run(fmt.Sprintf("validators=%d", entry.Validators), func(b *testing.B) {
+			fn(b, stateData, stateHTR)
+		})
+	}
+}
+
+func BenchmarkStateSweep_Unmarshal(b *testing.B) {
+	runSweep(b, func(b *testing.B, stateData []byte, stateHTR [32]byte) {
+		var state *BeaconState
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			state = new(BeaconState)
+			if err := dynSszMainnet.UnmarshalSSZ(state, stateData); err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.StopTimer()
+		htr, err := dynSszMainnet.HashTreeRoot(state)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if htr != stateHTR {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, stateHTR)
+		}
+	})
+}
+
+func BenchmarkStateSweep_Marshal(b *testing.B) {
+	runSweep(b, func(b *testing.B, stateData []byte, stateHTR [32]byte) {
+		state := new(BeaconState)
+		if err := dynSszMainnet.UnmarshalSSZ(state, stateData); err != nil {
+			b.Fatal(err)
+		}
+		var data []byte
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			var err error
+			data, err = dynSszMainnet.MarshalSSZ(state)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.StopTimer()
+		if !bytes.Equal(data, stateData) {
+			b.Fatal("marshaled data does not match original")
+		}
+	})
+}
+
+func BenchmarkStateSweep_HashTreeRoot(b *testing.B) {
+	runSweep(b, func(b *testing.B, stateData []byte, stateHTR [32]byte) {
+		state := new(BeaconState)
+		if err := dynSszMainnet.UnmarshalSSZ(state, stateData); err != nil {
+			b.Fatal(err)
+		}
+		var htr [32]byte
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			var err error
+			htr, err = dynSszMainnet.HashTreeRoot(state)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.StopTimer()
+		if htr != stateHTR {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, stateHTR)
+		}
+	})
+}
+
+// ======================= STATE SEQUENCE BENCHMARKS =======================
+
+// sequenceManifest mirrors the manifest.json written by `generator sequence`
+type sequenceManifest struct {
+	Entries []struct {
+		File   string `json:"file"`
+		Step   int    `json:"step"`
+		Preset string `json:"preset"`
+		Fork   string `json:"fork"`
+		HTR    string `json:"htr"`
+	} `json:"entries"`
+}
+
+// loadSequence loads the mainnet deneb states listed in
+// res/sequence/manifest.json in step order, together with their HTRs. Every
+// state differs from its predecessor by one slot of changes. It skips when no
+// sequence has been generated.
+func loadSequence(b *testing.B) ([][]byte, [][32]byte) {
+	manifestData, err := os.ReadFile("../../res/sequence/manifest.json")
+	if err != nil {
+		b.Skip("no state sequence (see scripts/generate-sequence.sh): " + err.Error())
+	}
+	var manifest sequenceManifest
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		b.Fatal(err)
+	}
+	var stepData [][]byte
+	var stepHTRs [][32]byte
+	for _, entry := range manifest.Entries {
+		if entry.Preset != "mainnet" || entry.Fork != "deneb" {
+			continue
+		}
+		if entry.Step != len(stepData) {
+			b.Fatalf("sequence step %d out of order", entry.Step)
+		}
+		data, err := os.ReadFile("../../res/sequence/" + entry.File)
+		if err != nil {
+			b.Fatal(err)
+		}
+		htrBytes, err := hex.DecodeString(entry.HTR)
+		if err != nil {
+			b.Fatal(err)
+		}
+		var htr [32]byte
+		copy(htr[:], htrBytes)
+		stepData = append(stepData, data)
+		stepHTRs = append(stepHTRs, htr)
+	}
+	if len(stepData) < 2 {
+		b.Skip("no mainnet state sequence in res/sequence/manifest.json")
+	}
+	return stepData, stepHTRs
+}
+
+// BenchmarkStateSequence_HashTreeRoot re-roots the successor states one after
+// the other. The library keeps no hash cache, so every state is hashed from
+// scratch.
+func BenchmarkStateSequence_HashTreeRoot(b *testing.B) {
+	stepData, stepHTRs := loadSequence(b)
+	states := make([]*BeaconState, len(stepData))
+	for step, data := range stepData {
+		states[step] = new(BeaconState)
+		if err := dynSszMainnet.UnmarshalSSZ(states[step], data); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		step := 1 + i%(len(states)-1)
+		htr, err := dynSszMainnet.HashTreeRoot(states[step])
+		if err != nil {
+			b.Fatal(err)
+		}
+		if htr != stepHTRs[step] {
+			b.Fatalf("step %d HTR mismatch: got %x, want %x", step, htr, stepHTRs[step])
+		}
+	}
+}
+
+// ===================== STATE REHASH MAINNET BENCHMARKS ======================
+//
+// The rehash benchmarks apply one of the updates in res/rehash-mainnet.json
+// to the mainnet state and re-root it, which is the work behind the state root
+// of every slot. Iterations alternate between the updated and the original
+// value, so every root follows a change.
+// The library keeps no hash cache, so every root is computed over the full
+// state.
+
+// rehashManifest mirrors the rehash-mainnet.json written by `generator rehash`
+type rehashManifest struct {
+	HTR     string `json:"htr"`
+	Balance struct {
+		Index   uint64 `json:"index"`
+		Balance uint64 `json:"balance"`
+		HTR     string `json:"htr"`
+	} `json:"balance"`
+	BlockRoot struct {
+		Index uint64 `json:"index"`
+		Root  string `json:"root"`
+		HTR   string `json:"htr"`
+	} `json:"block_root"`
+	Validator struct {
+		Index             uint64 `json:"index"`
+		ExitEpoch         uint64 `json:"exit_epoch"`
+		WithdrawableEpoch uint64 `json:"withdrawable_epoch"`
+		HTR               string `json:"htr"`
+	} `json:"validator"`
+}
+
+// loadRehash loads res/rehash-mainnet.json and checks that it describes the
+// state-mainnet.ssz corpus.
+func loadRehash(b *testing.B) *rehashManifest {
+	data, err := os.ReadFile("../../res/rehash-mainnet.json")
+	if err != nil {
+		b.Fatal(err)
+	}
+	var manifest rehashManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		b.Fatal(err)
+	}
+	if htr := rehashRoot(b, manifest.HTR); htr != stateMainnetHTR {
+		b.Fatalf("rehash-mainnet.json is for state %x, want %x", htr, stateMainnetHTR)
+	}
+	return &manifest
+}
+
+func rehashRoot(b *testing.B, s string) [32]byte {
+	rootBytes, err := hex.DecodeString(s)
+	if err != nil {
+		b.Fatal(err)
+	}
+	var root [32]byte
+	copy(root[:], rootBytes)
+	return root
+}
+
+func BenchmarkStateMainnet_RehashAfterBalanceUpdate(b *testing.B) {
+	update := loadRehash(b).Balance
+	state := new(BeaconState)
+	if err := dynSszMainnet.UnmarshalSSZ(state, stateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	balances := [2]Gwei{Gwei(update.Balance), state.Balances[update.Index]}
+	htrs := [2][32]byte{rehashRoot(b, update.HTR), stateMainnetHTR}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		state.Balances[update.Index] = balances[i%2]
+		htr, err := dynSszMainnet.HashTreeRoot(state)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if htr != htrs[i%2] {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, htrs[i%2])
+		}
+	}
+}
+
+func BenchmarkStateMainnet_RehashAfterBlockRootsUpdate(b *testing.B) {
+	update := loadRehash(b).BlockRoot
+	state := new(BeaconState)
+	if err := dynSszMainnet.UnmarshalSSZ(state, stateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	root := rehashRoot(b, update.Root)
+	roots := [2]Root{root, state.BlockRoots[update.Index]}
+	htrs := [2][32]byte{rehashRoot(b, update.HTR), stateMainnetHTR}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		state.BlockRoots[update.Index] = roots[i%2]
+		htr, err := dynSszMainnet.HashTreeRoot(state)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if htr != htrs[i%2] {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, htrs[i%2])
+		}
+	}
+}
+
+func BenchmarkStateMainnet_RehashAfterValidatorUpdate(b *testing.B) {
+	update := loadRehash(b).Validator
+	state := new(BeaconState)
+	if err := dynSszMainnet.UnmarshalSSZ(state, stateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	validators := [2]Validator{*state.Validators[update.Index], *state.Validators[update.Index]}
+	validators[0].ExitEpoch = Epoch(update.ExitEpoch)
+	validators[0].WithdrawableEpoch = Epoch(update.WithdrawableEpoch)
+	htrs := [2][32]byte{rehashRoot(b, update.HTR), stateMainnetHTR}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		*state.Validators[update.Index] = validators[i%2]
+		htr, err := dynSszMainnet.HashTreeRoot(state)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if htr != htrs[i%2] {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, htrs[i%2])
+		}
+	}
+}
+
+// ======================= MALFORMED BLOCK BENCHMARKS =======================
+
+// malformedManifest mirrors the manifest.json written by `generator malformed`
+type malformedManifest struct {
+	Entries []struct {
+		File    string `json:"file"`
+		Source  string `json:"source"`
+		Variant string `json:"variant"`
+		Class   string `json:"class"`
+	} `json:"entries"`
+}
+
+// runMalformed runs a sub-benchmark for every malformed variant of source
+// listed in res/malformed/manifest.json, asserting that decode rejects it
+// with an error rather than accepting it or panicking.
+func runMalformed(b *testing.B, source string, decode func(data []byte) error) {
+	manifestData, err := os.ReadFile("../../res/malformed/manifest.json")
+	if err != nil {
+		b.Skip("no malformed corpus (see scripts/generate-corpus.sh): " + err.Error())
+	}
+	var manifest malformedManifest
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		b.Fatal(err)
+	}
+	for _, entry := range manifest.Entries {
+		if entry.Source != source {
+			continue
+		}
+		data, err := os.ReadFile("../../res/malformed/" + entry.File)
+		if err != nil {
+			b.Fatal(err)
+		}
+		class := entry.Class
+		b.Run(entry.Variant, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				rejectMalformed(b, class, decode, data)
+			}
+		})
+	}
+}
+
+// rejectMalformed fails the benchmark unless decode returns an error for data
+func rejectMalformed(b *testing.B, class string, decode func(data []byte) error, data []byte) {
+	defer func() {
+		if r := recover(); r != nil {
+			b.Fatalf("panicked on malformed input (%s): %v", class, r)
+		}
+	}()
+	if err := decode(data); err == nil {
+		b.Fatalf("accepted malformed input (%s)", class)
+	}
+}
+
+func BenchmarkBlockMalformed_Unmarshal(b *testing.B) {
+	runMalformed(b, "block-mainnet.ssz", func(data []byte) error {
+		return dynSszMainnet.UnmarshalSSZ(new(SignedBeaconBlock), data)
+	})
+}
+
+// ======================== PARALLEL MAINNET BENCHMARKS ========================
+//
+// The parallel benchmarks run the block and attestation operations on
+// GOMAXPROCS goroutines at once, as a beacon node does, and expose contention
+// in shared hasher pools and global state. ns/op is wall time per operation
+// over all goroutines. run-benchmarks.sh runs them unpinned with -cpu 1,2,4,8.
+
+// parallelResults collects the last result of every RunParallel goroutine, so
+// that they can be checked once the timer is stopped
+type parallelResults[T any] struct {
+	mu     sync.Mutex
+	values []T
+}
+
+func (r *parallelResults[T]) add(value T) {
+	r.mu.Lock()
+	r.values = append(r.values, value)
+	r.mu.Unlock()
+}
+
+func BenchmarkBlockMainnet_UnmarshalParallel(b *testing.B) {
+	var results parallelResults[*SignedBeaconBlock]
+	b.RunParallel(func(pb *testing.PB) {
+		var block *SignedBeaconBlock
+		for pb.Next() {
+			block = new(SignedBeaconBlock)
+			if err := dynSszMainnet.UnmarshalSSZ(block, blockMainnetData); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+		results.add(block)
+	})
+	b.StopTimer()
+	for _, block := range results.values {
+		if block == nil {
+			continue // no iterations on that goroutine
+		}
+		htr, err := dynSszMainnet.HashTreeRoot(block.Message)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if htr != blockMainnetHTR {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMainnetHTR)
+		}
+	}
+}
+
+func BenchmarkBlockMainnet_MarshalParallel(b *testing.B) {
+	block := new(SignedBeaconBlock)
+	if err := dynSszMainnet.UnmarshalSSZ(block, blockMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var results parallelResults[[]byte]
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var data []byte
+		for pb.Next() {
+			var err error
+			data, err = dynSszMainnet.MarshalSSZ(block)
+			if err != nil {
+				b.Error(err)
+				return
+			}
+		}
+		results.add(data)
+	})
+	b.StopTimer()
+	for _, data := range results.values {
+		if data != nil && !bytes.Equal(data, blockMainnetData) {
+			b.Fatal("marshaled data does not match original")
+		}
+	}
+}
+
+func BenchmarkBlockMainnet_HashTreeRootParallel(b *testing.B) {
+	block := new(SignedBeaconBlock)
+	if err := dynSszMainnet.UnmarshalSSZ(block, blockMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var results parallelResults[[32]byte]
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var htr [32]byte
+		for pb.Next() {
+			var err error
+			htr, err = dynSszMainnet.HashTreeRoot(block.Message)
+			if err != nil {
+				b.Error(err)
+				return
+			}
+		}
+		results.add(htr)
+	})
+	b.StopTimer()
+	for _, htr := range results.values {
+		if htr != ([32]byte{}) && htr != blockMainnetHTR {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMainnetHTR)
+		}
+	}
+}
+
+func BenchmarkAttestationMainnet_UnmarshalParallel(b *testing.B) {
+	var results parallelResults[*Attestation]
+	b.RunParallel(func(pb *testing.PB) {
+		var attestation *Attestation
+		for pb.Next() {
+			attestation = new(Attestation)
+			if err := dynSszMainnet.UnmarshalSSZ(attestation, attestationMainnetData); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+		results.add(attestation)
+	})
+	b.StopTimer()
+	for _, attestation := range results.values {
+		if attestation == nil {
+			continue // no iterations on that goroutine
+		}
+		htr, err := dynSszMainnet.HashTreeRoot(attestation)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if htr != attestationMainnetHTR {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, attestationMainnetHTR)
+		}
+	}
+}
+
+func BenchmarkAttestationMainnet_MarshalParallel(b *testing.B) {
+	attestation := new(Attestation)
+	if err := dynSszMainnet.UnmarshalSSZ(attestation, attestationMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var results parallelResults[[]byte]
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var data []byte
+		for pb.Next() {
+			var err error
+			data, err = dynSszMainnet.MarshalSSZ(attestation)
+			if err != nil {
+				b.Error(err)
+				return
+			}
+		}
+		results.add(data)
+	})
+	b.StopTimer()
+	for _, data := range results.values {
+		if data != nil && !bytes.Equal(data, attestationMainnetData) {
+			b.Fatal("marshaled data does not match original")
+		}
+	}
+}
+
+func BenchmarkAttestationMainnet_HashTreeRootParallel(b *testing.B) {
+	attestation := new(Attestation)
+	if err := dynSszMainnet.UnmarshalSSZ(attestation, attestationMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var results parallelResults[[32]byte]
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var htr [32]byte
+		for pb.Next() {
+			var err error
+			htr, err = dynSszMainnet.HashTreeRoot(attestation)
+			if err != nil {
+				b.Error(err)
+				return
+			}
+		}
+		results.add(htr)
+	})
+	b.StopTimer()
+	for _, htr := range results.values {
+		if htr != ([32]byte{}) && htr != attestationMainnetHTR {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, attestationMainnetHTR)
+		}
+	}
+}
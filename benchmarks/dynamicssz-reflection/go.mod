@@ -3,6 +3,7 @@ module github.com/pk910/ssz-benchmark/benchmarks/dynamicssz-reflection
 go 1.25.0
 
 require (
+	github.com/golang/snappy v1.0.0
 	github.com/pk910/dynamic-ssz v1.3.2
 	github.com/prysmaticlabs/go-bitfield v0.0.0-20240618144021-706c95b2dd15
 	gopkg.in/yaml.v2 v2.4.0
@@ -0,0 +1,151 @@
+package dynamicsszreflection
+
+import (
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// AttestationElectra represents an attestation (Electra, EIP-7549)
+type AttestationElectra struct {
+	AggregationBits bitfield.Bitlist `dynssz-max:"MAX_VALIDATORS_PER_COMMITTEE*MAX_COMMITTEES_PER_SLOT" ssz-max:"131072" ssz-type:"bitlist"`
+	Data            *AttestationData
+	Signature       BLSSignature         `ssz-size:"96"`
+	CommitteeBits   bitfield.Bitvector64 `dynssz-size:"MAX_COMMITTEES_PER_SLOT/8" ssz-size:"8"`
+}
+
+// IndexedAttestationElectra represents an indexed attestation (Electra, EIP-7549)
+type IndexedAttestationElectra struct {
+	AttestingIndices []uint64 `dynssz-max:"MAX_VALIDATORS_PER_COMMITTEE*MAX_COMMITTEES_PER_SLOT" ssz-max:"131072"`
+	Data             *AttestationData
+	Signature        BLSSignature `ssz-size:"96"`
+}
+
+// AttesterSlashingElectra represents an attester slashing (Electra)
+type AttesterSlashingElectra struct {
+	Attestation1 *IndexedAttestationElectra
+	Attestation2 *IndexedAttestationElectra
+}
+
+// DepositRequest represents a deposit request (Electra, EIP-6110)
+type DepositRequest struct {
+	Pubkey                BLSPubKey `ssz-size:"48"`
+	WithdrawalCredentials Hash32    `ssz-size:"32"`
+	Amount                Gwei
+	Signature             BLSSignature `ssz-size:"96"`
+	Index                 uint64
+}
+
+// WithdrawalRequest represents a withdrawal request (Electra, EIP-7002)
+type WithdrawalRequest struct {
+	SourceAddress   ExecutionAddress `ssz-size:"20"`
+	ValidatorPubkey BLSPubKey        `ssz-size:"48"`
+	Amount          Gwei
+}
+
+// ConsolidationRequest represents a consolidation request (Electra, EIP-7251)
+type ConsolidationRequest struct {
+	SourceAddress ExecutionAddress `ssz-size:"20"`
+	SourcePubkey  BLSPubKey        `ssz-size:"48"`
+	TargetPubkey  BLSPubKey        `ssz-size:"48"`
+}
+
+// ExecutionRequests represents the execution layer requests of a block (Electra)
+type ExecutionRequests struct {
+	Deposits       []*DepositRequest       `dynssz-max:"MAX_DEPOSIT_REQUESTS_PER_PAYLOAD" ssz-max:"8192"`
+	Withdrawals    []*WithdrawalRequest    `dynssz-max:"MAX_WITHDRAWAL_REQUESTS_PER_PAYLOAD" ssz-max:"16"`
+	Consolidations []*ConsolidationRequest `dynssz-max:"MAX_CONSOLIDATION_REQUESTS_PER_PAYLOAD" ssz-max:"2"`
+}
+
+// PendingDeposit represents a pending deposit (Electra)
+type PendingDeposit struct {
+	Pubkey                BLSPubKey `ssz-size:"48"`
+	WithdrawalCredentials Hash32    `ssz-size:"32"`
+	Amount                Gwei
+	Signature             BLSSignature `ssz-size:"96"`
+	Slot                  Slot
+}
+
+// PendingPartialWithdrawal represents a pending partial withdrawal (Electra)
+type PendingPartialWithdrawal struct {
+	ValidatorIndex    ValidatorIndex
+	Amount            Gwei
+	WithdrawableEpoch Epoch
+}
+
+// PendingConsolidation represents a pending consolidation (Electra)
+type PendingConsolidation struct {
+	SourceIndex ValidatorIndex
+	TargetIndex ValidatorIndex
+}
+
+// BeaconBlockBodyElectra represents a beacon block body (Electra)
+type BeaconBlockBodyElectra struct {
+	RANDAOReveal          BLSSignature `ssz-size:"96"`
+	ETH1Data              *ETH1Data
+	Graffiti              Hash32                     `ssz-size:"32"`
+	ProposerSlashings     []*ProposerSlashing        `dynssz-max:"MAX_PROPOSER_SLASHINGS" ssz-max:"16"`
+	AttesterSlashings     []*AttesterSlashingElectra `dynssz-max:"MAX_ATTESTER_SLASHINGS_ELECTRA" ssz-max:"1"`
+	Attestations          []*AttestationElectra      `dynssz-max:"MAX_ATTESTATIONS_ELECTRA" ssz-max:"8"`
+	Deposits              []*Deposit                 `dynssz-max:"MAX_DEPOSITS" ssz-max:"16"`
+	VoluntaryExits        []*SignedVoluntaryExit     `dynssz-max:"MAX_VOLUNTARY_EXITS" ssz-max:"16"`
+	SyncAggregate         *SyncAggregate
+	ExecutionPayload      *ExecutionPayload
+	BLSToExecutionChanges []*SignedBLSToExecutionChange `dynssz-max:"MAX_BLS_TO_EXECUTION_CHANGES" ssz-max:"16"`
+	BlobKZGCommitments    []KZGCommitment               `dynssz-max:"MAX_BLOB_COMMITMENTS_PER_BLOCK" ssz-max:"4096" ssz-size:"?,48"`
+	ExecutionRequests     *ExecutionRequests
+}
+
+// BeaconBlockElectra represents a beacon block (Electra)
+type BeaconBlockElectra struct {
+	Slot          Slot
+	ProposerIndex ValidatorIndex
+	ParentRoot    Root `ssz-size:"32"`
+	StateRoot     Root `ssz-size:"32"`
+	Body          *BeaconBlockBodyElectra
+}
+
+// SignedBeaconBlockElectra represents a signed beacon block (Electra)
+type SignedBeaconBlockElectra struct {
+	Message   *BeaconBlockElectra
+	Signature BLSSignature `ssz-size:"96"`
+}
+
+// BeaconStateElectra represents a beacon state (Electra)
+type BeaconStateElectra struct {
+	GenesisTime                   uint64
+	GenesisValidatorsRoot         Root `ssz-size:"32"`
+	Slot                          Slot
+	Fork                          *Fork
+	LatestBlockHeader             *BeaconBlockHeader
+	BlockRoots                    []Root `dynssz-size:"SLOTS_PER_HISTORICAL_ROOT,32" ssz-size:"8192,32"`
+	StateRoots                    []Root `dynssz-size:"SLOTS_PER_HISTORICAL_ROOT,32" ssz-size:"8192,32"`
+	HistoricalRoots               []Root `dynssz-max:"HISTORICAL_ROOTS_LIMIT" ssz-max:"16777216" ssz-size:"?,32"`
+	ETH1Data                      *ETH1Data
+	ETH1DataVotes                 []*ETH1Data `dynssz-max:"EPOCHS_PER_ETH1_VOTING_PERIOD*SLOTS_PER_EPOCH" ssz-max:"2048"`
+	ETH1DepositIndex              uint64
+	Validators                    []*Validator         `dynssz-max:"VALIDATOR_REGISTRY_LIMIT" ssz-max:"1099511627776"`
+	Balances                      []Gwei               `dynssz-max:"VALIDATOR_REGISTRY_LIMIT" ssz-max:"1099511627776"`
+	RANDAOMixes                   []Root               `dynssz-size:"EPOCHS_PER_HISTORICAL_VECTOR,32" ssz-size:"65536,32"`
+	Slashings                     []Gwei               `dynssz-size:"EPOCHS_PER_SLASHINGS_VECTOR" ssz-size:"8192"`
+	PreviousEpochParticipation    []ParticipationFlags `dynssz-max:"VALIDATOR_REGISTRY_LIMIT" ssz-max:"1099511627776"`
+	CurrentEpochParticipation     []ParticipationFlags `dynssz-max:"VALIDATOR_REGISTRY_LIMIT" ssz-max:"1099511627776"`
+	JustificationBits             bitfield.Bitvector4  `ssz-size:"1"`
+	PreviousJustifiedCheckpoint   *Checkpoint
+	CurrentJustifiedCheckpoint    *Checkpoint
+	FinalizedCheckpoint           *Checkpoint
+	InactivityScores              []uint64 `dynssz-max:"VALIDATOR_REGISTRY_LIMIT" ssz-max:"1099511627776"`
+	CurrentSyncCommittee          *SyncCommittee
+	NextSyncCommittee             *SyncCommittee
+	LatestExecutionPayloadHeader  *ExecutionPayloadHeader
+	NextWithdrawalIndex           WithdrawalIndex
+	NextWithdrawalValidatorIndex  ValidatorIndex
+	HistoricalSummaries           []*HistoricalSummary `dynssz-max:"HISTORICAL_ROOTS_LIMIT" ssz-max:"16777216"`
+	DepositRequestsStartIndex     uint64
+	DepositBalanceToConsume       Gwei
+	ExitBalanceToConsume          Gwei
+	EarliestExitEpoch             Epoch
+	ConsolidationBalanceToConsume Gwei
+	EarliestConsolidationEpoch    Epoch
+	PendingDeposits               []*PendingDeposit           `dynssz-max:"PENDING_DEPOSITS_LIMIT" ssz-max:"134217728"`
+	PendingPartialWithdrawals     []*PendingPartialWithdrawal `dynssz-max:"PENDING_PARTIAL_WITHDRAWALS_LIMIT" ssz-max:"134217728"`
+	PendingConsolidations         []*PendingConsolidation     `dynssz-max:"PENDING_CONSOLIDATIONS_LIMIT" ssz-max:"262144"`
+}
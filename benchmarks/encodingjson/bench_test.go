@@ -0,0 +1,184 @@
+package encodingjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+var (
+	blockMainnetJSON []byte
+	stateMainnetJSON []byte
+	blockMinimalJSON []byte
+	stateMinimalJSON []byte
+)
+
+func init() {
+	blockMainnetJSON = loadJSON("block-mainnet")
+	stateMainnetJSON = loadJSON("state-mainnet")
+	blockMinimalJSON = loadJSON("block-minimal")
+	stateMinimalJSON = loadJSON("state-minimal")
+}
+
+// loadJSON loads res/<name>.json, the beacon-API JSON encoding the generator
+// writes next to res/<name>.ssz.
+func loadJSON(name string) []byte {
+	data, err := os.ReadFile("../../res/" + name + ".json")
+	if err != nil {
+		panic("failed to load " + name + ".json: " + err.Error())
+	}
+	return data
+}
+
+// checkRoundTrip re-encodes obj and compares it with the JSON it was decoded
+// from, the JSON counterpart of the HTR check of the SSZ benchmarks.
+func checkRoundTrip(b *testing.B, obj any, want []byte) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if !bytes.Equal(data, want) {
+		b.Fatal("re-encoded data does not match original")
+	}
+}
+
+// ========================= BLOCK MAINNET BENCHMARKS =========================
+
+func BenchmarkBlockMainnet_Unmarshal(b *testing.B) {
+	var block *SignedBeaconBlock
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlock)
+		if err := json.Unmarshal(blockMainnetJSON, block); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	checkRoundTrip(b, block, blockMainnetJSON)
+}
+
+func BenchmarkBlockMainnet_Marshal(b *testing.B) {
+	block := new(SignedBeaconBlock)
+	if err := json.Unmarshal(blockMainnetJSON, block); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = json.Marshal(block)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, blockMainnetJSON) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+// ========================= STATE MAINNET BENCHMARKS =========================
+
+func BenchmarkStateMainnet_Unmarshal(b *testing.B) {
+	var state *BeaconState
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		state = new(BeaconState)
+		if err := json.Unmarshal(stateMainnetJSON, state); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	checkRoundTrip(b, state, stateMainnetJSON)
+}
+
+func BenchmarkStateMainnet_Marshal(b *testing.B) {
+	state := new(BeaconState)
+	if err := json.Unmarshal(stateMainnetJSON, state); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = json.Marshal(state)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, stateMainnetJSON) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+// ========================= BLOCK MINIMAL BENCHMARKS =========================
+
+func BenchmarkBlockMinimal_Unmarshal(b *testing.B) {
+	var block *SignedBeaconBlock
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlock)
+		if err := json.Unmarshal(blockMinimalJSON, block); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	checkRoundTrip(b, block, blockMinimalJSON)
+}
+
+func BenchmarkBlockMinimal_Marshal(b *testing.B) {
+	block := new(SignedBeaconBlock)
+	if err := json.Unmarshal(blockMinimalJSON, block); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = json.Marshal(block)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, blockMinimalJSON) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+// ========================= STATE MINIMAL BENCHMARKS =========================
+
+func BenchmarkStateMinimal_Unmarshal(b *testing.B) {
+	var state *BeaconState
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		state = new(BeaconState)
+		if err := json.Unmarshal(stateMinimalJSON, state); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	checkRoundTrip(b, state, stateMinimalJSON)
+}
+
+func BenchmarkStateMinimal_Marshal(b *testing.B) {
+	state := new(BeaconState)
+	if err := json.Unmarshal(stateMinimalJSON, state); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = json.Marshal(state)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, stateMinimalJSON) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
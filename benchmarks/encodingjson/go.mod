@@ -0,0 +1,3 @@
+module github.com/pk910/ssz-benchmark/benchmarks/encodingjson
+
+go 1.25.0
@@ -0,0 +1,373 @@
+package encodingjson
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+)
+
+// Basic types - named so they can carry the beacon-API text encoding:
+// integers as decimal strings, byte vectors and lists as 0x-prefixed hex
+type Uint64 uint64
+type Uint8 uint8
+type Bytes []byte
+type Root [32]byte
+type Version [4]byte
+type BLSPubKey [48]byte
+type BLSSignature [96]byte
+type ExecutionAddress [20]byte
+type LogsBloom [256]byte
+type KZGCommitment [48]byte
+
+// Uint256 is a little-endian 256 bit integer, encoded as a decimal string
+type Uint256 [32]byte
+
+type Slot = Uint64
+type Epoch = Uint64
+type ValidatorIndex = Uint64
+type Gwei = Uint64
+type WithdrawalIndex = Uint64
+type Hash32 = Root
+type ParticipationFlags = Uint8
+
+func (u Uint64) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(u), 10), nil
+}
+
+func (u *Uint64) UnmarshalText(text []byte) error {
+	v, err := strconv.ParseUint(string(text), 10, 64)
+	*u = Uint64(v)
+	return err
+}
+
+func (u Uint8) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(u), 10), nil
+}
+
+func (u *Uint8) UnmarshalText(text []byte) error {
+	v, err := strconv.ParseUint(string(text), 10, 8)
+	*u = Uint8(v)
+	return err
+}
+
+func (u Uint256) MarshalText() ([]byte, error) {
+	be := slices.Clone(u[:])
+	slices.Reverse(be)
+	return new(big.Int).SetBytes(be).Append(nil, 10), nil
+}
+
+func (u *Uint256) UnmarshalText(text []byte) error {
+	v, ok := new(big.Int).SetString(string(text), 10)
+	if !ok || v.Sign() < 0 || v.BitLen() > 256 {
+		return fmt.Errorf("invalid uint256 %q", text)
+	}
+	v.FillBytes(u[:])
+	slices.Reverse(u[:])
+	return nil
+}
+
+func (b Bytes) MarshalText() ([]byte, error) { return marshalHex(b), nil }
+
+func (b *Bytes) UnmarshalText(text []byte) error {
+	data, err := unmarshalHex(text)
+	*b = data
+	return err
+}
+
+func (r Root) MarshalText() ([]byte, error)              { return marshalHex(r[:]), nil }
+func (r *Root) UnmarshalText(text []byte) error          { return unmarshalFixedHex(r[:], text) }
+func (v Version) MarshalText() ([]byte, error)           { return marshalHex(v[:]), nil }
+func (v *Version) UnmarshalText(text []byte) error       { return unmarshalFixedHex(v[:], text) }
+func (k BLSPubKey) MarshalText() ([]byte, error)         { return marshalHex(k[:]), nil }
+func (k *BLSPubKey) UnmarshalText(text []byte) error     { return unmarshalFixedHex(k[:], text) }
+func (s BLSSignature) MarshalText() ([]byte, error)      { return marshalHex(s[:]), nil }
+func (s *BLSSignature) UnmarshalText(text []byte) error  { return unmarshalFixedHex(s[:], text) }
+func (a ExecutionAddress) MarshalText() ([]byte, error)  { return marshalHex(a[:]), nil }
+func (a *ExecutionAddress) UnmarshalText(b []byte) error { return unmarshalFixedHex(a[:], b) }
+func (l LogsBloom) MarshalText() ([]byte, error)         { return marshalHex(l[:]), nil }
+func (l *LogsBloom) UnmarshalText(text []byte) error     { return unmarshalFixedHex(l[:], text) }
+func (c KZGCommitment) MarshalText() ([]byte, error)     { return marshalHex(c[:]), nil }
+func (c *KZGCommitment) UnmarshalText(text []byte) error { return unmarshalFixedHex(c[:], text) }
+
+func marshalHex(data []byte) []byte {
+	out := make([]byte, 2+hex.EncodedLen(len(data)))
+	copy(out, "0x")
+	hex.Encode(out[2:], data)
+	return out
+}
+
+func unmarshalHex(text []byte) ([]byte, error) {
+	if len(text) < 2 || text[0] != '0' || text[1] != 'x' {
+		return nil, fmt.Errorf("hex string %q lacks 0x prefix", text)
+	}
+	data := make([]byte, hex.DecodedLen(len(text)-2))
+	if _, err := hex.Decode(data, text[2:]); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func unmarshalFixedHex(dst []byte, text []byte) error {
+	if len(text) != 2+2*len(dst) {
+		return fmt.Errorf("hex string %q is not %d bytes", text, len(dst))
+	}
+	if text[0] != '0' || text[1] != 'x' {
+		return fmt.Errorf("hex string %q lacks 0x prefix", text)
+	}
+	_, err := hex.Decode(dst, text[2:])
+	return err
+}
+
+// Fork represents a fork
+type Fork struct {
+	PreviousVersion Version `json:"previous_version"`
+	CurrentVersion  Version `json:"current_version"`
+	Epoch           Epoch   `json:"epoch"`
+}
+
+// Checkpoint represents a checkpoint
+type Checkpoint struct {
+	Epoch Epoch `json:"epoch"`
+	Root  Root  `json:"root"`
+}
+
+// BeaconBlockHeader represents a beacon block header
+type BeaconBlockHeader struct {
+	Slot          Slot           `json:"slot"`
+	ProposerIndex ValidatorIndex `json:"proposer_index"`
+	ParentRoot    Root           `json:"parent_root"`
+	StateRoot     Root           `json:"state_root"`
+	BodyRoot      Root           `json:"body_root"`
+}
+
+// SignedBeaconBlockHeader represents a signed beacon block header
+type SignedBeaconBlockHeader struct {
+	Message   *BeaconBlockHeader `json:"message"`
+	Signature BLSSignature       `json:"signature"`
+}
+
+// ETH1Data represents eth1 data
+type ETH1Data struct {
+	DepositRoot  Root   `json:"deposit_root"`
+	DepositCount Uint64 `json:"deposit_count"`
+	BlockHash    Hash32 `json:"block_hash"`
+}
+
+// Validator represents a validator
+type Validator struct {
+	Pubkey                     BLSPubKey `json:"pubkey"`
+	WithdrawalCredentials      Hash32    `json:"withdrawal_credentials"`
+	EffectiveBalance           Gwei      `json:"effective_balance"`
+	Slashed                    bool      `json:"slashed"`
+	ActivationEligibilityEpoch Epoch     `json:"activation_eligibility_epoch"`
+	ActivationEpoch            Epoch     `json:"activation_epoch"`
+	ExitEpoch                  Epoch     `json:"exit_epoch"`
+	WithdrawableEpoch          Epoch     `json:"withdrawable_epoch"`
+}
+
+// ProposerSlashing represents a proposer slashing
+type ProposerSlashing struct {
+	SignedHeader1 *SignedBeaconBlockHeader `json:"signed_header_1"`
+	SignedHeader2 *SignedBeaconBlockHeader `json:"signed_header_2"`
+}
+
+// AttestationData represents attestation data
+type AttestationData struct {
+	Slot            Slot        `json:"slot"`
+	Index           Uint64      `json:"index"`
+	BeaconBlockRoot Root        `json:"beacon_block_root"`
+	Source          *Checkpoint `json:"source"`
+	Target          *Checkpoint `json:"target"`
+}
+
+// IndexedAttestation represents an indexed attestation
+type IndexedAttestation struct {
+	AttestingIndices []Uint64         `json:"attesting_indices"`
+	Data             *AttestationData `json:"data"`
+	Signature        BLSSignature     `json:"signature"`
+}
+
+// AttesterSlashing represents an attester slashing
+type AttesterSlashing struct {
+	Attestation1 *IndexedAttestation `json:"attestation_1"`
+	Attestation2 *IndexedAttestation `json:"attestation_2"`
+}
+
+// Attestation represents an attestation
+type Attestation struct {
+	AggregationBits Bytes            `json:"aggregation_bits"`
+	Data            *AttestationData `json:"data"`
+	Signature       BLSSignature     `json:"signature"`
+}
+
+// DepositData represents deposit data
+type DepositData struct {
+	Pubkey                BLSPubKey    `json:"pubkey"`
+	WithdrawalCredentials Hash32       `json:"withdrawal_credentials"`
+	Amount                Gwei         `json:"amount"`
+	Signature             BLSSignature `json:"signature"`
+}
+
+// Deposit represents a deposit
+type Deposit struct {
+	Proof []Root       `json:"proof"`
+	Data  *DepositData `json:"data"`
+}
+
+// VoluntaryExit represents a voluntary exit
+type VoluntaryExit struct {
+	Epoch          Epoch          `json:"epoch"`
+	ValidatorIndex ValidatorIndex `json:"validator_index"`
+}
+
+// SignedVoluntaryExit represents a signed voluntary exit
+type SignedVoluntaryExit struct {
+	Message   *VoluntaryExit `json:"message"`
+	Signature BLSSignature   `json:"signature"`
+}
+
+// SyncAggregate represents a sync aggregate
+type SyncAggregate struct {
+	SyncCommitteeBits      Bytes        `json:"sync_committee_bits"`
+	SyncCommitteeSignature BLSSignature `json:"sync_committee_signature"`
+}
+
+// SyncCommittee represents a sync committee
+type SyncCommittee struct {
+	Pubkeys         []BLSPubKey `json:"pubkeys"`
+	AggregatePubkey BLSPubKey   `json:"aggregate_pubkey"`
+}
+
+// Withdrawal represents a withdrawal
+type Withdrawal struct {
+	Index          WithdrawalIndex  `json:"index"`
+	ValidatorIndex ValidatorIndex   `json:"validator_index"`
+	Address        ExecutionAddress `json:"address"`
+	Amount         Gwei             `json:"amount"`
+}
+
+// BLSToExecutionChange represents a BLS to execution change
+type BLSToExecutionChange struct {
+	ValidatorIndex     ValidatorIndex   `json:"validator_index"`
+	FromBLSPubkey      BLSPubKey        `json:"from_bls_pubkey"`
+	ToExecutionAddress ExecutionAddress `json:"to_execution_address"`
+}
+
+// SignedBLSToExecutionChange represents a signed BLS to execution change
+type SignedBLSToExecutionChange struct {
+	Message   *BLSToExecutionChange `json:"message"`
+	Signature BLSSignature          `json:"signature"`
+}
+
+// HistoricalSummary represents a historical summary
+type HistoricalSummary struct {
+	BlockSummaryRoot Root `json:"block_summary_root"`
+	StateSummaryRoot Root `json:"state_summary_root"`
+}
+
+// ExecutionPayload represents an execution payload (Deneb)
+type ExecutionPayload struct {
+	ParentHash    Hash32           `json:"parent_hash"`
+	FeeRecipient  ExecutionAddress `json:"fee_recipient"`
+	StateRoot     Hash32           `json:"state_root"`
+	ReceiptsRoot  Hash32           `json:"receipts_root"`
+	LogsBloom     LogsBloom        `json:"logs_bloom"`
+	PrevRandao    Hash32           `json:"prev_randao"`
+	BlockNumber   Uint64           `json:"block_number"`
+	GasLimit      Uint64           `json:"gas_limit"`
+	GasUsed       Uint64           `json:"gas_used"`
+	Timestamp     Uint64           `json:"timestamp"`
+	ExtraData     Bytes            `json:"extra_data"`
+	BaseFeePerGas Uint256          `json:"base_fee_per_gas"`
+	BlockHash     Hash32           `json:"block_hash"`
+	Transactions  []Bytes          `json:"transactions"`
+	Withdrawals   []*Withdrawal    `json:"withdrawals"`
+	BlobGasUsed   Uint64           `json:"blob_gas_used"`
+	ExcessBlobGas Uint64           `json:"excess_blob_gas"`
+}
+
+// ExecutionPayloadHeader represents an execution payload header (Deneb)
+type ExecutionPayloadHeader struct {
+	ParentHash       Hash32           `json:"parent_hash"`
+	FeeRecipient     ExecutionAddress `json:"fee_recipient"`
+	StateRoot        Hash32           `json:"state_root"`
+	ReceiptsRoot     Hash32           `json:"receipts_root"`
+	LogsBloom        LogsBloom        `json:"logs_bloom"`
+	PrevRandao       Hash32           `json:"prev_randao"`
+	BlockNumber      Uint64           `json:"block_number"`
+	GasLimit         Uint64           `json:"gas_limit"`
+	GasUsed          Uint64           `json:"gas_used"`
+	Timestamp        Uint64           `json:"timestamp"`
+	ExtraData        Bytes            `json:"extra_data"`
+	BaseFeePerGas    Uint256          `json:"base_fee_per_gas"`
+	BlockHash        Hash32           `json:"block_hash"`
+	TransactionsRoot Root             `json:"transactions_root"`
+	WithdrawalsRoot  Root             `json:"withdrawals_root"`
+	BlobGasUsed      Uint64           `json:"blob_gas_used"`
+	ExcessBlobGas    Uint64           `json:"excess_blob_gas"`
+}
+
+// BeaconBlockBody represents a beacon block body (Deneb)
+type BeaconBlockBody struct {
+	RANDAOReveal          BLSSignature                  `json:"randao_reveal"`
+	ETH1Data              *ETH1Data                     `json:"eth1_data"`
+	Graffiti              Hash32                        `json:"graffiti"`
+	ProposerSlashings     []*ProposerSlashing           `json:"proposer_slashings"`
+	AttesterSlashings     []*AttesterSlashing           `json:"attester_slashings"`
+	Attestations          []*Attestation                `json:"attestations"`
+	Deposits              []*Deposit                    `json:"deposits"`
+	VoluntaryExits        []*SignedVoluntaryExit        `json:"voluntary_exits"`
+	SyncAggregate         *SyncAggregate                `json:"sync_aggregate"`
+	ExecutionPayload      *ExecutionPayload             `json:"execution_payload"`
+	BLSToExecutionChanges []*SignedBLSToExecutionChange `json:"bls_to_execution_changes"`
+	BlobKZGCommitments    []KZGCommitment               `json:"blob_kzg_commitments"`
+}
+
+// BeaconBlock represents a beacon block (Deneb)
+type BeaconBlock struct {
+	Slot          Slot             `json:"slot"`
+	ProposerIndex ValidatorIndex   `json:"proposer_index"`
+	ParentRoot    Root             `json:"parent_root"`
+	StateRoot     Root             `json:"state_root"`
+	Body          *BeaconBlockBody `json:"body"`
+}
+
+// SignedBeaconBlock represents a signed beacon block (Deneb)
+type SignedBeaconBlock struct {
+	Message   *BeaconBlock `json:"message"`
+	Signature BLSSignature `json:"signature"`
+}
+
+// BeaconState represents a beacon state (Deneb)
+type BeaconState struct {
+	GenesisTime                  Uint64                  `json:"genesis_time"`
+	GenesisValidatorsRoot        Root                    `json:"genesis_validators_root"`
+	Slot                         Slot                    `json:"slot"`
+	Fork                         *Fork                   `json:"fork"`
+	LatestBlockHeader            *BeaconBlockHeader      `json:"latest_block_header"`
+	BlockRoots                   []Root                  `json:"block_roots"`
+	StateRoots                   []Root                  `json:"state_roots"`
+	HistoricalRoots              []Root                  `json:"historical_roots"`
+	ETH1Data                     *ETH1Data               `json:"eth1_data"`
+	ETH1DataVotes                []*ETH1Data             `json:"eth1_data_votes"`
+	ETH1DepositIndex             Uint64                  `json:"eth1_deposit_index"`
+	Validators                   []*Validator            `json:"validators"`
+	Balances                     []Gwei                  `json:"balances"`
+	RANDAOMixes                  []Root                  `json:"randao_mixes"`
+	Slashings                    []Gwei                  `json:"slashings"`
+	PreviousEpochParticipation   []ParticipationFlags    `json:"previous_epoch_participation"`
+	CurrentEpochParticipation    []ParticipationFlags    `json:"current_epoch_participation"`
+	JustificationBits            Bytes                   `json:"justification_bits"`
+	PreviousJustifiedCheckpoint  *Checkpoint             `json:"previous_justified_checkpoint"`
+	CurrentJustifiedCheckpoint   *Checkpoint             `json:"current_justified_checkpoint"`
+	FinalizedCheckpoint          *Checkpoint             `json:"finalized_checkpoint"`
+	InactivityScores             []Uint64                `json:"inactivity_scores"`
+	CurrentSyncCommittee         *SyncCommittee          `json:"current_sync_committee"`
+	NextSyncCommittee            *SyncCommittee          `json:"next_sync_committee"`
+	LatestExecutionPayloadHeader *ExecutionPayloadHeader `json:"latest_execution_payload_header"`
+	NextWithdrawalIndex          WithdrawalIndex         `json:"next_withdrawal_index"`
+	NextWithdrawalValidatorIndex ValidatorIndex          `json:"next_withdrawal_validator_index"`
+	HistoricalSummaries          []*HistoricalSummary    `json:"historical_summaries"`
+}
@@ -4,6 +4,7 @@ go 1.25.0
 
 require (
 	github.com/ferranbt/fastssz v1.0.0
+	github.com/golang/snappy v1.0.0
 	github.com/prysmaticlabs/go-bitfield v0.0.0-20240618144021-706c95b2dd15
 )
 
@@ -0,0 +1,151 @@
+package fastssz
+
+import (
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// AttestationElectra represents an attestation (Electra)
+type AttestationElectra struct {
+	AggregationBits bitfield.Bitlist `ssz-max:"131072" ssz:"bitlist"`
+	Data            *AttestationData
+	Signature       BLSSignature `ssz-size:"96"`
+	CommitteeBits   []byte       `ssz-size:"8"`
+}
+
+// IndexedAttestationElectra represents an indexed attestation (Electra)
+type IndexedAttestationElectra struct {
+	AttestingIndices []uint64 `ssz-max:"131072"`
+	Data             *AttestationData
+	Signature        BLSSignature `ssz-size:"96"`
+}
+
+// AttesterSlashingElectra represents an attester slashing (Electra)
+type AttesterSlashingElectra struct {
+	Attestation1 *IndexedAttestationElectra
+	Attestation2 *IndexedAttestationElectra
+}
+
+// DepositRequest represents a deposit request
+type DepositRequest struct {
+	Pubkey                BLSPubKey `ssz-size:"48"`
+	WithdrawalCredentials Hash32    `ssz-size:"32"`
+	Amount                Gwei
+	Signature             BLSSignature `ssz-size:"96"`
+	Index                 uint64
+}
+
+// WithdrawalRequest represents a withdrawal request
+type WithdrawalRequest struct {
+	SourceAddress   ExecutionAddress `ssz-size:"20"`
+	ValidatorPubkey BLSPubKey        `ssz-size:"48"`
+	Amount          Gwei
+}
+
+// ConsolidationRequest represents a consolidation request
+type ConsolidationRequest struct {
+	SourceAddress ExecutionAddress `ssz-size:"20"`
+	SourcePubkey  BLSPubKey        `ssz-size:"48"`
+	TargetPubkey  BLSPubKey        `ssz-size:"48"`
+}
+
+// ExecutionRequests represents the execution layer requests of a block
+type ExecutionRequests struct {
+	Deposits       []*DepositRequest       `ssz-max:"8192"`
+	Withdrawals    []*WithdrawalRequest    `ssz-max:"16"`
+	Consolidations []*ConsolidationRequest `ssz-max:"2"`
+}
+
+// PendingDeposit represents a pending deposit
+type PendingDeposit struct {
+	Pubkey                BLSPubKey `ssz-size:"48"`
+	WithdrawalCredentials Hash32    `ssz-size:"32"`
+	Amount                Gwei
+	Signature             BLSSignature `ssz-size:"96"`
+	Slot                  Slot
+}
+
+// PendingPartialWithdrawal represents a pending partial withdrawal
+type PendingPartialWithdrawal struct {
+	ValidatorIndex    ValidatorIndex
+	Amount            Gwei
+	WithdrawableEpoch Epoch
+}
+
+// PendingConsolidation represents a pending consolidation
+type PendingConsolidation struct {
+	SourceIndex ValidatorIndex
+	TargetIndex ValidatorIndex
+}
+
+// BeaconBlockBodyElectra represents a beacon block body (Electra)
+type BeaconBlockBodyElectra struct {
+	RANDAOReveal          BLSSignature `ssz-size:"96"`
+	ETH1Data              *ETH1Data
+	Graffiti              Hash32                     `ssz-size:"32"`
+	ProposerSlashings     []*ProposerSlashing        `ssz-max:"16"`
+	AttesterSlashings     []*AttesterSlashingElectra `ssz-max:"1"`
+	Attestations          []*AttestationElectra      `ssz-max:"8"`
+	Deposits              []*Deposit                 `ssz-max:"16"`
+	VoluntaryExits        []*SignedVoluntaryExit     `ssz-max:"16"`
+	SyncAggregate         *SyncAggregate
+	ExecutionPayload      *ExecutionPayload
+	BLSToExecutionChanges []*SignedBLSToExecutionChange `ssz-max:"16"`
+	BlobKZGCommitments    []KZGCommitment               `ssz-max:"4096" ssz-size:"?,48"`
+	ExecutionRequests     *ExecutionRequests
+}
+
+// BeaconBlockElectra represents a beacon block (Electra)
+type BeaconBlockElectra struct {
+	Slot          Slot
+	ProposerIndex ValidatorIndex
+	ParentRoot    Root `ssz-size:"32"`
+	StateRoot     Root `ssz-size:"32"`
+	Body          *BeaconBlockBodyElectra
+}
+
+// SignedBeaconBlockElectra represents a signed beacon block (Electra)
+type SignedBeaconBlockElectra struct {
+	Message   *BeaconBlockElectra
+	Signature BLSSignature `ssz-size:"96"`
+}
+
+// BeaconStateElectra represents a beacon state (Electra)
+type BeaconStateElectra struct {
+	GenesisTime                   uint64
+	GenesisValidatorsRoot         Root `ssz-size:"32"`
+	Slot                          Slot
+	Fork                          *Fork
+	LatestBlockHeader             *BeaconBlockHeader
+	BlockRoots                    [][]byte `ssz-size:"8192,32"`
+	StateRoots                    [][]byte `ssz-size:"8192,32"`
+	HistoricalRoots               []Root   `ssz-max:"16777216" ssz-size:"?,32"`
+	ETH1Data                      *ETH1Data
+	ETH1DataVotes                 []*ETH1Data `ssz-max:"2048"`
+	ETH1DepositIndex              uint64
+	Validators                    []*Validator         `ssz-max:"1099511627776"`
+	Balances                      []Gwei               `ssz-max:"1099511627776"`
+	RANDAOMixes                   [][]byte             `ssz-size:"65536,32"`
+	Slashings                     []Gwei               `ssz-size:"8192"`
+	PreviousEpochParticipation    []ParticipationFlags `ssz-max:"1099511627776"`
+	CurrentEpochParticipation     []ParticipationFlags `ssz-max:"1099511627776"`
+	JustificationBits             [1]byte              `ssz-size:"1"`
+	PreviousJustifiedCheckpoint   *Checkpoint
+	CurrentJustifiedCheckpoint    *Checkpoint
+	FinalizedCheckpoint           *Checkpoint
+	InactivityScores              []uint64 `ssz-max:"1099511627776"`
+	CurrentSyncCommittee          *SyncCommittee
+	NextSyncCommittee             *SyncCommittee
+	LatestExecutionPayloadHeader  *ExecutionPayloadHeader
+	NextWithdrawalIndex           WithdrawalIndex
+	NextWithdrawalValidatorIndex  ValidatorIndex
+	HistoricalSummaries           []*HistoricalSummary `ssz-max:"16777216"`
+	DepositRequestsStartIndex     uint64
+	DepositBalanceToConsume       Gwei
+	ExitBalanceToConsume          Gwei
+	EarliestExitEpoch             Epoch
+	ConsolidationBalanceToConsume Gwei
+	EarliestConsolidationEpoch    Epoch
+	PendingDeposits               []*PendingDeposit           `ssz-max:"134217728"`
+	PendingPartialWithdrawals     []*PendingPartialWithdrawal `ssz-max:"134217728"`
+	PendingConsolidations         []*PendingConsolidation     `ssz-max:"262144"`
+}
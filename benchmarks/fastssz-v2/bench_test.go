@@ -1,11 +1,20 @@
 package fastssz
 
 import (
+	"bufio"
 	"bytes"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"io"
 	"os"
+	"runtime"
+	"sync"
 	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/golang/snappy"
 )
 
 type Metadata struct {
@@ -22,31 +31,87 @@ var (
 	stateMainnetHTR [32]byte
 	blockMinimalHTR [32]byte
 	stateMinimalHTR [32]byte
+
+	blockElectraMainnetData []byte
+	stateElectraMainnetData []byte
+
+	blockElectraMainnetHTR [32]byte
+	stateElectraMainnetHTR [32]byte
+
+	blockEmptyMainnetData    []byte
+	blockMaxMainnetData      []byte
+	blockBoundaryMainnetData []byte
+	blockTypedMainnetData    []byte
+
+	blockEmptyMainnetHTR    [32]byte
+	blockMaxMainnetHTR      [32]byte
+	blockBoundaryMainnetHTR [32]byte
+	blockTypedMainnetHTR    [32]byte
+
+	attestationMainnetData                 []byte
+	voluntaryExitMainnetData               []byte
+	blsChangeMainnetData                   []byte
+	syncCommitteeMainnetData               []byte
+	executionPayloadMainnetData            []byte
+	validatorMainnetData                   []byte
+	blobSidecarMainnetData                 []byte
+	lightClientBootstrapMainnetData        []byte
+	lightClientUpdateMainnetData           []byte
+	lightClientFinalityUpdateMainnetData   []byte
+	lightClientOptimisticUpdateMainnetData []byte
+
+	attestationMainnetHTR                 [32]byte
+	voluntaryExitMainnetHTR               [32]byte
+	blsChangeMainnetHTR                   [32]byte
+	syncCommitteeMainnetHTR               [32]byte
+	executionPayloadMainnetHTR            [32]byte
+	validatorMainnetHTR                   [32]byte
+	blobSidecarMainnetHTR                 [32]byte
+	lightClientBootstrapMainnetHTR        [32]byte
+	lightClientUpdateMainnetHTR           [32]byte
+	lightClientFinalityUpdateMainnetHTR   [32]byte
+	lightClientOptimisticUpdateMainnetHTR [32]byte
+
+	blockMainnetSnappyFramed []byte
+	blockMainnetSnappyBlock  []byte
+	stateMainnetSnappyFramed []byte
+	stateMainnetSnappyBlock  []byte
 )
 
 func init() {
-	var err error
-	blockMainnetData, err = os.ReadFile("../../res/block-mainnet.ssz")
-	if err != nil {
-		panic("failed to load block-mainnet.ssz: " + err.Error())
-	}
-	stateMainnetData, err = os.ReadFile("../../res/state-mainnet.ssz")
-	if err != nil {
-		panic("failed to load state-mainnet.ssz: " + err.Error())
-	}
-	blockMinimalData, err = os.ReadFile("../../res/block-minimal.ssz")
-	if err != nil {
-		panic("failed to load block-minimal.ssz: " + err.Error())
-	}
-	stateMinimalData, err = os.ReadFile("../../res/state-minimal.ssz")
+	blockMainnetData, blockMainnetHTR = loadCorpus("block-mainnet")
+	stateMainnetData, stateMainnetHTR = loadCorpus("state-mainnet")
+	blockMinimalData, blockMinimalHTR = loadCorpus("block-minimal")
+	stateMinimalData, stateMinimalHTR = loadCorpus("state-minimal")
+	blockElectraMainnetData, blockElectraMainnetHTR = loadCorpus("block-electra-mainnet")
+	stateElectraMainnetData, stateElectraMainnetHTR = loadCorpus("state-electra-mainnet")
+	blockEmptyMainnetData, blockEmptyMainnetHTR = loadCorpus("block-empty-mainnet")
+	blockMaxMainnetData, blockMaxMainnetHTR = loadCorpus("block-max-mainnet")
+	blockBoundaryMainnetData, blockBoundaryMainnetHTR = loadCorpus("block-boundary-mainnet")
+	blockTypedMainnetData, blockTypedMainnetHTR = loadCorpus("block-typed-mainnet")
+	attestationMainnetData, attestationMainnetHTR = loadCorpus("attestation-mainnet")
+	voluntaryExitMainnetData, voluntaryExitMainnetHTR = loadCorpus("voluntary-exit-mainnet")
+	blsChangeMainnetData, blsChangeMainnetHTR = loadCorpus("bls-change-mainnet")
+	syncCommitteeMainnetData, syncCommitteeMainnetHTR = loadCorpus("sync-committee-mainnet")
+	executionPayloadMainnetData, executionPayloadMainnetHTR = loadCorpus("execution-payload-mainnet")
+	validatorMainnetData, validatorMainnetHTR = loadCorpus("validator-mainnet")
+	blobSidecarMainnetData, blobSidecarMainnetHTR = loadCorpus("blob-sidecar-mainnet")
+	lightClientBootstrapMainnetData, lightClientBootstrapMainnetHTR = loadCorpus("light-client-bootstrap-mainnet")
+	lightClientUpdateMainnetData, lightClientUpdateMainnetHTR = loadCorpus("light-client-update-mainnet")
+	lightClientFinalityUpdateMainnetData, lightClientFinalityUpdateMainnetHTR = loadCorpus("light-client-finality-update-mainnet")
+	lightClientOptimisticUpdateMainnetData, lightClientOptimisticUpdateMainnetHTR = loadCorpus("light-client-optimistic-update-mainnet")
+	blockMainnetSnappyFramed, blockMainnetSnappyBlock = loadSnappy("block-mainnet")
+	stateMainnetSnappyFramed, stateMainnetSnappyBlock = loadSnappy("state-mainnet")
+}
+
+// loadCorpus loads res/<name>.ssz together with the expected HTR from
+// res/<name>-meta.json.
+func loadCorpus(name string) ([]byte, [32]byte) {
+	data, err := os.ReadFile("../../res/" + name + ".ssz")
 	if err != nil {
-		panic("failed to load state-minimal.ssz: " + err.Error())
+		panic("failed to load " + name + ".ssz: " + err.Error())
 	}
-
-	blockMainnetHTR = loadHTR("../../res/block-mainnet-meta.json")
-	stateMainnetHTR = loadHTR("../../res/state-mainnet-meta.json")
-	blockMinimalHTR = loadHTR("../../res/block-minimal-meta.json")
-	stateMinimalHTR = loadHTR("../../res/state-minimal-meta.json")
+	return data, loadHTR("../../res/" + name + "-meta.json")
 }
 
 func loadHTR(path string) [32]byte {
@@ -67,6 +132,20 @@ func loadHTR(path string) [32]byte {
 	return htr
 }
 
+// loadSnappy loads the framed (res/<name>.ssz.sz) and block
+// (res/<name>.ssz_snappy) snappy encodings of res/<name>.ssz.
+func loadSnappy(name string) ([]byte, []byte) {
+	framed, err := os.ReadFile("../../res/" + name + ".ssz.sz")
+	if err != nil {
+		panic("failed to load " + name + ".ssz.sz: " + err.Error())
+	}
+	block, err := os.ReadFile("../../res/" + name + ".ssz_snappy")
+	if err != nil {
+		panic("failed to load " + name + ".ssz_snappy: " + err.Error())
+	}
+	return framed, block
+}
+
 // ========================= BLOCK MAINNET BENCHMARKS =========================
 
 func BenchmarkBlockMainnet_Unmarshal(b *testing.B) {
@@ -110,6 +189,28 @@ func BenchmarkBlockMainnet_Marshal(b *testing.B) {
 	}
 }
 
+func BenchmarkBlockMainnet_MarshalTo(b *testing.B) {
+	SetMainnetSpec()
+	block := new(SignedBeaconBlock)
+	if err := block.UnmarshalSSZ(blockMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	buf := make([]byte, 0, len(blockMainnetData))
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = block.MarshalSSZTo(buf[:0])
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, blockMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
 func BenchmarkBlockMainnet_HashTreeRoot(b *testing.B) {
 	SetMainnetSpec()
 	block := new(SignedBeaconBlock)
@@ -131,6 +232,50 @@ func BenchmarkBlockMainnet_HashTreeRoot(b *testing.B) {
 	}
 }
 
+func BenchmarkBlockMainnet_HashTreeRootWith(b *testing.B) {
+	SetMainnetSpec()
+	block := new(SignedBeaconBlock)
+	if err := block.UnmarshalSSZ(blockMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		hh.Reset()
+		if err := block.Message.HashTreeRootWith(hh); err != nil {
+			b.Fatal(err)
+		}
+		var err error
+		htr, err = hh.HashRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != blockMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMainnetHTR)
+	}
+}
+
+func BenchmarkBlockMainnet_SizeSSZ(b *testing.B) {
+	SetMainnetSpec()
+	block := new(SignedBeaconBlock)
+	if err := block.UnmarshalSSZ(blockMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var size int
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		size = block.SizeSSZ()
+	}
+	b.StopTimer()
+	if size != len(blockMainnetData) {
+		b.Fatalf("size mismatch: got %d, want %d", size, len(blockMainnetData))
+	}
+}
+
 // ========================= STATE MAINNET BENCHMARKS =========================
 
 func BenchmarkStateMainnet_Unmarshal(b *testing.B) {
@@ -174,6 +319,28 @@ func BenchmarkStateMainnet_Marshal(b *testing.B) {
 	}
 }
 
+func BenchmarkStateMainnet_MarshalTo(b *testing.B) {
+	SetMainnetSpec()
+	state := new(BeaconState)
+	if err := state.UnmarshalSSZ(stateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	buf := make([]byte, 0, len(stateMainnetData))
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = state.MarshalSSZTo(buf[:0])
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, stateMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
 func BenchmarkStateMainnet_HashTreeRoot(b *testing.B) {
 	SetMainnetSpec()
 	state := new(BeaconState)
@@ -195,6 +362,50 @@ func BenchmarkStateMainnet_HashTreeRoot(b *testing.B) {
 	}
 }
 
+func BenchmarkStateMainnet_HashTreeRootWith(b *testing.B) {
+	SetMainnetSpec()
+	state := new(BeaconState)
+	if err := state.UnmarshalSSZ(stateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		hh.Reset()
+		if err := state.HashTreeRootWith(hh); err != nil {
+			b.Fatal(err)
+		}
+		var err error
+		htr, err = hh.HashRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != stateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, stateMainnetHTR)
+	}
+}
+
+func BenchmarkStateMainnet_SizeSSZ(b *testing.B) {
+	SetMainnetSpec()
+	state := new(BeaconState)
+	if err := state.UnmarshalSSZ(stateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var size int
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		size = state.SizeSSZ()
+	}
+	b.StopTimer()
+	if size != len(stateMainnetData) {
+		b.Fatalf("size mismatch: got %d, want %d", size, len(stateMainnetData))
+	}
+}
+
 // ========================= BLOCK MINIMAL BENCHMARKS =========================
 
 func BenchmarkBlockMinimal_Unmarshal(b *testing.B) {
@@ -322,3 +533,2037 @@ func BenchmarkStateMinimal_HashTreeRoot(b *testing.B) {
 		b.Fatalf("HTR mismatch: got %x, want %x", htr, stateMinimalHTR)
 	}
 }
+
+// ===================== BLOCK ELECTRA MAINNET BENCHMARKS =====================
+
+func BenchmarkBlockElectraMainnet_Unmarshal(b *testing.B) {
+	SetMainnetSpec()
+	var block *SignedBeaconBlockElectra
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlockElectra)
+		if err := block.UnmarshalSSZ(blockElectraMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := block.Message.HashTreeRoot()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != blockElectraMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockElectraMainnetHTR)
+	}
+}
+
+func BenchmarkBlockElectraMainnet_Marshal(b *testing.B) {
+	SetMainnetSpec()
+	block := new(SignedBeaconBlockElectra)
+	if err := block.UnmarshalSSZ(blockElectraMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = block.MarshalSSZ()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, blockElectraMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockElectraMainnet_HashTreeRoot(b *testing.B) {
+	SetMainnetSpec()
+	block := new(SignedBeaconBlockElectra)
+	if err := block.UnmarshalSSZ(blockElectraMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = block.Message.HashTreeRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != blockElectraMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockElectraMainnetHTR)
+	}
+}
+
+// ===================== STATE ELECTRA MAINNET BENCHMARKS =====================
+
+func BenchmarkStateElectraMainnet_Unmarshal(b *testing.B) {
+	SetMainnetSpec()
+	var state *BeaconStateElectra
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		state = new(BeaconStateElectra)
+		if err := state.UnmarshalSSZ(stateElectraMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := state.HashTreeRoot()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != stateElectraMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, stateElectraMainnetHTR)
+	}
+}
+
+func BenchmarkStateElectraMainnet_Marshal(b *testing.B) {
+	SetMainnetSpec()
+	state := new(BeaconStateElectra)
+	if err := state.UnmarshalSSZ(stateElectraMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = state.MarshalSSZ()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, stateElectraMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkStateElectraMainnet_HashTreeRoot(b *testing.B) {
+	SetMainnetSpec()
+	state := new(BeaconStateElectra)
+	if err := state.UnmarshalSSZ(stateElectraMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = state.HashTreeRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != stateElectraMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, stateElectraMainnetHTR)
+	}
+}
+
+// ====================== SUB-OBJECT MAINNET BENCHMARKS =======================
+
+func BenchmarkAttestationMainnet_Unmarshal(b *testing.B) {
+	SetMainnetSpec()
+	var attestation *Attestation
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		attestation = new(Attestation)
+		if err := attestation.UnmarshalSSZ(attestationMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := attestation.HashTreeRoot()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != attestationMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, attestationMainnetHTR)
+	}
+}
+
+func BenchmarkAttestationMainnet_Marshal(b *testing.B) {
+	SetMainnetSpec()
+	attestation := new(Attestation)
+	if err := attestation.UnmarshalSSZ(attestationMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = attestation.MarshalSSZ()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, attestationMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkAttestationMainnet_HashTreeRoot(b *testing.B) {
+	SetMainnetSpec()
+	attestation := new(Attestation)
+	if err := attestation.UnmarshalSSZ(attestationMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = attestation.HashTreeRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != attestationMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, attestationMainnetHTR)
+	}
+}
+
+func BenchmarkVoluntaryExitMainnet_Unmarshal(b *testing.B) {
+	SetMainnetSpec()
+	var exit *SignedVoluntaryExit
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		exit = new(SignedVoluntaryExit)
+		if err := exit.UnmarshalSSZ(voluntaryExitMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := exit.HashTreeRoot()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != voluntaryExitMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, voluntaryExitMainnetHTR)
+	}
+}
+
+func BenchmarkVoluntaryExitMainnet_Marshal(b *testing.B) {
+	SetMainnetSpec()
+	exit := new(SignedVoluntaryExit)
+	if err := exit.UnmarshalSSZ(voluntaryExitMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = exit.MarshalSSZ()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, voluntaryExitMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkVoluntaryExitMainnet_HashTreeRoot(b *testing.B) {
+	SetMainnetSpec()
+	exit := new(SignedVoluntaryExit)
+	if err := exit.UnmarshalSSZ(voluntaryExitMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = exit.HashTreeRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != voluntaryExitMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, voluntaryExitMainnetHTR)
+	}
+}
+
+func BenchmarkBLSChangeMainnet_Unmarshal(b *testing.B) {
+	SetMainnetSpec()
+	var change *SignedBLSToExecutionChange
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		change = new(SignedBLSToExecutionChange)
+		if err := change.UnmarshalSSZ(blsChangeMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := change.HashTreeRoot()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != blsChangeMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blsChangeMainnetHTR)
+	}
+}
+
+func BenchmarkBLSChangeMainnet_Marshal(b *testing.B) {
+	SetMainnetSpec()
+	change := new(SignedBLSToExecutionChange)
+	if err := change.UnmarshalSSZ(blsChangeMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = change.MarshalSSZ()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, blsChangeMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBLSChangeMainnet_HashTreeRoot(b *testing.B) {
+	SetMainnetSpec()
+	change := new(SignedBLSToExecutionChange)
+	if err := change.UnmarshalSSZ(blsChangeMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = change.HashTreeRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != blsChangeMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blsChangeMainnetHTR)
+	}
+}
+
+func BenchmarkSyncCommitteeMainnet_Unmarshal(b *testing.B) {
+	SetMainnetSpec()
+	var committee *SyncCommittee
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		committee = new(SyncCommittee)
+		if err := committee.UnmarshalSSZ(syncCommitteeMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := committee.HashTreeRoot()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != syncCommitteeMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, syncCommitteeMainnetHTR)
+	}
+}
+
+func BenchmarkSyncCommitteeMainnet_Marshal(b *testing.B) {
+	SetMainnetSpec()
+	committee := new(SyncCommittee)
+	if err := committee.UnmarshalSSZ(syncCommitteeMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = committee.MarshalSSZ()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, syncCommitteeMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkSyncCommitteeMainnet_HashTreeRoot(b *testing.B) {
+	SetMainnetSpec()
+	committee := new(SyncCommittee)
+	if err := committee.UnmarshalSSZ(syncCommitteeMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = committee.HashTreeRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != syncCommitteeMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, syncCommitteeMainnetHTR)
+	}
+}
+
+func BenchmarkExecutionPayloadMainnet_Unmarshal(b *testing.B) {
+	SetMainnetSpec()
+	var payload *ExecutionPayload
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		payload = new(ExecutionPayload)
+		if err := payload.UnmarshalSSZ(executionPayloadMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := payload.HashTreeRoot()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != executionPayloadMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, executionPayloadMainnetHTR)
+	}
+}
+
+func BenchmarkExecutionPayloadMainnet_Marshal(b *testing.B) {
+	SetMainnetSpec()
+	payload := new(ExecutionPayload)
+	if err := payload.UnmarshalSSZ(executionPayloadMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = payload.MarshalSSZ()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, executionPayloadMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkExecutionPayloadMainnet_HashTreeRoot(b *testing.B) {
+	SetMainnetSpec()
+	payload := new(ExecutionPayload)
+	if err := payload.UnmarshalSSZ(executionPayloadMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = payload.HashTreeRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != executionPayloadMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, executionPayloadMainnetHTR)
+	}
+}
+
+func BenchmarkValidatorMainnet_Unmarshal(b *testing.B) {
+	SetMainnetSpec()
+	var validator *Validator
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		validator = new(Validator)
+		if err := validator.UnmarshalSSZ(validatorMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := validator.HashTreeRoot()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != validatorMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, validatorMainnetHTR)
+	}
+}
+
+func BenchmarkValidatorMainnet_Marshal(b *testing.B) {
+	SetMainnetSpec()
+	validator := new(Validator)
+	if err := validator.UnmarshalSSZ(validatorMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = validator.MarshalSSZ()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, validatorMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkValidatorMainnet_HashTreeRoot(b *testing.B) {
+	SetMainnetSpec()
+	validator := new(Validator)
+	if err := validator.UnmarshalSSZ(validatorMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = validator.HashTreeRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != validatorMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, validatorMainnetHTR)
+	}
+}
+
+func BenchmarkBlobSidecarMainnet_Unmarshal(b *testing.B) {
+	SetMainnetSpec()
+	var sidecar *BlobSidecar
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sidecar = new(BlobSidecar)
+		if err := sidecar.UnmarshalSSZ(blobSidecarMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := sidecar.HashTreeRoot()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != blobSidecarMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blobSidecarMainnetHTR)
+	}
+}
+
+func BenchmarkBlobSidecarMainnet_Marshal(b *testing.B) {
+	SetMainnetSpec()
+	sidecar := new(BlobSidecar)
+	if err := sidecar.UnmarshalSSZ(blobSidecarMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = sidecar.MarshalSSZ()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, blobSidecarMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlobSidecarMainnet_HashTreeRoot(b *testing.B) {
+	SetMainnetSpec()
+	sidecar := new(BlobSidecar)
+	if err := sidecar.UnmarshalSSZ(blobSidecarMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = sidecar.HashTreeRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != blobSidecarMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blobSidecarMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientBootstrapMainnet_Unmarshal(b *testing.B) {
+	SetMainnetSpec()
+	var bootstrap *LightClientBootstrap
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bootstrap = new(LightClientBootstrap)
+		if err := bootstrap.UnmarshalSSZ(lightClientBootstrapMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := bootstrap.HashTreeRoot()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != lightClientBootstrapMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientBootstrapMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientBootstrapMainnet_Marshal(b *testing.B) {
+	SetMainnetSpec()
+	bootstrap := new(LightClientBootstrap)
+	if err := bootstrap.UnmarshalSSZ(lightClientBootstrapMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = bootstrap.MarshalSSZ()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, lightClientBootstrapMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkLightClientBootstrapMainnet_HashTreeRoot(b *testing.B) {
+	SetMainnetSpec()
+	bootstrap := new(LightClientBootstrap)
+	if err := bootstrap.UnmarshalSSZ(lightClientBootstrapMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = bootstrap.HashTreeRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != lightClientBootstrapMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientBootstrapMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientUpdateMainnet_Unmarshal(b *testing.B) {
+	SetMainnetSpec()
+	var update *LightClientUpdate
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		update = new(LightClientUpdate)
+		if err := update.UnmarshalSSZ(lightClientUpdateMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := update.HashTreeRoot()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != lightClientUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientUpdateMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientUpdateMainnet_Marshal(b *testing.B) {
+	SetMainnetSpec()
+	update := new(LightClientUpdate)
+	if err := update.UnmarshalSSZ(lightClientUpdateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = update.MarshalSSZ()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, lightClientUpdateMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkLightClientUpdateMainnet_HashTreeRoot(b *testing.B) {
+	SetMainnetSpec()
+	update := new(LightClientUpdate)
+	if err := update.UnmarshalSSZ(lightClientUpdateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = update.HashTreeRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != lightClientUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientUpdateMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientFinalityUpdateMainnet_Unmarshal(b *testing.B) {
+	SetMainnetSpec()
+	var update *LightClientFinalityUpdate
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		update = new(LightClientFinalityUpdate)
+		if err := update.UnmarshalSSZ(lightClientFinalityUpdateMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := update.HashTreeRoot()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != lightClientFinalityUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientFinalityUpdateMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientFinalityUpdateMainnet_Marshal(b *testing.B) {
+	SetMainnetSpec()
+	update := new(LightClientFinalityUpdate)
+	if err := update.UnmarshalSSZ(lightClientFinalityUpdateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = update.MarshalSSZ()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, lightClientFinalityUpdateMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkLightClientFinalityUpdateMainnet_HashTreeRoot(b *testing.B) {
+	SetMainnetSpec()
+	update := new(LightClientFinalityUpdate)
+	if err := update.UnmarshalSSZ(lightClientFinalityUpdateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = update.HashTreeRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != lightClientFinalityUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientFinalityUpdateMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientOptimisticUpdateMainnet_Unmarshal(b *testing.B) {
+	SetMainnetSpec()
+	var update *LightClientOptimisticUpdate
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		update = new(LightClientOptimisticUpdate)
+		if err := update.UnmarshalSSZ(lightClientOptimisticUpdateMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := update.HashTreeRoot()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != lightClientOptimisticUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientOptimisticUpdateMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientOptimisticUpdateMainnet_Marshal(b *testing.B) {
+	SetMainnetSpec()
+	update := new(LightClientOptimisticUpdate)
+	if err := update.UnmarshalSSZ(lightClientOptimisticUpdateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = update.MarshalSSZ()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, lightClientOptimisticUpdateMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkLightClientOptimisticUpdateMainnet_HashTreeRoot(b *testing.B) {
+	SetMainnetSpec()
+	update := new(LightClientOptimisticUpdate)
+	if err := update.UnmarshalSSZ(lightClientOptimisticUpdateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = update.HashTreeRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != lightClientOptimisticUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientOptimisticUpdateMainnetHTR)
+	}
+}
+
+// ====================== BLOCK EDGE-CASE BENCHMARKS =======================
+
+func BenchmarkBlockEmptyMainnet_Unmarshal(b *testing.B) {
+	SetMainnetSpec()
+	var block *SignedBeaconBlock
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlock)
+		if err := block.UnmarshalSSZ(blockEmptyMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := block.Message.HashTreeRoot()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != blockEmptyMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockEmptyMainnetHTR)
+	}
+}
+
+func BenchmarkBlockEmptyMainnet_Marshal(b *testing.B) {
+	SetMainnetSpec()
+	block := new(SignedBeaconBlock)
+	if err := block.UnmarshalSSZ(blockEmptyMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = block.MarshalSSZ()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, blockEmptyMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockEmptyMainnet_HashTreeRoot(b *testing.B) {
+	SetMainnetSpec()
+	block := new(SignedBeaconBlock)
+	if err := block.UnmarshalSSZ(blockEmptyMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = block.Message.HashTreeRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != blockEmptyMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockEmptyMainnetHTR)
+	}
+}
+
+func BenchmarkBlockMaxMainnet_Unmarshal(b *testing.B) {
+	SetMainnetSpec()
+	var block *SignedBeaconBlock
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlock)
+		if err := block.UnmarshalSSZ(blockMaxMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := block.Message.HashTreeRoot()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != blockMaxMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMaxMainnetHTR)
+	}
+}
+
+func BenchmarkBlockMaxMainnet_Marshal(b *testing.B) {
+	SetMainnetSpec()
+	block := new(SignedBeaconBlock)
+	if err := block.UnmarshalSSZ(blockMaxMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = block.MarshalSSZ()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, blockMaxMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockMaxMainnet_HashTreeRoot(b *testing.B) {
+	SetMainnetSpec()
+	block := new(SignedBeaconBlock)
+	if err := block.UnmarshalSSZ(blockMaxMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = block.Message.HashTreeRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != blockMaxMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMaxMainnetHTR)
+	}
+}
+
+func BenchmarkBlockBoundaryMainnet_Unmarshal(b *testing.B) {
+	SetMainnetSpec()
+	var block *SignedBeaconBlock
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlock)
+		if err := block.UnmarshalSSZ(blockBoundaryMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := block.Message.HashTreeRoot()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != blockBoundaryMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockBoundaryMainnetHTR)
+	}
+}
+
+func BenchmarkBlockBoundaryMainnet_Marshal(b *testing.B) {
+	SetMainnetSpec()
+	block := new(SignedBeaconBlock)
+	if err := block.UnmarshalSSZ(blockBoundaryMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = block.MarshalSSZ()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, blockBoundaryMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockBoundaryMainnet_HashTreeRoot(b *testing.B) {
+	SetMainnetSpec()
+	block := new(SignedBeaconBlock)
+	if err := block.UnmarshalSSZ(blockBoundaryMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = block.Message.HashTreeRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != blockBoundaryMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockBoundaryMainnetHTR)
+	}
+}
+
+func BenchmarkBlockTypedMainnet_Unmarshal(b *testing.B) {
+	SetMainnetSpec()
+	var block *SignedBeaconBlock
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlock)
+		if err := block.UnmarshalSSZ(blockTypedMainnetData); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := block.Message.HashTreeRoot()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != blockTypedMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockTypedMainnetHTR)
+	}
+}
+
+func BenchmarkBlockTypedMainnet_Marshal(b *testing.B) {
+	SetMainnetSpec()
+	block := new(SignedBeaconBlock)
+	if err := block.UnmarshalSSZ(blockTypedMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var data []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		data, err = block.MarshalSSZ()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(data, blockTypedMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockTypedMainnet_HashTreeRoot(b *testing.B) {
+	SetMainnetSpec()
+	block := new(SignedBeaconBlock)
+	if err := block.UnmarshalSSZ(blockTypedMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		htr, err = block.Message.HashTreeRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if htr != blockTypedMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockTypedMainnetHTR)
+	}
+}
+
+// ======================= SNAPPY PIPELINE BENCHMARKS =======================
+//
+// Decompress+unmarshal and marshal+compress end-to-end, for the framed snappy
+// encoding of req/resp (_Snappy*) and the block encoding of gossip
+// (_SnappyBlock*). The library only works on byte slices, so the framed
+// variants go through a buffer.
+
+// checkSnappyFramed decompresses a framed snappy stream and compares it with
+// the uncompressed corpus.
+func checkSnappyFramed(b *testing.B, framed []byte, want []byte) {
+	data, err := io.ReadAll(snappy.NewReader(bytes.NewReader(framed)))
+	if err != nil {
+		b.Fatal(err)
+	}
+	if !bytes.Equal(data, want) {
+		b.Fatal("decompressed data does not match original")
+	}
+}
+
+// checkSnappyBlock decompresses a snappy block and compares it with the
+// uncompressed corpus.
+func checkSnappyBlock(b *testing.B, block []byte, want []byte) {
+	data, err := snappy.Decode(nil, block)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if !bytes.Equal(data, want) {
+		b.Fatal("decompressed data does not match original")
+	}
+}
+
+func BenchmarkBlockMainnet_SnappyUnmarshal(b *testing.B) {
+	SetMainnetSpec()
+	var block *SignedBeaconBlock
+	reader := snappy.NewReader(nil)
+	buf := make([]byte, len(blockMainnetData))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlock)
+		reader.Reset(bytes.NewReader(blockMainnetSnappyFramed))
+		if _, err := io.ReadFull(reader, buf); err != nil {
+			b.Fatal(err)
+		}
+		if err := block.UnmarshalSSZ(buf); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := block.Message.HashTreeRoot()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != blockMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMainnetHTR)
+	}
+}
+
+func BenchmarkBlockMainnet_SnappyMarshal(b *testing.B) {
+	SetMainnetSpec()
+	block := new(SignedBeaconBlock)
+	if err := block.UnmarshalSSZ(blockMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var buf bytes.Buffer
+	writer := snappy.NewBufferedWriter(nil)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+		writer.Reset(&buf)
+		data, err := block.MarshalSSZ()
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err := writer.Write(data); err != nil {
+			b.Fatal(err)
+		}
+		if err := writer.Close(); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	checkSnappyFramed(b, buf.Bytes(), blockMainnetData)
+}
+
+func BenchmarkBlockMainnet_SnappyBlockUnmarshal(b *testing.B) {
+	SetMainnetSpec()
+	var block *SignedBeaconBlock
+	buf := make([]byte, len(blockMainnetData))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlock)
+		data, err := snappy.Decode(buf, blockMainnetSnappyBlock)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if err := block.UnmarshalSSZ(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := block.Message.HashTreeRoot()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != blockMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMainnetHTR)
+	}
+}
+
+func BenchmarkBlockMainnet_SnappyBlockMarshal(b *testing.B) {
+	SetMainnetSpec()
+	block := new(SignedBeaconBlock)
+	if err := block.UnmarshalSSZ(blockMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	buf := make([]byte, snappy.MaxEncodedLen(len(blockMainnetData)))
+	var compressed []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		data, err := block.MarshalSSZ()
+		if err != nil {
+			b.Fatal(err)
+		}
+		compressed = snappy.Encode(buf, data)
+	}
+	b.StopTimer()
+	checkSnappyBlock(b, compressed, blockMainnetData)
+}
+
+func BenchmarkStateMainnet_SnappyUnmarshal(b *testing.B) {
+	SetMainnetSpec()
+	var state *BeaconState
+	reader := snappy.NewReader(nil)
+	buf := make([]byte, len(stateMainnetData))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		state = new(BeaconState)
+		reader.Reset(bytes.NewReader(stateMainnetSnappyFramed))
+		if _, err := io.ReadFull(reader, buf); err != nil {
+			b.Fatal(err)
+		}
+		if err := state.UnmarshalSSZ(buf); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := state.HashTreeRoot()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != stateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, stateMainnetHTR)
+	}
+}
+
+func BenchmarkStateMainnet_SnappyMarshal(b *testing.B) {
+	SetMainnetSpec()
+	state := new(BeaconState)
+	if err := state.UnmarshalSSZ(stateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var buf bytes.Buffer
+	writer := snappy.NewBufferedWriter(nil)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+		writer.Reset(&buf)
+		data, err := state.MarshalSSZ()
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err := writer.Write(data); err != nil {
+			b.Fatal(err)
+		}
+		if err := writer.Close(); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	checkSnappyFramed(b, buf.Bytes(), stateMainnetData)
+}
+
+func BenchmarkStateMainnet_SnappyBlockUnmarshal(b *testing.B) {
+	SetMainnetSpec()
+	var state *BeaconState
+	buf := make([]byte, len(stateMainnetData))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		state = new(BeaconState)
+		data, err := snappy.Decode(buf, stateMainnetSnappyBlock)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if err := state.UnmarshalSSZ(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr, err := state.HashTreeRoot()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if htr != stateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, stateMainnetHTR)
+	}
+}
+
+func BenchmarkStateMainnet_SnappyBlockMarshal(b *testing.B) {
+	SetMainnetSpec()
+	state := new(BeaconState)
+	if err := state.UnmarshalSSZ(stateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	buf := make([]byte, snappy.MaxEncodedLen(len(stateMainnetData)))
+	var compressed []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		data, err := state.MarshalSSZ()
+		if err != nil {
+			b.Fatal(err)
+		}
+		compressed = snappy.Encode(buf, data)
+	}
+	b.StopTimer()
+	checkSnappyBlock(b, compressed, stateMainnetData)
+}
+
+// =========================== ERA FILE BENCHMARKS ===========================
+//
+// Decode a whole era file (e2store records with snappy compressed blocks and
+// the boundary state) the way archive and sync tooling reads it. Records do
+// not store their uncompressed size, so each is decompressed into a reused
+// buffer and decoded from there.
+
+// eraManifest mirrors the manifest.json written by `generator era`
+type eraManifest struct {
+	Entries []*eraEntry `json:"entries"`
+}
+
+type eraEntry struct {
+	File         string `json:"file"`
+	Preset       string `json:"preset"`
+	Blocks       int    `json:"blocks"`
+	LastBlockHTR string `json:"last_block_htr"`
+	StateHTR     string `json:"state_htr"`
+}
+
+// runEra reads the era file of preset listed in res/era/manifest.json b.N
+// times and reports the decoded blocks per second, plus the peak heap growth
+// while reading it from an extra untimed pass. It skips when no era corpus
+// has been generated and returns the manifest entry for the HTR checks.
+func runEra(b *testing.B, preset string, decodeBlock func(data []byte) error, decodeState func(data []byte) error) *eraEntry {
+	manifestData, err := os.ReadFile("../../res/era/manifest.json")
+	if err != nil {
+		b.Skip("no era corpus (see scripts/generate-era.sh): " + err.Error())
+	}
+	var manifest eraManifest
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		b.Fatal(err)
+	}
+	var entry *eraEntry
+	for _, e := range manifest.Entries {
+		if e.Preset == preset {
+			entry = e
+		}
+	}
+	if entry == nil {
+		b.Skip("no " + preset + " era in res/era/manifest.json")
+	}
+	path := "../../res/era/" + entry.File
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		blocks, err := readEra(path, decodeBlock, decodeState, nil)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if blocks != entry.Blocks {
+			b.Fatalf("decoded %d blocks, want %d", blocks, entry.Blocks)
+		}
+	}
+	b.StopTimer()
+	b.ReportMetric(float64(entry.Blocks*b.N)/b.Elapsed().Seconds(), "blocks/s")
+
+	var baseline, stats runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&baseline)
+	peak := baseline.HeapAlloc
+	_, err = readEra(path, decodeBlock, decodeState, func() {
+		runtime.ReadMemStats(&stats)
+		peak = max(peak, stats.HeapAlloc)
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportMetric(float64(peak-baseline.HeapAlloc), "peak-heap-B")
+	return entry
+}
+
+// readEra reads an era file record by record, decoding the compressed blocks
+// and the state and skipping all other records. sample, if set, runs after
+// every decoded record. It returns the number of blocks.
+func readEra(path string, decodeBlock func(data []byte) error, decodeState func(data []byte) error, sample func()) (int, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	reader := bufio.NewReaderSize(file, 1<<20)
+	snappyReader := snappy.NewReader(nil)
+	var header [8]byte
+	var buf bytes.Buffer
+	blocks := 0
+	for {
+		if _, err := io.ReadFull(reader, header[:]); err == io.EOF {
+			return blocks, nil
+		} else if err != nil {
+			return 0, err
+		}
+		length := int64(binary.LittleEndian.Uint32(header[2:6]))
+
+		var decode func(data []byte) error
+		switch {
+		case header[0] == 0x01 && header[1] == 0x00:
+			decode = decodeBlock
+			blocks++
+		case header[0] == 0x02 && header[1] == 0x00:
+			decode = decodeState
+		default:
+			if _, err := reader.Discard(int(length)); err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		snappyReader.Reset(io.LimitReader(reader, length))
+		buf.Reset()
+		if _, err := buf.ReadFrom(snappyReader); err != nil {
+			return 0, err
+		}
+		if err := decode(buf.Bytes()); err != nil {
+			return 0, err
+		}
+		if sample != nil {
+			sample()
+		}
+	}
+}
+
+func BenchmarkEraMainnet_Decode(b *testing.B) {
+	SetMainnetSpec()
+	var block *SignedBeaconBlock
+	var state *BeaconState
+	era := runEra(b, "mainnet", func(data []byte) error {
+		block = new(SignedBeaconBlock)
+		return block.UnmarshalSSZ(data)
+	}, func(data []byte) error {
+		state = new(BeaconState)
+		return state.UnmarshalSSZ(data)
+	})
+	htr, err := block.Message.HashTreeRoot()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if hex.EncodeToString(htr[:]) != era.LastBlockHTR {
+		b.Fatalf("HTR mismatch: got %x, want %s", htr, era.LastBlockHTR)
+	}
+	htr, err = state.HashTreeRoot()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if hex.EncodeToString(htr[:]) != era.StateHTR {
+		b.Fatalf("HTR mismatch: got %x, want %s", htr, era.StateHTR)
+	}
+}
+
+func BenchmarkEraMinimal_Decode(b *testing.B) {
+	SetMinimalSpec()
+	var block *SignedBeaconBlock
+	var state *BeaconState
+	era := runEra(b, "minimal", func(data []byte) error {
+		block = new(SignedBeaconBlock)
+		return block.UnmarshalSSZ(data)
+	}, func(data []byte) error {
+		state = new(BeaconState)
+		return state.UnmarshalSSZ(data)
+	})
+	htr, err := block.Message.HashTreeRoot()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if hex.EncodeToString(htr[:]) != era.LastBlockHTR {
+		b.Fatalf("HTR mismatch: got %x, want %s", htr, era.LastBlockHTR)
+	}
+	htr, err = state.HashTreeRoot()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if hex.EncodeToString(htr[:]) != era.StateHTR {
+		b.Fatalf("HTR mismatch: got %x, want %s", htr, era.StateHTR)
+	}
+}
+
+// ========================= STATE SWEEP BENCHMARKS =========================
+
+// sweepManifest mirrors the manifest.json written by `generator sweep`
+type sweepManifest struct {
+	Entries []struct {
+		File       string `json:"file"`
+		Validators int    `json:"validators"`
+		Preset     string `json:"preset"`
+		Fork       string `json:"fork"`
+		HTR        string `json:"htr"`
+	} `json:"entries"`
+}
+
+// runSweep runs fn as a sub-benchmark for every mainnet deneb state listed in
+// res/sweep/manifest.json. It skips when no sweep has been generated.
+func runSweep(b *testing.B, fn func(b *testing.B, stateData []byte, stateHTR [32]byte)) {
+	manifestData, err := os.ReadFile("../../res/sweep/manifest.json")
+	if err != nil {
+		b.Skip("no sweep corpus (see scripts/generate-sweep.sh): " + err.Error())
+	}
+	var manifest sweepManifest
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		b.Fatal(err)
+	}
+	for _, entry := range manifest.Entries {
+		if entry.Preset != "mainnet" || entry.Fork != "deneb" {
+			continue
+		}
+		stateData, err := os.ReadFile("../../res/sweep/" + entry.File)
+		if err != nil {
+			b.Fatal(err)
+		}
+		htrBytes, err := hex.DecodeString(entry.HTR)
+		if err != nil {
+			b.Fatal(err)
+		}
+		var stateHTR [32]byte
+		copy(stateHTR[:], htrBytes)
+		b.Run(fmt.Sprintf("validators=%d", entry.Validators), func(b *testing.B) {
+			fn(b, stateData, stateHTR)
+		})
+	}
+}
+
+func BenchmarkStateSweep_Unmarshal(b *testing.B) {
+	runSweep(b, func(b *testing.B, stateData []byte, stateHTR [32]byte) {
+		SetMainnetSpec()
+		var state *BeaconState
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			state = new(BeaconState)
+			if err := state.UnmarshalSSZ(stateData); err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.StopTimer()
+		htr, err := state.HashTreeRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+		if htr != stateHTR {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, stateHTR)
+		}
+	})
+}
+
+func BenchmarkStateSweep_Marshal(b *testing.B) {
+	runSweep(b, func(b *testing.B, stateData []byte, stateHTR [32]byte) {
+		SetMainnetSpec()
+		state := new(BeaconState)
+		if err := state.UnmarshalSSZ(stateData); err != nil {
+			b.Fatal(err)
+		}
+		var data []byte
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			var err error
+			data, err = state.MarshalSSZ()
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.StopTimer()
+		if !bytes.Equal(data, stateData) {
+			b.Fatal("marshaled data does not match original")
+		}
+	})
+}
+
+func BenchmarkStateSweep_HashTreeRoot(b *testing.B) {
+	runSweep(b, func(b *testing.B, stateData []byte, stateHTR [32]byte) {
+		SetMainnetSpec()
+		state := new(BeaconState)
+		if err := state.UnmarshalSSZ(stateData); err != nil {
+			b.Fatal(err)
+		}
+		var htr [32]byte
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			var err error
+			htr, err = state.HashTreeRoot()
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.StopTimer()
+		if htr != stateHTR {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, stateHTR)
+		}
+	})
+}
+
+// ======================= STATE SEQUENCE BENCHMARKS =======================
+
+// sequenceManifest mirrors the manifest.json written by `generator sequence`
+type sequenceManifest struct {
+	Entries []struct {
+		File   string `json:"file"`
+		Step   int    `json:"step"`
+		Preset string `json:"preset"`
+		Fork   string `json:"fork"`
+		HTR    string `json:"htr"`
+	} `json:"entries"`
+}
+
+// loadSequence loads the mainnet deneb states listed in
+// res/sequence/manifest.json in step order, together with their HTRs. Every
+// state differs from its predecessor by one slot of changes. It skips when no
+// sequence has been generated.
+func loadSequence(b *testing.B) ([][]byte, [][32]byte) {
+	manifestData, err := os.ReadFile("../../res/sequence/manifest.json")
+	if err != nil {
+		b.Skip("no state sequence (see scripts/generate-sequence.sh): " + err.Error())
+	}
+	var manifest sequenceManifest
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		b.Fatal(err)
+	}
+	var stepData [][]byte
+	var stepHTRs [][32]byte
+	for _, entry := range manifest.Entries {
+		if entry.Preset != "mainnet" || entry.Fork != "deneb" {
+			continue
+		}
+		if entry.Step != len(stepData) {
+			b.Fatalf("sequence step %d out of order", entry.Step)
+		}
+		data, err := os.ReadFile("../../res/sequence/" + entry.File)
+		if err != nil {
+			b.Fatal(err)
+		}
+		htrBytes, err := hex.DecodeString(entry.HTR)
+		if err != nil {
+			b.Fatal(err)
+		}
+		var htr [32]byte
+		copy(htr[:], htrBytes)
+		stepData = append(stepData, data)
+		stepHTRs = append(stepHTRs, htr)
+	}
+	if len(stepData) < 2 {
+		b.Skip("no mainnet state sequence in res/sequence/manifest.json")
+	}
+	return stepData, stepHTRs
+}
+
+// BenchmarkStateSequence_HashTreeRoot re-roots the successor states one after
+// the other. The library keeps no hash cache, so every state is hashed from
+// scratch.
+func BenchmarkStateSequence_HashTreeRoot(b *testing.B) {
+	SetMainnetSpec()
+	stepData, stepHTRs := loadSequence(b)
+	states := make([]*BeaconState, len(stepData))
+	for step, data := range stepData {
+		states[step] = new(BeaconState)
+		if err := states[step].UnmarshalSSZ(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		step := 1 + i%(len(states)-1)
+		htr, err := states[step].HashTreeRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+		if htr != stepHTRs[step] {
+			b.Fatalf("step %d HTR mismatch: got %x, want %x", step, htr, stepHTRs[step])
+		}
+	}
+}
+
+// ===================== STATE REHASH MAINNET BENCHMARKS ======================
+//
+// The rehash benchmarks apply one of the updates in res/rehash-mainnet.json
+// to the mainnet state and re-root it, which is the work behind the state root
+// of every slot. Iterations alternate between the updated and the original
+// value, so every root follows a change.
+// The library keeps no hash cache, so every root is computed over the full
+// state.
+
+// rehashManifest mirrors the rehash-mainnet.json written by `generator rehash`
+type rehashManifest struct {
+	HTR     string `json:"htr"`
+	Balance struct {
+		Index   uint64 `json:"index"`
+		Balance uint64 `json:"balance"`
+		HTR     string `json:"htr"`
+	} `json:"balance"`
+	BlockRoot struct {
+		Index uint64 `json:"index"`
+		Root  string `json:"root"`
+		HTR   string `json:"htr"`
+	} `json:"block_root"`
+	Validator struct {
+		Index             uint64 `json:"index"`
+		ExitEpoch         uint64 `json:"exit_epoch"`
+		WithdrawableEpoch uint64 `json:"withdrawable_epoch"`
+		HTR               string `json:"htr"`
+	} `json:"validator"`
+}
+
+// loadRehash loads res/rehash-mainnet.json and checks that it describes the
+// state-mainnet.ssz corpus.
+func loadRehash(b *testing.B) *rehashManifest {
+	data, err := os.ReadFile("../../res/rehash-mainnet.json")
+	if err != nil {
+		b.Fatal(err)
+	}
+	var manifest rehashManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		b.Fatal(err)
+	}
+	if htr := rehashRoot(b, manifest.HTR); htr != stateMainnetHTR {
+		b.Fatalf("rehash-mainnet.json is for state %x, want %x", htr, stateMainnetHTR)
+	}
+	return &manifest
+}
+
+func rehashRoot(b *testing.B, s string) [32]byte {
+	rootBytes, err := hex.DecodeString(s)
+	if err != nil {
+		b.Fatal(err)
+	}
+	var root [32]byte
+	copy(root[:], rootBytes)
+	return root
+}
+
+func BenchmarkStateMainnet_RehashAfterBalanceUpdate(b *testing.B) {
+	update := loadRehash(b).Balance
+	SetMainnetSpec()
+	state := new(BeaconState)
+	if err := state.UnmarshalSSZ(stateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	balances := [2]Gwei{Gwei(update.Balance), state.Balances[update.Index]}
+	htrs := [2][32]byte{rehashRoot(b, update.HTR), stateMainnetHTR}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		state.Balances[update.Index] = balances[i%2]
+		htr, err := state.HashTreeRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+		if htr != htrs[i%2] {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, htrs[i%2])
+		}
+	}
+}
+
+func BenchmarkStateMainnet_RehashAfterBlockRootsUpdate(b *testing.B) {
+	update := loadRehash(b).BlockRoot
+	SetMainnetSpec()
+	state := new(BeaconState)
+	if err := state.UnmarshalSSZ(stateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	root := rehashRoot(b, update.Root)
+	roots := [2][]byte{root[:], state.BlockRoots[update.Index]}
+	htrs := [2][32]byte{rehashRoot(b, update.HTR), stateMainnetHTR}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		state.BlockRoots[update.Index] = roots[i%2]
+		htr, err := state.HashTreeRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+		if htr != htrs[i%2] {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, htrs[i%2])
+		}
+	}
+}
+
+func BenchmarkStateMainnet_RehashAfterValidatorUpdate(b *testing.B) {
+	update := loadRehash(b).Validator
+	SetMainnetSpec()
+	state := new(BeaconState)
+	if err := state.UnmarshalSSZ(stateMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	validators := [2]Validator{*state.Validators[update.Index], *state.Validators[update.Index]}
+	validators[0].ExitEpoch = Epoch(update.ExitEpoch)
+	validators[0].WithdrawableEpoch = Epoch(update.WithdrawableEpoch)
+	htrs := [2][32]byte{rehashRoot(b, update.HTR), stateMainnetHTR}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		*state.Validators[update.Index] = validators[i%2]
+		htr, err := state.HashTreeRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+		if htr != htrs[i%2] {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, htrs[i%2])
+		}
+	}
+}
+
+// ======================= MALFORMED BLOCK BENCHMARKS =======================
+
+// malformedManifest mirrors the manifest.json written by `generator malformed`
+type malformedManifest struct {
+	Entries []struct {
+		File    string `json:"file"`
+		Source  string `json:"source"`
+		Variant string `json:"variant"`
+		Class   string `json:"class"`
+	} `json:"entries"`
+}
+
+// runMalformed runs a sub-benchmark for every malformed variant of source
+// listed in res/malformed/manifest.json, asserting that decode rejects it
+// with an error rather than accepting it or panicking.
+func runMalformed(b *testing.B, source string, decode func(data []byte) error) {
+	manifestData, err := os.ReadFile("../../res/malformed/manifest.json")
+	if err != nil {
+		b.Skip("no malformed corpus (see scripts/generate-corpus.sh): " + err.Error())
+	}
+	var manifest malformedManifest
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		b.Fatal(err)
+	}
+	for _, entry := range manifest.Entries {
+		if entry.Source != source {
+			continue
+		}
+		data, err := os.ReadFile("../../res/malformed/" + entry.File)
+		if err != nil {
+			b.Fatal(err)
+		}
+		class := entry.Class
+		b.Run(entry.Variant, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				rejectMalformed(b, class, decode, data)
+			}
+		})
+	}
+}
+
+// rejectMalformed fails the benchmark unless decode returns an error for data
+func rejectMalformed(b *testing.B, class string, decode func(data []byte) error, data []byte) {
+	defer func() {
+		if r := recover(); r != nil {
+			b.Fatalf("panicked on malformed input (%s): %v", class, r)
+		}
+	}()
+	if err := decode(data); err == nil {
+		b.Fatalf("accepted malformed input (%s)", class)
+	}
+}
+
+func BenchmarkBlockMalformed_Unmarshal(b *testing.B) {
+	SetMainnetSpec()
+	runMalformed(b, "block-mainnet.ssz", func(data []byte) error {
+		return new(SignedBeaconBlock).UnmarshalSSZ(data)
+	})
+}
+
+// ======================== PARALLEL MAINNET BENCHMARKS ========================
+//
+// The parallel benchmarks run the block and attestation operations on
+// GOMAXPROCS goroutines at once, as a beacon node does, and expose contention
+// in shared hasher pools and global state. ns/op is wall time per operation
+// over all goroutines. run-benchmarks.sh runs them unpinned with -cpu 1,2,4,8.
+
+// parallelResults collects the last result of every RunParallel goroutine, so
+// that they can be checked once the timer is stopped
+type parallelResults[T any] struct {
+	mu     sync.Mutex
+	values []T
+}
+
+func (r *parallelResults[T]) add(value T) {
+	r.mu.Lock()
+	r.values = append(r.values, value)
+	r.mu.Unlock()
+}
+
+func BenchmarkBlockMainnet_UnmarshalParallel(b *testing.B) {
+	SetMainnetSpec()
+	var results parallelResults[*SignedBeaconBlock]
+	b.RunParallel(func(pb *testing.PB) {
+		var block *SignedBeaconBlock
+		for pb.Next() {
+			block = new(SignedBeaconBlock)
+			if err := block.UnmarshalSSZ(blockMainnetData); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+		results.add(block)
+	})
+	b.StopTimer()
+	for _, block := range results.values {
+		if block == nil {
+			continue // no iterations on that goroutine
+		}
+		htr, err := block.Message.HashTreeRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+		if htr != blockMainnetHTR {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMainnetHTR)
+		}
+	}
+}
+
+func BenchmarkBlockMainnet_MarshalParallel(b *testing.B) {
+	SetMainnetSpec()
+	block := new(SignedBeaconBlock)
+	if err := block.UnmarshalSSZ(blockMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var results parallelResults[[]byte]
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var data []byte
+		for pb.Next() {
+			var err error
+			data, err = block.MarshalSSZ()
+			if err != nil {
+				b.Error(err)
+				return
+			}
+		}
+		results.add(data)
+	})
+	b.StopTimer()
+	for _, data := range results.values {
+		if data != nil && !bytes.Equal(data, blockMainnetData) {
+			b.Fatal("marshaled data does not match original")
+		}
+	}
+}
+
+func BenchmarkBlockMainnet_HashTreeRootParallel(b *testing.B) {
+	SetMainnetSpec()
+	block := new(SignedBeaconBlock)
+	if err := block.UnmarshalSSZ(blockMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var results parallelResults[[32]byte]
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var htr [32]byte
+		for pb.Next() {
+			var err error
+			htr, err = block.Message.HashTreeRoot()
+			if err != nil {
+				b.Error(err)
+				return
+			}
+		}
+		results.add(htr)
+	})
+	b.StopTimer()
+	for _, htr := range results.values {
+		if htr != ([32]byte{}) && htr != blockMainnetHTR {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMainnetHTR)
+		}
+	}
+}
+
+func BenchmarkAttestationMainnet_UnmarshalParallel(b *testing.B) {
+	SetMainnetSpec()
+	var results parallelResults[*Attestation]
+	b.RunParallel(func(pb *testing.PB) {
+		var attestation *Attestation
+		for pb.Next() {
+			attestation = new(Attestation)
+			if err := attestation.UnmarshalSSZ(attestationMainnetData); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+		results.add(attestation)
+	})
+	b.StopTimer()
+	for _, attestation := range results.values {
+		if attestation == nil {
+			continue // no iterations on that goroutine
+		}
+		htr, err := attestation.HashTreeRoot()
+		if err != nil {
+			b.Fatal(err)
+		}
+		if htr != attestationMainnetHTR {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, attestationMainnetHTR)
+		}
+	}
+}
+
+func BenchmarkAttestationMainnet_MarshalParallel(b *testing.B) {
+	SetMainnetSpec()
+	attestation := new(Attestation)
+	if err := attestation.UnmarshalSSZ(attestationMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var results parallelResults[[]byte]
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var data []byte
+		for pb.Next() {
+			var err error
+			data, err = attestation.MarshalSSZ()
+			if err != nil {
+				b.Error(err)
+				return
+			}
+		}
+		results.add(data)
+	})
+	b.StopTimer()
+	for _, data := range results.values {
+		if data != nil && !bytes.Equal(data, attestationMainnetData) {
+			b.Fatal("marshaled data does not match original")
+		}
+	}
+}
+
+func BenchmarkAttestationMainnet_HashTreeRootParallel(b *testing.B) {
+	SetMainnetSpec()
+	attestation := new(Attestation)
+	if err := attestation.UnmarshalSSZ(attestationMainnetData); err != nil {
+		b.Fatal(err)
+	}
+	var results parallelResults[[32]byte]
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var htr [32]byte
+		for pb.Next() {
+			var err error
+			htr, err = attestation.HashTreeRoot()
+			if err != nil {
+				b.Error(err)
+				return
+			}
+		}
+		results.add(htr)
+	})
+	b.StopTimer()
+	for _, htr := range results.values {
+		if htr != ([32]byte{}) && htr != attestationMainnetHTR {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, attestationMainnetHTR)
+		}
+	}
+}
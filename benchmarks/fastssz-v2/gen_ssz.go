@@ -1,5 +1,5 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: 14aa101bce69d3ee1c8fae0ef416dace003d4220918ef700df6c58cfdcce1e9d
+// Hash: 9be26c9f7fd169d139c029414e98b6c2ccf57102174003c050695b17ff45e8cc
 // Version: 2.0.0
 package fastssz
 
@@ -3444,13 +3444,3800 @@ func (s *SignedBeaconBlock) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(s)
 }
 
+// MarshalSSZ ssz marshals the BlobSidecar object
+func (b *BlobSidecar) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BlobSidecar object to a target array
+func (b *BlobSidecar) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Index'
+	dst = ssz.MarshalValue(dst, b.Index)
+
+	// Field (1) 'Blob'
+	if size := uint64(len(b.Blob)); size != 131072 {
+		err = ssz.ErrBytesLengthFn("BlobSidecar.Blob", size, 131072)
+		return
+	}
+	dst = append(dst, b.Blob...)
+
+	// Field (2) 'KZGCommitment'
+	dst = append(dst, b.KZGCommitment[:]...)
+
+	// Field (3) 'KZGProof'
+	dst = append(dst, b.KZGProof[:]...)
+
+	// Field (4) 'SignedBlockHeader'
+	if b.SignedBlockHeader == nil {
+		b.SignedBlockHeader = new(SignedBeaconBlockHeader)
+	}
+	if dst, err = b.SignedBlockHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (5) 'KZGCommitmentInclusionProof'
+	if size := uint64(len(b.KZGCommitmentInclusionProof)); size != kzgCommitmentInclusionProofDepth {
+		err = ssz.ErrVectorLengthFn("BlobSidecar.KZGCommitmentInclusionProof", size, kzgCommitmentInclusionProofDepth)
+		return
+	}
+	for ii := uint64(0); ii < kzgCommitmentInclusionProofDepth; ii++ {
+		if size := uint64(len(b.KZGCommitmentInclusionProof[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("BlobSidecar.KZGCommitmentInclusionProof[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.KZGCommitmentInclusionProof[ii]...)
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BlobSidecar object
+func (b *BlobSidecar) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(b, buf)
+}
+
+// UnmarshalSSZTail unmarshals the BlobSidecar object and returns the remaining bufferº
+func (b *BlobSidecar) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := b.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	// Field (0) 'Index'
+	b.Index, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Field (1) 'Blob'
+	b.Blob, buf = ssz.UnmarshalBytes(b.Blob, buf, 131072)
+
+	// Field (2) 'KZGCommitment'
+	buf = ssz.UnmarshalFixedBytes(b.KZGCommitment[:], buf)
+
+	// Field (3) 'KZGProof'
+	buf = ssz.UnmarshalFixedBytes(b.KZGProof[:], buf)
+
+	// Field (4) 'SignedBlockHeader'
+	if buf, err = ssz.UnmarshalFieldTail(&b.SignedBlockHeader, buf); err != nil {
+		return
+	}
+
+	// Field (5) 'KZGCommitmentInclusionProof'
+	b.KZGCommitmentInclusionProof = make([][]byte, kzgCommitmentInclusionProofDepth)
+	for ii := uint64(0); ii < kzgCommitmentInclusionProofDepth; ii++ {
+		b.KZGCommitmentInclusionProof[ii], buf = ssz.UnmarshalBytes(b.KZGCommitmentInclusionProof[ii], buf, 32)
+	}
+
+	return buf, nil
+}
+
+// fixedSize returns the fixed size of the BlobSidecar object
+func (b *BlobSidecar) fixedSize() int {
+	return int((131384 + (kzgCommitmentInclusionProofDepth * 32)))
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BlobSidecar object
+func (b *BlobSidecar) SizeSSZ() (size int) {
+	size = b.fixedSize()
+	return
+}
+
+// HashTreeRoot ssz hashes the BlobSidecar object
+func (b *BlobSidecar) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BlobSidecar object with a hasher
+func (b *BlobSidecar) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Index'
+	hh.PutUint64(b.Index)
+
+	// Field (1) 'Blob'
+	if size := uint64(len(b.Blob)); size != 131072 {
+		err = ssz.ErrBytesLengthFn("BlobSidecar.Blob", size, 131072)
+		return
+	}
+	hh.PutBytes(b.Blob)
+
+	// Field (2) 'KZGCommitment'
+	hh.PutBytes(b.KZGCommitment[:])
+
+	// Field (3) 'KZGProof'
+	hh.PutBytes(b.KZGProof[:])
+
+	// Field (4) 'SignedBlockHeader'
+	if b.SignedBlockHeader == nil {
+		b.SignedBlockHeader = new(SignedBeaconBlockHeader)
+	}
+	if err = b.SignedBlockHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (5) 'KZGCommitmentInclusionProof'
+	{
+		if size := uint64(len(b.KZGCommitmentInclusionProof)); size != kzgCommitmentInclusionProofDepth {
+			err = ssz.ErrVectorLengthFn("BlobSidecar.KZGCommitmentInclusionProof", size, kzgCommitmentInclusionProofDepth)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.KZGCommitmentInclusionProof {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the BlobSidecar object
+func (b *BlobSidecar) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// MarshalSSZ ssz marshals the LightClientHeader object
+func (l *LightClientHeader) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(l)
+}
+
+// MarshalSSZTo ssz marshals the LightClientHeader object to a target array
+func (l *LightClientHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := l.fixedSize()
+
+	// Field (0) 'Beacon'
+	if l.Beacon == nil {
+		l.Beacon = new(BeaconBlockHeader)
+	}
+	if dst, err = l.Beacon.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (1) 'Execution'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (2) 'ExecutionBranch'
+	if size := uint64(len(l.ExecutionBranch)); size != 4 {
+		err = ssz.ErrVectorLengthFn("LightClientHeader.ExecutionBranch", size, 4)
+		return
+	}
+	for ii := uint64(0); ii < 4; ii++ {
+		if size := uint64(len(l.ExecutionBranch[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("LightClientHeader.ExecutionBranch[ii]", size, 32)
+			return
+		}
+		dst = append(dst, l.ExecutionBranch[ii]...)
+	}
+
+	// Field (1) 'Execution'
+	if dst, err = l.Execution.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the LightClientHeader object
+func (l *LightClientHeader) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(l, buf)
+}
+
+// UnmarshalSSZTail unmarshals the LightClientHeader object and returns the remaining bufferº
+func (l *LightClientHeader) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := l.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Field (0) 'Beacon'
+	if buf, err = ssz.UnmarshalFieldTail(&l.Beacon, buf); err != nil {
+		return
+	}
+
+	// Offset (1) 'Execution'
+	if o1, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (2) 'ExecutionBranch'
+	l.ExecutionBranch = make([][]byte, 4)
+	for ii := uint64(0); ii < 4; ii++ {
+		l.ExecutionBranch[ii], buf = ssz.UnmarshalBytes(l.ExecutionBranch[ii], buf, 32)
+	}
+
+	// Field (1) 'Execution'
+	if err = ssz.UnmarshalField(&l.Execution, tail[o1:]); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the LightClientHeader object
+func (l *LightClientHeader) fixedSize() int {
+	return int(244)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the LightClientHeader object
+func (l *LightClientHeader) SizeSSZ() (size int) {
+	size = l.fixedSize()
+
+	// Field (1) 'Execution'
+	if l.Execution == nil {
+		l.Execution = new(ExecutionPayloadHeader)
+	}
+	size += l.Execution.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the LightClientHeader object
+func (l *LightClientHeader) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(l)
+}
+
+// HashTreeRootWith ssz hashes the LightClientHeader object with a hasher
+func (l *LightClientHeader) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Beacon'
+	if l.Beacon == nil {
+		l.Beacon = new(BeaconBlockHeader)
+	}
+	if err = l.Beacon.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Execution'
+	if err = l.Execution.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'ExecutionBranch'
+	{
+		if size := uint64(len(l.ExecutionBranch)); size != 4 {
+			err = ssz.ErrVectorLengthFn("LightClientHeader.ExecutionBranch", size, 4)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range l.ExecutionBranch {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the LightClientHeader object
+func (l *LightClientHeader) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(l)
+}
+
+// MarshalSSZ ssz marshals the LightClientBootstrap object
+func (l *LightClientBootstrap) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(l)
+}
+
+// MarshalSSZTo ssz marshals the LightClientBootstrap object to a target array
+func (l *LightClientBootstrap) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := l.fixedSize()
+
+	// Offset (0) 'Header'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'CurrentSyncCommittee'
+	if l.CurrentSyncCommittee == nil {
+		l.CurrentSyncCommittee = new(SyncCommittee)
+	}
+	if dst, err = l.CurrentSyncCommittee.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'CurrentSyncCommitteeBranch'
+	if size := uint64(len(l.CurrentSyncCommitteeBranch)); size != 5 {
+		err = ssz.ErrVectorLengthFn("LightClientBootstrap.CurrentSyncCommitteeBranch", size, 5)
+		return
+	}
+	for ii := uint64(0); ii < 5; ii++ {
+		if size := uint64(len(l.CurrentSyncCommitteeBranch[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("LightClientBootstrap.CurrentSyncCommitteeBranch[ii]", size, 32)
+			return
+		}
+		dst = append(dst, l.CurrentSyncCommitteeBranch[ii]...)
+	}
+
+	// Field (0) 'Header'
+	if dst, err = l.Header.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the LightClientBootstrap object
+func (l *LightClientBootstrap) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(l, buf)
+}
+
+// UnmarshalSSZTail unmarshals the LightClientBootstrap object and returns the remaining bufferº
+func (l *LightClientBootstrap) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := l.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Offset (0) 'Header'
+	if o0, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'CurrentSyncCommittee'
+	if buf, err = ssz.UnmarshalFieldTail(&l.CurrentSyncCommittee, buf); err != nil {
+		return
+	}
+
+	// Field (2) 'CurrentSyncCommitteeBranch'
+	l.CurrentSyncCommitteeBranch = make([][]byte, 5)
+	for ii := uint64(0); ii < 5; ii++ {
+		l.CurrentSyncCommitteeBranch[ii], buf = ssz.UnmarshalBytes(l.CurrentSyncCommitteeBranch[ii], buf, 32)
+	}
+
+	// Field (0) 'Header'
+	if err = ssz.UnmarshalField(&l.Header, tail[o0:]); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the LightClientBootstrap object
+func (l *LightClientBootstrap) fixedSize() int {
+	return int((164 + (48 + (syncCommitteeSize * 48))))
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the LightClientBootstrap object
+func (l *LightClientBootstrap) SizeSSZ() (size int) {
+	size = l.fixedSize()
+
+	// Field (0) 'Header'
+	if l.Header == nil {
+		l.Header = new(LightClientHeader)
+	}
+	size += l.Header.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the LightClientBootstrap object
+func (l *LightClientBootstrap) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(l)
+}
+
+// HashTreeRootWith ssz hashes the LightClientBootstrap object with a hasher
+func (l *LightClientBootstrap) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Header'
+	if err = l.Header.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'CurrentSyncCommittee'
+	if l.CurrentSyncCommittee == nil {
+		l.CurrentSyncCommittee = new(SyncCommittee)
+	}
+	if err = l.CurrentSyncCommittee.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'CurrentSyncCommitteeBranch'
+	{
+		if size := uint64(len(l.CurrentSyncCommitteeBranch)); size != 5 {
+			err = ssz.ErrVectorLengthFn("LightClientBootstrap.CurrentSyncCommitteeBranch", size, 5)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range l.CurrentSyncCommitteeBranch {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the LightClientBootstrap object
+func (l *LightClientBootstrap) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(l)
+}
+
+// MarshalSSZ ssz marshals the LightClientUpdate object
+func (l *LightClientUpdate) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(l)
+}
+
+// MarshalSSZTo ssz marshals the LightClientUpdate object to a target array
+func (l *LightClientUpdate) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := l.fixedSize()
+
+	// Offset (0) 'AttestedHeader'
+	dst = ssz.WriteOffset(dst, offset)
+	if l.AttestedHeader == nil {
+		l.AttestedHeader = new(LightClientHeader)
+	}
+	offset += l.AttestedHeader.SizeSSZ()
+
+	// Field (1) 'NextSyncCommittee'
+	if l.NextSyncCommittee == nil {
+		l.NextSyncCommittee = new(SyncCommittee)
+	}
+	if dst, err = l.NextSyncCommittee.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'NextSyncCommitteeBranch'
+	if size := uint64(len(l.NextSyncCommitteeBranch)); size != 5 {
+		err = ssz.ErrVectorLengthFn("LightClientUpdate.NextSyncCommitteeBranch", size, 5)
+		return
+	}
+	for ii := uint64(0); ii < 5; ii++ {
+		if size := uint64(len(l.NextSyncCommitteeBranch[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("LightClientUpdate.NextSyncCommitteeBranch[ii]", size, 32)
+			return
+		}
+		dst = append(dst, l.NextSyncCommitteeBranch[ii]...)
+	}
+
+	// Offset (3) 'FinalizedHeader'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (4) 'FinalityBranch'
+	if size := uint64(len(l.FinalityBranch)); size != 6 {
+		err = ssz.ErrVectorLengthFn("LightClientUpdate.FinalityBranch", size, 6)
+		return
+	}
+	for ii := uint64(0); ii < 6; ii++ {
+		if size := uint64(len(l.FinalityBranch[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("LightClientUpdate.FinalityBranch[ii]", size, 32)
+			return
+		}
+		dst = append(dst, l.FinalityBranch[ii]...)
+	}
+
+	// Field (5) 'SyncAggregate'
+	if l.SyncAggregate == nil {
+		l.SyncAggregate = new(SyncAggregate)
+	}
+	if dst, err = l.SyncAggregate.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (6) 'SignatureSlot'
+	dst = ssz.MarshalValue(dst, uint64(l.SignatureSlot))
+
+	// Field (0) 'AttestedHeader'
+	if dst, err = l.AttestedHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'FinalizedHeader'
+	if dst, err = l.FinalizedHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the LightClientUpdate object
+func (l *LightClientUpdate) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(l, buf)
+}
+
+// UnmarshalSSZTail unmarshals the LightClientUpdate object and returns the remaining bufferº
+func (l *LightClientUpdate) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := l.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o3 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Offset (0) 'AttestedHeader'
+	if o0, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'NextSyncCommittee'
+	if buf, err = ssz.UnmarshalFieldTail(&l.NextSyncCommittee, buf); err != nil {
+		return
+	}
+
+	// Field (2) 'NextSyncCommitteeBranch'
+	l.NextSyncCommitteeBranch = make([][]byte, 5)
+	for ii := uint64(0); ii < 5; ii++ {
+		l.NextSyncCommitteeBranch[ii], buf = ssz.UnmarshalBytes(l.NextSyncCommitteeBranch[ii], buf, 32)
+	}
+
+	// Offset (3) 'FinalizedHeader'
+	if o3, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (4) 'FinalityBranch'
+	l.FinalityBranch = make([][]byte, 6)
+	for ii := uint64(0); ii < 6; ii++ {
+		l.FinalityBranch[ii], buf = ssz.UnmarshalBytes(l.FinalityBranch[ii], buf, 32)
+	}
+
+	// Field (5) 'SyncAggregate'
+	if buf, err = ssz.UnmarshalFieldTail(&l.SyncAggregate, buf); err != nil {
+		return
+	}
+
+	// Field (6) 'SignatureSlot'
+	{
+		var val uint64
+		val, buf = ssz.UnmarshallValue[uint64](buf)
+		l.SignatureSlot = Slot(val)
+	}
+
+	// Field (0) 'AttestedHeader'
+	if err = ssz.UnmarshalField(&l.AttestedHeader, tail[o0:o3]); err != nil {
+		return
+	}
+
+	// Field (3) 'FinalizedHeader'
+	if err = ssz.UnmarshalField(&l.FinalizedHeader, tail[o3:]); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the LightClientUpdate object
+func (l *LightClientUpdate) fixedSize() int {
+	return int((368 + (48 + (syncCommitteeSize * 48)) + (96 + syncCommitteeBitsSize)))
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the LightClientUpdate object
+func (l *LightClientUpdate) SizeSSZ() (size int) {
+	size = l.fixedSize()
+
+	// Field (0) 'AttestedHeader'
+	if l.AttestedHeader == nil {
+		l.AttestedHeader = new(LightClientHeader)
+	}
+	size += l.AttestedHeader.SizeSSZ()
+
+	// Field (3) 'FinalizedHeader'
+	if l.FinalizedHeader == nil {
+		l.FinalizedHeader = new(LightClientHeader)
+	}
+	size += l.FinalizedHeader.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the LightClientUpdate object
+func (l *LightClientUpdate) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(l)
+}
+
+// HashTreeRootWith ssz hashes the LightClientUpdate object with a hasher
+func (l *LightClientUpdate) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'AttestedHeader'
+	if err = l.AttestedHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'NextSyncCommittee'
+	if l.NextSyncCommittee == nil {
+		l.NextSyncCommittee = new(SyncCommittee)
+	}
+	if err = l.NextSyncCommittee.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'NextSyncCommitteeBranch'
+	{
+		if size := uint64(len(l.NextSyncCommitteeBranch)); size != 5 {
+			err = ssz.ErrVectorLengthFn("LightClientUpdate.NextSyncCommitteeBranch", size, 5)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range l.NextSyncCommitteeBranch {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (3) 'FinalizedHeader'
+	if err = l.FinalizedHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (4) 'FinalityBranch'
+	{
+		if size := uint64(len(l.FinalityBranch)); size != 6 {
+			err = ssz.ErrVectorLengthFn("LightClientUpdate.FinalityBranch", size, 6)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range l.FinalityBranch {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (5) 'SyncAggregate'
+	if l.SyncAggregate == nil {
+		l.SyncAggregate = new(SyncAggregate)
+	}
+	if err = l.SyncAggregate.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (6) 'SignatureSlot'
+	hh.PutUint64(uint64(l.SignatureSlot))
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the LightClientUpdate object
+func (l *LightClientUpdate) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(l)
+}
+
+// MarshalSSZ ssz marshals the LightClientFinalityUpdate object
+func (l *LightClientFinalityUpdate) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(l)
+}
+
+// MarshalSSZTo ssz marshals the LightClientFinalityUpdate object to a target array
+func (l *LightClientFinalityUpdate) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := l.fixedSize()
+
+	// Offset (0) 'AttestedHeader'
+	dst = ssz.WriteOffset(dst, offset)
+	if l.AttestedHeader == nil {
+		l.AttestedHeader = new(LightClientHeader)
+	}
+	offset += l.AttestedHeader.SizeSSZ()
+
+	// Offset (1) 'FinalizedHeader'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (2) 'FinalityBranch'
+	if size := uint64(len(l.FinalityBranch)); size != 6 {
+		err = ssz.ErrVectorLengthFn("LightClientFinalityUpdate.FinalityBranch", size, 6)
+		return
+	}
+	for ii := uint64(0); ii < 6; ii++ {
+		if size := uint64(len(l.FinalityBranch[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("LightClientFinalityUpdate.FinalityBranch[ii]", size, 32)
+			return
+		}
+		dst = append(dst, l.FinalityBranch[ii]...)
+	}
+
+	// Field (3) 'SyncAggregate'
+	if l.SyncAggregate == nil {
+		l.SyncAggregate = new(SyncAggregate)
+	}
+	if dst, err = l.SyncAggregate.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'SignatureSlot'
+	dst = ssz.MarshalValue(dst, uint64(l.SignatureSlot))
+
+	// Field (0) 'AttestedHeader'
+	if dst, err = l.AttestedHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'FinalizedHeader'
+	if dst, err = l.FinalizedHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the LightClientFinalityUpdate object
+func (l *LightClientFinalityUpdate) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(l, buf)
+}
+
+// UnmarshalSSZTail unmarshals the LightClientFinalityUpdate object and returns the remaining bufferº
+func (l *LightClientFinalityUpdate) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := l.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Offset (0) 'AttestedHeader'
+	if o0, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (1) 'FinalizedHeader'
+	if o1, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (2) 'FinalityBranch'
+	l.FinalityBranch = make([][]byte, 6)
+	for ii := uint64(0); ii < 6; ii++ {
+		l.FinalityBranch[ii], buf = ssz.UnmarshalBytes(l.FinalityBranch[ii], buf, 32)
+	}
+
+	// Field (3) 'SyncAggregate'
+	if buf, err = ssz.UnmarshalFieldTail(&l.SyncAggregate, buf); err != nil {
+		return
+	}
+
+	// Field (4) 'SignatureSlot'
+	{
+		var val uint64
+		val, buf = ssz.UnmarshallValue[uint64](buf)
+		l.SignatureSlot = Slot(val)
+	}
+
+	// Field (0) 'AttestedHeader'
+	if err = ssz.UnmarshalField(&l.AttestedHeader, tail[o0:o1]); err != nil {
+		return
+	}
+
+	// Field (1) 'FinalizedHeader'
+	if err = ssz.UnmarshalField(&l.FinalizedHeader, tail[o1:]); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the LightClientFinalityUpdate object
+func (l *LightClientFinalityUpdate) fixedSize() int {
+	return int((208 + (96 + syncCommitteeBitsSize)))
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the LightClientFinalityUpdate object
+func (l *LightClientFinalityUpdate) SizeSSZ() (size int) {
+	size = l.fixedSize()
+
+	// Field (0) 'AttestedHeader'
+	if l.AttestedHeader == nil {
+		l.AttestedHeader = new(LightClientHeader)
+	}
+	size += l.AttestedHeader.SizeSSZ()
+
+	// Field (1) 'FinalizedHeader'
+	if l.FinalizedHeader == nil {
+		l.FinalizedHeader = new(LightClientHeader)
+	}
+	size += l.FinalizedHeader.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the LightClientFinalityUpdate object
+func (l *LightClientFinalityUpdate) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(l)
+}
+
+// HashTreeRootWith ssz hashes the LightClientFinalityUpdate object with a hasher
+func (l *LightClientFinalityUpdate) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'AttestedHeader'
+	if err = l.AttestedHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'FinalizedHeader'
+	if err = l.FinalizedHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'FinalityBranch'
+	{
+		if size := uint64(len(l.FinalityBranch)); size != 6 {
+			err = ssz.ErrVectorLengthFn("LightClientFinalityUpdate.FinalityBranch", size, 6)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range l.FinalityBranch {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (3) 'SyncAggregate'
+	if l.SyncAggregate == nil {
+		l.SyncAggregate = new(SyncAggregate)
+	}
+	if err = l.SyncAggregate.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (4) 'SignatureSlot'
+	hh.PutUint64(uint64(l.SignatureSlot))
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the LightClientFinalityUpdate object
+func (l *LightClientFinalityUpdate) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(l)
+}
+
+// MarshalSSZ ssz marshals the LightClientOptimisticUpdate object
+func (l *LightClientOptimisticUpdate) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(l)
+}
+
+// MarshalSSZTo ssz marshals the LightClientOptimisticUpdate object to a target array
+func (l *LightClientOptimisticUpdate) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := l.fixedSize()
+
+	// Offset (0) 'AttestedHeader'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'SyncAggregate'
+	if l.SyncAggregate == nil {
+		l.SyncAggregate = new(SyncAggregate)
+	}
+	if dst, err = l.SyncAggregate.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'SignatureSlot'
+	dst = ssz.MarshalValue(dst, uint64(l.SignatureSlot))
+
+	// Field (0) 'AttestedHeader'
+	if dst, err = l.AttestedHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the LightClientOptimisticUpdate object
+func (l *LightClientOptimisticUpdate) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(l, buf)
+}
+
+// UnmarshalSSZTail unmarshals the LightClientOptimisticUpdate object and returns the remaining bufferº
+func (l *LightClientOptimisticUpdate) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := l.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Offset (0) 'AttestedHeader'
+	if o0, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'SyncAggregate'
+	if buf, err = ssz.UnmarshalFieldTail(&l.SyncAggregate, buf); err != nil {
+		return
+	}
+
+	// Field (2) 'SignatureSlot'
+	{
+		var val uint64
+		val, buf = ssz.UnmarshallValue[uint64](buf)
+		l.SignatureSlot = Slot(val)
+	}
+
+	// Field (0) 'AttestedHeader'
+	if err = ssz.UnmarshalField(&l.AttestedHeader, tail[o0:]); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the LightClientOptimisticUpdate object
+func (l *LightClientOptimisticUpdate) fixedSize() int {
+	return int((12 + (96 + syncCommitteeBitsSize)))
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the LightClientOptimisticUpdate object
+func (l *LightClientOptimisticUpdate) SizeSSZ() (size int) {
+	size = l.fixedSize()
+
+	// Field (0) 'AttestedHeader'
+	if l.AttestedHeader == nil {
+		l.AttestedHeader = new(LightClientHeader)
+	}
+	size += l.AttestedHeader.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the LightClientOptimisticUpdate object
+func (l *LightClientOptimisticUpdate) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(l)
+}
+
+// HashTreeRootWith ssz hashes the LightClientOptimisticUpdate object with a hasher
+func (l *LightClientOptimisticUpdate) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'AttestedHeader'
+	if err = l.AttestedHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'SyncAggregate'
+	if l.SyncAggregate == nil {
+		l.SyncAggregate = new(SyncAggregate)
+	}
+	if err = l.SyncAggregate.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'SignatureSlot'
+	hh.PutUint64(uint64(l.SignatureSlot))
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the LightClientOptimisticUpdate object
+func (l *LightClientOptimisticUpdate) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(l)
+}
+
 // MarshalSSZ ssz marshals the BeaconState object
 func (b *BeaconState) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
 }
 
-// MarshalSSZTo ssz marshals the BeaconState object to a target array
-func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the BeaconState object to a target array
+func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := b.fixedSize()
+
+	// Field (0) 'GenesisTime'
+	dst = ssz.MarshalValue(dst, b.GenesisTime)
+
+	// Field (1) 'GenesisValidatorsRoot'
+	dst = append(dst, b.GenesisValidatorsRoot[:]...)
+
+	// Field (2) 'Slot'
+	dst = ssz.MarshalValue(dst, uint64(b.Slot))
+
+	// Field (3) 'Fork'
+	if b.Fork == nil {
+		b.Fork = new(Fork)
+	}
+	if dst, err = b.Fork.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'LatestBlockHeader'
+	if b.LatestBlockHeader == nil {
+		b.LatestBlockHeader = new(BeaconBlockHeader)
+	}
+	if dst, err = b.LatestBlockHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (5) 'BlockRoots'
+	if size := uint64(len(b.BlockRoots)); size != slotsPerHistoricalRoot {
+		err = ssz.ErrVectorLengthFn("BeaconState.BlockRoots", size, slotsPerHistoricalRoot)
+		return
+	}
+	for ii := uint64(0); ii < slotsPerHistoricalRoot; ii++ {
+		if size := uint64(len(b.BlockRoots[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("BeaconState.BlockRoots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.BlockRoots[ii]...)
+	}
+
+	// Field (6) 'StateRoots'
+	if size := uint64(len(b.StateRoots)); size != slotsPerHistoricalRoot {
+		err = ssz.ErrVectorLengthFn("BeaconState.StateRoots", size, slotsPerHistoricalRoot)
+		return
+	}
+	for ii := uint64(0); ii < slotsPerHistoricalRoot; ii++ {
+		if size := uint64(len(b.StateRoots[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("BeaconState.StateRoots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.StateRoots[ii]...)
+	}
+
+	// Offset (7) 'HistoricalRoots'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.HistoricalRoots) * 32
+
+	// Field (8) 'ETH1Data'
+	if b.ETH1Data == nil {
+		b.ETH1Data = new(ETH1Data)
+	}
+	if dst, err = b.ETH1Data.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (9) 'ETH1DataVotes'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ETH1DataVotes) * 72
+
+	// Field (10) 'ETH1DepositIndex'
+	dst = ssz.MarshalValue(dst, b.ETH1DepositIndex)
+
+	// Offset (11) 'Validators'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Validators) * 121
+
+	// Offset (12) 'Balances'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Balances) * 8
+
+	// Field (13) 'RANDAOMixes'
+	if size := uint64(len(b.RANDAOMixes)); size != epochsPerHistoricalVector {
+		err = ssz.ErrVectorLengthFn("BeaconState.RANDAOMixes", size, epochsPerHistoricalVector)
+		return
+	}
+	for ii := uint64(0); ii < epochsPerHistoricalVector; ii++ {
+		if size := uint64(len(b.RANDAOMixes[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("BeaconState.RANDAOMixes[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.RANDAOMixes[ii]...)
+	}
+
+	// Field (14) 'Slashings'
+	if size := uint64(len(b.Slashings)); size != epochsPerSlashingsVector {
+		err = ssz.ErrVectorLengthFn("BeaconState.Slashings", size, epochsPerSlashingsVector)
+		return
+	}
+	for ii := uint64(0); ii < epochsPerSlashingsVector; ii++ {
+		dst = ssz.MarshalValue(dst, uint64(b.Slashings[ii]))
+	}
+
+	// Offset (15) 'PreviousEpochParticipation'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.PreviousEpochParticipation) * 1
+
+	// Offset (16) 'CurrentEpochParticipation'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.CurrentEpochParticipation) * 1
+
+	// Field (17) 'JustificationBits'
+	dst = append(dst, b.JustificationBits[:]...)
+
+	// Field (18) 'PreviousJustifiedCheckpoint'
+	if b.PreviousJustifiedCheckpoint == nil {
+		b.PreviousJustifiedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = b.PreviousJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (19) 'CurrentJustifiedCheckpoint'
+	if b.CurrentJustifiedCheckpoint == nil {
+		b.CurrentJustifiedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = b.CurrentJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (20) 'FinalizedCheckpoint'
+	if b.FinalizedCheckpoint == nil {
+		b.FinalizedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = b.FinalizedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (21) 'InactivityScores'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.InactivityScores) * 8
+
+	// Field (22) 'CurrentSyncCommittee'
+	if b.CurrentSyncCommittee == nil {
+		b.CurrentSyncCommittee = new(SyncCommittee)
+	}
+	if dst, err = b.CurrentSyncCommittee.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (23) 'NextSyncCommittee'
+	if b.NextSyncCommittee == nil {
+		b.NextSyncCommittee = new(SyncCommittee)
+	}
+	if dst, err = b.NextSyncCommittee.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (24) 'LatestExecutionPayloadHeader'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.LatestExecutionPayloadHeader == nil {
+		b.LatestExecutionPayloadHeader = new(ExecutionPayloadHeader)
+	}
+	offset += b.LatestExecutionPayloadHeader.SizeSSZ()
+
+	// Field (25) 'NextWithdrawalIndex'
+	dst = ssz.MarshalValue(dst, uint64(b.NextWithdrawalIndex))
+
+	// Field (26) 'NextWithdrawalValidatorIndex'
+	dst = ssz.MarshalValue(dst, uint64(b.NextWithdrawalValidatorIndex))
+
+	// Offset (27) 'HistoricalSummaries'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (7) 'HistoricalRoots'
+	if size := uint64(len(b.HistoricalRoots)); size > 16777216 {
+		err = ssz.ErrListTooBigFn("BeaconState.HistoricalRoots", size, 16777216)
+		return
+	}
+	for ii := 0; ii < len(b.HistoricalRoots); ii++ {
+		dst = append(dst, b.HistoricalRoots[ii][:]...)
+	}
+
+	// Field (9) 'ETH1DataVotes'
+	if size := uint64(len(b.ETH1DataVotes)); size > eth1DataVotesLimit {
+		err = ssz.ErrListTooBigFn("BeaconState.ETH1DataVotes", size, eth1DataVotesLimit)
+		return
+	}
+	for ii := 0; ii < len(b.ETH1DataVotes); ii++ {
+		if dst, err = b.ETH1DataVotes[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (11) 'Validators'
+	if size := uint64(len(b.Validators)); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("BeaconState.Validators", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.Validators); ii++ {
+		if dst, err = b.Validators[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (12) 'Balances'
+	if size := uint64(len(b.Balances)); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("BeaconState.Balances", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.Balances); ii++ {
+		dst = ssz.MarshalValue(dst, uint64(b.Balances[ii]))
+	}
+
+	// Field (15) 'PreviousEpochParticipation'
+	if size := uint64(len(b.PreviousEpochParticipation)); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("BeaconState.PreviousEpochParticipation", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.PreviousEpochParticipation); ii++ {
+		dst = ssz.MarshalValue(dst, uint8(b.PreviousEpochParticipation[ii]))
+	}
+
+	// Field (16) 'CurrentEpochParticipation'
+	if size := uint64(len(b.CurrentEpochParticipation)); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("BeaconState.CurrentEpochParticipation", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.CurrentEpochParticipation); ii++ {
+		dst = ssz.MarshalValue(dst, uint8(b.CurrentEpochParticipation[ii]))
+	}
+
+	// Field (21) 'InactivityScores'
+	if size := uint64(len(b.InactivityScores)); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("BeaconState.InactivityScores", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.InactivityScores); ii++ {
+		dst = ssz.MarshalValue(dst, b.InactivityScores[ii])
+	}
+
+	// Field (24) 'LatestExecutionPayloadHeader'
+	if dst, err = b.LatestExecutionPayloadHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (27) 'HistoricalSummaries'
+	if size := uint64(len(b.HistoricalSummaries)); size > 16777216 {
+		err = ssz.ErrListTooBigFn("BeaconState.HistoricalSummaries", size, 16777216)
+		return
+	}
+	for ii := 0; ii < len(b.HistoricalSummaries); ii++ {
+		if dst, err = b.HistoricalSummaries[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconState object
+func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(b, buf)
+}
+
+// UnmarshalSSZTail unmarshals the BeaconState object and returns the remaining bufferº
+func (b *BeaconState) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := b.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o7, o9, o11, o12, o15, o16, o21, o24, o27 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Field (0) 'GenesisTime'
+	b.GenesisTime, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Field (1) 'GenesisValidatorsRoot'
+	buf = ssz.UnmarshalFixedBytes(b.GenesisValidatorsRoot[:], buf)
+
+	// Field (2) 'Slot'
+	{
+		var val uint64
+		val, buf = ssz.UnmarshallValue[uint64](buf)
+		b.Slot = Slot(val)
+	}
+
+	// Field (3) 'Fork'
+	if buf, err = ssz.UnmarshalFieldTail(&b.Fork, buf); err != nil {
+		return
+	}
+
+	// Field (4) 'LatestBlockHeader'
+	if buf, err = ssz.UnmarshalFieldTail(&b.LatestBlockHeader, buf); err != nil {
+		return
+	}
+
+	// Field (5) 'BlockRoots'
+	b.BlockRoots = make([][]byte, slotsPerHistoricalRoot)
+	for ii := uint64(0); ii < slotsPerHistoricalRoot; ii++ {
+		b.BlockRoots[ii], buf = ssz.UnmarshalBytes(b.BlockRoots[ii], buf, 32)
+	}
+
+	// Field (6) 'StateRoots'
+	b.StateRoots = make([][]byte, slotsPerHistoricalRoot)
+	for ii := uint64(0); ii < slotsPerHistoricalRoot; ii++ {
+		b.StateRoots[ii], buf = ssz.UnmarshalBytes(b.StateRoots[ii], buf, 32)
+	}
+
+	// Offset (7) 'HistoricalRoots'
+	if o7, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (8) 'ETH1Data'
+	if buf, err = ssz.UnmarshalFieldTail(&b.ETH1Data, buf); err != nil {
+		return
+	}
+
+	// Offset (9) 'ETH1DataVotes'
+	if o9, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (10) 'ETH1DepositIndex'
+	b.ETH1DepositIndex, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Offset (11) 'Validators'
+	if o11, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (12) 'Balances'
+	if o12, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (13) 'RANDAOMixes'
+	b.RANDAOMixes = make([][]byte, epochsPerHistoricalVector)
+	for ii := uint64(0); ii < epochsPerHistoricalVector; ii++ {
+		b.RANDAOMixes[ii], buf = ssz.UnmarshalBytes(b.RANDAOMixes[ii], buf, 32)
+	}
+
+	// Field (14) 'Slashings'
+	b.Slashings = ssz.Extend(b.Slashings, epochsPerSlashingsVector)
+	for ii := uint64(0); ii < epochsPerSlashingsVector; ii++ {
+		{
+			var val uint64
+			val, buf = ssz.UnmarshallValue[uint64](buf)
+			b.Slashings[ii] = Gwei(val)
+		}
+	}
+
+	// Offset (15) 'PreviousEpochParticipation'
+	if o15, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (16) 'CurrentEpochParticipation'
+	if o16, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (17) 'JustificationBits'
+	buf = ssz.UnmarshalFixedBytes(b.JustificationBits[:], buf)
+
+	// Field (18) 'PreviousJustifiedCheckpoint'
+	if buf, err = ssz.UnmarshalFieldTail(&b.PreviousJustifiedCheckpoint, buf); err != nil {
+		return
+	}
+
+	// Field (19) 'CurrentJustifiedCheckpoint'
+	if buf, err = ssz.UnmarshalFieldTail(&b.CurrentJustifiedCheckpoint, buf); err != nil {
+		return
+	}
+
+	// Field (20) 'FinalizedCheckpoint'
+	if buf, err = ssz.UnmarshalFieldTail(&b.FinalizedCheckpoint, buf); err != nil {
+		return
+	}
+
+	// Offset (21) 'InactivityScores'
+	if o21, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (22) 'CurrentSyncCommittee'
+	if buf, err = ssz.UnmarshalFieldTail(&b.CurrentSyncCommittee, buf); err != nil {
+		return
+	}
+
+	// Field (23) 'NextSyncCommittee'
+	if buf, err = ssz.UnmarshalFieldTail(&b.NextSyncCommittee, buf); err != nil {
+		return
+	}
+
+	// Offset (24) 'LatestExecutionPayloadHeader'
+	if o24, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (25) 'NextWithdrawalIndex'
+	{
+		var val uint64
+		val, buf = ssz.UnmarshallValue[uint64](buf)
+		b.NextWithdrawalIndex = WithdrawalIndex(val)
+	}
+
+	// Field (26) 'NextWithdrawalValidatorIndex'
+	{
+		var val uint64
+		val, buf = ssz.UnmarshallValue[uint64](buf)
+		b.NextWithdrawalValidatorIndex = ValidatorIndex(val)
+	}
+
+	// Offset (27) 'HistoricalSummaries'
+	if o27, _, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (7) 'HistoricalRoots'
+	if err = ssz.UnmarshalSliceWithIndexCallback(&b.HistoricalRoots, tail[o7:o9], 32, 16777216, func(ii uint64, buf []byte) (err error) {
+		buf = ssz.UnmarshalFixedBytes(b.HistoricalRoots[ii][:], buf)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// Field (9) 'ETH1DataVotes'
+	if err = ssz.UnmarshalSliceSSZ(&b.ETH1DataVotes, tail[o9:o11], eth1DataVotesLimit); err != nil {
+		return nil, err
+	}
+
+	// Field (11) 'Validators'
+	if err = ssz.UnmarshalSliceSSZ(&b.Validators, tail[o11:o12], 1099511627776); err != nil {
+		return nil, err
+	}
+
+	// Field (12) 'Balances'
+	if err = ssz.UnmarshalSliceWithIndexCallback(&b.Balances, tail[o12:o15], 8, 1099511627776, func(ii uint64, buf []byte) (err error) {
+		{
+			var val uint64
+			val, buf = ssz.UnmarshallValue[uint64](buf)
+			b.Balances[ii] = Gwei(val)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// Field (15) 'PreviousEpochParticipation'
+	if err = ssz.UnmarshalSliceWithIndexCallback(&b.PreviousEpochParticipation, tail[o15:o16], 1, 1099511627776, func(ii uint64, buf []byte) (err error) {
+		{
+			var val uint8
+			val, buf = ssz.UnmarshallValue[uint8](buf)
+			b.PreviousEpochParticipation[ii] = ParticipationFlags(val)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// Field (16) 'CurrentEpochParticipation'
+	if err = ssz.UnmarshalSliceWithIndexCallback(&b.CurrentEpochParticipation, tail[o16:o21], 1, 1099511627776, func(ii uint64, buf []byte) (err error) {
+		{
+			var val uint8
+			val, buf = ssz.UnmarshallValue[uint8](buf)
+			b.CurrentEpochParticipation[ii] = ParticipationFlags(val)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// Field (21) 'InactivityScores'
+	if err = ssz.UnmarshalSliceWithIndexCallback(&b.InactivityScores, tail[o21:o24], 8, 1099511627776, func(ii uint64, buf []byte) (err error) {
+		b.InactivityScores[ii], buf = ssz.UnmarshallValue[uint64](buf)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// Field (24) 'LatestExecutionPayloadHeader'
+	if err = ssz.UnmarshalField(&b.LatestExecutionPayloadHeader, tail[o24:o27]); err != nil {
+		return
+	}
+
+	// Field (27) 'HistoricalSummaries'
+	if err = ssz.UnmarshalSliceSSZ(&b.HistoricalSummaries, tail[o27:], 16777216); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the BeaconState object
+func (b *BeaconState) fixedSize() int {
+	return int((429 + (slotsPerHistoricalRoot * 32) + (slotsPerHistoricalRoot * 32) + (epochsPerHistoricalVector * 32) + (epochsPerSlashingsVector * 8) + (48 + (syncCommitteeSize * 48)) + (48 + (syncCommitteeSize * 48))))
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconState object
+func (b *BeaconState) SizeSSZ() (size int) {
+	size = b.fixedSize()
+
+	// Field (7) 'HistoricalRoots'
+	size += len(b.HistoricalRoots) * 32
+
+	// Field (9) 'ETH1DataVotes'
+	size += len(b.ETH1DataVotes) * 72
+
+	// Field (11) 'Validators'
+	size += len(b.Validators) * 121
+
+	// Field (12) 'Balances'
+	size += len(b.Balances) * 8
+
+	// Field (15) 'PreviousEpochParticipation'
+	size += len(b.PreviousEpochParticipation) * 1
+
+	// Field (16) 'CurrentEpochParticipation'
+	size += len(b.CurrentEpochParticipation) * 1
+
+	// Field (21) 'InactivityScores'
+	size += len(b.InactivityScores) * 8
+
+	// Field (24) 'LatestExecutionPayloadHeader'
+	if b.LatestExecutionPayloadHeader == nil {
+		b.LatestExecutionPayloadHeader = new(ExecutionPayloadHeader)
+	}
+	size += b.LatestExecutionPayloadHeader.SizeSSZ()
+
+	// Field (27) 'HistoricalSummaries'
+	size += len(b.HistoricalSummaries) * 64
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BeaconState object
+func (b *BeaconState) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconState object with a hasher
+func (b *BeaconState) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'GenesisTime'
+	hh.PutUint64(b.GenesisTime)
+
+	// Field (1) 'GenesisValidatorsRoot'
+	hh.PutBytes(b.GenesisValidatorsRoot[:])
+
+	// Field (2) 'Slot'
+	hh.PutUint64(uint64(b.Slot))
+
+	// Field (3) 'Fork'
+	if b.Fork == nil {
+		b.Fork = new(Fork)
+	}
+	if err = b.Fork.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (4) 'LatestBlockHeader'
+	if b.LatestBlockHeader == nil {
+		b.LatestBlockHeader = new(BeaconBlockHeader)
+	}
+	if err = b.LatestBlockHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (5) 'BlockRoots'
+	{
+		if size := uint64(len(b.BlockRoots)); size != slotsPerHistoricalRoot {
+			err = ssz.ErrVectorLengthFn("BeaconState.BlockRoots", size, slotsPerHistoricalRoot)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.BlockRoots {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (6) 'StateRoots'
+	{
+		if size := uint64(len(b.StateRoots)); size != slotsPerHistoricalRoot {
+			err = ssz.ErrVectorLengthFn("BeaconState.StateRoots", size, slotsPerHistoricalRoot)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.StateRoots {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (7) 'HistoricalRoots'
+	{
+		if size := uint64(len(b.HistoricalRoots)); size > 16777216 {
+			err = ssz.ErrListTooBigFn("BeaconState.HistoricalRoots", size, 16777216)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.HistoricalRoots {
+			hh.Append(i[:])
+		}
+		numItems := uint64(len(b.HistoricalRoots))
+		hh.MerkleizeWithMixin(subIndx, numItems, 16777216)
+	}
+
+	// Field (8) 'ETH1Data'
+	if b.ETH1Data == nil {
+		b.ETH1Data = new(ETH1Data)
+	}
+	if err = b.ETH1Data.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (9) 'ETH1DataVotes'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.ETH1DataVotes))
+		if num > eth1DataVotesLimit {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.ETH1DataVotes {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, eth1DataVotesLimit)
+	}
+
+	// Field (10) 'ETH1DepositIndex'
+	hh.PutUint64(b.ETH1DepositIndex)
+
+	// Field (11) 'Validators'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Validators))
+		if num > 1099511627776 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Validators {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
+	}
+
+	// Field (12) 'Balances'
+	{
+		if size := uint64(len(b.Balances)); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.Balances", size, 1099511627776)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.Balances {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(b.Balances))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+	}
+
+	// Field (13) 'RANDAOMixes'
+	{
+		if size := uint64(len(b.RANDAOMixes)); size != epochsPerHistoricalVector {
+			err = ssz.ErrVectorLengthFn("BeaconState.RANDAOMixes", size, epochsPerHistoricalVector)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.RANDAOMixes {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (14) 'Slashings'
+	{
+		if size := uint64(len(b.Slashings)); size != epochsPerSlashingsVector {
+			err = ssz.ErrVectorLengthFn("BeaconState.Slashings", size, epochsPerSlashingsVector)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.Slashings {
+			hh.AppendUint64(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (15) 'PreviousEpochParticipation'
+	{
+		if size := uint64(len(b.PreviousEpochParticipation)); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.PreviousEpochParticipation", size, 1099511627776)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.PreviousEpochParticipation {
+			hh.AppendUint8(i)
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(b.PreviousEpochParticipation))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 1))
+	}
+
+	// Field (16) 'CurrentEpochParticipation'
+	{
+		if size := uint64(len(b.CurrentEpochParticipation)); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.CurrentEpochParticipation", size, 1099511627776)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.CurrentEpochParticipation {
+			hh.AppendUint8(i)
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(b.CurrentEpochParticipation))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 1))
+	}
+
+	// Field (17) 'JustificationBits'
+	hh.PutBytes(b.JustificationBits[:])
+
+	// Field (18) 'PreviousJustifiedCheckpoint'
+	if b.PreviousJustifiedCheckpoint == nil {
+		b.PreviousJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (19) 'CurrentJustifiedCheckpoint'
+	if b.CurrentJustifiedCheckpoint == nil {
+		b.CurrentJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (20) 'FinalizedCheckpoint'
+	if b.FinalizedCheckpoint == nil {
+		b.FinalizedCheckpoint = new(Checkpoint)
+	}
+	if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (21) 'InactivityScores'
+	{
+		if size := uint64(len(b.InactivityScores)); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.InactivityScores", size, 1099511627776)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.InactivityScores {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(b.InactivityScores))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+	}
+
+	// Field (22) 'CurrentSyncCommittee'
+	if b.CurrentSyncCommittee == nil {
+		b.CurrentSyncCommittee = new(SyncCommittee)
+	}
+	if err = b.CurrentSyncCommittee.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (23) 'NextSyncCommittee'
+	if b.NextSyncCommittee == nil {
+		b.NextSyncCommittee = new(SyncCommittee)
+	}
+	if err = b.NextSyncCommittee.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (24) 'LatestExecutionPayloadHeader'
+	if err = b.LatestExecutionPayloadHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (25) 'NextWithdrawalIndex'
+	hh.PutUint64(uint64(b.NextWithdrawalIndex))
+
+	// Field (26) 'NextWithdrawalValidatorIndex'
+	hh.PutUint64(uint64(b.NextWithdrawalValidatorIndex))
+
+	// Field (27) 'HistoricalSummaries'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.HistoricalSummaries))
+		if num > 16777216 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.HistoricalSummaries {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16777216)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the BeaconState object
+func (b *BeaconState) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// MarshalSSZ ssz marshals the AttestationElectra object
+func (a *AttestationElectra) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(a)
+}
+
+// MarshalSSZTo ssz marshals the AttestationElectra object to a target array
+func (a *AttestationElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := a.fixedSize()
+
+	// Offset (0) 'AggregationBits'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Data'
+	if a.Data == nil {
+		a.Data = new(AttestationData)
+	}
+	if dst, err = a.Data.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Signature'
+	dst = append(dst, a.Signature[:]...)
+
+	// Field (3) 'CommitteeBits'
+	if size := uint64(len(a.CommitteeBits)); size != committeeBitsSize {
+		err = ssz.ErrBytesLengthFn("AttestationElectra.CommitteeBits", size, committeeBitsSize)
+		return
+	}
+	dst = append(dst, a.CommitteeBits...)
+
+	// Field (0) 'AggregationBits'
+	if size := uint64(len(a.AggregationBits)); size > 131072 {
+		err = ssz.ErrBytesLengthFn("AttestationElectra.AggregationBits", size, 131072)
+		return
+	}
+	dst = append(dst, a.AggregationBits...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the AttestationElectra object
+func (a *AttestationElectra) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(a, buf)
+}
+
+// UnmarshalSSZTail unmarshals the AttestationElectra object and returns the remaining bufferº
+func (a *AttestationElectra) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := a.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Offset (0) 'AggregationBits'
+	if o0, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'Data'
+	if buf, err = ssz.UnmarshalFieldTail(&a.Data, buf); err != nil {
+		return
+	}
+
+	// Field (2) 'Signature'
+	buf = ssz.UnmarshalFixedBytes(a.Signature[:], buf)
+
+	// Field (3) 'CommitteeBits'
+	a.CommitteeBits, buf = ssz.UnmarshalBytes(a.CommitteeBits, buf, committeeBitsSize)
+
+	// Field (0) 'AggregationBits'
+	if a.AggregationBits, err = ssz.UnmarshalBitList(a.AggregationBits, tail[o0:], 131072); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the AttestationElectra object
+func (a *AttestationElectra) fixedSize() int {
+	return int((228 + committeeBitsSize))
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the AttestationElectra object
+func (a *AttestationElectra) SizeSSZ() (size int) {
+	size = a.fixedSize()
+
+	// Field (0) 'AggregationBits'
+	size += len(a.AggregationBits)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the AttestationElectra object
+func (a *AttestationElectra) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(a)
+}
+
+// HashTreeRootWith ssz hashes the AttestationElectra object with a hasher
+func (a *AttestationElectra) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'AggregationBits'
+	if len(a.AggregationBits) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
+	}
+	hh.PutBitlist(a.AggregationBits, 131072)
+
+	// Field (1) 'Data'
+	if a.Data == nil {
+		a.Data = new(AttestationData)
+	}
+	if err = a.Data.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'Signature'
+	hh.PutBytes(a.Signature[:])
+
+	// Field (3) 'CommitteeBits'
+	if size := uint64(len(a.CommitteeBits)); size != committeeBitsSize {
+		err = ssz.ErrBytesLengthFn("AttestationElectra.CommitteeBits", size, committeeBitsSize)
+		return
+	}
+	hh.PutBytes(a.CommitteeBits)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the AttestationElectra object
+func (a *AttestationElectra) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(a)
+}
+
+// MarshalSSZ ssz marshals the IndexedAttestationElectra object
+func (i *IndexedAttestationElectra) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(i)
+}
+
+// MarshalSSZTo ssz marshals the IndexedAttestationElectra object to a target array
+func (i *IndexedAttestationElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := i.fixedSize()
+
+	// Offset (0) 'AttestingIndices'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Data'
+	if i.Data == nil {
+		i.Data = new(AttestationData)
+	}
+	if dst, err = i.Data.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Signature'
+	dst = append(dst, i.Signature[:]...)
+
+	// Field (0) 'AttestingIndices'
+	if size := uint64(len(i.AttestingIndices)); size > 131072 {
+		err = ssz.ErrListTooBigFn("IndexedAttestationElectra.AttestingIndices", size, 131072)
+		return
+	}
+	for ii := 0; ii < len(i.AttestingIndices); ii++ {
+		dst = ssz.MarshalValue(dst, i.AttestingIndices[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the IndexedAttestationElectra object
+func (i *IndexedAttestationElectra) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(i, buf)
+}
+
+// UnmarshalSSZTail unmarshals the IndexedAttestationElectra object and returns the remaining bufferº
+func (i *IndexedAttestationElectra) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := i.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Offset (0) 'AttestingIndices'
+	if o0, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'Data'
+	if buf, err = ssz.UnmarshalFieldTail(&i.Data, buf); err != nil {
+		return
+	}
+
+	// Field (2) 'Signature'
+	buf = ssz.UnmarshalFixedBytes(i.Signature[:], buf)
+
+	// Field (0) 'AttestingIndices'
+	if err = ssz.UnmarshalSliceWithIndexCallback(&i.AttestingIndices, tail[o0:], 8, 131072, func(ii uint64, buf []byte) (err error) {
+		i.AttestingIndices[ii], buf = ssz.UnmarshallValue[uint64](buf)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the IndexedAttestationElectra object
+func (i *IndexedAttestationElectra) fixedSize() int {
+	return int(228)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the IndexedAttestationElectra object
+func (i *IndexedAttestationElectra) SizeSSZ() (size int) {
+	size = i.fixedSize()
+
+	// Field (0) 'AttestingIndices'
+	size += len(i.AttestingIndices) * 8
+
+	return
+}
+
+// HashTreeRoot ssz hashes the IndexedAttestationElectra object
+func (i *IndexedAttestationElectra) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(i)
+}
+
+// HashTreeRootWith ssz hashes the IndexedAttestationElectra object with a hasher
+func (i *IndexedAttestationElectra) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'AttestingIndices'
+	{
+		if size := uint64(len(i.AttestingIndices)); size > 131072 {
+			err = ssz.ErrListTooBigFn("IndexedAttestationElectra.AttestingIndices", size, 131072)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range i.AttestingIndices {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(i.AttestingIndices))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(131072, numItems, 8))
+	}
+
+	// Field (1) 'Data'
+	if i.Data == nil {
+		i.Data = new(AttestationData)
+	}
+	if err = i.Data.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'Signature'
+	hh.PutBytes(i.Signature[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the IndexedAttestationElectra object
+func (i *IndexedAttestationElectra) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(i)
+}
+
+// MarshalSSZ ssz marshals the AttesterSlashingElectra object
+func (a *AttesterSlashingElectra) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(a)
+}
+
+// MarshalSSZTo ssz marshals the AttesterSlashingElectra object to a target array
+func (a *AttesterSlashingElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := a.fixedSize()
+
+	// Offset (0) 'Attestation1'
+	dst = ssz.WriteOffset(dst, offset)
+	if a.Attestation1 == nil {
+		a.Attestation1 = new(IndexedAttestationElectra)
+	}
+	offset += a.Attestation1.SizeSSZ()
+
+	// Offset (1) 'Attestation2'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Attestation1'
+	if dst, err = a.Attestation1.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Attestation2'
+	if dst, err = a.Attestation2.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the AttesterSlashingElectra object
+func (a *AttesterSlashingElectra) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(a, buf)
+}
+
+// UnmarshalSSZTail unmarshals the AttesterSlashingElectra object and returns the remaining bufferº
+func (a *AttesterSlashingElectra) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := a.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Offset (0) 'Attestation1'
+	if o0, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (1) 'Attestation2'
+	if o1, _, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (0) 'Attestation1'
+	if err = ssz.UnmarshalField(&a.Attestation1, tail[o0:o1]); err != nil {
+		return
+	}
+
+	// Field (1) 'Attestation2'
+	if err = ssz.UnmarshalField(&a.Attestation2, tail[o1:]); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the AttesterSlashingElectra object
+func (a *AttesterSlashingElectra) fixedSize() int {
+	return int(8)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the AttesterSlashingElectra object
+func (a *AttesterSlashingElectra) SizeSSZ() (size int) {
+	size = a.fixedSize()
+
+	// Field (0) 'Attestation1'
+	if a.Attestation1 == nil {
+		a.Attestation1 = new(IndexedAttestationElectra)
+	}
+	size += a.Attestation1.SizeSSZ()
+
+	// Field (1) 'Attestation2'
+	if a.Attestation2 == nil {
+		a.Attestation2 = new(IndexedAttestationElectra)
+	}
+	size += a.Attestation2.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the AttesterSlashingElectra object
+func (a *AttesterSlashingElectra) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(a)
+}
+
+// HashTreeRootWith ssz hashes the AttesterSlashingElectra object with a hasher
+func (a *AttesterSlashingElectra) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Attestation1'
+	if err = a.Attestation1.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Attestation2'
+	if err = a.Attestation2.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the AttesterSlashingElectra object
+func (a *AttesterSlashingElectra) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(a)
+}
+
+// MarshalSSZ ssz marshals the DepositRequest object
+func (d *DepositRequest) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(d)
+}
+
+// MarshalSSZTo ssz marshals the DepositRequest object to a target array
+func (d *DepositRequest) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Pubkey'
+	dst = append(dst, d.Pubkey[:]...)
+
+	// Field (1) 'WithdrawalCredentials'
+	dst = append(dst, d.WithdrawalCredentials[:]...)
+
+	// Field (2) 'Amount'
+	dst = ssz.MarshalValue(dst, uint64(d.Amount))
+
+	// Field (3) 'Signature'
+	dst = append(dst, d.Signature[:]...)
+
+	// Field (4) 'Index'
+	dst = ssz.MarshalValue(dst, d.Index)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the DepositRequest object
+func (d *DepositRequest) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(d, buf)
+}
+
+// UnmarshalSSZTail unmarshals the DepositRequest object and returns the remaining bufferº
+func (d *DepositRequest) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := d.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	// Field (0) 'Pubkey'
+	buf = ssz.UnmarshalFixedBytes(d.Pubkey[:], buf)
+
+	// Field (1) 'WithdrawalCredentials'
+	buf = ssz.UnmarshalFixedBytes(d.WithdrawalCredentials[:], buf)
+
+	// Field (2) 'Amount'
+	{
+		var val uint64
+		val, buf = ssz.UnmarshallValue[uint64](buf)
+		d.Amount = Gwei(val)
+	}
+
+	// Field (3) 'Signature'
+	buf = ssz.UnmarshalFixedBytes(d.Signature[:], buf)
+
+	// Field (4) 'Index'
+	d.Index, buf = ssz.UnmarshallValue[uint64](buf)
+
+	return buf, nil
+}
+
+// fixedSize returns the fixed size of the DepositRequest object
+func (d *DepositRequest) fixedSize() int {
+	return int(192)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the DepositRequest object
+func (d *DepositRequest) SizeSSZ() (size int) {
+	size = d.fixedSize()
+	return
+}
+
+// HashTreeRoot ssz hashes the DepositRequest object
+func (d *DepositRequest) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(d)
+}
+
+// HashTreeRootWith ssz hashes the DepositRequest object with a hasher
+func (d *DepositRequest) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Pubkey'
+	hh.PutBytes(d.Pubkey[:])
+
+	// Field (1) 'WithdrawalCredentials'
+	hh.PutBytes(d.WithdrawalCredentials[:])
+
+	// Field (2) 'Amount'
+	hh.PutUint64(uint64(d.Amount))
+
+	// Field (3) 'Signature'
+	hh.PutBytes(d.Signature[:])
+
+	// Field (4) 'Index'
+	hh.PutUint64(d.Index)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the DepositRequest object
+func (d *DepositRequest) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(d)
+}
+
+// MarshalSSZ ssz marshals the WithdrawalRequest object
+func (w *WithdrawalRequest) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(w)
+}
+
+// MarshalSSZTo ssz marshals the WithdrawalRequest object to a target array
+func (w *WithdrawalRequest) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'SourceAddress'
+	dst = append(dst, w.SourceAddress[:]...)
+
+	// Field (1) 'ValidatorPubkey'
+	dst = append(dst, w.ValidatorPubkey[:]...)
+
+	// Field (2) 'Amount'
+	dst = ssz.MarshalValue(dst, uint64(w.Amount))
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the WithdrawalRequest object
+func (w *WithdrawalRequest) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(w, buf)
+}
+
+// UnmarshalSSZTail unmarshals the WithdrawalRequest object and returns the remaining bufferº
+func (w *WithdrawalRequest) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := w.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	// Field (0) 'SourceAddress'
+	buf = ssz.UnmarshalFixedBytes(w.SourceAddress[:], buf)
+
+	// Field (1) 'ValidatorPubkey'
+	buf = ssz.UnmarshalFixedBytes(w.ValidatorPubkey[:], buf)
+
+	// Field (2) 'Amount'
+	{
+		var val uint64
+		val, buf = ssz.UnmarshallValue[uint64](buf)
+		w.Amount = Gwei(val)
+	}
+
+	return buf, nil
+}
+
+// fixedSize returns the fixed size of the WithdrawalRequest object
+func (w *WithdrawalRequest) fixedSize() int {
+	return int(76)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the WithdrawalRequest object
+func (w *WithdrawalRequest) SizeSSZ() (size int) {
+	size = w.fixedSize()
+	return
+}
+
+// HashTreeRoot ssz hashes the WithdrawalRequest object
+func (w *WithdrawalRequest) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(w)
+}
+
+// HashTreeRootWith ssz hashes the WithdrawalRequest object with a hasher
+func (w *WithdrawalRequest) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'SourceAddress'
+	hh.PutBytes(w.SourceAddress[:])
+
+	// Field (1) 'ValidatorPubkey'
+	hh.PutBytes(w.ValidatorPubkey[:])
+
+	// Field (2) 'Amount'
+	hh.PutUint64(uint64(w.Amount))
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the WithdrawalRequest object
+func (w *WithdrawalRequest) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(w)
+}
+
+// MarshalSSZ ssz marshals the ConsolidationRequest object
+func (c *ConsolidationRequest) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the ConsolidationRequest object to a target array
+func (c *ConsolidationRequest) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'SourceAddress'
+	dst = append(dst, c.SourceAddress[:]...)
+
+	// Field (1) 'SourcePubkey'
+	dst = append(dst, c.SourcePubkey[:]...)
+
+	// Field (2) 'TargetPubkey'
+	dst = append(dst, c.TargetPubkey[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ConsolidationRequest object
+func (c *ConsolidationRequest) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(c, buf)
+}
+
+// UnmarshalSSZTail unmarshals the ConsolidationRequest object and returns the remaining bufferº
+func (c *ConsolidationRequest) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := c.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	// Field (0) 'SourceAddress'
+	buf = ssz.UnmarshalFixedBytes(c.SourceAddress[:], buf)
+
+	// Field (1) 'SourcePubkey'
+	buf = ssz.UnmarshalFixedBytes(c.SourcePubkey[:], buf)
+
+	// Field (2) 'TargetPubkey'
+	buf = ssz.UnmarshalFixedBytes(c.TargetPubkey[:], buf)
+
+	return buf, nil
+}
+
+// fixedSize returns the fixed size of the ConsolidationRequest object
+func (c *ConsolidationRequest) fixedSize() int {
+	return int(116)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ConsolidationRequest object
+func (c *ConsolidationRequest) SizeSSZ() (size int) {
+	size = c.fixedSize()
+	return
+}
+
+// HashTreeRoot ssz hashes the ConsolidationRequest object
+func (c *ConsolidationRequest) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the ConsolidationRequest object with a hasher
+func (c *ConsolidationRequest) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'SourceAddress'
+	hh.PutBytes(c.SourceAddress[:])
+
+	// Field (1) 'SourcePubkey'
+	hh.PutBytes(c.SourcePubkey[:])
+
+	// Field (2) 'TargetPubkey'
+	hh.PutBytes(c.TargetPubkey[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ConsolidationRequest object
+func (c *ConsolidationRequest) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(c)
+}
+
+// MarshalSSZ ssz marshals the ExecutionRequests object
+func (e *ExecutionRequests) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
+}
+
+// MarshalSSZTo ssz marshals the ExecutionRequests object to a target array
+func (e *ExecutionRequests) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := e.fixedSize()
+
+	// Offset (0) 'Deposits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(e.Deposits) * 192
+
+	// Offset (1) 'Withdrawals'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(e.Withdrawals) * 76
+
+	// Offset (2) 'Consolidations'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Deposits'
+	if size := uint64(len(e.Deposits)); size > maxDepositRequests {
+		err = ssz.ErrListTooBigFn("ExecutionRequests.Deposits", size, maxDepositRequests)
+		return
+	}
+	for ii := 0; ii < len(e.Deposits); ii++ {
+		if dst, err = e.Deposits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (1) 'Withdrawals'
+	if size := uint64(len(e.Withdrawals)); size > maxWithdrawalRequests {
+		err = ssz.ErrListTooBigFn("ExecutionRequests.Withdrawals", size, maxWithdrawalRequests)
+		return
+	}
+	for ii := 0; ii < len(e.Withdrawals); ii++ {
+		if dst, err = e.Withdrawals[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (2) 'Consolidations'
+	if size := uint64(len(e.Consolidations)); size > 2 {
+		err = ssz.ErrListTooBigFn("ExecutionRequests.Consolidations", size, 2)
+		return
+	}
+	for ii := 0; ii < len(e.Consolidations); ii++ {
+		if dst, err = e.Consolidations[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ExecutionRequests object
+func (e *ExecutionRequests) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(e, buf)
+}
+
+// UnmarshalSSZTail unmarshals the ExecutionRequests object and returns the remaining bufferº
+func (e *ExecutionRequests) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := e.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1, o2 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Offset (0) 'Deposits'
+	if o0, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (1) 'Withdrawals'
+	if o1, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (2) 'Consolidations'
+	if o2, _, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (0) 'Deposits'
+	if err = ssz.UnmarshalSliceSSZ(&e.Deposits, tail[o0:o1], maxDepositRequests); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'Withdrawals'
+	if err = ssz.UnmarshalSliceSSZ(&e.Withdrawals, tail[o1:o2], maxWithdrawalRequests); err != nil {
+		return nil, err
+	}
+
+	// Field (2) 'Consolidations'
+	if err = ssz.UnmarshalSliceSSZ(&e.Consolidations, tail[o2:], 2); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the ExecutionRequests object
+func (e *ExecutionRequests) fixedSize() int {
+	return int(12)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ExecutionRequests object
+func (e *ExecutionRequests) SizeSSZ() (size int) {
+	size = e.fixedSize()
+
+	// Field (0) 'Deposits'
+	size += len(e.Deposits) * 192
+
+	// Field (1) 'Withdrawals'
+	size += len(e.Withdrawals) * 76
+
+	// Field (2) 'Consolidations'
+	size += len(e.Consolidations) * 116
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ExecutionRequests object
+func (e *ExecutionRequests) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(e)
+}
+
+// HashTreeRootWith ssz hashes the ExecutionRequests object with a hasher
+func (e *ExecutionRequests) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Deposits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(e.Deposits))
+		if num > maxDepositRequests {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range e.Deposits {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, maxDepositRequests)
+	}
+
+	// Field (1) 'Withdrawals'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(e.Withdrawals))
+		if num > maxWithdrawalRequests {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range e.Withdrawals {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, maxWithdrawalRequests)
+	}
+
+	// Field (2) 'Consolidations'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(e.Consolidations))
+		if num > 2 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range e.Consolidations {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 2)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ExecutionRequests object
+func (e *ExecutionRequests) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(e)
+}
+
+// MarshalSSZ ssz marshals the PendingDeposit object
+func (p *PendingDeposit) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the PendingDeposit object to a target array
+func (p *PendingDeposit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Pubkey'
+	dst = append(dst, p.Pubkey[:]...)
+
+	// Field (1) 'WithdrawalCredentials'
+	dst = append(dst, p.WithdrawalCredentials[:]...)
+
+	// Field (2) 'Amount'
+	dst = ssz.MarshalValue(dst, uint64(p.Amount))
+
+	// Field (3) 'Signature'
+	dst = append(dst, p.Signature[:]...)
+
+	// Field (4) 'Slot'
+	dst = ssz.MarshalValue(dst, uint64(p.Slot))
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the PendingDeposit object
+func (p *PendingDeposit) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(p, buf)
+}
+
+// UnmarshalSSZTail unmarshals the PendingDeposit object and returns the remaining bufferº
+func (p *PendingDeposit) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := p.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	// Field (0) 'Pubkey'
+	buf = ssz.UnmarshalFixedBytes(p.Pubkey[:], buf)
+
+	// Field (1) 'WithdrawalCredentials'
+	buf = ssz.UnmarshalFixedBytes(p.WithdrawalCredentials[:], buf)
+
+	// Field (2) 'Amount'
+	{
+		var val uint64
+		val, buf = ssz.UnmarshallValue[uint64](buf)
+		p.Amount = Gwei(val)
+	}
+
+	// Field (3) 'Signature'
+	buf = ssz.UnmarshalFixedBytes(p.Signature[:], buf)
+
+	// Field (4) 'Slot'
+	{
+		var val uint64
+		val, buf = ssz.UnmarshallValue[uint64](buf)
+		p.Slot = Slot(val)
+	}
+
+	return buf, nil
+}
+
+// fixedSize returns the fixed size of the PendingDeposit object
+func (p *PendingDeposit) fixedSize() int {
+	return int(192)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the PendingDeposit object
+func (p *PendingDeposit) SizeSSZ() (size int) {
+	size = p.fixedSize()
+	return
+}
+
+// HashTreeRoot ssz hashes the PendingDeposit object
+func (p *PendingDeposit) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
+}
+
+// HashTreeRootWith ssz hashes the PendingDeposit object with a hasher
+func (p *PendingDeposit) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Pubkey'
+	hh.PutBytes(p.Pubkey[:])
+
+	// Field (1) 'WithdrawalCredentials'
+	hh.PutBytes(p.WithdrawalCredentials[:])
+
+	// Field (2) 'Amount'
+	hh.PutUint64(uint64(p.Amount))
+
+	// Field (3) 'Signature'
+	hh.PutBytes(p.Signature[:])
+
+	// Field (4) 'Slot'
+	hh.PutUint64(uint64(p.Slot))
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the PendingDeposit object
+func (p *PendingDeposit) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(p)
+}
+
+// MarshalSSZ ssz marshals the PendingPartialWithdrawal object
+func (p *PendingPartialWithdrawal) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the PendingPartialWithdrawal object to a target array
+func (p *PendingPartialWithdrawal) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'ValidatorIndex'
+	dst = ssz.MarshalValue(dst, uint64(p.ValidatorIndex))
+
+	// Field (1) 'Amount'
+	dst = ssz.MarshalValue(dst, uint64(p.Amount))
+
+	// Field (2) 'WithdrawableEpoch'
+	dst = ssz.MarshalValue(dst, uint64(p.WithdrawableEpoch))
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the PendingPartialWithdrawal object
+func (p *PendingPartialWithdrawal) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(p, buf)
+}
+
+// UnmarshalSSZTail unmarshals the PendingPartialWithdrawal object and returns the remaining bufferº
+func (p *PendingPartialWithdrawal) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := p.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	// Field (0) 'ValidatorIndex'
+	{
+		var val uint64
+		val, buf = ssz.UnmarshallValue[uint64](buf)
+		p.ValidatorIndex = ValidatorIndex(val)
+	}
+
+	// Field (1) 'Amount'
+	{
+		var val uint64
+		val, buf = ssz.UnmarshallValue[uint64](buf)
+		p.Amount = Gwei(val)
+	}
+
+	// Field (2) 'WithdrawableEpoch'
+	{
+		var val uint64
+		val, buf = ssz.UnmarshallValue[uint64](buf)
+		p.WithdrawableEpoch = Epoch(val)
+	}
+
+	return buf, nil
+}
+
+// fixedSize returns the fixed size of the PendingPartialWithdrawal object
+func (p *PendingPartialWithdrawal) fixedSize() int {
+	return int(24)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the PendingPartialWithdrawal object
+func (p *PendingPartialWithdrawal) SizeSSZ() (size int) {
+	size = p.fixedSize()
+	return
+}
+
+// HashTreeRoot ssz hashes the PendingPartialWithdrawal object
+func (p *PendingPartialWithdrawal) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
+}
+
+// HashTreeRootWith ssz hashes the PendingPartialWithdrawal object with a hasher
+func (p *PendingPartialWithdrawal) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ValidatorIndex'
+	hh.PutUint64(uint64(p.ValidatorIndex))
+
+	// Field (1) 'Amount'
+	hh.PutUint64(uint64(p.Amount))
+
+	// Field (2) 'WithdrawableEpoch'
+	hh.PutUint64(uint64(p.WithdrawableEpoch))
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the PendingPartialWithdrawal object
+func (p *PendingPartialWithdrawal) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(p)
+}
+
+// MarshalSSZ ssz marshals the PendingConsolidation object
+func (p *PendingConsolidation) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the PendingConsolidation object to a target array
+func (p *PendingConsolidation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'SourceIndex'
+	dst = ssz.MarshalValue(dst, uint64(p.SourceIndex))
+
+	// Field (1) 'TargetIndex'
+	dst = ssz.MarshalValue(dst, uint64(p.TargetIndex))
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the PendingConsolidation object
+func (p *PendingConsolidation) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(p, buf)
+}
+
+// UnmarshalSSZTail unmarshals the PendingConsolidation object and returns the remaining bufferº
+func (p *PendingConsolidation) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := p.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	// Field (0) 'SourceIndex'
+	{
+		var val uint64
+		val, buf = ssz.UnmarshallValue[uint64](buf)
+		p.SourceIndex = ValidatorIndex(val)
+	}
+
+	// Field (1) 'TargetIndex'
+	{
+		var val uint64
+		val, buf = ssz.UnmarshallValue[uint64](buf)
+		p.TargetIndex = ValidatorIndex(val)
+	}
+
+	return buf, nil
+}
+
+// fixedSize returns the fixed size of the PendingConsolidation object
+func (p *PendingConsolidation) fixedSize() int {
+	return int(16)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the PendingConsolidation object
+func (p *PendingConsolidation) SizeSSZ() (size int) {
+	size = p.fixedSize()
+	return
+}
+
+// HashTreeRoot ssz hashes the PendingConsolidation object
+func (p *PendingConsolidation) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
+}
+
+// HashTreeRootWith ssz hashes the PendingConsolidation object with a hasher
+func (p *PendingConsolidation) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'SourceIndex'
+	hh.PutUint64(uint64(p.SourceIndex))
+
+	// Field (1) 'TargetIndex'
+	hh.PutUint64(uint64(p.TargetIndex))
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the PendingConsolidation object
+func (p *PendingConsolidation) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(p)
+}
+
+// MarshalSSZ ssz marshals the BeaconBlockBodyElectra object
+func (b *BeaconBlockBodyElectra) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconBlockBodyElectra object to a target array
+func (b *BeaconBlockBodyElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := b.fixedSize()
+
+	// Field (0) 'RANDAOReveal'
+	dst = append(dst, b.RANDAOReveal[:]...)
+
+	// Field (1) 'ETH1Data'
+	if b.ETH1Data == nil {
+		b.ETH1Data = new(ETH1Data)
+	}
+	if dst, err = b.ETH1Data.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	dst = append(dst, b.Graffiti[:]...)
+
+	// Offset (3) 'ProposerSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ProposerSlashings) * 416
+
+	// Offset (4) 'AttesterSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		offset += 4
+		offset += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Offset (5) 'Attestations'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		offset += 4
+		offset += b.Attestations[ii].SizeSSZ()
+	}
+
+	// Offset (6) 'Deposits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Deposits) * 1240
+
+	// Offset (7) 'VoluntaryExits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.VoluntaryExits) * 112
+
+	// Field (8) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(SyncAggregate)
+	}
+	if dst, err = b.SyncAggregate.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (9) 'ExecutionPayload'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.ExecutionPayload == nil {
+		b.ExecutionPayload = new(ExecutionPayload)
+	}
+	offset += b.ExecutionPayload.SizeSSZ()
+
+	// Offset (10) 'BLSToExecutionChanges'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.BLSToExecutionChanges) * 172
+
+	// Offset (11) 'BlobKZGCommitments'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.BlobKZGCommitments) * 48
+
+	// Offset (12) 'ExecutionRequests'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (3) 'ProposerSlashings'
+	if size := uint64(len(b.ProposerSlashings)); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyElectra.ProposerSlashings", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	if size := uint64(len(b.AttesterSlashings)); size > 1 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyElectra.AttesterSlashings", size, 1)
+		return
+	}
+	{
+		offset = 4 * len(b.AttesterSlashings)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (5) 'Attestations'
+	if size := uint64(len(b.Attestations)); size > 8 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyElectra.Attestations", size, 8)
+		return
+	}
+	{
+		offset = 4 * len(b.Attestations)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (6) 'Deposits'
+	if size := uint64(len(b.Deposits)); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyElectra.Deposits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.Deposits); ii++ {
+		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	if size := uint64(len(b.VoluntaryExits)); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyElectra.VoluntaryExits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (9) 'ExecutionPayload'
+	if dst, err = b.ExecutionPayload.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (10) 'BLSToExecutionChanges'
+	if size := uint64(len(b.BLSToExecutionChanges)); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyElectra.BLSToExecutionChanges", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.BLSToExecutionChanges); ii++ {
+		if dst, err = b.BLSToExecutionChanges[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (11) 'BlobKZGCommitments'
+	if size := uint64(len(b.BlobKZGCommitments)); size > maxBlobCommitmentsPerBlock {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyElectra.BlobKZGCommitments", size, maxBlobCommitmentsPerBlock)
+		return
+	}
+	for ii := 0; ii < len(b.BlobKZGCommitments); ii++ {
+		dst = append(dst, b.BlobKZGCommitments[ii][:]...)
+	}
+
+	// Field (12) 'ExecutionRequests'
+	if dst, err = b.ExecutionRequests.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconBlockBodyElectra object
+func (b *BeaconBlockBodyElectra) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(b, buf)
+}
+
+// UnmarshalSSZTail unmarshals the BeaconBlockBodyElectra object and returns the remaining bufferº
+func (b *BeaconBlockBodyElectra) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := b.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o3, o4, o5, o6, o7, o9, o10, o11, o12 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Field (0) 'RANDAOReveal'
+	buf = ssz.UnmarshalFixedBytes(b.RANDAOReveal[:], buf)
+
+	// Field (1) 'ETH1Data'
+	if buf, err = ssz.UnmarshalFieldTail(&b.ETH1Data, buf); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	buf = ssz.UnmarshalFixedBytes(b.Graffiti[:], buf)
+
+	// Offset (3) 'ProposerSlashings'
+	if o3, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (4) 'AttesterSlashings'
+	if o4, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (5) 'Attestations'
+	if o5, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (6) 'Deposits'
+	if o6, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (7) 'VoluntaryExits'
+	if o7, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (8) 'SyncAggregate'
+	if buf, err = ssz.UnmarshalFieldTail(&b.SyncAggregate, buf); err != nil {
+		return
+	}
+
+	// Offset (9) 'ExecutionPayload'
+	if o9, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (10) 'BLSToExecutionChanges'
+	if o10, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (11) 'BlobKZGCommitments'
+	if o11, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (12) 'ExecutionRequests'
+	if o12, _, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (3) 'ProposerSlashings'
+	if err = ssz.UnmarshalSliceSSZ(&b.ProposerSlashings, tail[o3:o4], 16); err != nil {
+		return nil, err
+	}
+
+	// Field (4) 'AttesterSlashings'
+	if err = ssz.UnmarshalDynamicSliceSSZ(&b.AttesterSlashings, tail[o4:o5], 1); err != nil {
+		return nil, err
+	}
+
+	// Field (5) 'Attestations'
+	if err = ssz.UnmarshalDynamicSliceSSZ(&b.Attestations, tail[o5:o6], 8); err != nil {
+		return nil, err
+	}
+
+	// Field (6) 'Deposits'
+	if err = ssz.UnmarshalSliceSSZ(&b.Deposits, tail[o6:o7], 16); err != nil {
+		return nil, err
+	}
+
+	// Field (7) 'VoluntaryExits'
+	if err = ssz.UnmarshalSliceSSZ(&b.VoluntaryExits, tail[o7:o9], 16); err != nil {
+		return nil, err
+	}
+
+	// Field (9) 'ExecutionPayload'
+	if err = ssz.UnmarshalField(&b.ExecutionPayload, tail[o9:o10]); err != nil {
+		return
+	}
+
+	// Field (10) 'BLSToExecutionChanges'
+	if err = ssz.UnmarshalSliceSSZ(&b.BLSToExecutionChanges, tail[o10:o11], 16); err != nil {
+		return nil, err
+	}
+
+	// Field (11) 'BlobKZGCommitments'
+	if err = ssz.UnmarshalSliceWithIndexCallback(&b.BlobKZGCommitments, tail[o11:o12], 48, maxBlobCommitmentsPerBlock, func(ii uint64, buf []byte) (err error) {
+		buf = ssz.UnmarshalFixedBytes(b.BlobKZGCommitments[ii][:], buf)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// Field (12) 'ExecutionRequests'
+	if err = ssz.UnmarshalField(&b.ExecutionRequests, tail[o12:]); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the BeaconBlockBodyElectra object
+func (b *BeaconBlockBodyElectra) fixedSize() int {
+	return int((236 + (96 + syncCommitteeBitsSize)))
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyElectra object
+func (b *BeaconBlockBodyElectra) SizeSSZ() (size int) {
+	size = b.fixedSize()
+
+	// Field (3) 'ProposerSlashings'
+	size += len(b.ProposerSlashings) * 416
+
+	// Field (4) 'AttesterSlashings'
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		size += 4
+		size += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Field (5) 'Attestations'
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		size += 4
+		size += b.Attestations[ii].SizeSSZ()
+	}
+
+	// Field (6) 'Deposits'
+	size += len(b.Deposits) * 1240
+
+	// Field (7) 'VoluntaryExits'
+	size += len(b.VoluntaryExits) * 112
+
+	// Field (9) 'ExecutionPayload'
+	if b.ExecutionPayload == nil {
+		b.ExecutionPayload = new(ExecutionPayload)
+	}
+	size += b.ExecutionPayload.SizeSSZ()
+
+	// Field (10) 'BLSToExecutionChanges'
+	size += len(b.BLSToExecutionChanges) * 172
+
+	// Field (11) 'BlobKZGCommitments'
+	size += len(b.BlobKZGCommitments) * 48
+
+	// Field (12) 'ExecutionRequests'
+	if b.ExecutionRequests == nil {
+		b.ExecutionRequests = new(ExecutionRequests)
+	}
+	size += b.ExecutionRequests.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BeaconBlockBodyElectra object
+func (b *BeaconBlockBodyElectra) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconBlockBodyElectra object with a hasher
+func (b *BeaconBlockBodyElectra) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'RANDAOReveal'
+	hh.PutBytes(b.RANDAOReveal[:])
+
+	// Field (1) 'ETH1Data'
+	if b.ETH1Data == nil {
+		b.ETH1Data = new(ETH1Data)
+	}
+	if err = b.ETH1Data.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	hh.PutBytes(b.Graffiti[:])
+
+	// Field (3) 'ProposerSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.ProposerSlashings))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.ProposerSlashings {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.AttesterSlashings))
+		if num > 1 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.AttesterSlashings {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 1)
+	}
+
+	// Field (5) 'Attestations'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Attestations))
+		if num > 8 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Attestations {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 8)
+	}
+
+	// Field (6) 'Deposits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Deposits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Deposits {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.VoluntaryExits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.VoluntaryExits {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
+
+	// Field (8) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(SyncAggregate)
+	}
+	if err = b.SyncAggregate.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (9) 'ExecutionPayload'
+	if err = b.ExecutionPayload.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (10) 'BLSToExecutionChanges'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.BLSToExecutionChanges))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.BLSToExecutionChanges {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
+
+	// Field (11) 'BlobKZGCommitments'
+	{
+		if size := uint64(len(b.BlobKZGCommitments)); size > maxBlobCommitmentsPerBlock {
+			err = ssz.ErrListTooBigFn("BeaconBlockBodyElectra.BlobKZGCommitments", size, maxBlobCommitmentsPerBlock)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.BlobKZGCommitments {
+			hh.PutBytes(i[:])
+		}
+		numItems := uint64(len(b.BlobKZGCommitments))
+		hh.MerkleizeWithMixin(subIndx, numItems, maxBlobCommitmentsPerBlock)
+	}
+
+	// Field (12) 'ExecutionRequests'
+	if err = b.ExecutionRequests.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the BeaconBlockBodyElectra object
+func (b *BeaconBlockBodyElectra) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// MarshalSSZ ssz marshals the BeaconBlockElectra object
+func (b *BeaconBlockElectra) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconBlockElectra object to a target array
+func (b *BeaconBlockElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := b.fixedSize()
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalValue(dst, uint64(b.Slot))
+
+	// Field (1) 'ProposerIndex'
+	dst = ssz.MarshalValue(dst, uint64(b.ProposerIndex))
+
+	// Field (2) 'ParentRoot'
+	dst = append(dst, b.ParentRoot[:]...)
+
+	// Field (3) 'StateRoot'
+	dst = append(dst, b.StateRoot[:]...)
+
+	// Offset (4) 'Body'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (4) 'Body'
+	if dst, err = b.Body.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconBlockElectra object
+func (b *BeaconBlockElectra) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(b, buf)
+}
+
+// UnmarshalSSZTail unmarshals the BeaconBlockElectra object and returns the remaining bufferº
+func (b *BeaconBlockElectra) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := b.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o4 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Field (0) 'Slot'
+	{
+		var val uint64
+		val, buf = ssz.UnmarshallValue[uint64](buf)
+		b.Slot = Slot(val)
+	}
+
+	// Field (1) 'ProposerIndex'
+	{
+		var val uint64
+		val, buf = ssz.UnmarshallValue[uint64](buf)
+		b.ProposerIndex = ValidatorIndex(val)
+	}
+
+	// Field (2) 'ParentRoot'
+	buf = ssz.UnmarshalFixedBytes(b.ParentRoot[:], buf)
+
+	// Field (3) 'StateRoot'
+	buf = ssz.UnmarshalFixedBytes(b.StateRoot[:], buf)
+
+	// Offset (4) 'Body'
+	if o4, _, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (4) 'Body'
+	if err = ssz.UnmarshalField(&b.Body, tail[o4:]); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the BeaconBlockElectra object
+func (b *BeaconBlockElectra) fixedSize() int {
+	return int(84)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockElectra object
+func (b *BeaconBlockElectra) SizeSSZ() (size int) {
+	size = b.fixedSize()
+
+	// Field (4) 'Body'
+	if b.Body == nil {
+		b.Body = new(BeaconBlockBodyElectra)
+	}
+	size += b.Body.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BeaconBlockElectra object
+func (b *BeaconBlockElectra) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconBlockElectra object with a hasher
+func (b *BeaconBlockElectra) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(uint64(b.Slot))
+
+	// Field (1) 'ProposerIndex'
+	hh.PutUint64(uint64(b.ProposerIndex))
+
+	// Field (2) 'ParentRoot'
+	hh.PutBytes(b.ParentRoot[:])
+
+	// Field (3) 'StateRoot'
+	hh.PutBytes(b.StateRoot[:])
+
+	// Field (4) 'Body'
+	if err = b.Body.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the BeaconBlockElectra object
+func (b *BeaconBlockElectra) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// MarshalSSZ ssz marshals the SignedBeaconBlockElectra object
+func (s *SignedBeaconBlockElectra) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedBeaconBlockElectra object to a target array
+func (s *SignedBeaconBlockElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := s.fixedSize()
+
+	// Offset (0) 'Message'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Signature'
+	dst = append(dst, s.Signature[:]...)
+
+	// Field (0) 'Message'
+	if dst, err = s.Message.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedBeaconBlockElectra object
+func (s *SignedBeaconBlockElectra) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(s, buf)
+}
+
+// UnmarshalSSZTail unmarshals the SignedBeaconBlockElectra object and returns the remaining bufferº
+func (s *SignedBeaconBlockElectra) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := s.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Offset (0) 'Message'
+	if o0, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'Signature'
+	buf = ssz.UnmarshalFixedBytes(s.Signature[:], buf)
+
+	// Field (0) 'Message'
+	if err = ssz.UnmarshalField(&s.Message, tail[o0:]); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the SignedBeaconBlockElectra object
+func (s *SignedBeaconBlockElectra) fixedSize() int {
+	return int(100)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlockElectra object
+func (s *SignedBeaconBlockElectra) SizeSSZ() (size int) {
+	size = s.fixedSize()
+
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(BeaconBlockElectra)
+	}
+	size += s.Message.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedBeaconBlockElectra object
+func (s *SignedBeaconBlockElectra) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedBeaconBlockElectra object with a hasher
+func (s *SignedBeaconBlockElectra) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Message'
+	if err = s.Message.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	hh.PutBytes(s.Signature[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SignedBeaconBlockElectra object
+func (s *SignedBeaconBlockElectra) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// MarshalSSZ ssz marshals the BeaconStateElectra object
+func (b *BeaconStateElectra) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconStateElectra object to a target array
+func (b *BeaconStateElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 	offset := b.fixedSize()
 
@@ -3481,12 +7268,12 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 	// Field (5) 'BlockRoots'
 	if size := uint64(len(b.BlockRoots)); size != slotsPerHistoricalRoot {
-		err = ssz.ErrVectorLengthFn("BeaconState.BlockRoots", size, slotsPerHistoricalRoot)
+		err = ssz.ErrVectorLengthFn("BeaconStateElectra.BlockRoots", size, slotsPerHistoricalRoot)
 		return
 	}
 	for ii := uint64(0); ii < slotsPerHistoricalRoot; ii++ {
 		if size := uint64(len(b.BlockRoots[ii])); size != 32 {
-			err = ssz.ErrBytesLengthFn("BeaconState.BlockRoots[ii]", size, 32)
+			err = ssz.ErrBytesLengthFn("BeaconStateElectra.BlockRoots[ii]", size, 32)
 			return
 		}
 		dst = append(dst, b.BlockRoots[ii]...)
@@ -3494,12 +7281,12 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 	// Field (6) 'StateRoots'
 	if size := uint64(len(b.StateRoots)); size != slotsPerHistoricalRoot {
-		err = ssz.ErrVectorLengthFn("BeaconState.StateRoots", size, slotsPerHistoricalRoot)
+		err = ssz.ErrVectorLengthFn("BeaconStateElectra.StateRoots", size, slotsPerHistoricalRoot)
 		return
 	}
 	for ii := uint64(0); ii < slotsPerHistoricalRoot; ii++ {
 		if size := uint64(len(b.StateRoots[ii])); size != 32 {
-			err = ssz.ErrBytesLengthFn("BeaconState.StateRoots[ii]", size, 32)
+			err = ssz.ErrBytesLengthFn("BeaconStateElectra.StateRoots[ii]", size, 32)
 			return
 		}
 		dst = append(dst, b.StateRoots[ii]...)
@@ -3534,12 +7321,12 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 	// Field (13) 'RANDAOMixes'
 	if size := uint64(len(b.RANDAOMixes)); size != epochsPerHistoricalVector {
-		err = ssz.ErrVectorLengthFn("BeaconState.RANDAOMixes", size, epochsPerHistoricalVector)
+		err = ssz.ErrVectorLengthFn("BeaconStateElectra.RANDAOMixes", size, epochsPerHistoricalVector)
 		return
 	}
 	for ii := uint64(0); ii < epochsPerHistoricalVector; ii++ {
 		if size := uint64(len(b.RANDAOMixes[ii])); size != 32 {
-			err = ssz.ErrBytesLengthFn("BeaconState.RANDAOMixes[ii]", size, 32)
+			err = ssz.ErrBytesLengthFn("BeaconStateElectra.RANDAOMixes[ii]", size, 32)
 			return
 		}
 		dst = append(dst, b.RANDAOMixes[ii]...)
@@ -3547,7 +7334,7 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 	// Field (14) 'Slashings'
 	if size := uint64(len(b.Slashings)); size != epochsPerSlashingsVector {
-		err = ssz.ErrVectorLengthFn("BeaconState.Slashings", size, epochsPerSlashingsVector)
+		err = ssz.ErrVectorLengthFn("BeaconStateElectra.Slashings", size, epochsPerSlashingsVector)
 		return
 	}
 	for ii := uint64(0); ii < epochsPerSlashingsVector; ii++ {
@@ -3624,10 +7411,40 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 	// Offset (27) 'HistoricalSummaries'
 	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.HistoricalSummaries) * 64
+
+	// Field (28) 'DepositRequestsStartIndex'
+	dst = ssz.MarshalValue(dst, b.DepositRequestsStartIndex)
+
+	// Field (29) 'DepositBalanceToConsume'
+	dst = ssz.MarshalValue(dst, uint64(b.DepositBalanceToConsume))
+
+	// Field (30) 'ExitBalanceToConsume'
+	dst = ssz.MarshalValue(dst, uint64(b.ExitBalanceToConsume))
+
+	// Field (31) 'EarliestExitEpoch'
+	dst = ssz.MarshalValue(dst, uint64(b.EarliestExitEpoch))
+
+	// Field (32) 'ConsolidationBalanceToConsume'
+	dst = ssz.MarshalValue(dst, uint64(b.ConsolidationBalanceToConsume))
+
+	// Field (33) 'EarliestConsolidationEpoch'
+	dst = ssz.MarshalValue(dst, uint64(b.EarliestConsolidationEpoch))
+
+	// Offset (34) 'PendingDeposits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.PendingDeposits) * 192
+
+	// Offset (35) 'PendingPartialWithdrawals'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.PendingPartialWithdrawals) * 24
+
+	// Offset (36) 'PendingConsolidations'
+	dst = ssz.WriteOffset(dst, offset)
 
 	// Field (7) 'HistoricalRoots'
 	if size := uint64(len(b.HistoricalRoots)); size > 16777216 {
-		err = ssz.ErrListTooBigFn("BeaconState.HistoricalRoots", size, 16777216)
+		err = ssz.ErrListTooBigFn("BeaconStateElectra.HistoricalRoots", size, 16777216)
 		return
 	}
 	for ii := 0; ii < len(b.HistoricalRoots); ii++ {
@@ -3636,7 +7453,7 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 	// Field (9) 'ETH1DataVotes'
 	if size := uint64(len(b.ETH1DataVotes)); size > eth1DataVotesLimit {
-		err = ssz.ErrListTooBigFn("BeaconState.ETH1DataVotes", size, eth1DataVotesLimit)
+		err = ssz.ErrListTooBigFn("BeaconStateElectra.ETH1DataVotes", size, eth1DataVotesLimit)
 		return
 	}
 	for ii := 0; ii < len(b.ETH1DataVotes); ii++ {
@@ -3647,7 +7464,7 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 	// Field (11) 'Validators'
 	if size := uint64(len(b.Validators)); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("BeaconState.Validators", size, 1099511627776)
+		err = ssz.ErrListTooBigFn("BeaconStateElectra.Validators", size, 1099511627776)
 		return
 	}
 	for ii := 0; ii < len(b.Validators); ii++ {
@@ -3658,7 +7475,7 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 	// Field (12) 'Balances'
 	if size := uint64(len(b.Balances)); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("BeaconState.Balances", size, 1099511627776)
+		err = ssz.ErrListTooBigFn("BeaconStateElectra.Balances", size, 1099511627776)
 		return
 	}
 	for ii := 0; ii < len(b.Balances); ii++ {
@@ -3667,7 +7484,7 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 	// Field (15) 'PreviousEpochParticipation'
 	if size := uint64(len(b.PreviousEpochParticipation)); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("BeaconState.PreviousEpochParticipation", size, 1099511627776)
+		err = ssz.ErrListTooBigFn("BeaconStateElectra.PreviousEpochParticipation", size, 1099511627776)
 		return
 	}
 	for ii := 0; ii < len(b.PreviousEpochParticipation); ii++ {
@@ -3676,7 +7493,7 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 	// Field (16) 'CurrentEpochParticipation'
 	if size := uint64(len(b.CurrentEpochParticipation)); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("BeaconState.CurrentEpochParticipation", size, 1099511627776)
+		err = ssz.ErrListTooBigFn("BeaconStateElectra.CurrentEpochParticipation", size, 1099511627776)
 		return
 	}
 	for ii := 0; ii < len(b.CurrentEpochParticipation); ii++ {
@@ -3685,7 +7502,7 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 	// Field (21) 'InactivityScores'
 	if size := uint64(len(b.InactivityScores)); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("BeaconState.InactivityScores", size, 1099511627776)
+		err = ssz.ErrListTooBigFn("BeaconStateElectra.InactivityScores", size, 1099511627776)
 		return
 	}
 	for ii := 0; ii < len(b.InactivityScores); ii++ {
@@ -3699,7 +7516,7 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 	// Field (27) 'HistoricalSummaries'
 	if size := uint64(len(b.HistoricalSummaries)); size > 16777216 {
-		err = ssz.ErrListTooBigFn("BeaconState.HistoricalSummaries", size, 16777216)
+		err = ssz.ErrListTooBigFn("BeaconStateElectra.HistoricalSummaries", size, 16777216)
 		return
 	}
 	for ii := 0; ii < len(b.HistoricalSummaries); ii++ {
@@ -3708,16 +7525,49 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		}
 	}
 
+	// Field (34) 'PendingDeposits'
+	if size := uint64(len(b.PendingDeposits)); size > 134217728 {
+		err = ssz.ErrListTooBigFn("BeaconStateElectra.PendingDeposits", size, 134217728)
+		return
+	}
+	for ii := 0; ii < len(b.PendingDeposits); ii++ {
+		if dst, err = b.PendingDeposits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (35) 'PendingPartialWithdrawals'
+	if size := uint64(len(b.PendingPartialWithdrawals)); size > pendingPartialWithdrawalsLimit {
+		err = ssz.ErrListTooBigFn("BeaconStateElectra.PendingPartialWithdrawals", size, pendingPartialWithdrawalsLimit)
+		return
+	}
+	for ii := 0; ii < len(b.PendingPartialWithdrawals); ii++ {
+		if dst, err = b.PendingPartialWithdrawals[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (36) 'PendingConsolidations'
+	if size := uint64(len(b.PendingConsolidations)); size > pendingConsolidationsLimit {
+		err = ssz.ErrListTooBigFn("BeaconStateElectra.PendingConsolidations", size, pendingConsolidationsLimit)
+		return
+	}
+	for ii := 0; ii < len(b.PendingConsolidations); ii++ {
+		if dst, err = b.PendingConsolidations[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconState object
-func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the BeaconStateElectra object
+func (b *BeaconStateElectra) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(b, buf)
 }
 
-// UnmarshalSSZTail unmarshals the BeaconState object and returns the remaining bufferº
-func (b *BeaconState) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+// UnmarshalSSZTail unmarshals the BeaconStateElectra object and returns the remaining bufferº
+func (b *BeaconStateElectra) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	size := len(buf)
 	fixedSize := b.fixedSize()
 	if size < fixedSize {
@@ -3725,7 +7575,7 @@ func (b *BeaconState) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	}
 
 	tail := buf
-	var o7, o9, o11, o12, o15, o16, o21, o24, o27 uint64
+	var o7, o9, o11, o12, o15, o16, o21, o24, o27, o34, o35, o36 uint64
 	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
 
 	// Field (0) 'GenesisTime'
@@ -3870,7 +7720,60 @@ func (b *BeaconState) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	}
 
 	// Offset (27) 'HistoricalSummaries'
-	if o27, _, err = marker.ReadOffset(buf); err != nil {
+	if o27, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (28) 'DepositRequestsStartIndex'
+	b.DepositRequestsStartIndex, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Field (29) 'DepositBalanceToConsume'
+	{
+		var val uint64
+		val, buf = ssz.UnmarshallValue[uint64](buf)
+		b.DepositBalanceToConsume = Gwei(val)
+	}
+
+	// Field (30) 'ExitBalanceToConsume'
+	{
+		var val uint64
+		val, buf = ssz.UnmarshallValue[uint64](buf)
+		b.ExitBalanceToConsume = Gwei(val)
+	}
+
+	// Field (31) 'EarliestExitEpoch'
+	{
+		var val uint64
+		val, buf = ssz.UnmarshallValue[uint64](buf)
+		b.EarliestExitEpoch = Epoch(val)
+	}
+
+	// Field (32) 'ConsolidationBalanceToConsume'
+	{
+		var val uint64
+		val, buf = ssz.UnmarshallValue[uint64](buf)
+		b.ConsolidationBalanceToConsume = Gwei(val)
+	}
+
+	// Field (33) 'EarliestConsolidationEpoch'
+	{
+		var val uint64
+		val, buf = ssz.UnmarshallValue[uint64](buf)
+		b.EarliestConsolidationEpoch = Epoch(val)
+	}
+
+	// Offset (34) 'PendingDeposits'
+	if o34, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (35) 'PendingPartialWithdrawals'
+	if o35, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (36) 'PendingConsolidations'
+	if o36, _, err = marker.ReadOffset(buf); err != nil {
 		return nil, err
 	}
 
@@ -3942,20 +7845,35 @@ func (b *BeaconState) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	}
 
 	// Field (27) 'HistoricalSummaries'
-	if err = ssz.UnmarshalSliceSSZ(&b.HistoricalSummaries, tail[o27:], 16777216); err != nil {
+	if err = ssz.UnmarshalSliceSSZ(&b.HistoricalSummaries, tail[o27:o34], 16777216); err != nil {
+		return nil, err
+	}
+
+	// Field (34) 'PendingDeposits'
+	if err = ssz.UnmarshalSliceSSZ(&b.PendingDeposits, tail[o34:o35], 134217728); err != nil {
+		return nil, err
+	}
+
+	// Field (35) 'PendingPartialWithdrawals'
+	if err = ssz.UnmarshalSliceSSZ(&b.PendingPartialWithdrawals, tail[o35:o36], pendingPartialWithdrawalsLimit); err != nil {
+		return nil, err
+	}
+
+	// Field (36) 'PendingConsolidations'
+	if err = ssz.UnmarshalSliceSSZ(&b.PendingConsolidations, tail[o36:], pendingConsolidationsLimit); err != nil {
 		return nil, err
 	}
 
 	return
 }
 
-// fixedSize returns the fixed size of the BeaconState object
-func (b *BeaconState) fixedSize() int {
-	return int((429 + (slotsPerHistoricalRoot * 32) + (slotsPerHistoricalRoot * 32) + (epochsPerHistoricalVector * 32) + (epochsPerSlashingsVector * 8) + (48 + (syncCommitteeSize * 48)) + (48 + (syncCommitteeSize * 48))))
+// fixedSize returns the fixed size of the BeaconStateElectra object
+func (b *BeaconStateElectra) fixedSize() int {
+	return int((489 + (slotsPerHistoricalRoot * 32) + (slotsPerHistoricalRoot * 32) + (epochsPerHistoricalVector * 32) + (epochsPerSlashingsVector * 8) + (48 + (syncCommitteeSize * 48)) + (48 + (syncCommitteeSize * 48))))
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconState object
-func (b *BeaconState) SizeSSZ() (size int) {
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconStateElectra object
+func (b *BeaconStateElectra) SizeSSZ() (size int) {
 	size = b.fixedSize()
 
 	// Field (7) 'HistoricalRoots'
@@ -3988,16 +7906,25 @@ func (b *BeaconState) SizeSSZ() (size int) {
 	// Field (27) 'HistoricalSummaries'
 	size += len(b.HistoricalSummaries) * 64
 
+	// Field (34) 'PendingDeposits'
+	size += len(b.PendingDeposits) * 192
+
+	// Field (35) 'PendingPartialWithdrawals'
+	size += len(b.PendingPartialWithdrawals) * 24
+
+	// Field (36) 'PendingConsolidations'
+	size += len(b.PendingConsolidations) * 16
+
 	return
 }
 
-// HashTreeRoot ssz hashes the BeaconState object
-func (b *BeaconState) HashTreeRoot() ([32]byte, error) {
+// HashTreeRoot ssz hashes the BeaconStateElectra object
+func (b *BeaconStateElectra) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(b)
 }
 
-// HashTreeRootWith ssz hashes the BeaconState object with a hasher
-func (b *BeaconState) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+// HashTreeRootWith ssz hashes the BeaconStateElectra object with a hasher
+func (b *BeaconStateElectra) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
 
 	// Field (0) 'GenesisTime'
@@ -4028,7 +7955,7 @@ func (b *BeaconState) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	// Field (5) 'BlockRoots'
 	{
 		if size := uint64(len(b.BlockRoots)); size != slotsPerHistoricalRoot {
-			err = ssz.ErrVectorLengthFn("BeaconState.BlockRoots", size, slotsPerHistoricalRoot)
+			err = ssz.ErrVectorLengthFn("BeaconStateElectra.BlockRoots", size, slotsPerHistoricalRoot)
 			return
 		}
 		subIndx := hh.Index()
@@ -4045,7 +7972,7 @@ func (b *BeaconState) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	// Field (6) 'StateRoots'
 	{
 		if size := uint64(len(b.StateRoots)); size != slotsPerHistoricalRoot {
-			err = ssz.ErrVectorLengthFn("BeaconState.StateRoots", size, slotsPerHistoricalRoot)
+			err = ssz.ErrVectorLengthFn("BeaconStateElectra.StateRoots", size, slotsPerHistoricalRoot)
 			return
 		}
 		subIndx := hh.Index()
@@ -4062,7 +7989,7 @@ func (b *BeaconState) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	// Field (7) 'HistoricalRoots'
 	{
 		if size := uint64(len(b.HistoricalRoots)); size > 16777216 {
-			err = ssz.ErrListTooBigFn("BeaconState.HistoricalRoots", size, 16777216)
+			err = ssz.ErrListTooBigFn("BeaconStateElectra.HistoricalRoots", size, 16777216)
 			return
 		}
 		subIndx := hh.Index()
@@ -4119,7 +8046,7 @@ func (b *BeaconState) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	// Field (12) 'Balances'
 	{
 		if size := uint64(len(b.Balances)); size > 1099511627776 {
-			err = ssz.ErrListTooBigFn("BeaconState.Balances", size, 1099511627776)
+			err = ssz.ErrListTooBigFn("BeaconStateElectra.Balances", size, 1099511627776)
 			return
 		}
 		subIndx := hh.Index()
@@ -4134,7 +8061,7 @@ func (b *BeaconState) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	// Field (13) 'RANDAOMixes'
 	{
 		if size := uint64(len(b.RANDAOMixes)); size != epochsPerHistoricalVector {
-			err = ssz.ErrVectorLengthFn("BeaconState.RANDAOMixes", size, epochsPerHistoricalVector)
+			err = ssz.ErrVectorLengthFn("BeaconStateElectra.RANDAOMixes", size, epochsPerHistoricalVector)
 			return
 		}
 		subIndx := hh.Index()
@@ -4151,7 +8078,7 @@ func (b *BeaconState) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	// Field (14) 'Slashings'
 	{
 		if size := uint64(len(b.Slashings)); size != epochsPerSlashingsVector {
-			err = ssz.ErrVectorLengthFn("BeaconState.Slashings", size, epochsPerSlashingsVector)
+			err = ssz.ErrVectorLengthFn("BeaconStateElectra.Slashings", size, epochsPerSlashingsVector)
 			return
 		}
 		subIndx := hh.Index()
@@ -4164,7 +8091,7 @@ func (b *BeaconState) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	// Field (15) 'PreviousEpochParticipation'
 	{
 		if size := uint64(len(b.PreviousEpochParticipation)); size > 1099511627776 {
-			err = ssz.ErrListTooBigFn("BeaconState.PreviousEpochParticipation", size, 1099511627776)
+			err = ssz.ErrListTooBigFn("BeaconStateElectra.PreviousEpochParticipation", size, 1099511627776)
 			return
 		}
 		subIndx := hh.Index()
@@ -4179,7 +8106,7 @@ func (b *BeaconState) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	// Field (16) 'CurrentEpochParticipation'
 	{
 		if size := uint64(len(b.CurrentEpochParticipation)); size > 1099511627776 {
-			err = ssz.ErrListTooBigFn("BeaconState.CurrentEpochParticipation", size, 1099511627776)
+			err = ssz.ErrListTooBigFn("BeaconStateElectra.CurrentEpochParticipation", size, 1099511627776)
 			return
 		}
 		subIndx := hh.Index()
@@ -4221,7 +8148,7 @@ func (b *BeaconState) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	// Field (21) 'InactivityScores'
 	{
 		if size := uint64(len(b.InactivityScores)); size > 1099511627776 {
-			err = ssz.ErrListTooBigFn("BeaconState.InactivityScores", size, 1099511627776)
+			err = ssz.ErrListTooBigFn("BeaconStateElectra.InactivityScores", size, 1099511627776)
 			return
 		}
 		subIndx := hh.Index()
@@ -4276,11 +8203,77 @@ func (b *BeaconState) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 		hh.MerkleizeWithMixin(subIndx, num, 16777216)
 	}
 
+	// Field (28) 'DepositRequestsStartIndex'
+	hh.PutUint64(b.DepositRequestsStartIndex)
+
+	// Field (29) 'DepositBalanceToConsume'
+	hh.PutUint64(uint64(b.DepositBalanceToConsume))
+
+	// Field (30) 'ExitBalanceToConsume'
+	hh.PutUint64(uint64(b.ExitBalanceToConsume))
+
+	// Field (31) 'EarliestExitEpoch'
+	hh.PutUint64(uint64(b.EarliestExitEpoch))
+
+	// Field (32) 'ConsolidationBalanceToConsume'
+	hh.PutUint64(uint64(b.ConsolidationBalanceToConsume))
+
+	// Field (33) 'EarliestConsolidationEpoch'
+	hh.PutUint64(uint64(b.EarliestConsolidationEpoch))
+
+	// Field (34) 'PendingDeposits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.PendingDeposits))
+		if num > 134217728 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.PendingDeposits {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 134217728)
+	}
+
+	// Field (35) 'PendingPartialWithdrawals'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.PendingPartialWithdrawals))
+		if num > pendingPartialWithdrawalsLimit {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.PendingPartialWithdrawals {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, pendingPartialWithdrawalsLimit)
+	}
+
+	// Field (36) 'PendingConsolidations'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.PendingConsolidations))
+		if num > pendingConsolidationsLimit {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.PendingConsolidations {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, pendingConsolidationsLimit)
+	}
+
 	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the BeaconState object
-func (b *BeaconState) GetTree() (*ssz.Node, error) {
+// GetTree ssz hashes the BeaconStateElectra object
+func (b *BeaconStateElectra) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(b)
 }
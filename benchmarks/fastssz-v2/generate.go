@@ -1,3 +1,3 @@
 package fastssz
 
-//go:generate go run github.com/ferranbt/fastssz/sszgen@v0.0.0-20250808103907-ac370aa5f7e4 --output gen_ssz.go --path . --objs Fork,Checkpoint,BeaconBlockHeader,SignedBeaconBlockHeader,ETH1Data,Validator,ProposerSlashing,AttestationData,IndexedAttestation,AttesterSlashing,Attestation,DepositData,Deposit,VoluntaryExit,SignedVoluntaryExit,SyncAggregate,SyncCommittee,Withdrawal,BLSToExecutionChange,SignedBLSToExecutionChange,HistoricalSummary,ExecutionPayload,ExecutionPayloadHeader,BeaconBlockBody,BeaconBlock,SignedBeaconBlock,BeaconState
+//go:generate go run github.com/ferranbt/fastssz/sszgen@v0.0.0-20250808103907-ac370aa5f7e4 --output gen_ssz.go --path . --objs Fork,Checkpoint,BeaconBlockHeader,SignedBeaconBlockHeader,ETH1Data,Validator,ProposerSlashing,AttestationData,IndexedAttestation,AttesterSlashing,Attestation,DepositData,Deposit,VoluntaryExit,SignedVoluntaryExit,SyncAggregate,SyncCommittee,Withdrawal,BLSToExecutionChange,SignedBLSToExecutionChange,HistoricalSummary,ExecutionPayload,ExecutionPayloadHeader,BeaconBlockBody,BeaconBlock,SignedBeaconBlock,BeaconState,BlobSidecar,LightClientHeader,LightClientBootstrap,LightClientUpdate,LightClientFinalityUpdate,LightClientOptimisticUpdate,AttestationElectra,IndexedAttestationElectra,AttesterSlashingElectra,DepositRequest,WithdrawalRequest,ConsolidationRequest,ExecutionRequests,PendingDeposit,PendingPartialWithdrawal,PendingConsolidation,BeaconBlockBodyElectra,BeaconBlockElectra,SignedBeaconBlockElectra,BeaconStateElectra
@@ -16,6 +16,7 @@ type BLSSignature = [96]byte
 type WithdrawalIndex = uint64
 type ParticipationFlags = uint8
 type KZGCommitment = [48]byte
+type KZGProof = [48]byte
 type ExecutionAddress = [20]byte
 type LogsBloom = [256]byte
 type Uint256 = [32]byte
@@ -240,6 +241,57 @@ type SignedBeaconBlock struct {
 	Signature BLSSignature `ssz-size:"96"`
 }
 
+// BlobSidecar represents a blob sidecar (Deneb)
+type BlobSidecar struct {
+	Index                       uint64
+	Blob                        []byte        `ssz-size:"131072"`
+	KZGCommitment               KZGCommitment `ssz-size:"48"`
+	KZGProof                    KZGProof      `ssz-size:"48"`
+	SignedBlockHeader           *SignedBeaconBlockHeader
+	KZGCommitmentInclusionProof [][]byte `ssz-size:"var(kzgCommitmentInclusionProofDepth),32"`
+}
+
+// LightClientHeader represents a light client header (Deneb)
+type LightClientHeader struct {
+	Beacon          *BeaconBlockHeader
+	Execution       *ExecutionPayloadHeader
+	ExecutionBranch [][]byte `ssz-size:"4,32"`
+}
+
+// LightClientBootstrap represents a light client bootstrap (Deneb)
+type LightClientBootstrap struct {
+	Header                     *LightClientHeader
+	CurrentSyncCommittee       *SyncCommittee
+	CurrentSyncCommitteeBranch [][]byte `ssz-size:"5,32"`
+}
+
+// LightClientUpdate represents a light client update (Deneb)
+type LightClientUpdate struct {
+	AttestedHeader          *LightClientHeader
+	NextSyncCommittee       *SyncCommittee
+	NextSyncCommitteeBranch [][]byte `ssz-size:"5,32"`
+	FinalizedHeader         *LightClientHeader
+	FinalityBranch          [][]byte `ssz-size:"6,32"`
+	SyncAggregate           *SyncAggregate
+	SignatureSlot           Slot
+}
+
+// LightClientFinalityUpdate represents a light client finality update (Deneb)
+type LightClientFinalityUpdate struct {
+	AttestedHeader  *LightClientHeader
+	FinalizedHeader *LightClientHeader
+	FinalityBranch  [][]byte `ssz-size:"6,32"`
+	SyncAggregate   *SyncAggregate
+	SignatureSlot   Slot
+}
+
+// LightClientOptimisticUpdate represents a light client optimistic update (Deneb)
+type LightClientOptimisticUpdate struct {
+	AttestedHeader *LightClientHeader
+	SyncAggregate  *SyncAggregate
+	SignatureSlot  Slot
+}
+
 // BeaconState represents a beacon state (Deneb)
 type BeaconState struct {
 	GenesisTime                  uint64
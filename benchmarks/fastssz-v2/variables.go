@@ -26,6 +26,24 @@ var (
 
 	// MAX_BLOB_COMMITMENTS_PER_BLOCK
 	maxBlobCommitmentsPerBlock uint64
+
+	// KZG_COMMITMENT_INCLUSION_PROOF_DEPTH
+	kzgCommitmentInclusionProofDepth uint64
+
+	// MAX_COMMITTEES_PER_SLOT / 8 (bytes)
+	committeeBitsSize uint64
+
+	// MAX_DEPOSIT_REQUESTS_PER_PAYLOAD
+	maxDepositRequests uint64
+
+	// MAX_WITHDRAWAL_REQUESTS_PER_PAYLOAD
+	maxWithdrawalRequests uint64
+
+	// PENDING_PARTIAL_WITHDRAWALS_LIMIT
+	pendingPartialWithdrawalsLimit uint64
+
+	// PENDING_CONSOLIDATIONS_LIMIT
+	pendingConsolidationsLimit uint64
 )
 
 func init() {
@@ -42,6 +60,12 @@ func SetMainnetSpec() {
 	syncCommitteeSize = 512
 	maxWithdrawals = 16
 	maxBlobCommitmentsPerBlock = 4096
+	kzgCommitmentInclusionProofDepth = 17
+	committeeBitsSize = 8
+	maxDepositRequests = 8192
+	maxWithdrawalRequests = 16
+	pendingPartialWithdrawalsLimit = 134217728
+	pendingConsolidationsLimit = 262144
 }
 
 // SetMinimalSpec sets the spec variables to minimal values
@@ -54,4 +78,10 @@ func SetMinimalSpec() {
 	syncCommitteeSize = 32
 	maxWithdrawals = 4
 	maxBlobCommitmentsPerBlock = 32
+	kzgCommitmentInclusionProofDepth = 10
+	committeeBitsSize = 1
+	maxDepositRequests = 4
+	maxWithdrawalRequests = 2
+	pendingPartialWithdrawalsLimit = 64
+	pendingConsolidationsLimit = 64
 }
@@ -1,12 +1,19 @@
 package karalabessz
 
 import (
+	"bufio"
 	"bytes"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"io"
 	"os"
+	"runtime"
+	"sync"
 	"testing"
 
+	"github.com/golang/snappy"
 	"github.com/karalabe/ssz"
 )
 
@@ -20,22 +27,85 @@ var (
 
 	blockMainnetHTR [32]byte
 	stateMainnetHTR [32]byte
+
+	blockElectraMainnetData []byte
+	stateElectraMainnetData []byte
+
+	blockElectraMainnetHTR [32]byte
+	stateElectraMainnetHTR [32]byte
+
+	blockEmptyMainnetData    []byte
+	blockMaxMainnetData      []byte
+	blockBoundaryMainnetData []byte
+	blockTypedMainnetData    []byte
+
+	blockEmptyMainnetHTR    [32]byte
+	blockMaxMainnetHTR      [32]byte
+	blockBoundaryMainnetHTR [32]byte
+	blockTypedMainnetHTR    [32]byte
+
+	attestationMainnetData                 []byte
+	voluntaryExitMainnetData               []byte
+	blsChangeMainnetData                   []byte
+	syncCommitteeMainnetData               []byte
+	executionPayloadMainnetData            []byte
+	validatorMainnetData                   []byte
+	blobSidecarMainnetData                 []byte
+	lightClientBootstrapMainnetData        []byte
+	lightClientUpdateMainnetData           []byte
+	lightClientFinalityUpdateMainnetData   []byte
+	lightClientOptimisticUpdateMainnetData []byte
+
+	attestationMainnetHTR                 [32]byte
+	voluntaryExitMainnetHTR               [32]byte
+	blsChangeMainnetHTR                   [32]byte
+	syncCommitteeMainnetHTR               [32]byte
+	executionPayloadMainnetHTR            [32]byte
+	validatorMainnetHTR                   [32]byte
+	blobSidecarMainnetHTR                 [32]byte
+	lightClientBootstrapMainnetHTR        [32]byte
+	lightClientUpdateMainnetHTR           [32]byte
+	lightClientFinalityUpdateMainnetHTR   [32]byte
+	lightClientOptimisticUpdateMainnetHTR [32]byte
+
+	blockMainnetSnappyFramed []byte
+	blockMainnetSnappyBlock  []byte
+	stateMainnetSnappyFramed []byte
+	stateMainnetSnappyBlock  []byte
 )
 
 func init() {
-	var err error
-	blockMainnetData, err = os.ReadFile("../../res/block-mainnet.ssz")
-	if err != nil {
-		panic("failed to load block-mainnet.ssz: " + err.Error())
-	}
-	stateMainnetData, err = os.ReadFile("../../res/state-mainnet.ssz")
+	blockMainnetData, blockMainnetHTR = loadCorpus("block-mainnet")
+	stateMainnetData, stateMainnetHTR = loadCorpus("state-mainnet")
+	blockElectraMainnetData, blockElectraMainnetHTR = loadCorpus("block-electra-mainnet")
+	stateElectraMainnetData, stateElectraMainnetHTR = loadCorpus("state-electra-mainnet")
+	blockEmptyMainnetData, blockEmptyMainnetHTR = loadCorpus("block-empty-mainnet")
+	blockMaxMainnetData, blockMaxMainnetHTR = loadCorpus("block-max-mainnet")
+	blockBoundaryMainnetData, blockBoundaryMainnetHTR = loadCorpus("block-boundary-mainnet")
+	blockTypedMainnetData, blockTypedMainnetHTR = loadCorpus("block-typed-mainnet")
+	attestationMainnetData, attestationMainnetHTR = loadCorpus("attestation-mainnet")
+	voluntaryExitMainnetData, voluntaryExitMainnetHTR = loadCorpus("voluntary-exit-mainnet")
+	blsChangeMainnetData, blsChangeMainnetHTR = loadCorpus("bls-change-mainnet")
+	syncCommitteeMainnetData, syncCommitteeMainnetHTR = loadCorpus("sync-committee-mainnet")
+	executionPayloadMainnetData, executionPayloadMainnetHTR = loadCorpus("execution-payload-mainnet")
+	validatorMainnetData, validatorMainnetHTR = loadCorpus("validator-mainnet")
+	blobSidecarMainnetData, blobSidecarMainnetHTR = loadCorpus("blob-sidecar-mainnet")
+	lightClientBootstrapMainnetData, lightClientBootstrapMainnetHTR = loadCorpus("light-client-bootstrap-mainnet")
+	lightClientUpdateMainnetData, lightClientUpdateMainnetHTR = loadCorpus("light-client-update-mainnet")
+	lightClientFinalityUpdateMainnetData, lightClientFinalityUpdateMainnetHTR = loadCorpus("light-client-finality-update-mainnet")
+	lightClientOptimisticUpdateMainnetData, lightClientOptimisticUpdateMainnetHTR = loadCorpus("light-client-optimistic-update-mainnet")
+	blockMainnetSnappyFramed, blockMainnetSnappyBlock = loadSnappy("block-mainnet")
+	stateMainnetSnappyFramed, stateMainnetSnappyBlock = loadSnappy("state-mainnet")
+}
+
+// loadCorpus loads res/<name>.ssz together with the expected HTR from
+// res/<name>-meta.json.
+func loadCorpus(name string) ([]byte, [32]byte) {
+	data, err := os.ReadFile("../../res/" + name + ".ssz")
 	if err != nil {
-		panic("failed to load state-mainnet.ssz: " + err.Error())
+		panic("failed to load " + name + ".ssz: " + err.Error())
 	}
-
-	// Load metadata
-	blockMainnetHTR = loadHTR("../../res/block-mainnet-meta.json")
-	stateMainnetHTR = loadHTR("../../res/state-mainnet-meta.json")
+	return data, loadHTR("../../res/" + name + "-meta.json")
 }
 
 func loadHTR(path string) [32]byte {
@@ -56,6 +126,20 @@ func loadHTR(path string) [32]byte {
 	return htr
 }
 
+// loadSnappy loads the framed (res/<name>.ssz.sz) and block
+// (res/<name>.ssz_snappy) snappy encodings of res/<name>.ssz.
+func loadSnappy(name string) ([]byte, []byte) {
+	framed, err := os.ReadFile("../../res/" + name + ".ssz.sz")
+	if err != nil {
+		panic("failed to load " + name + ".ssz.sz: " + err.Error())
+	}
+	block, err := os.ReadFile("../../res/" + name + ".ssz_snappy")
+	if err != nil {
+		panic("failed to load " + name + ".ssz_snappy: " + err.Error())
+	}
+	return framed, block
+}
+
 type TestWriter struct {
 	data []byte
 }
@@ -148,6 +232,24 @@ func BenchmarkBlockMainnet_MarshalWriter(b *testing.B) {
 	}
 }
 
+func BenchmarkBlockMainnet_MarshalTo(b *testing.B) {
+	block := new(SignedBeaconBlockDeneb)
+	if err := ssz.DecodeFromBytes(blockMainnetData, block); err != nil {
+		b.Fatal(err)
+	}
+	buf := make([]byte, len(blockMainnetData))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := ssz.EncodeToBytes(buf, block); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf, blockMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
 func BenchmarkBlockMainnet_HashTreeRoot(b *testing.B) {
 	block := new(SignedBeaconBlockDeneb)
 	if err := ssz.DecodeFromBytes(blockMainnetData, block); err != nil {
@@ -164,6 +266,22 @@ func BenchmarkBlockMainnet_HashTreeRoot(b *testing.B) {
 	}
 }
 
+func BenchmarkBlockMainnet_SizeSSZ(b *testing.B) {
+	block := new(SignedBeaconBlockDeneb)
+	if err := ssz.DecodeFromBytes(blockMainnetData, block); err != nil {
+		b.Fatal(err)
+	}
+	var size uint32
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		size = ssz.Size(block)
+	}
+	b.StopTimer()
+	if int(size) != len(blockMainnetData) {
+		b.Fatalf("size mismatch: got %d, want %d", size, len(blockMainnetData))
+	}
+}
+
 // ========================= STATE MAINNET BENCHMARKS =========================
 
 func BenchmarkStateMainnet_Unmarshal(b *testing.B) {
@@ -239,6 +357,24 @@ func BenchmarkStateMainnet_MarshalWriter(b *testing.B) {
 	}
 }
 
+func BenchmarkStateMainnet_MarshalTo(b *testing.B) {
+	state := new(BeaconStateDeneb)
+	if err := ssz.DecodeFromBytes(stateMainnetData, state); err != nil {
+		b.Fatal(err)
+	}
+	buf := make([]byte, len(stateMainnetData))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := ssz.EncodeToBytes(buf, state); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf, stateMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
 func BenchmarkStateMainnet_HashTreeRoot(b *testing.B) {
 	state := new(BeaconStateDeneb)
 	if err := ssz.DecodeFromBytes(stateMainnetData, state); err != nil {
@@ -254,3 +390,2390 @@ func BenchmarkStateMainnet_HashTreeRoot(b *testing.B) {
 		b.Fatalf("HTR mismatch: got %x, want %x", htr, stateMainnetHTR)
 	}
 }
+
+func BenchmarkStateMainnet_SizeSSZ(b *testing.B) {
+	state := new(BeaconStateDeneb)
+	if err := ssz.DecodeFromBytes(stateMainnetData, state); err != nil {
+		b.Fatal(err)
+	}
+	var size uint32
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		size = ssz.Size(state)
+	}
+	b.StopTimer()
+	if int(size) != len(stateMainnetData) {
+		b.Fatalf("size mismatch: got %d, want %d", size, len(stateMainnetData))
+	}
+}
+
+// ===================== BLOCK ELECTRA MAINNET BENCHMARKS =====================
+
+func BenchmarkBlockElectraMainnet_Unmarshal(b *testing.B) {
+	var block *SignedBeaconBlockElectra
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlockElectra)
+		if err := ssz.DecodeFromBytes(blockElectraMainnetData, block); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(block.Message)
+	if htr != blockElectraMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockElectraMainnetHTR)
+	}
+}
+
+func BenchmarkBlockElectraMainnet_UnmarshalReader(b *testing.B) {
+	var block *SignedBeaconBlockElectra
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlockElectra)
+		reader := bytes.NewReader(blockElectraMainnetData)
+		if err := ssz.DecodeFromStream(reader, block, uint32(len(blockElectraMainnetData))); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(block.Message)
+	if htr != blockElectraMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockElectraMainnetHTR)
+	}
+}
+
+func BenchmarkBlockElectraMainnet_Marshal(b *testing.B) {
+	block := new(SignedBeaconBlockElectra)
+	if err := ssz.DecodeFromBytes(blockElectraMainnetData, block); err != nil {
+		b.Fatal(err)
+	}
+	var buf []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = make([]byte, ssz.SizeOnFork(block, ssz.ForkElectra))
+		if err := ssz.EncodeToBytes(buf, block); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf, blockElectraMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockElectraMainnet_MarshalWriter(b *testing.B) {
+	block := new(SignedBeaconBlockElectra)
+	if err := ssz.DecodeFromBytes(blockElectraMainnetData, block); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(blockElectraMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		if err := ssz.EncodeToStreamOnFork(writer, block, ssz.ForkElectra); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, blockElectraMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockElectraMainnet_HashTreeRoot(b *testing.B) {
+	block := new(SignedBeaconBlockElectra)
+	if err := ssz.DecodeFromBytes(blockElectraMainnetData, block); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = ssz.HashSequential(block.Message)
+	}
+	b.StopTimer()
+	if htr != blockElectraMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockElectraMainnetHTR)
+	}
+}
+
+// ===================== STATE ELECTRA MAINNET BENCHMARKS =====================
+
+func BenchmarkStateElectraMainnet_Unmarshal(b *testing.B) {
+	var state *BeaconStateElectra
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		state = new(BeaconStateElectra)
+		if err := ssz.DecodeFromBytes(stateElectraMainnetData, state); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(state)
+	if htr != stateElectraMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, stateElectraMainnetHTR)
+	}
+}
+
+func BenchmarkStateElectraMainnet_UnmarshalReader(b *testing.B) {
+	var state *BeaconStateElectra
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		state = new(BeaconStateElectra)
+		reader := bytes.NewReader(stateElectraMainnetData)
+		if err := ssz.DecodeFromStream(reader, state, uint32(len(stateElectraMainnetData))); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(state)
+	if htr != stateElectraMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, stateElectraMainnetHTR)
+	}
+}
+
+func BenchmarkStateElectraMainnet_Marshal(b *testing.B) {
+	state := new(BeaconStateElectra)
+	if err := ssz.DecodeFromBytes(stateElectraMainnetData, state); err != nil {
+		b.Fatal(err)
+	}
+	var buf []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = make([]byte, ssz.SizeOnFork(state, ssz.ForkElectra))
+		if err := ssz.EncodeToBytes(buf, state); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf, stateElectraMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkStateElectraMainnet_MarshalWriter(b *testing.B) {
+	state := new(BeaconStateElectra)
+	if err := ssz.DecodeFromBytes(stateElectraMainnetData, state); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(stateElectraMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		if err := ssz.EncodeToStreamOnFork(writer, state, ssz.ForkElectra); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, stateElectraMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkStateElectraMainnet_HashTreeRoot(b *testing.B) {
+	state := new(BeaconStateElectra)
+	if err := ssz.DecodeFromBytes(stateElectraMainnetData, state); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = ssz.HashSequential(state)
+	}
+	b.StopTimer()
+	if htr != stateElectraMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, stateElectraMainnetHTR)
+	}
+}
+
+// ====================== SUB-OBJECT MAINNET BENCHMARKS =======================
+
+func BenchmarkAttestationMainnet_Unmarshal(b *testing.B) {
+	var attestation *Attestation
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		attestation = new(Attestation)
+		if err := ssz.DecodeFromBytes(attestationMainnetData, attestation); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(attestation)
+	if htr != attestationMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, attestationMainnetHTR)
+	}
+}
+
+func BenchmarkAttestationMainnet_UnmarshalReader(b *testing.B) {
+	var attestation *Attestation
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		attestation = new(Attestation)
+		reader := bytes.NewReader(attestationMainnetData)
+		if err := ssz.DecodeFromStream(reader, attestation, uint32(len(attestationMainnetData))); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(attestation)
+	if htr != attestationMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, attestationMainnetHTR)
+	}
+}
+
+func BenchmarkAttestationMainnet_Marshal(b *testing.B) {
+	attestation := new(Attestation)
+	if err := ssz.DecodeFromBytes(attestationMainnetData, attestation); err != nil {
+		b.Fatal(err)
+	}
+	var buf []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = make([]byte, ssz.SizeOnFork(attestation, ssz.ForkDeneb))
+		if err := ssz.EncodeToBytes(buf, attestation); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf, attestationMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkAttestationMainnet_MarshalWriter(b *testing.B) {
+	attestation := new(Attestation)
+	if err := ssz.DecodeFromBytes(attestationMainnetData, attestation); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(attestationMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		if err := ssz.EncodeToStreamOnFork(writer, attestation, ssz.ForkDeneb); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, attestationMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkAttestationMainnet_HashTreeRoot(b *testing.B) {
+	attestation := new(Attestation)
+	if err := ssz.DecodeFromBytes(attestationMainnetData, attestation); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = ssz.HashSequential(attestation)
+	}
+	b.StopTimer()
+	if htr != attestationMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, attestationMainnetHTR)
+	}
+}
+
+func BenchmarkVoluntaryExitMainnet_Unmarshal(b *testing.B) {
+	var exit *SignedVoluntaryExit
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		exit = new(SignedVoluntaryExit)
+		if err := ssz.DecodeFromBytes(voluntaryExitMainnetData, exit); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(exit)
+	if htr != voluntaryExitMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, voluntaryExitMainnetHTR)
+	}
+}
+
+func BenchmarkVoluntaryExitMainnet_UnmarshalReader(b *testing.B) {
+	var exit *SignedVoluntaryExit
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		exit = new(SignedVoluntaryExit)
+		reader := bytes.NewReader(voluntaryExitMainnetData)
+		if err := ssz.DecodeFromStream(reader, exit, uint32(len(voluntaryExitMainnetData))); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(exit)
+	if htr != voluntaryExitMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, voluntaryExitMainnetHTR)
+	}
+}
+
+func BenchmarkVoluntaryExitMainnet_Marshal(b *testing.B) {
+	exit := new(SignedVoluntaryExit)
+	if err := ssz.DecodeFromBytes(voluntaryExitMainnetData, exit); err != nil {
+		b.Fatal(err)
+	}
+	var buf []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = make([]byte, ssz.SizeOnFork(exit, ssz.ForkDeneb))
+		if err := ssz.EncodeToBytes(buf, exit); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf, voluntaryExitMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkVoluntaryExitMainnet_MarshalWriter(b *testing.B) {
+	exit := new(SignedVoluntaryExit)
+	if err := ssz.DecodeFromBytes(voluntaryExitMainnetData, exit); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(voluntaryExitMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		if err := ssz.EncodeToStreamOnFork(writer, exit, ssz.ForkDeneb); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, voluntaryExitMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkVoluntaryExitMainnet_HashTreeRoot(b *testing.B) {
+	exit := new(SignedVoluntaryExit)
+	if err := ssz.DecodeFromBytes(voluntaryExitMainnetData, exit); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = ssz.HashSequential(exit)
+	}
+	b.StopTimer()
+	if htr != voluntaryExitMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, voluntaryExitMainnetHTR)
+	}
+}
+
+func BenchmarkBLSChangeMainnet_Unmarshal(b *testing.B) {
+	var change *SignedBLSToExecutionChange
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		change = new(SignedBLSToExecutionChange)
+		if err := ssz.DecodeFromBytes(blsChangeMainnetData, change); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(change)
+	if htr != blsChangeMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blsChangeMainnetHTR)
+	}
+}
+
+func BenchmarkBLSChangeMainnet_UnmarshalReader(b *testing.B) {
+	var change *SignedBLSToExecutionChange
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		change = new(SignedBLSToExecutionChange)
+		reader := bytes.NewReader(blsChangeMainnetData)
+		if err := ssz.DecodeFromStream(reader, change, uint32(len(blsChangeMainnetData))); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(change)
+	if htr != blsChangeMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blsChangeMainnetHTR)
+	}
+}
+
+func BenchmarkBLSChangeMainnet_Marshal(b *testing.B) {
+	change := new(SignedBLSToExecutionChange)
+	if err := ssz.DecodeFromBytes(blsChangeMainnetData, change); err != nil {
+		b.Fatal(err)
+	}
+	var buf []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = make([]byte, ssz.SizeOnFork(change, ssz.ForkDeneb))
+		if err := ssz.EncodeToBytes(buf, change); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf, blsChangeMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBLSChangeMainnet_MarshalWriter(b *testing.B) {
+	change := new(SignedBLSToExecutionChange)
+	if err := ssz.DecodeFromBytes(blsChangeMainnetData, change); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(blsChangeMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		if err := ssz.EncodeToStreamOnFork(writer, change, ssz.ForkDeneb); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, blsChangeMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBLSChangeMainnet_HashTreeRoot(b *testing.B) {
+	change := new(SignedBLSToExecutionChange)
+	if err := ssz.DecodeFromBytes(blsChangeMainnetData, change); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = ssz.HashSequential(change)
+	}
+	b.StopTimer()
+	if htr != blsChangeMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blsChangeMainnetHTR)
+	}
+}
+
+func BenchmarkSyncCommitteeMainnet_Unmarshal(b *testing.B) {
+	var committee *SyncCommittee
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		committee = new(SyncCommittee)
+		if err := ssz.DecodeFromBytes(syncCommitteeMainnetData, committee); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(committee)
+	if htr != syncCommitteeMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, syncCommitteeMainnetHTR)
+	}
+}
+
+func BenchmarkSyncCommitteeMainnet_UnmarshalReader(b *testing.B) {
+	var committee *SyncCommittee
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		committee = new(SyncCommittee)
+		reader := bytes.NewReader(syncCommitteeMainnetData)
+		if err := ssz.DecodeFromStream(reader, committee, uint32(len(syncCommitteeMainnetData))); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(committee)
+	if htr != syncCommitteeMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, syncCommitteeMainnetHTR)
+	}
+}
+
+func BenchmarkSyncCommitteeMainnet_Marshal(b *testing.B) {
+	committee := new(SyncCommittee)
+	if err := ssz.DecodeFromBytes(syncCommitteeMainnetData, committee); err != nil {
+		b.Fatal(err)
+	}
+	var buf []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = make([]byte, ssz.SizeOnFork(committee, ssz.ForkDeneb))
+		if err := ssz.EncodeToBytes(buf, committee); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf, syncCommitteeMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkSyncCommitteeMainnet_MarshalWriter(b *testing.B) {
+	committee := new(SyncCommittee)
+	if err := ssz.DecodeFromBytes(syncCommitteeMainnetData, committee); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(syncCommitteeMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		if err := ssz.EncodeToStreamOnFork(writer, committee, ssz.ForkDeneb); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, syncCommitteeMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkSyncCommitteeMainnet_HashTreeRoot(b *testing.B) {
+	committee := new(SyncCommittee)
+	if err := ssz.DecodeFromBytes(syncCommitteeMainnetData, committee); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = ssz.HashSequential(committee)
+	}
+	b.StopTimer()
+	if htr != syncCommitteeMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, syncCommitteeMainnetHTR)
+	}
+}
+
+func BenchmarkExecutionPayloadMainnet_Unmarshal(b *testing.B) {
+	var payload *ExecutionPayloadDeneb
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		payload = new(ExecutionPayloadDeneb)
+		if err := ssz.DecodeFromBytes(executionPayloadMainnetData, payload); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(payload)
+	if htr != executionPayloadMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, executionPayloadMainnetHTR)
+	}
+}
+
+func BenchmarkExecutionPayloadMainnet_UnmarshalReader(b *testing.B) {
+	var payload *ExecutionPayloadDeneb
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		payload = new(ExecutionPayloadDeneb)
+		reader := bytes.NewReader(executionPayloadMainnetData)
+		if err := ssz.DecodeFromStream(reader, payload, uint32(len(executionPayloadMainnetData))); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(payload)
+	if htr != executionPayloadMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, executionPayloadMainnetHTR)
+	}
+}
+
+func BenchmarkExecutionPayloadMainnet_Marshal(b *testing.B) {
+	payload := new(ExecutionPayloadDeneb)
+	if err := ssz.DecodeFromBytes(executionPayloadMainnetData, payload); err != nil {
+		b.Fatal(err)
+	}
+	var buf []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = make([]byte, ssz.SizeOnFork(payload, ssz.ForkDeneb))
+		if err := ssz.EncodeToBytes(buf, payload); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf, executionPayloadMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkExecutionPayloadMainnet_MarshalWriter(b *testing.B) {
+	payload := new(ExecutionPayloadDeneb)
+	if err := ssz.DecodeFromBytes(executionPayloadMainnetData, payload); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(executionPayloadMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		if err := ssz.EncodeToStreamOnFork(writer, payload, ssz.ForkDeneb); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, executionPayloadMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkExecutionPayloadMainnet_HashTreeRoot(b *testing.B) {
+	payload := new(ExecutionPayloadDeneb)
+	if err := ssz.DecodeFromBytes(executionPayloadMainnetData, payload); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = ssz.HashSequential(payload)
+	}
+	b.StopTimer()
+	if htr != executionPayloadMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, executionPayloadMainnetHTR)
+	}
+}
+
+func BenchmarkValidatorMainnet_Unmarshal(b *testing.B) {
+	var validator *Validator
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		validator = new(Validator)
+		if err := ssz.DecodeFromBytes(validatorMainnetData, validator); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(validator)
+	if htr != validatorMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, validatorMainnetHTR)
+	}
+}
+
+func BenchmarkValidatorMainnet_UnmarshalReader(b *testing.B) {
+	var validator *Validator
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		validator = new(Validator)
+		reader := bytes.NewReader(validatorMainnetData)
+		if err := ssz.DecodeFromStream(reader, validator, uint32(len(validatorMainnetData))); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(validator)
+	if htr != validatorMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, validatorMainnetHTR)
+	}
+}
+
+func BenchmarkValidatorMainnet_Marshal(b *testing.B) {
+	validator := new(Validator)
+	if err := ssz.DecodeFromBytes(validatorMainnetData, validator); err != nil {
+		b.Fatal(err)
+	}
+	var buf []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = make([]byte, ssz.SizeOnFork(validator, ssz.ForkDeneb))
+		if err := ssz.EncodeToBytes(buf, validator); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf, validatorMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkValidatorMainnet_MarshalWriter(b *testing.B) {
+	validator := new(Validator)
+	if err := ssz.DecodeFromBytes(validatorMainnetData, validator); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(validatorMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		if err := ssz.EncodeToStreamOnFork(writer, validator, ssz.ForkDeneb); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, validatorMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkValidatorMainnet_HashTreeRoot(b *testing.B) {
+	validator := new(Validator)
+	if err := ssz.DecodeFromBytes(validatorMainnetData, validator); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = ssz.HashSequential(validator)
+	}
+	b.StopTimer()
+	if htr != validatorMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, validatorMainnetHTR)
+	}
+}
+
+func BenchmarkBlobSidecarMainnet_Unmarshal(b *testing.B) {
+	var sidecar *BlobSidecarDeneb
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sidecar = new(BlobSidecarDeneb)
+		if err := ssz.DecodeFromBytes(blobSidecarMainnetData, sidecar); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(sidecar)
+	if htr != blobSidecarMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blobSidecarMainnetHTR)
+	}
+}
+
+func BenchmarkBlobSidecarMainnet_UnmarshalReader(b *testing.B) {
+	var sidecar *BlobSidecarDeneb
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sidecar = new(BlobSidecarDeneb)
+		reader := bytes.NewReader(blobSidecarMainnetData)
+		if err := ssz.DecodeFromStream(reader, sidecar, uint32(len(blobSidecarMainnetData))); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(sidecar)
+	if htr != blobSidecarMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blobSidecarMainnetHTR)
+	}
+}
+
+func BenchmarkBlobSidecarMainnet_Marshal(b *testing.B) {
+	sidecar := new(BlobSidecarDeneb)
+	if err := ssz.DecodeFromBytes(blobSidecarMainnetData, sidecar); err != nil {
+		b.Fatal(err)
+	}
+	var buf []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = make([]byte, ssz.SizeOnFork(sidecar, ssz.ForkDeneb))
+		if err := ssz.EncodeToBytes(buf, sidecar); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf, blobSidecarMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlobSidecarMainnet_MarshalWriter(b *testing.B) {
+	sidecar := new(BlobSidecarDeneb)
+	if err := ssz.DecodeFromBytes(blobSidecarMainnetData, sidecar); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(blobSidecarMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		if err := ssz.EncodeToStreamOnFork(writer, sidecar, ssz.ForkDeneb); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, blobSidecarMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlobSidecarMainnet_HashTreeRoot(b *testing.B) {
+	sidecar := new(BlobSidecarDeneb)
+	if err := ssz.DecodeFromBytes(blobSidecarMainnetData, sidecar); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = ssz.HashSequential(sidecar)
+	}
+	b.StopTimer()
+	if htr != blobSidecarMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blobSidecarMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientBootstrapMainnet_Unmarshal(b *testing.B) {
+	var bootstrap *LightClientBootstrapDeneb
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bootstrap = new(LightClientBootstrapDeneb)
+		if err := ssz.DecodeFromBytes(lightClientBootstrapMainnetData, bootstrap); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(bootstrap)
+	if htr != lightClientBootstrapMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientBootstrapMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientBootstrapMainnet_UnmarshalReader(b *testing.B) {
+	var bootstrap *LightClientBootstrapDeneb
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bootstrap = new(LightClientBootstrapDeneb)
+		reader := bytes.NewReader(lightClientBootstrapMainnetData)
+		if err := ssz.DecodeFromStream(reader, bootstrap, uint32(len(lightClientBootstrapMainnetData))); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(bootstrap)
+	if htr != lightClientBootstrapMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientBootstrapMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientBootstrapMainnet_Marshal(b *testing.B) {
+	bootstrap := new(LightClientBootstrapDeneb)
+	if err := ssz.DecodeFromBytes(lightClientBootstrapMainnetData, bootstrap); err != nil {
+		b.Fatal(err)
+	}
+	var buf []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = make([]byte, ssz.SizeOnFork(bootstrap, ssz.ForkDeneb))
+		if err := ssz.EncodeToBytes(buf, bootstrap); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf, lightClientBootstrapMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkLightClientBootstrapMainnet_MarshalWriter(b *testing.B) {
+	bootstrap := new(LightClientBootstrapDeneb)
+	if err := ssz.DecodeFromBytes(lightClientBootstrapMainnetData, bootstrap); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(lightClientBootstrapMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		if err := ssz.EncodeToStreamOnFork(writer, bootstrap, ssz.ForkDeneb); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, lightClientBootstrapMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkLightClientBootstrapMainnet_HashTreeRoot(b *testing.B) {
+	bootstrap := new(LightClientBootstrapDeneb)
+	if err := ssz.DecodeFromBytes(lightClientBootstrapMainnetData, bootstrap); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = ssz.HashSequential(bootstrap)
+	}
+	b.StopTimer()
+	if htr != lightClientBootstrapMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientBootstrapMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientUpdateMainnet_Unmarshal(b *testing.B) {
+	var update *LightClientUpdateDeneb
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		update = new(LightClientUpdateDeneb)
+		if err := ssz.DecodeFromBytes(lightClientUpdateMainnetData, update); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(update)
+	if htr != lightClientUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientUpdateMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientUpdateMainnet_UnmarshalReader(b *testing.B) {
+	var update *LightClientUpdateDeneb
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		update = new(LightClientUpdateDeneb)
+		reader := bytes.NewReader(lightClientUpdateMainnetData)
+		if err := ssz.DecodeFromStream(reader, update, uint32(len(lightClientUpdateMainnetData))); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(update)
+	if htr != lightClientUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientUpdateMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientUpdateMainnet_Marshal(b *testing.B) {
+	update := new(LightClientUpdateDeneb)
+	if err := ssz.DecodeFromBytes(lightClientUpdateMainnetData, update); err != nil {
+		b.Fatal(err)
+	}
+	var buf []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = make([]byte, ssz.SizeOnFork(update, ssz.ForkDeneb))
+		if err := ssz.EncodeToBytes(buf, update); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf, lightClientUpdateMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkLightClientUpdateMainnet_MarshalWriter(b *testing.B) {
+	update := new(LightClientUpdateDeneb)
+	if err := ssz.DecodeFromBytes(lightClientUpdateMainnetData, update); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(lightClientUpdateMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		if err := ssz.EncodeToStreamOnFork(writer, update, ssz.ForkDeneb); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, lightClientUpdateMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkLightClientUpdateMainnet_HashTreeRoot(b *testing.B) {
+	update := new(LightClientUpdateDeneb)
+	if err := ssz.DecodeFromBytes(lightClientUpdateMainnetData, update); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = ssz.HashSequential(update)
+	}
+	b.StopTimer()
+	if htr != lightClientUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientUpdateMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientFinalityUpdateMainnet_Unmarshal(b *testing.B) {
+	var update *LightClientFinalityUpdateDeneb
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		update = new(LightClientFinalityUpdateDeneb)
+		if err := ssz.DecodeFromBytes(lightClientFinalityUpdateMainnetData, update); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(update)
+	if htr != lightClientFinalityUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientFinalityUpdateMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientFinalityUpdateMainnet_UnmarshalReader(b *testing.B) {
+	var update *LightClientFinalityUpdateDeneb
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		update = new(LightClientFinalityUpdateDeneb)
+		reader := bytes.NewReader(lightClientFinalityUpdateMainnetData)
+		if err := ssz.DecodeFromStream(reader, update, uint32(len(lightClientFinalityUpdateMainnetData))); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(update)
+	if htr != lightClientFinalityUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientFinalityUpdateMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientFinalityUpdateMainnet_Marshal(b *testing.B) {
+	update := new(LightClientFinalityUpdateDeneb)
+	if err := ssz.DecodeFromBytes(lightClientFinalityUpdateMainnetData, update); err != nil {
+		b.Fatal(err)
+	}
+	var buf []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = make([]byte, ssz.SizeOnFork(update, ssz.ForkDeneb))
+		if err := ssz.EncodeToBytes(buf, update); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf, lightClientFinalityUpdateMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkLightClientFinalityUpdateMainnet_MarshalWriter(b *testing.B) {
+	update := new(LightClientFinalityUpdateDeneb)
+	if err := ssz.DecodeFromBytes(lightClientFinalityUpdateMainnetData, update); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(lightClientFinalityUpdateMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		if err := ssz.EncodeToStreamOnFork(writer, update, ssz.ForkDeneb); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, lightClientFinalityUpdateMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkLightClientFinalityUpdateMainnet_HashTreeRoot(b *testing.B) {
+	update := new(LightClientFinalityUpdateDeneb)
+	if err := ssz.DecodeFromBytes(lightClientFinalityUpdateMainnetData, update); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = ssz.HashSequential(update)
+	}
+	b.StopTimer()
+	if htr != lightClientFinalityUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientFinalityUpdateMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientOptimisticUpdateMainnet_Unmarshal(b *testing.B) {
+	var update *LightClientOptimisticUpdateDeneb
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		update = new(LightClientOptimisticUpdateDeneb)
+		if err := ssz.DecodeFromBytes(lightClientOptimisticUpdateMainnetData, update); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(update)
+	if htr != lightClientOptimisticUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientOptimisticUpdateMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientOptimisticUpdateMainnet_UnmarshalReader(b *testing.B) {
+	var update *LightClientOptimisticUpdateDeneb
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		update = new(LightClientOptimisticUpdateDeneb)
+		reader := bytes.NewReader(lightClientOptimisticUpdateMainnetData)
+		if err := ssz.DecodeFromStream(reader, update, uint32(len(lightClientOptimisticUpdateMainnetData))); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(update)
+	if htr != lightClientOptimisticUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientOptimisticUpdateMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientOptimisticUpdateMainnet_Marshal(b *testing.B) {
+	update := new(LightClientOptimisticUpdateDeneb)
+	if err := ssz.DecodeFromBytes(lightClientOptimisticUpdateMainnetData, update); err != nil {
+		b.Fatal(err)
+	}
+	var buf []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = make([]byte, ssz.SizeOnFork(update, ssz.ForkDeneb))
+		if err := ssz.EncodeToBytes(buf, update); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf, lightClientOptimisticUpdateMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkLightClientOptimisticUpdateMainnet_MarshalWriter(b *testing.B) {
+	update := new(LightClientOptimisticUpdateDeneb)
+	if err := ssz.DecodeFromBytes(lightClientOptimisticUpdateMainnetData, update); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(lightClientOptimisticUpdateMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		if err := ssz.EncodeToStreamOnFork(writer, update, ssz.ForkDeneb); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, lightClientOptimisticUpdateMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkLightClientOptimisticUpdateMainnet_HashTreeRoot(b *testing.B) {
+	update := new(LightClientOptimisticUpdateDeneb)
+	if err := ssz.DecodeFromBytes(lightClientOptimisticUpdateMainnetData, update); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = ssz.HashSequential(update)
+	}
+	b.StopTimer()
+	if htr != lightClientOptimisticUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientOptimisticUpdateMainnetHTR)
+	}
+}
+
+// ====================== BLOCK EDGE-CASE BENCHMARKS =======================
+
+func BenchmarkBlockEmptyMainnet_Unmarshal(b *testing.B) {
+	var block *SignedBeaconBlockDeneb
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlockDeneb)
+		if err := ssz.DecodeFromBytes(blockEmptyMainnetData, block); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(block.Message)
+	if htr != blockEmptyMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockEmptyMainnetHTR)
+	}
+}
+
+func BenchmarkBlockEmptyMainnet_UnmarshalReader(b *testing.B) {
+	var block *SignedBeaconBlockDeneb
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlockDeneb)
+		reader := bytes.NewReader(blockEmptyMainnetData)
+		if err := ssz.DecodeFromStream(reader, block, uint32(len(blockEmptyMainnetData))); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(block.Message)
+	if htr != blockEmptyMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockEmptyMainnetHTR)
+	}
+}
+
+func BenchmarkBlockEmptyMainnet_Marshal(b *testing.B) {
+	block := new(SignedBeaconBlockDeneb)
+	if err := ssz.DecodeFromBytes(blockEmptyMainnetData, block); err != nil {
+		b.Fatal(err)
+	}
+	var buf []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = make([]byte, ssz.SizeOnFork(block, ssz.ForkDeneb))
+		if err := ssz.EncodeToBytes(buf, block); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf, blockEmptyMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockEmptyMainnet_MarshalWriter(b *testing.B) {
+	block := new(SignedBeaconBlockDeneb)
+	if err := ssz.DecodeFromBytes(blockEmptyMainnetData, block); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(blockEmptyMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		if err := ssz.EncodeToStreamOnFork(writer, block, ssz.ForkDeneb); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, blockEmptyMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockEmptyMainnet_HashTreeRoot(b *testing.B) {
+	block := new(SignedBeaconBlockDeneb)
+	if err := ssz.DecodeFromBytes(blockEmptyMainnetData, block); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = ssz.HashSequential(block.Message)
+	}
+	b.StopTimer()
+	if htr != blockEmptyMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockEmptyMainnetHTR)
+	}
+}
+
+func BenchmarkBlockMaxMainnet_Unmarshal(b *testing.B) {
+	var block *SignedBeaconBlockDeneb
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlockDeneb)
+		if err := ssz.DecodeFromBytes(blockMaxMainnetData, block); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(block.Message)
+	if htr != blockMaxMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMaxMainnetHTR)
+	}
+}
+
+func BenchmarkBlockMaxMainnet_UnmarshalReader(b *testing.B) {
+	var block *SignedBeaconBlockDeneb
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlockDeneb)
+		reader := bytes.NewReader(blockMaxMainnetData)
+		if err := ssz.DecodeFromStream(reader, block, uint32(len(blockMaxMainnetData))); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(block.Message)
+	if htr != blockMaxMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMaxMainnetHTR)
+	}
+}
+
+func BenchmarkBlockMaxMainnet_Marshal(b *testing.B) {
+	block := new(SignedBeaconBlockDeneb)
+	if err := ssz.DecodeFromBytes(blockMaxMainnetData, block); err != nil {
+		b.Fatal(err)
+	}
+	var buf []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = make([]byte, ssz.SizeOnFork(block, ssz.ForkDeneb))
+		if err := ssz.EncodeToBytes(buf, block); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf, blockMaxMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockMaxMainnet_MarshalWriter(b *testing.B) {
+	block := new(SignedBeaconBlockDeneb)
+	if err := ssz.DecodeFromBytes(blockMaxMainnetData, block); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(blockMaxMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		if err := ssz.EncodeToStreamOnFork(writer, block, ssz.ForkDeneb); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, blockMaxMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockMaxMainnet_HashTreeRoot(b *testing.B) {
+	block := new(SignedBeaconBlockDeneb)
+	if err := ssz.DecodeFromBytes(blockMaxMainnetData, block); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = ssz.HashSequential(block.Message)
+	}
+	b.StopTimer()
+	if htr != blockMaxMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMaxMainnetHTR)
+	}
+}
+
+func BenchmarkBlockBoundaryMainnet_Unmarshal(b *testing.B) {
+	var block *SignedBeaconBlockDeneb
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlockDeneb)
+		if err := ssz.DecodeFromBytes(blockBoundaryMainnetData, block); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(block.Message)
+	if htr != blockBoundaryMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockBoundaryMainnetHTR)
+	}
+}
+
+func BenchmarkBlockBoundaryMainnet_UnmarshalReader(b *testing.B) {
+	var block *SignedBeaconBlockDeneb
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlockDeneb)
+		reader := bytes.NewReader(blockBoundaryMainnetData)
+		if err := ssz.DecodeFromStream(reader, block, uint32(len(blockBoundaryMainnetData))); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(block.Message)
+	if htr != blockBoundaryMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockBoundaryMainnetHTR)
+	}
+}
+
+func BenchmarkBlockBoundaryMainnet_Marshal(b *testing.B) {
+	block := new(SignedBeaconBlockDeneb)
+	if err := ssz.DecodeFromBytes(blockBoundaryMainnetData, block); err != nil {
+		b.Fatal(err)
+	}
+	var buf []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = make([]byte, ssz.SizeOnFork(block, ssz.ForkDeneb))
+		if err := ssz.EncodeToBytes(buf, block); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf, blockBoundaryMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockBoundaryMainnet_MarshalWriter(b *testing.B) {
+	block := new(SignedBeaconBlockDeneb)
+	if err := ssz.DecodeFromBytes(blockBoundaryMainnetData, block); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(blockBoundaryMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		if err := ssz.EncodeToStreamOnFork(writer, block, ssz.ForkDeneb); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, blockBoundaryMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockBoundaryMainnet_HashTreeRoot(b *testing.B) {
+	block := new(SignedBeaconBlockDeneb)
+	if err := ssz.DecodeFromBytes(blockBoundaryMainnetData, block); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = ssz.HashSequential(block.Message)
+	}
+	b.StopTimer()
+	if htr != blockBoundaryMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockBoundaryMainnetHTR)
+	}
+}
+
+func BenchmarkBlockTypedMainnet_Unmarshal(b *testing.B) {
+	var block *SignedBeaconBlockDeneb
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlockDeneb)
+		if err := ssz.DecodeFromBytes(blockTypedMainnetData, block); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(block.Message)
+	if htr != blockTypedMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockTypedMainnetHTR)
+	}
+}
+
+func BenchmarkBlockTypedMainnet_UnmarshalReader(b *testing.B) {
+	var block *SignedBeaconBlockDeneb
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlockDeneb)
+		reader := bytes.NewReader(blockTypedMainnetData)
+		if err := ssz.DecodeFromStream(reader, block, uint32(len(blockTypedMainnetData))); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(block.Message)
+	if htr != blockTypedMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockTypedMainnetHTR)
+	}
+}
+
+func BenchmarkBlockTypedMainnet_Marshal(b *testing.B) {
+	block := new(SignedBeaconBlockDeneb)
+	if err := ssz.DecodeFromBytes(blockTypedMainnetData, block); err != nil {
+		b.Fatal(err)
+	}
+	var buf []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = make([]byte, ssz.SizeOnFork(block, ssz.ForkDeneb))
+		if err := ssz.EncodeToBytes(buf, block); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf, blockTypedMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockTypedMainnet_MarshalWriter(b *testing.B) {
+	block := new(SignedBeaconBlockDeneb)
+	if err := ssz.DecodeFromBytes(blockTypedMainnetData, block); err != nil {
+		b.Fatal(err)
+	}
+	var writer = &TestWriter{
+		data: make([]byte, 0, len(blockTypedMainnetData)),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writer.Reset()
+		if err := ssz.EncodeToStreamOnFork(writer, block, ssz.ForkDeneb); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(writer.data, blockTypedMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockTypedMainnet_HashTreeRoot(b *testing.B) {
+	block := new(SignedBeaconBlockDeneb)
+	if err := ssz.DecodeFromBytes(blockTypedMainnetData, block); err != nil {
+		b.Fatal(err)
+	}
+	var htr [32]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = ssz.HashSequential(block.Message)
+	}
+	b.StopTimer()
+	if htr != blockTypedMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockTypedMainnetHTR)
+	}
+}
+
+// ======================= SNAPPY PIPELINE BENCHMARKS =======================
+//
+// Decompress+unmarshal and marshal+compress end-to-end, for the framed snappy
+// encoding of req/resp (_Snappy*) and the block encoding of gossip
+// (_SnappyBlock*). The framed variants decode from and encode to the snappy
+// stream directly (DecodeFromStream / EncodeToStreamOnFork).
+
+// checkSnappyFramed decompresses a framed snappy stream and compares it with
+// the uncompressed corpus.
+func checkSnappyFramed(b *testing.B, framed []byte, want []byte) {
+	data, err := io.ReadAll(snappy.NewReader(bytes.NewReader(framed)))
+	if err != nil {
+		b.Fatal(err)
+	}
+	if !bytes.Equal(data, want) {
+		b.Fatal("decompressed data does not match original")
+	}
+}
+
+// checkSnappyBlock decompresses a snappy block and compares it with the
+// uncompressed corpus.
+func checkSnappyBlock(b *testing.B, block []byte, want []byte) {
+	data, err := snappy.Decode(nil, block)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if !bytes.Equal(data, want) {
+		b.Fatal("decompressed data does not match original")
+	}
+}
+
+func BenchmarkBlockMainnet_SnappyUnmarshal(b *testing.B) {
+	var block *SignedBeaconBlockDeneb
+	reader := snappy.NewReader(nil)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlockDeneb)
+		reader.Reset(bytes.NewReader(blockMainnetSnappyFramed))
+		if err := ssz.DecodeFromStream(reader, block, uint32(len(blockMainnetData))); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(block.Message)
+	if htr != blockMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMainnetHTR)
+	}
+}
+
+func BenchmarkBlockMainnet_SnappyMarshal(b *testing.B) {
+	block := new(SignedBeaconBlockDeneb)
+	if err := ssz.DecodeFromBytes(blockMainnetData, block); err != nil {
+		b.Fatal(err)
+	}
+	var buf bytes.Buffer
+	writer := snappy.NewBufferedWriter(nil)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+		writer.Reset(&buf)
+		if err := ssz.EncodeToStreamOnFork(writer, block, ssz.ForkDeneb); err != nil {
+			b.Fatal(err)
+		}
+		if err := writer.Close(); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	checkSnappyFramed(b, buf.Bytes(), blockMainnetData)
+}
+
+func BenchmarkBlockMainnet_SnappyBlockUnmarshal(b *testing.B) {
+	var block *SignedBeaconBlockDeneb
+	buf := make([]byte, len(blockMainnetData))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(SignedBeaconBlockDeneb)
+		data, err := snappy.Decode(buf, blockMainnetSnappyBlock)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if err := ssz.DecodeFromBytes(data, block); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(block.Message)
+	if htr != blockMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMainnetHTR)
+	}
+}
+
+func BenchmarkBlockMainnet_SnappyBlockMarshal(b *testing.B) {
+	block := new(SignedBeaconBlockDeneb)
+	if err := ssz.DecodeFromBytes(blockMainnetData, block); err != nil {
+		b.Fatal(err)
+	}
+	buf := make([]byte, snappy.MaxEncodedLen(len(blockMainnetData)))
+	var compressed []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		data := make([]byte, ssz.SizeOnFork(block, ssz.ForkDeneb))
+		if err := ssz.EncodeToBytes(data, block); err != nil {
+			b.Fatal(err)
+		}
+		compressed = snappy.Encode(buf, data)
+	}
+	b.StopTimer()
+	checkSnappyBlock(b, compressed, blockMainnetData)
+}
+
+func BenchmarkStateMainnet_SnappyUnmarshal(b *testing.B) {
+	var state *BeaconStateDeneb
+	reader := snappy.NewReader(nil)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		state = new(BeaconStateDeneb)
+		reader.Reset(bytes.NewReader(stateMainnetSnappyFramed))
+		if err := ssz.DecodeFromStream(reader, state, uint32(len(stateMainnetData))); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(state)
+	if htr != stateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, stateMainnetHTR)
+	}
+}
+
+func BenchmarkStateMainnet_SnappyMarshal(b *testing.B) {
+	state := new(BeaconStateDeneb)
+	if err := ssz.DecodeFromBytes(stateMainnetData, state); err != nil {
+		b.Fatal(err)
+	}
+	var buf bytes.Buffer
+	writer := snappy.NewBufferedWriter(nil)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+		writer.Reset(&buf)
+		if err := ssz.EncodeToStreamOnFork(writer, state, ssz.ForkDeneb); err != nil {
+			b.Fatal(err)
+		}
+		if err := writer.Close(); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	checkSnappyFramed(b, buf.Bytes(), stateMainnetData)
+}
+
+func BenchmarkStateMainnet_SnappyBlockUnmarshal(b *testing.B) {
+	var state *BeaconStateDeneb
+	buf := make([]byte, len(stateMainnetData))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		state = new(BeaconStateDeneb)
+		data, err := snappy.Decode(buf, stateMainnetSnappyBlock)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if err := ssz.DecodeFromBytes(data, state); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := ssz.HashSequential(state)
+	if htr != stateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, stateMainnetHTR)
+	}
+}
+
+func BenchmarkStateMainnet_SnappyBlockMarshal(b *testing.B) {
+	state := new(BeaconStateDeneb)
+	if err := ssz.DecodeFromBytes(stateMainnetData, state); err != nil {
+		b.Fatal(err)
+	}
+	buf := make([]byte, snappy.MaxEncodedLen(len(stateMainnetData)))
+	var compressed []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		data := make([]byte, ssz.SizeOnFork(state, ssz.ForkDeneb))
+		if err := ssz.EncodeToBytes(data, state); err != nil {
+			b.Fatal(err)
+		}
+		compressed = snappy.Encode(buf, data)
+	}
+	b.StopTimer()
+	checkSnappyBlock(b, compressed, stateMainnetData)
+}
+
+// =========================== ERA FILE BENCHMARKS ===========================
+//
+// Decode a whole era file (e2store records with snappy compressed blocks and
+// the boundary state) the way archive and sync tooling reads it. Records do
+// not store their uncompressed size, so each is decompressed into a reused
+// buffer and decoded from there.
+
+// eraManifest mirrors the manifest.json written by `generator era`
+type eraManifest struct {
+	Entries []*eraEntry `json:"entries"`
+}
+
+type eraEntry struct {
+	File         string `json:"file"`
+	Preset       string `json:"preset"`
+	Blocks       int    `json:"blocks"`
+	LastBlockHTR string `json:"last_block_htr"`
+	StateHTR     string `json:"state_htr"`
+}
+
+// runEra reads the era file of preset listed in res/era/manifest.json b.N
+// times and reports the decoded blocks per second, plus the peak heap growth
+// while reading it from an extra untimed pass. It skips when no era corpus
+// has been generated and returns the manifest entry for the HTR checks.
+func runEra(b *testing.B, preset string, decodeBlock func(data []byte) error, decodeState func(data []byte) error) *eraEntry {
+	manifestData, err := os.ReadFile("../../res/era/manifest.json")
+	if err != nil {
+		b.Skip("no era corpus (see scripts/generate-era.sh): " + err.Error())
+	}
+	var manifest eraManifest
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		b.Fatal(err)
+	}
+	var entry *eraEntry
+	for _, e := range manifest.Entries {
+		if e.Preset == preset {
+			entry = e
+		}
+	}
+	if entry == nil {
+		b.Skip("no " + preset + " era in res/era/manifest.json")
+	}
+	path := "../../res/era/" + entry.File
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		blocks, err := readEra(path, decodeBlock, decodeState, nil)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if blocks != entry.Blocks {
+			b.Fatalf("decoded %d blocks, want %d", blocks, entry.Blocks)
+		}
+	}
+	b.StopTimer()
+	b.ReportMetric(float64(entry.Blocks*b.N)/b.Elapsed().Seconds(), "blocks/s")
+
+	var baseline, stats runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&baseline)
+	peak := baseline.HeapAlloc
+	_, err = readEra(path, decodeBlock, decodeState, func() {
+		runtime.ReadMemStats(&stats)
+		peak = max(peak, stats.HeapAlloc)
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportMetric(float64(peak-baseline.HeapAlloc), "peak-heap-B")
+	return entry
+}
+
+// readEra reads an era file record by record, decoding the compressed blocks
+// and the state and skipping all other records. sample, if set, runs after
+// every decoded record. It returns the number of blocks.
+func readEra(path string, decodeBlock func(data []byte) error, decodeState func(data []byte) error, sample func()) (int, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	reader := bufio.NewReaderSize(file, 1<<20)
+	snappyReader := snappy.NewReader(nil)
+	var header [8]byte
+	var buf bytes.Buffer
+	blocks := 0
+	for {
+		if _, err := io.ReadFull(reader, header[:]); err == io.EOF {
+			return blocks, nil
+		} else if err != nil {
+			return 0, err
+		}
+		length := int64(binary.LittleEndian.Uint32(header[2:6]))
+
+		var decode func(data []byte) error
+		switch {
+		case header[0] == 0x01 && header[1] == 0x00:
+			decode = decodeBlock
+			blocks++
+		case header[0] == 0x02 && header[1] == 0x00:
+			decode = decodeState
+		default:
+			if _, err := reader.Discard(int(length)); err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		snappyReader.Reset(io.LimitReader(reader, length))
+		buf.Reset()
+		if _, err := buf.ReadFrom(snappyReader); err != nil {
+			return 0, err
+		}
+		if err := decode(buf.Bytes()); err != nil {
+			return 0, err
+		}
+		if sample != nil {
+			sample()
+		}
+	}
+}
+
+func BenchmarkEraMainnet_Decode(b *testing.B) {
+	var block *SignedBeaconBlockDeneb
+	var state *BeaconStateDeneb
+	era := runEra(b, "mainnet", func(data []byte) error {
+		block = new(SignedBeaconBlockDeneb)
+		return ssz.DecodeFromBytes(data, block)
+	}, func(data []byte) error {
+		state = new(BeaconStateDeneb)
+		return ssz.DecodeFromBytes(data, state)
+	})
+	htr := ssz.HashSequential(block.Message)
+	if hex.EncodeToString(htr[:]) != era.LastBlockHTR {
+		b.Fatalf("HTR mismatch: got %x, want %s", htr, era.LastBlockHTR)
+	}
+	htr = ssz.HashSequential(state)
+	if hex.EncodeToString(htr[:]) != era.StateHTR {
+		b.Fatalf("HTR mismatch: got %x, want %s", htr, era.StateHTR)
+	}
+}
+
+// ========================= STATE SWEEP BENCHMARKS =========================
+
+// sweepManifest mirrors the manifest.json written by `generator sweep`
+type sweepManifest struct {
+	Entries []struct {
+		File       string `json:"file"`
+		Validators int    `json:"validators"`
+		Preset     string `json:"preset"`
+		Fork       string `json:"fork"`
+		HTR        string `json:"htr"`
+	} `json:"entries"`
+}
+
+// runSweep runs fn as a sub-benchmark for every mainnet deneb state listed in
+// res/sweep/manifest.json. It skips when no sweep has been generated.
+func runSweep(b *testing.B, fn func(b *testing.B, stateData []byte, stateHTR [32]byte)) {
+	manifestData, err := os.ReadFile("../../res/sweep/manifest.json")
+	if err != nil {
+		b.Skip("no sweep corpus (see scripts/generate-sweep.sh): " + err.Error())
+	}
+	var manifest sweepManifest
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		b.Fatal(err)
+	}
+	for _, entry := range manifest.Entries {
+		if entry.Preset != "mainnet" || entry.Fork != "deneb" {
+			continue
+		}
+		stateData, err := os.ReadFile("../../res/sweep/" + entry.File)
+		if err != nil {
+			b.Fatal(err)
+		}
+		htrBytes, err := hex.DecodeString(entry.HTR)
+		if err != nil {
+			b.Fatal(err)
+		}
+		var stateHTR [32]byte
+		copy(stateHTR[:], htrBytes)
+		b.Run(fmt.Sprintf("validators=%d", entry.Validators), func(b *testing.B) {
+			fn(b, stateData, stateHTR)
+		})
+	}
+}
+
+func BenchmarkStateSweep_Unmarshal(b *testing.B) {
+	runSweep(b, func(b *testing.B, stateData []byte, stateHTR [32]byte) {
+		var state *BeaconStateDeneb
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			state = new(BeaconStateDeneb)
+			if err := ssz.DecodeFromBytes(stateData, state); err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.StopTimer()
+		htr := ssz.HashSequential(state)
+		if htr != stateHTR {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, stateHTR)
+		}
+	})
+}
+
+func BenchmarkStateSweep_Marshal(b *testing.B) {
+	runSweep(b, func(b *testing.B, stateData []byte, stateHTR [32]byte) {
+		state := new(BeaconStateDeneb)
+		if err := ssz.DecodeFromBytes(stateData, state); err != nil {
+			b.Fatal(err)
+		}
+		var buf []byte
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			buf = make([]byte, ssz.SizeOnFork(state, ssz.ForkDeneb))
+			if err := ssz.EncodeToBytes(buf, state); err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.StopTimer()
+		if !bytes.Equal(buf, stateData) {
+			b.Fatal("marshaled data does not match original")
+		}
+	})
+}
+
+func BenchmarkStateSweep_HashTreeRoot(b *testing.B) {
+	runSweep(b, func(b *testing.B, stateData []byte, stateHTR [32]byte) {
+		state := new(BeaconStateDeneb)
+		if err := ssz.DecodeFromBytes(stateData, state); err != nil {
+			b.Fatal(err)
+		}
+		var htr [32]byte
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			htr = ssz.HashSequential(state)
+		}
+		b.StopTimer()
+		if htr != stateHTR {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, stateHTR)
+		}
+	})
+}
+
+// ======================= STATE SEQUENCE BENCHMARKS =======================
+
+// sequenceManifest mirrors the manifest.json written by `generator sequence`
+type sequenceManifest struct {
+	Entries []struct {
+		File   string `json:"file"`
+		Step   int    `json:"step"`
+		Preset string `json:"preset"`
+		Fork   string `json:"fork"`
+		HTR    string `json:"htr"`
+	} `json:"entries"`
+}
+
+// loadSequence loads the mainnet deneb states listed in
+// res/sequence/manifest.json in step order, together with their HTRs. Every
+// state differs from its predecessor by one slot of changes. It skips when no
+// sequence has been generated.
+func loadSequence(b *testing.B) ([][]byte, [][32]byte) {
+	manifestData, err := os.ReadFile("../../res/sequence/manifest.json")
+	if err != nil {
+		b.Skip("no state sequence (see scripts/generate-sequence.sh): " + err.Error())
+	}
+	var manifest sequenceManifest
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		b.Fatal(err)
+	}
+	var stepData [][]byte
+	var stepHTRs [][32]byte
+	for _, entry := range manifest.Entries {
+		if entry.Preset != "mainnet" || entry.Fork != "deneb" {
+			continue
+		}
+		if entry.Step != len(stepData) {
+			b.Fatalf("sequence step %d out of order", entry.Step)
+		}
+		data, err := os.ReadFile("../../res/sequence/" + entry.File)
+		if err != nil {
+			b.Fatal(err)
+		}
+		htrBytes, err := hex.DecodeString(entry.HTR)
+		if err != nil {
+			b.Fatal(err)
+		}
+		var htr [32]byte
+		copy(htr[:], htrBytes)
+		stepData = append(stepData, data)
+		stepHTRs = append(stepHTRs, htr)
+	}
+	if len(stepData) < 2 {
+		b.Skip("no mainnet state sequence in res/sequence/manifest.json")
+	}
+	return stepData, stepHTRs
+}
+
+// BenchmarkStateSequence_HashTreeRoot re-roots the successor states one after
+// the other. The library keeps no hash cache, so every state is hashed from
+// scratch.
+func BenchmarkStateSequence_HashTreeRoot(b *testing.B) {
+	stepData, stepHTRs := loadSequence(b)
+	states := make([]*BeaconStateDeneb, len(stepData))
+	for step, data := range stepData {
+		states[step] = new(BeaconStateDeneb)
+		if err := ssz.DecodeFromBytes(data, states[step]); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		step := 1 + i%(len(states)-1)
+		htr := ssz.HashSequential(states[step])
+		if htr != stepHTRs[step] {
+			b.Fatalf("step %d HTR mismatch: got %x, want %x", step, htr, stepHTRs[step])
+		}
+	}
+}
+
+// ===================== STATE REHASH MAINNET BENCHMARKS ======================
+//
+// The rehash benchmarks apply one of the updates in res/rehash-mainnet.json
+// to the mainnet state and re-root it, which is the work behind the state root
+// of every slot. Iterations alternate between the updated and the original
+// value, so every root follows a change.
+// The library keeps no hash cache, so every root is computed over the full
+// state.
+
+// rehashManifest mirrors the rehash-mainnet.json written by `generator rehash`
+type rehashManifest struct {
+	HTR     string `json:"htr"`
+	Balance struct {
+		Index   uint64 `json:"index"`
+		Balance uint64 `json:"balance"`
+		HTR     string `json:"htr"`
+	} `json:"balance"`
+	BlockRoot struct {
+		Index uint64 `json:"index"`
+		Root  string `json:"root"`
+		HTR   string `json:"htr"`
+	} `json:"block_root"`
+	Validator struct {
+		Index             uint64 `json:"index"`
+		ExitEpoch         uint64 `json:"exit_epoch"`
+		WithdrawableEpoch uint64 `json:"withdrawable_epoch"`
+		HTR               string `json:"htr"`
+	} `json:"validator"`
+}
+
+// loadRehash loads res/rehash-mainnet.json and checks that it describes the
+// state-mainnet.ssz corpus.
+func loadRehash(b *testing.B) *rehashManifest {
+	data, err := os.ReadFile("../../res/rehash-mainnet.json")
+	if err != nil {
+		b.Fatal(err)
+	}
+	var manifest rehashManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		b.Fatal(err)
+	}
+	if htr := rehashRoot(b, manifest.HTR); htr != stateMainnetHTR {
+		b.Fatalf("rehash-mainnet.json is for state %x, want %x", htr, stateMainnetHTR)
+	}
+	return &manifest
+}
+
+func rehashRoot(b *testing.B, s string) [32]byte {
+	rootBytes, err := hex.DecodeString(s)
+	if err != nil {
+		b.Fatal(err)
+	}
+	var root [32]byte
+	copy(root[:], rootBytes)
+	return root
+}
+
+func BenchmarkStateMainnet_RehashAfterBalanceUpdate(b *testing.B) {
+	update := loadRehash(b).Balance
+	state := new(BeaconStateDeneb)
+	if err := ssz.DecodeFromBytes(stateMainnetData, state); err != nil {
+		b.Fatal(err)
+	}
+	balances := [2]uint64{uint64(update.Balance), state.Balances[update.Index]}
+	htrs := [2][32]byte{rehashRoot(b, update.HTR), stateMainnetHTR}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		state.Balances[update.Index] = balances[i%2]
+		htr := ssz.HashSequential(state)
+		if htr != htrs[i%2] {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, htrs[i%2])
+		}
+	}
+}
+
+func BenchmarkStateMainnet_RehashAfterBlockRootsUpdate(b *testing.B) {
+	update := loadRehash(b).BlockRoot
+	state := new(BeaconStateDeneb)
+	if err := ssz.DecodeFromBytes(stateMainnetData, state); err != nil {
+		b.Fatal(err)
+	}
+	root := rehashRoot(b, update.Root)
+	roots := [2][32]byte{root, state.BlockRoots[update.Index]}
+	htrs := [2][32]byte{rehashRoot(b, update.HTR), stateMainnetHTR}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		state.BlockRoots[update.Index] = roots[i%2]
+		htr := ssz.HashSequential(state)
+		if htr != htrs[i%2] {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, htrs[i%2])
+		}
+	}
+}
+
+func BenchmarkStateMainnet_RehashAfterValidatorUpdate(b *testing.B) {
+	update := loadRehash(b).Validator
+	state := new(BeaconStateDeneb)
+	if err := ssz.DecodeFromBytes(stateMainnetData, state); err != nil {
+		b.Fatal(err)
+	}
+	validators := [2]Validator{*state.Validators[update.Index], *state.Validators[update.Index]}
+	validators[0].ExitEpoch = uint64(update.ExitEpoch)
+	validators[0].WithdrawableEpoch = uint64(update.WithdrawableEpoch)
+	htrs := [2][32]byte{rehashRoot(b, update.HTR), stateMainnetHTR}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		*state.Validators[update.Index] = validators[i%2]
+		htr := ssz.HashSequential(state)
+		if htr != htrs[i%2] {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, htrs[i%2])
+		}
+	}
+}
+
+// ======================= MALFORMED BLOCK BENCHMARKS =======================
+
+// malformedManifest mirrors the manifest.json written by `generator malformed`
+type malformedManifest struct {
+	Entries []struct {
+		File    string `json:"file"`
+		Source  string `json:"source"`
+		Variant string `json:"variant"`
+		Class   string `json:"class"`
+	} `json:"entries"`
+}
+
+// runMalformed runs a sub-benchmark for every malformed variant of source
+// listed in res/malformed/manifest.json, asserting that decode rejects it
+// with an error rather than accepting it or panicking.
+func runMalformed(b *testing.B, source string, decode func(data []byte) error) {
+	manifestData, err := os.ReadFile("../../res/malformed/manifest.json")
+	if err != nil {
+		b.Skip("no malformed corpus (see scripts/generate-corpus.sh): " + err.Error())
+	}
+	var manifest malformedManifest
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		b.Fatal(err)
+	}
+	for _, entry := range manifest.Entries {
+		if entry.Source != source {
+			continue
+		}
+		data, err := os.ReadFile("../../res/malformed/" + entry.File)
+		if err != nil {
+			b.Fatal(err)
+		}
+		class := entry.Class
+		b.Run(entry.Variant, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				rejectMalformed(b, class, decode, data)
+			}
+		})
+	}
+}
+
+// rejectMalformed fails the benchmark unless decode returns an error for data
+func rejectMalformed(b *testing.B, class string, decode func(data []byte) error, data []byte) {
+	defer func() {
+		if r := recover(); r != nil {
+			b.Fatalf("panicked on malformed input (%s): %v", class, r)
+		}
+	}()
+	if err := decode(data); err == nil {
+		b.Fatalf("accepted malformed input (%s)", class)
+	}
+}
+
+func BenchmarkBlockMalformed_Unmarshal(b *testing.B) {
+	runMalformed(b, "block-mainnet.ssz", func(data []byte) error {
+		return ssz.DecodeFromBytes(data, new(SignedBeaconBlockDeneb))
+	})
+}
+
+// ======================== PARALLEL MAINNET BENCHMARKS ========================
+//
+// The parallel benchmarks run the block and attestation operations on
+// GOMAXPROCS goroutines at once, as a beacon node does, and expose contention
+// in shared hasher pools and global state. ns/op is wall time per operation
+// over all goroutines. run-benchmarks.sh runs them unpinned with -cpu 1,2,4,8.
+
+// parallelResults collects the last result of every RunParallel goroutine, so
+// that they can be checked once the timer is stopped
+type parallelResults[T any] struct {
+	mu     sync.Mutex
+	values []T
+}
+
+func (r *parallelResults[T]) add(value T) {
+	r.mu.Lock()
+	r.values = append(r.values, value)
+	r.mu.Unlock()
+}
+
+func BenchmarkBlockMainnet_UnmarshalParallel(b *testing.B) {
+	var results parallelResults[*SignedBeaconBlockDeneb]
+	b.RunParallel(func(pb *testing.PB) {
+		var block *SignedBeaconBlockDeneb
+		for pb.Next() {
+			block = new(SignedBeaconBlockDeneb)
+			if err := ssz.DecodeFromBytes(blockMainnetData, block); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+		results.add(block)
+	})
+	b.StopTimer()
+	for _, block := range results.values {
+		if block == nil {
+			continue // no iterations on that goroutine
+		}
+		htr := ssz.HashSequential(block.Message)
+		if htr != blockMainnetHTR {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMainnetHTR)
+		}
+	}
+}
+
+func BenchmarkBlockMainnet_MarshalParallel(b *testing.B) {
+	block := new(SignedBeaconBlockDeneb)
+	if err := ssz.DecodeFromBytes(blockMainnetData, block); err != nil {
+		b.Fatal(err)
+	}
+	var results parallelResults[[]byte]
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var data []byte
+		for pb.Next() {
+			data = make([]byte, ssz.SizeOnFork(block, ssz.ForkDeneb))
+			if err := ssz.EncodeToBytes(data, block); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+		results.add(data)
+	})
+	b.StopTimer()
+	for _, data := range results.values {
+		if data != nil && !bytes.Equal(data, blockMainnetData) {
+			b.Fatal("marshaled data does not match original")
+		}
+	}
+}
+
+func BenchmarkBlockMainnet_HashTreeRootParallel(b *testing.B) {
+	block := new(SignedBeaconBlockDeneb)
+	if err := ssz.DecodeFromBytes(blockMainnetData, block); err != nil {
+		b.Fatal(err)
+	}
+	var results parallelResults[[32]byte]
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var htr [32]byte
+		for pb.Next() {
+			htr = ssz.HashSequential(block.Message)
+		}
+		results.add(htr)
+	})
+	b.StopTimer()
+	for _, htr := range results.values {
+		if htr != ([32]byte{}) && htr != blockMainnetHTR {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMainnetHTR)
+		}
+	}
+}
+
+func BenchmarkAttestationMainnet_UnmarshalParallel(b *testing.B) {
+	var results parallelResults[*Attestation]
+	b.RunParallel(func(pb *testing.PB) {
+		var attestation *Attestation
+		for pb.Next() {
+			attestation = new(Attestation)
+			if err := ssz.DecodeFromBytes(attestationMainnetData, attestation); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+		results.add(attestation)
+	})
+	b.StopTimer()
+	for _, attestation := range results.values {
+		if attestation == nil {
+			continue // no iterations on that goroutine
+		}
+		htr := ssz.HashSequential(attestation)
+		if htr != attestationMainnetHTR {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, attestationMainnetHTR)
+		}
+	}
+}
+
+func BenchmarkAttestationMainnet_MarshalParallel(b *testing.B) {
+	attestation := new(Attestation)
+	if err := ssz.DecodeFromBytes(attestationMainnetData, attestation); err != nil {
+		b.Fatal(err)
+	}
+	var results parallelResults[[]byte]
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var data []byte
+		for pb.Next() {
+			data = make([]byte, ssz.SizeOnFork(attestation, ssz.ForkDeneb))
+			if err := ssz.EncodeToBytes(data, attestation); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+		results.add(data)
+	})
+	b.StopTimer()
+	for _, data := range results.values {
+		if data != nil && !bytes.Equal(data, attestationMainnetData) {
+			b.Fatal("marshaled data does not match original")
+		}
+	}
+}
+
+func BenchmarkAttestationMainnet_HashTreeRootParallel(b *testing.B) {
+	attestation := new(Attestation)
+	if err := ssz.DecodeFromBytes(attestationMainnetData, attestation); err != nil {
+		b.Fatal(err)
+	}
+	var results parallelResults[[32]byte]
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var htr [32]byte
+		for pb.Next() {
+			htr = ssz.HashSequential(attestation)
+		}
+		results.add(htr)
+	})
+	b.StopTimer()
+	for _, htr := range results.values {
+		if htr != ([32]byte{}) && htr != attestationMainnetHTR {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, attestationMainnetHTR)
+		}
+	}
+}
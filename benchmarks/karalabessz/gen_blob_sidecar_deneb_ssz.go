@@ -0,0 +1,27 @@
+// Code generated by github.com/karalabe/ssz. DO NOT EDIT.
+
+package karalabessz
+
+import "github.com/karalabe/ssz"
+
+// Cached static size computed on package init.
+var staticSizeCacheBlobSidecarDeneb = ssz.PrecomputeStaticSizeCache((*BlobSidecarDeneb)(nil))
+
+// SizeSSZ returns the total size of the static ssz object.
+func (obj *BlobSidecarDeneb) SizeSSZ(sizer *ssz.Sizer) (size uint32) {
+	if fork := int(sizer.Fork()); fork < len(staticSizeCacheBlobSidecarDeneb) {
+		return staticSizeCacheBlobSidecarDeneb[fork]
+	}
+	size = 8 + 131072 + 48 + 48 + (*SignedBeaconBlockHeader)(nil).SizeSSZ(sizer) + 17*32
+	return size
+}
+
+// DefineSSZ defines how an object is encoded/decoded.
+func (obj *BlobSidecarDeneb) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &obj.Index)                                              // Field  (0) -                       Index -      8 bytes
+	ssz.DefineCheckedStaticBytes(codec, &obj.Blob, 131072)                           // Field  (1) -                        Blob - 131072 bytes
+	ssz.DefineStaticBytes(codec, &obj.KZGCommitment)                                 // Field  (2) -               KZGCommitment -     48 bytes
+	ssz.DefineStaticBytes(codec, &obj.KZGProof)                                      // Field  (3) -                    KZGProof -     48 bytes
+	ssz.DefineStaticObject(codec, &obj.SignedBlockHeader)                            // Field  (4) -           SignedBlockHeader -      ? bytes (SignedBeaconBlockHeader)
+	ssz.DefineCheckedArrayOfStaticBytes(codec, &obj.KZGCommitmentInclusionProof, 17) // Field  (5) - KZGCommitmentInclusionProof -    544 bytes
+}
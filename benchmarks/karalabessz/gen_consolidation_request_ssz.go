@@ -0,0 +1,17 @@
+// Code generated by github.com/karalabe/ssz. DO NOT EDIT.
+
+package karalabessz
+
+import "github.com/karalabe/ssz"
+
+// SizeSSZ returns the total size of the static ssz object.
+func (obj *ConsolidationRequest) SizeSSZ(sizer *ssz.Sizer) uint32 {
+	return 20 + 48 + 48
+}
+
+// DefineSSZ defines how an object is encoded/decoded.
+func (obj *ConsolidationRequest) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &obj.SourceAddress) // Field  (0) - SourceAddress - 20 bytes
+	ssz.DefineStaticBytes(codec, &obj.SourcePubkey)  // Field  (1) -  SourcePubkey - 48 bytes
+	ssz.DefineStaticBytes(codec, &obj.TargetPubkey)  // Field  (2) -  TargetPubkey - 48 bytes
+}
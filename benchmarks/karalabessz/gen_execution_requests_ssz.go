@@ -0,0 +1,32 @@
+// Code generated by github.com/karalabe/ssz. DO NOT EDIT.
+
+package karalabessz
+
+import "github.com/karalabe/ssz"
+
+// SizeSSZ returns either the static size of the object if fixed == true, or
+// the total size otherwise.
+func (obj *ExecutionRequests) SizeSSZ(sizer *ssz.Sizer, fixed bool) (size uint32) {
+	size = 4 + 4 + 4
+	if fixed {
+		return size
+	}
+	size += ssz.SizeSliceOfStaticObjects(sizer, obj.Deposits)
+	size += ssz.SizeSliceOfStaticObjects(sizer, obj.Withdrawals)
+	size += ssz.SizeSliceOfStaticObjects(sizer, obj.Consolidations)
+
+	return size
+}
+
+// DefineSSZ defines how an object is encoded/decoded.
+func (obj *ExecutionRequests) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &obj.Deposits, 8192)    // Offset (0) -       Deposits - 4 bytes
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &obj.Withdrawals, 16)   // Offset (1) -    Withdrawals - 4 bytes
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &obj.Consolidations, 2) // Offset (2) - Consolidations - 4 bytes
+
+	// Define the dynamic data (fields)
+	ssz.DefineSliceOfStaticObjectsContent(codec, &obj.Deposits, 8192)    // Field  (0) -       Deposits - ? bytes
+	ssz.DefineSliceOfStaticObjectsContent(codec, &obj.Withdrawals, 16)   // Field  (1) -    Withdrawals - ? bytes
+	ssz.DefineSliceOfStaticObjectsContent(codec, &obj.Consolidations, 2) // Field  (2) - Consolidations - ? bytes
+}
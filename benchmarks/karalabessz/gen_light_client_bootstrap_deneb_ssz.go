@@ -0,0 +1,37 @@
+// Code generated by github.com/karalabe/ssz. DO NOT EDIT.
+
+package karalabessz
+
+import "github.com/karalabe/ssz"
+
+// Cached static size computed on package init.
+var staticSizeCacheLightClientBootstrapDeneb = ssz.PrecomputeStaticSizeCache((*LightClientBootstrapDeneb)(nil))
+
+// SizeSSZ returns either the static size of the object if fixed == true, or
+// the total size otherwise.
+func (obj *LightClientBootstrapDeneb) SizeSSZ(sizer *ssz.Sizer, fixed bool) (size uint32) {
+	// Load static size if already precomputed, calculate otherwise
+	if fork := int(sizer.Fork()); fork < len(staticSizeCacheLightClientBootstrapDeneb) {
+		size = staticSizeCacheLightClientBootstrapDeneb[fork]
+	} else {
+		size = 4 + (*SyncCommittee)(nil).SizeSSZ(sizer) + 5*32
+	}
+	// Either return the static size or accumulate the dynamic too
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicObject(sizer, obj.Header)
+
+	return size
+}
+
+// DefineSSZ defines how an object is encoded/decoded.
+func (obj *LightClientBootstrapDeneb) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineDynamicObjectOffset(codec, &obj.Header)                              // Offset (0) -                     Header -   4 bytes
+	ssz.DefineStaticObject(codec, &obj.CurrentSyncCommittee)                       // Field  (1) -       CurrentSyncCommittee -   ? bytes (SyncCommittee)
+	ssz.DefineCheckedArrayOfStaticBytes(codec, &obj.CurrentSyncCommitteeBranch, 5) // Field  (2) - CurrentSyncCommitteeBranch - 160 bytes
+
+	// Define the dynamic data (fields)
+	ssz.DefineDynamicObjectContent(codec, &obj.Header) // Field  (0) -                     Header - ? bytes
+}
@@ -0,0 +1,37 @@
+// Code generated by github.com/karalabe/ssz. DO NOT EDIT.
+
+package karalabessz
+
+import "github.com/karalabe/ssz"
+
+// Cached static size computed on package init.
+var staticSizeCacheLightClientHeaderDeneb = ssz.PrecomputeStaticSizeCache((*LightClientHeaderDeneb)(nil))
+
+// SizeSSZ returns either the static size of the object if fixed == true, or
+// the total size otherwise.
+func (obj *LightClientHeaderDeneb) SizeSSZ(sizer *ssz.Sizer, fixed bool) (size uint32) {
+	// Load static size if already precomputed, calculate otherwise
+	if fork := int(sizer.Fork()); fork < len(staticSizeCacheLightClientHeaderDeneb) {
+		size = staticSizeCacheLightClientHeaderDeneb[fork]
+	} else {
+		size = (*BeaconBlockHeader)(nil).SizeSSZ(sizer) + 4 + 4*32
+	}
+	// Either return the static size or accumulate the dynamic too
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicObject(sizer, obj.Execution)
+
+	return size
+}
+
+// DefineSSZ defines how an object is encoded/decoded.
+func (obj *LightClientHeaderDeneb) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineStaticObject(codec, &obj.Beacon)                          // Field  (0) -          Beacon -   ? bytes (BeaconBlockHeader)
+	ssz.DefineDynamicObjectOffset(codec, &obj.Execution)                // Offset (1) -       Execution -   4 bytes
+	ssz.DefineCheckedArrayOfStaticBytes(codec, &obj.ExecutionBranch, 4) // Field  (2) - ExecutionBranch - 128 bytes
+
+	// Define the dynamic data (fields)
+	ssz.DefineDynamicObjectContent(codec, &obj.Execution) // Field  (1) -       Execution - ? bytes
+}
@@ -0,0 +1,37 @@
+// Code generated by github.com/karalabe/ssz. DO NOT EDIT.
+
+package karalabessz
+
+import "github.com/karalabe/ssz"
+
+// Cached static size computed on package init.
+var staticSizeCacheLightClientOptimisticUpdateDeneb = ssz.PrecomputeStaticSizeCache((*LightClientOptimisticUpdateDeneb)(nil))
+
+// SizeSSZ returns either the static size of the object if fixed == true, or
+// the total size otherwise.
+func (obj *LightClientOptimisticUpdateDeneb) SizeSSZ(sizer *ssz.Sizer, fixed bool) (size uint32) {
+	// Load static size if already precomputed, calculate otherwise
+	if fork := int(sizer.Fork()); fork < len(staticSizeCacheLightClientOptimisticUpdateDeneb) {
+		size = staticSizeCacheLightClientOptimisticUpdateDeneb[fork]
+	} else {
+		size = 4 + (*SyncAggregate)(nil).SizeSSZ(sizer) + 8
+	}
+	// Either return the static size or accumulate the dynamic too
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicObject(sizer, obj.AttestedHeader)
+
+	return size
+}
+
+// DefineSSZ defines how an object is encoded/decoded.
+func (obj *LightClientOptimisticUpdateDeneb) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineDynamicObjectOffset(codec, &obj.AttestedHeader) // Offset (0) - AttestedHeader - 4 bytes
+	ssz.DefineStaticObject(codec, &obj.SyncAggregate)         // Field  (1) -  SyncAggregate - ? bytes (SyncAggregate)
+	ssz.DefineUint64(codec, &obj.SignatureSlot)               // Field  (2) -  SignatureSlot - 8 bytes
+
+	// Define the dynamic data (fields)
+	ssz.DefineDynamicObjectContent(codec, &obj.AttestedHeader) // Field  (0) - AttestedHeader - ? bytes
+}
@@ -0,0 +1,43 @@
+// Code generated by github.com/karalabe/ssz. DO NOT EDIT.
+
+package karalabessz
+
+import "github.com/karalabe/ssz"
+
+// Cached static size computed on package init.
+var staticSizeCacheLightClientUpdateDeneb = ssz.PrecomputeStaticSizeCache((*LightClientUpdateDeneb)(nil))
+
+// SizeSSZ returns either the static size of the object if fixed == true, or
+// the total size otherwise.
+func (obj *LightClientUpdateDeneb) SizeSSZ(sizer *ssz.Sizer, fixed bool) (size uint32) {
+	// Load static size if already precomputed, calculate otherwise
+	if fork := int(sizer.Fork()); fork < len(staticSizeCacheLightClientUpdateDeneb) {
+		size = staticSizeCacheLightClientUpdateDeneb[fork]
+	} else {
+		size = 4 + (*SyncCommittee)(nil).SizeSSZ(sizer) + 5*32 + 4 + 6*32 + (*SyncAggregate)(nil).SizeSSZ(sizer) + 8
+	}
+	// Either return the static size or accumulate the dynamic too
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicObject(sizer, obj.AttestedHeader)
+	size += ssz.SizeDynamicObject(sizer, obj.FinalizedHeader)
+
+	return size
+}
+
+// DefineSSZ defines how an object is encoded/decoded.
+func (obj *LightClientUpdateDeneb) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineDynamicObjectOffset(codec, &obj.AttestedHeader)                   // Offset (0) -          AttestedHeader -   4 bytes
+	ssz.DefineStaticObject(codec, &obj.NextSyncCommittee)                       // Field  (1) -       NextSyncCommittee -   ? bytes (SyncCommittee)
+	ssz.DefineCheckedArrayOfStaticBytes(codec, &obj.NextSyncCommitteeBranch, 5) // Field  (2) - NextSyncCommitteeBranch - 160 bytes
+	ssz.DefineDynamicObjectOffset(codec, &obj.FinalizedHeader)                  // Offset (3) -         FinalizedHeader -   4 bytes
+	ssz.DefineCheckedArrayOfStaticBytes(codec, &obj.FinalityBranch, 6)          // Field  (4) -          FinalityBranch - 192 bytes
+	ssz.DefineStaticObject(codec, &obj.SyncAggregate)                           // Field  (5) -           SyncAggregate -   ? bytes (SyncAggregate)
+	ssz.DefineUint64(codec, &obj.SignatureSlot)                                 // Field  (6) -           SignatureSlot -   8 bytes
+
+	// Define the dynamic data (fields)
+	ssz.DefineDynamicObjectContent(codec, &obj.AttestedHeader)  // Field  (0) -          AttestedHeader - ? bytes
+	ssz.DefineDynamicObjectContent(codec, &obj.FinalizedHeader) // Field  (3) -         FinalizedHeader - ? bytes
+}
@@ -0,0 +1,16 @@
+// Code generated by github.com/karalabe/ssz. DO NOT EDIT.
+
+package karalabessz
+
+import "github.com/karalabe/ssz"
+
+// SizeSSZ returns the total size of the static ssz object.
+func (obj *PendingConsolidation) SizeSSZ(sizer *ssz.Sizer) uint32 {
+	return 8 + 8
+}
+
+// DefineSSZ defines how an object is encoded/decoded.
+func (obj *PendingConsolidation) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &obj.SourceIndex) // Field  (0) - SourceIndex - 8 bytes
+	ssz.DefineUint64(codec, &obj.TargetIndex) // Field  (1) - TargetIndex - 8 bytes
+}
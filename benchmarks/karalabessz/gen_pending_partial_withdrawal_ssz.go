@@ -0,0 +1,17 @@
+// Code generated by github.com/karalabe/ssz. DO NOT EDIT.
+
+package karalabessz
+
+import "github.com/karalabe/ssz"
+
+// SizeSSZ returns the total size of the static ssz object.
+func (obj *PendingPartialWithdrawal) SizeSSZ(sizer *ssz.Sizer) uint32 {
+	return 8 + 8 + 8
+}
+
+// DefineSSZ defines how an object is encoded/decoded.
+func (obj *PendingPartialWithdrawal) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &obj.ValidatorIndex)    // Field  (0) -    ValidatorIndex - 8 bytes
+	ssz.DefineUint64(codec, &obj.Amount)            // Field  (1) -            Amount - 8 bytes
+	ssz.DefineUint64(codec, &obj.WithdrawableEpoch) // Field  (2) - WithdrawableEpoch - 8 bytes
+}
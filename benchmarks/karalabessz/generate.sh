@@ -43,4 +43,26 @@ go run github.com/karalabe/ssz/cmd/sszgen@v0.3.0 -type ExecutionPayloadDeneb -ou
 go run github.com/karalabe/ssz/cmd/sszgen@v0.3.0 -type BeaconBlockBodyDeneb -out gen_beacon_block_body_deneb_ssz.go
 go run github.com/karalabe/ssz/cmd/sszgen@v0.3.0 -type BeaconBlockDeneb -out gen_beacon_block_deneb_ssz.go
 go run github.com/karalabe/ssz/cmd/sszgen@v0.3.0 -type SignedBeaconBlockDeneb -out gen_signed_beacon_block_deneb_ssz.go
-go run github.com/karalabe/ssz/cmd/sszgen@v0.3.0 -type BeaconStateDeneb -out gen_beacon_state_deneb_ssz.go
\ No newline at end of file
+go run github.com/karalabe/ssz/cmd/sszgen@v0.3.0 -type BeaconStateDeneb -out gen_beacon_state_deneb_ssz.go
+go run github.com/karalabe/ssz/cmd/sszgen@v0.3.0 -type BlobSidecarDeneb -out gen_blob_sidecar_deneb_ssz.go
+go run github.com/karalabe/ssz/cmd/sszgen@v0.3.0 -type LightClientHeaderDeneb -out gen_light_client_header_deneb_ssz.go
+go run github.com/karalabe/ssz/cmd/sszgen@v0.3.0 -type LightClientBootstrapDeneb -out gen_light_client_bootstrap_deneb_ssz.go
+go run github.com/karalabe/ssz/cmd/sszgen@v0.3.0 -type LightClientUpdateDeneb -out gen_light_client_update_deneb_ssz.go
+go run github.com/karalabe/ssz/cmd/sszgen@v0.3.0 -type LightClientFinalityUpdateDeneb -out gen_light_client_finality_update_deneb_ssz.go
+go run github.com/karalabe/ssz/cmd/sszgen@v0.3.0 -type LightClientOptimisticUpdateDeneb -out gen_light_client_optimistic_update_deneb_ssz.go
+
+# Electra types
+go run github.com/karalabe/ssz/cmd/sszgen@v0.3.0 -type DepositRequest -out gen_deposit_request_ssz.go
+go run github.com/karalabe/ssz/cmd/sszgen@v0.3.0 -type WithdrawalRequest -out gen_withdrawal_request_ssz.go
+go run github.com/karalabe/ssz/cmd/sszgen@v0.3.0 -type ConsolidationRequest -out gen_consolidation_request_ssz.go
+go run github.com/karalabe/ssz/cmd/sszgen@v0.3.0 -type PendingDeposit -out gen_pending_deposit_ssz.go
+go run github.com/karalabe/ssz/cmd/sszgen@v0.3.0 -type PendingPartialWithdrawal -out gen_pending_partial_withdrawal_ssz.go
+go run github.com/karalabe/ssz/cmd/sszgen@v0.3.0 -type PendingConsolidation -out gen_pending_consolidation_ssz.go
+go run github.com/karalabe/ssz/cmd/sszgen@v0.3.0 -type ExecutionRequests -out gen_execution_requests_ssz.go
+go run github.com/karalabe/ssz/cmd/sszgen@v0.3.0 -type IndexedAttestationElectra -out gen_indexed_attestation_electra_ssz.go
+go run github.com/karalabe/ssz/cmd/sszgen@v0.3.0 -type AttestationElectra -out gen_attestation_electra_ssz.go
+go run github.com/karalabe/ssz/cmd/sszgen@v0.3.0 -type AttesterSlashingElectra -out gen_attester_slashing_electra_ssz.go
+go run github.com/karalabe/ssz/cmd/sszgen@v0.3.0 -type BeaconBlockBodyElectra -out gen_beacon_block_body_electra_ssz.go
+go run github.com/karalabe/ssz/cmd/sszgen@v0.3.0 -type BeaconBlockElectra -out gen_beacon_block_electra_ssz.go
+go run github.com/karalabe/ssz/cmd/sszgen@v0.3.0 -type SignedBeaconBlockElectra -out gen_signed_beacon_block_electra_ssz.go
+go run github.com/karalabe/ssz/cmd/sszgen@v0.3.0 -type BeaconStateElectra -out gen_beacon_state_electra_ssz.go
@@ -3,6 +3,7 @@ module github.com/pk910/ssz-benchmark/benchmarks/karalabessz
 go 1.25.0
 
 require (
+	github.com/golang/snappy v1.0.0
 	github.com/holiman/uint256 v1.3.1
 	github.com/karalabe/ssz v0.3.0
 	github.com/prysmaticlabs/go-bitfield v0.0.0-20240618144021-706c95b2dd15
@@ -237,6 +237,57 @@ type SignedBeaconBlockDeneb struct {
 	Signature [96]byte
 }
 
+// BlobSidecarDeneb represents a blob sidecar (Deneb)
+type BlobSidecarDeneb struct {
+	Index                       uint64
+	Blob                        []byte `ssz-size:"131072"`
+	KZGCommitment               [48]byte
+	KZGProof                    [48]byte
+	SignedBlockHeader           *SignedBeaconBlockHeader
+	KZGCommitmentInclusionProof [][32]byte `ssz-size:"17"`
+}
+
+// LightClientHeaderDeneb represents a light client header (Deneb)
+type LightClientHeaderDeneb struct {
+	Beacon          *BeaconBlockHeader
+	Execution       *ExecutionPayloadHeaderDeneb
+	ExecutionBranch [][32]byte `ssz-size:"4"`
+}
+
+// LightClientBootstrapDeneb represents a light client bootstrap (Deneb)
+type LightClientBootstrapDeneb struct {
+	Header                     *LightClientHeaderDeneb
+	CurrentSyncCommittee       *SyncCommittee
+	CurrentSyncCommitteeBranch [][32]byte `ssz-size:"5"`
+}
+
+// LightClientUpdateDeneb represents a light client update (Deneb)
+type LightClientUpdateDeneb struct {
+	AttestedHeader          *LightClientHeaderDeneb
+	NextSyncCommittee       *SyncCommittee
+	NextSyncCommitteeBranch [][32]byte `ssz-size:"5"`
+	FinalizedHeader         *LightClientHeaderDeneb
+	FinalityBranch          [][32]byte `ssz-size:"6"`
+	SyncAggregate           *SyncAggregate
+	SignatureSlot           uint64
+}
+
+// LightClientFinalityUpdateDeneb represents a light client finality update (Deneb)
+type LightClientFinalityUpdateDeneb struct {
+	AttestedHeader  *LightClientHeaderDeneb
+	FinalizedHeader *LightClientHeaderDeneb
+	FinalityBranch  [][32]byte `ssz-size:"6"`
+	SyncAggregate   *SyncAggregate
+	SignatureSlot   uint64
+}
+
+// LightClientOptimisticUpdateDeneb represents a light client optimistic update (Deneb)
+type LightClientOptimisticUpdateDeneb struct {
+	AttestedHeader *LightClientHeaderDeneb
+	SyncAggregate  *SyncAggregate
+	SignatureSlot  uint64
+}
+
 // BeaconStateDeneb represents a beacon state (Deneb)
 type BeaconStateDeneb struct {
 	GenesisTime                  uint64
@@ -0,0 +1,151 @@
+package karalabessz
+
+import (
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// AttestationElectra represents an attestation (Electra)
+type AttestationElectra struct {
+	AggregationBits bitfield.Bitlist `ssz-max:"131072"`
+	Data            *AttestationData
+	Signature       [96]byte
+	CommitteeBits   [8]byte // Bitvector[64], byte aligned so encoded as plain bytes
+}
+
+// IndexedAttestationElectra represents an indexed attestation (Electra)
+type IndexedAttestationElectra struct {
+	AttestationIndices []uint64 `ssz-max:"131072"`
+	Data               *AttestationData
+	Signature          [96]byte
+}
+
+// AttesterSlashingElectra represents an attester slashing (Electra)
+type AttesterSlashingElectra struct {
+	Attestation1 *IndexedAttestationElectra
+	Attestation2 *IndexedAttestationElectra
+}
+
+// DepositRequest represents a deposit request
+type DepositRequest struct {
+	Pubkey                [48]byte
+	WithdrawalCredentials [32]byte
+	Amount                uint64
+	Signature             [96]byte
+	Index                 uint64
+}
+
+// WithdrawalRequest represents a withdrawal request
+type WithdrawalRequest struct {
+	SourceAddress   Address
+	ValidatorPubkey [48]byte
+	Amount          uint64
+}
+
+// ConsolidationRequest represents a consolidation request
+type ConsolidationRequest struct {
+	SourceAddress Address
+	SourcePubkey  [48]byte
+	TargetPubkey  [48]byte
+}
+
+// ExecutionRequests represents the execution layer requests of a block
+type ExecutionRequests struct {
+	Deposits       []*DepositRequest       `ssz-max:"8192"`
+	Withdrawals    []*WithdrawalRequest    `ssz-max:"16"`
+	Consolidations []*ConsolidationRequest `ssz-max:"2"`
+}
+
+// PendingDeposit represents a pending deposit
+type PendingDeposit struct {
+	Pubkey                [48]byte
+	WithdrawalCredentials [32]byte
+	Amount                uint64
+	Signature             [96]byte
+	Slot                  uint64
+}
+
+// PendingPartialWithdrawal represents a pending partial withdrawal
+type PendingPartialWithdrawal struct {
+	ValidatorIndex    uint64
+	Amount            uint64
+	WithdrawableEpoch uint64
+}
+
+// PendingConsolidation represents a pending consolidation
+type PendingConsolidation struct {
+	SourceIndex uint64
+	TargetIndex uint64
+}
+
+// BeaconBlockBodyElectra represents a beacon block body (Electra)
+type BeaconBlockBodyElectra struct {
+	RandaoReveal          [96]byte
+	Eth1Data              *Eth1Data
+	Graffiti              [32]byte
+	ProposerSlashings     []*ProposerSlashing        `ssz-max:"16"`
+	AttesterSlashings     []*AttesterSlashingElectra `ssz-max:"1"`
+	Attestations          []*AttestationElectra      `ssz-max:"8"`
+	Deposits              []*Deposit                 `ssz-max:"16"`
+	VoluntaryExits        []*SignedVoluntaryExit     `ssz-max:"16"`
+	SyncAggregate         *SyncAggregate
+	ExecutionPayload      *ExecutionPayloadDeneb
+	BlsToExecutionChanges []*SignedBLSToExecutionChange `ssz-max:"16"`
+	BlobKzgCommitments    [][48]byte                    `ssz-max:"4096"`
+	ExecutionRequests     *ExecutionRequests
+}
+
+// BeaconBlockElectra represents a beacon block (Electra)
+type BeaconBlockElectra struct {
+	Slot          Slot
+	ProposerIndex uint64
+	ParentRoot    Hash
+	StateRoot     Hash
+	Body          *BeaconBlockBodyElectra
+}
+
+// SignedBeaconBlockElectra represents a signed beacon block (Electra)
+type SignedBeaconBlockElectra struct {
+	Message   *BeaconBlockElectra
+	Signature [96]byte
+}
+
+// BeaconStateElectra represents a beacon state (Electra)
+type BeaconStateElectra struct {
+	GenesisTime                   uint64
+	GenesisValidatorsRoot         [32]byte
+	Slot                          uint64
+	Fork                          *Fork
+	LatestBlockHeader             *BeaconBlockHeader
+	BlockRoots                    [8192][32]byte
+	StateRoots                    [8192][32]byte
+	HistoricalRoots               [][32]byte `ssz-max:"16777216"`
+	Eth1Data                      *Eth1Data
+	Eth1DataVotes                 []*Eth1Data `ssz-max:"2048"`
+	Eth1DepositIndex              uint64
+	Validators                    []*Validator `ssz-max:"1099511627776"`
+	Balances                      []uint64     `ssz-max:"1099511627776"`
+	RandaoMixes                   [65536][32]byte
+	Slashings                     [8192]uint64
+	PreviousEpochParticipation    []byte  `ssz-max:"1099511627776"`
+	CurrentEpochParticipation     []byte  `ssz-max:"1099511627776"`
+	JustificationBits             [1]byte `ssz-size:"4" ssz:"bits"`
+	PreviousJustifiedCheckpoint   *Checkpoint
+	CurrentJustifiedCheckpoint    *Checkpoint
+	FinalizedCheckpoint           *Checkpoint
+	InactivityScores              []uint64 `ssz-max:"1099511627776"`
+	CurrentSyncCommittee          *SyncCommittee
+	NextSyncCommittee             *SyncCommittee
+	LatestExecutionPayloadHeader  *ExecutionPayloadHeaderDeneb
+	NextWithdrawalIndex           uint64
+	NextWithdrawalValidatorIndex  uint64
+	HistoricalSummaries           []*HistoricalSummary `ssz-max:"16777216"`
+	DepositRequestsStartIndex     uint64
+	DepositBalanceToConsume       uint64
+	ExitBalanceToConsume          uint64
+	EarliestExitEpoch             uint64
+	ConsolidationBalanceToConsume uint64
+	EarliestConsolidationEpoch    uint64
+	PendingDeposits               []*PendingDeposit           `ssz-max:"134217728"`
+	PendingPartialWithdrawals     []*PendingPartialWithdrawal `ssz-max:"134217728"`
+	PendingConsolidations         []*PendingConsolidation     `ssz-max:"262144"`
+}
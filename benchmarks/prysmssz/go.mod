@@ -3,6 +3,7 @@ module github.com/pk910/ssz-benchmark/benchmarks/prysmssz
 go 1.25.0
 
 require (
+	github.com/golang/snappy v1.0.0
 	github.com/prysmaticlabs/fastssz v0.0.0-20260421202104-7a6eb71e6e45
 	github.com/prysmaticlabs/go-bitfield v0.0.0-20240618144021-706c95b2dd15
 )
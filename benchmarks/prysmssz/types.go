@@ -224,6 +224,57 @@ type SignedBeaconBlock struct {
 	Signature [96]byte `ssz-size:"96"`
 }
 
+// BlobSidecar represents a blob sidecar (Deneb)
+type BlobSidecar struct {
+	Index                       uint64
+	Blob                        []byte   `ssz-size:"131072"`
+	KZGCommitment               [48]byte `ssz-size:"48"`
+	KZGProof                    [48]byte `ssz-size:"48"`
+	SignedBlockHeader           *SignedBeaconBlockHeader
+	KZGCommitmentInclusionProof [][]byte `ssz-size:"17,32"`
+}
+
+// LightClientHeader represents a light client header (Deneb)
+type LightClientHeader struct {
+	Beacon          *BeaconBlockHeader
+	Execution       *ExecutionPayloadHeader
+	ExecutionBranch [][]byte `ssz-size:"4,32"`
+}
+
+// LightClientBootstrap represents a light client bootstrap (Deneb)
+type LightClientBootstrap struct {
+	Header                     *LightClientHeader
+	CurrentSyncCommittee       *SyncCommittee
+	CurrentSyncCommitteeBranch [][]byte `ssz-size:"5,32"`
+}
+
+// LightClientUpdate represents a light client update (Deneb)
+type LightClientUpdate struct {
+	AttestedHeader          *LightClientHeader
+	NextSyncCommittee       *SyncCommittee
+	NextSyncCommitteeBranch [][]byte `ssz-size:"5,32"`
+	FinalizedHeader         *LightClientHeader
+	FinalityBranch          [][]byte `ssz-size:"6,32"`
+	SyncAggregate           *SyncAggregate
+	SignatureSlot           uint64
+}
+
+// LightClientFinalityUpdate represents a light client finality update (Deneb)
+type LightClientFinalityUpdate struct {
+	AttestedHeader  *LightClientHeader
+	FinalizedHeader *LightClientHeader
+	FinalityBranch  [][]byte `ssz-size:"6,32"`
+	SyncAggregate   *SyncAggregate
+	SignatureSlot   uint64
+}
+
+// LightClientOptimisticUpdate represents a light client optimistic update (Deneb)
+type LightClientOptimisticUpdate struct {
+	AttestedHeader *LightClientHeader
+	SyncAggregate  *SyncAggregate
+	SignatureSlot  uint64
+}
+
 // BeaconState represents a beacon state (Deneb)
 type BeaconState struct {
 	GenesisTime                  uint64
@@ -0,0 +1,151 @@
+package prysmssz
+
+import (
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// AttestationElectra represents an attestation (Electra)
+type AttestationElectra struct {
+	AggregationBits bitfield.Bitlist `ssz-max:"131072" ssz:"bitlist"`
+	Data            *AttestationData
+	Signature       [96]byte `ssz-size:"96"`
+	CommitteeBits   []byte   `ssz-size:"8"`
+}
+
+// IndexedAttestationElectra represents an indexed attestation (Electra)
+type IndexedAttestationElectra struct {
+	AttestingIndices []uint64 `ssz-max:"131072"`
+	Data             *AttestationData
+	Signature        [96]byte `ssz-size:"96"`
+}
+
+// AttesterSlashingElectra represents an attester slashing (Electra)
+type AttesterSlashingElectra struct {
+	Attestation1 *IndexedAttestationElectra
+	Attestation2 *IndexedAttestationElectra
+}
+
+// DepositRequest represents a deposit request
+type DepositRequest struct {
+	Pubkey                [48]byte `ssz-size:"48"`
+	WithdrawalCredentials [32]byte `ssz-size:"32"`
+	Amount                uint64
+	Signature             [96]byte `ssz-size:"96"`
+	Index                 uint64
+}
+
+// WithdrawalRequest represents a withdrawal request
+type WithdrawalRequest struct {
+	SourceAddress   [20]byte `ssz-size:"20"`
+	ValidatorPubkey [48]byte `ssz-size:"48"`
+	Amount          uint64
+}
+
+// ConsolidationRequest represents a consolidation request
+type ConsolidationRequest struct {
+	SourceAddress [20]byte `ssz-size:"20"`
+	SourcePubkey  [48]byte `ssz-size:"48"`
+	TargetPubkey  [48]byte `ssz-size:"48"`
+}
+
+// ExecutionRequests represents the execution layer requests of a block
+type ExecutionRequests struct {
+	Deposits       []*DepositRequest       `ssz-max:"8192"`
+	Withdrawals    []*WithdrawalRequest    `ssz-max:"16"`
+	Consolidations []*ConsolidationRequest `ssz-max:"2"`
+}
+
+// PendingDeposit represents a pending deposit
+type PendingDeposit struct {
+	Pubkey                [48]byte `ssz-size:"48"`
+	WithdrawalCredentials [32]byte `ssz-size:"32"`
+	Amount                uint64
+	Signature             [96]byte `ssz-size:"96"`
+	Slot                  uint64
+}
+
+// PendingPartialWithdrawal represents a pending partial withdrawal
+type PendingPartialWithdrawal struct {
+	ValidatorIndex    uint64
+	Amount            uint64
+	WithdrawableEpoch uint64
+}
+
+// PendingConsolidation represents a pending consolidation
+type PendingConsolidation struct {
+	SourceIndex uint64
+	TargetIndex uint64
+}
+
+// BeaconBlockBodyElectra represents a beacon block body (Electra)
+type BeaconBlockBodyElectra struct {
+	RANDAOReveal          [96]byte `ssz-size:"96"`
+	ETH1Data              *ETH1Data
+	Graffiti              [32]byte                   `ssz-size:"32"`
+	ProposerSlashings     []*ProposerSlashing        `ssz-max:"16"`
+	AttesterSlashings     []*AttesterSlashingElectra `ssz-max:"1"`
+	Attestations          []*AttestationElectra      `ssz-max:"8"`
+	Deposits              []*Deposit                 `ssz-max:"16"`
+	VoluntaryExits        []*SignedVoluntaryExit     `ssz-max:"16"`
+	SyncAggregate         *SyncAggregate
+	ExecutionPayload      *ExecutionPayload
+	BLSToExecutionChanges []*SignedBLSToExecutionChange `ssz-max:"16"`
+	BlobKZGCommitments    [][48]byte                    `ssz-max:"4096" ssz-size:"?,48"`
+	ExecutionRequests     *ExecutionRequests
+}
+
+// BeaconBlockElectra represents a beacon block (Electra)
+type BeaconBlockElectra struct {
+	Slot          uint64
+	ProposerIndex uint64
+	ParentRoot    [32]byte `ssz-size:"32"`
+	StateRoot     [32]byte `ssz-size:"32"`
+	Body          *BeaconBlockBodyElectra
+}
+
+// SignedBeaconBlockElectra represents a signed beacon block (Electra)
+type SignedBeaconBlockElectra struct {
+	Message   *BeaconBlockElectra
+	Signature [96]byte `ssz-size:"96"`
+}
+
+// BeaconStateElectra represents a beacon state (Electra)
+type BeaconStateElectra struct {
+	GenesisTime                   uint64
+	GenesisValidatorsRoot         [32]byte `ssz-size:"32"`
+	Slot                          uint64
+	Fork                          *Fork
+	LatestBlockHeader             *BeaconBlockHeader
+	BlockRoots                    [][]byte   `ssz-size:"8192,32"`
+	StateRoots                    [][]byte   `ssz-size:"8192,32"`
+	HistoricalRoots               [][32]byte `ssz-max:"16777216" ssz-size:"?,32"`
+	ETH1Data                      *ETH1Data
+	ETH1DataVotes                 []*ETH1Data `ssz-max:"2048"`
+	ETH1DepositIndex              uint64
+	Validators                    []*Validator `ssz-max:"1099511627776"`
+	Balances                      []uint64     `ssz-max:"1099511627776"`
+	RANDAOMixes                   [][]byte     `ssz-size:"65536,32"`
+	Slashings                     []uint64     `ssz-size:"8192"`
+	PreviousEpochParticipation    []uint8      `ssz-max:"1099511627776"`
+	CurrentEpochParticipation     []uint8      `ssz-max:"1099511627776"`
+	JustificationBits             [1]byte      `ssz-size:"1"`
+	PreviousJustifiedCheckpoint   *Checkpoint
+	CurrentJustifiedCheckpoint    *Checkpoint
+	FinalizedCheckpoint           *Checkpoint
+	InactivityScores              []uint64 `ssz-max:"1099511627776"`
+	CurrentSyncCommittee          *SyncCommittee
+	NextSyncCommittee             *SyncCommittee
+	LatestExecutionPayloadHeader  *ExecutionPayloadHeader
+	NextWithdrawalIndex           uint64
+	NextWithdrawalValidatorIndex  uint64
+	HistoricalSummaries           []*HistoricalSummary `ssz-max:"16777216"`
+	DepositRequestsStartIndex     uint64
+	DepositBalanceToConsume       uint64
+	ExitBalanceToConsume          uint64
+	EarliestExitEpoch             uint64
+	ConsolidationBalanceToConsume uint64
+	EarliestConsolidationEpoch    uint64
+	PendingDeposits               []*PendingDeposit           `ssz-max:"134217728"`
+	PendingPartialWithdrawals     []*PendingPartialWithdrawal `ssz-max:"134217728"`
+	PendingConsolidations         []*PendingConsolidation     `ssz-max:"262144"`
+}
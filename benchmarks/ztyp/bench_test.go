@@ -1,14 +1,25 @@
 package ztyp
 
 import (
+	"bufio"
 	"bytes"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"io"
 	"os"
+	"runtime"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/golang/snappy"
+	"github.com/protolambda/zrnt/eth2/beacon/altair"
 	"github.com/protolambda/zrnt/eth2/beacon/common"
 	"github.com/protolambda/zrnt/eth2/beacon/deneb"
+	"github.com/protolambda/zrnt/eth2/beacon/electra"
+	"github.com/protolambda/zrnt/eth2/beacon/phase0"
 	"github.com/protolambda/zrnt/eth2/configs"
 	"github.com/protolambda/ztyp/codec"
 	"github.com/protolambda/ztyp/tree"
@@ -27,6 +38,51 @@ var (
 	blockMainnetHTR common.Root
 	stateMainnetHTR common.Root
 
+	blockElectraMainnetData []byte
+	stateElectraMainnetData []byte
+
+	blockElectraMainnetHTR common.Root
+	stateElectraMainnetHTR common.Root
+
+	blockEmptyMainnetData    []byte
+	blockMaxMainnetData      []byte
+	blockBoundaryMainnetData []byte
+	blockTypedMainnetData    []byte
+
+	blockEmptyMainnetHTR    common.Root
+	blockMaxMainnetHTR      common.Root
+	blockBoundaryMainnetHTR common.Root
+	blockTypedMainnetHTR    common.Root
+
+	attestationMainnetData                 []byte
+	voluntaryExitMainnetData               []byte
+	blsChangeMainnetData                   []byte
+	syncCommitteeMainnetData               []byte
+	executionPayloadMainnetData            []byte
+	validatorMainnetData                   []byte
+	blobSidecarMainnetData                 []byte
+	lightClientBootstrapMainnetData        []byte
+	lightClientUpdateMainnetData           []byte
+	lightClientFinalityUpdateMainnetData   []byte
+	lightClientOptimisticUpdateMainnetData []byte
+
+	attestationMainnetHTR                 common.Root
+	voluntaryExitMainnetHTR               common.Root
+	blsChangeMainnetHTR                   common.Root
+	syncCommitteeMainnetHTR               common.Root
+	executionPayloadMainnetHTR            common.Root
+	validatorMainnetHTR                   common.Root
+	blobSidecarMainnetHTR                 common.Root
+	lightClientBootstrapMainnetHTR        common.Root
+	lightClientUpdateMainnetHTR           common.Root
+	lightClientFinalityUpdateMainnetHTR   common.Root
+	lightClientOptimisticUpdateMainnetHTR common.Root
+
+	blockMainnetSnappyFramed []byte
+	blockMainnetSnappyBlock  []byte
+	stateMainnetSnappyFramed []byte
+	stateMainnetSnappyBlock  []byte
+
 	// Minimal preset support is currently broken in zrnt - keeping for future use
 	// specMinimal     *common.Spec
 	// blockMinimalData []byte
@@ -38,31 +94,42 @@ var (
 func init() {
 	specMainnet = configs.Mainnet
 
-	var err error
-	blockMainnetData, err = os.ReadFile("../../res/block-mainnet.ssz")
-	if err != nil {
-		panic("failed to load block-mainnet.ssz: " + err.Error())
-	}
-	stateMainnetData, err = os.ReadFile("../../res/state-mainnet.ssz")
-	if err != nil {
-		panic("failed to load state-mainnet.ssz: " + err.Error())
-	}
-
-	blockMainnetHTR = loadHTR("../../res/block-mainnet-meta.json")
-	stateMainnetHTR = loadHTR("../../res/state-mainnet-meta.json")
+	blockMainnetData, blockMainnetHTR = loadCorpus("block-mainnet")
+	stateMainnetData, stateMainnetHTR = loadCorpus("state-mainnet")
+	blockElectraMainnetData, blockElectraMainnetHTR = loadCorpus("block-electra-mainnet")
+	stateElectraMainnetData, stateElectraMainnetHTR = loadCorpus("state-electra-mainnet")
+	blockEmptyMainnetData, blockEmptyMainnetHTR = loadCorpus("block-empty-mainnet")
+	blockMaxMainnetData, blockMaxMainnetHTR = loadCorpus("block-max-mainnet")
+	blockBoundaryMainnetData, blockBoundaryMainnetHTR = loadCorpus("block-boundary-mainnet")
+	blockTypedMainnetData, blockTypedMainnetHTR = loadCorpus("block-typed-mainnet")
+	attestationMainnetData, attestationMainnetHTR = loadCorpus("attestation-mainnet")
+	voluntaryExitMainnetData, voluntaryExitMainnetHTR = loadCorpus("voluntary-exit-mainnet")
+	blsChangeMainnetData, blsChangeMainnetHTR = loadCorpus("bls-change-mainnet")
+	syncCommitteeMainnetData, syncCommitteeMainnetHTR = loadCorpus("sync-committee-mainnet")
+	executionPayloadMainnetData, executionPayloadMainnetHTR = loadCorpus("execution-payload-mainnet")
+	validatorMainnetData, validatorMainnetHTR = loadCorpus("validator-mainnet")
+	blobSidecarMainnetData, blobSidecarMainnetHTR = loadCorpus("blob-sidecar-mainnet")
+	lightClientBootstrapMainnetData, lightClientBootstrapMainnetHTR = loadCorpus("light-client-bootstrap-mainnet")
+	lightClientUpdateMainnetData, lightClientUpdateMainnetHTR = loadCorpus("light-client-update-mainnet")
+	lightClientFinalityUpdateMainnetData, lightClientFinalityUpdateMainnetHTR = loadCorpus("light-client-finality-update-mainnet")
+	lightClientOptimisticUpdateMainnetData, lightClientOptimisticUpdateMainnetHTR = loadCorpus("light-client-optimistic-update-mainnet")
+	blockMainnetSnappyFramed, blockMainnetSnappyBlock = loadSnappy("block-mainnet")
+	stateMainnetSnappyFramed, stateMainnetSnappyBlock = loadSnappy("state-mainnet")
 
 	// Minimal preset support is currently broken in zrnt - keeping for future use
 	// specMinimal = configs.Minimal
-	// blockMinimalData, err = os.ReadFile("../../res/block-minimal.ssz")
-	// if err != nil {
-	// 	panic("failed to load block-minimal.ssz: " + err.Error())
-	// }
-	// stateMinimalData, err = os.ReadFile("../../res/state-minimal.ssz")
-	// if err != nil {
-	// 	panic("failed to load state-minimal.ssz: " + err.Error())
-	// }
-	// blockMinimalHTR = loadHTR("../../res/block-minimal-meta.json")
-	// stateMinimalHTR = loadHTR("../../res/state-minimal-meta.json")
+	// blockMinimalData, blockMinimalHTR = loadCorpus("block-minimal")
+	// stateMinimalData, stateMinimalHTR = loadCorpus("state-minimal")
+}
+
+// loadCorpus loads res/<name>.ssz together with the expected HTR from
+// res/<name>-meta.json.
+func loadCorpus(name string) ([]byte, common.Root) {
+	data, err := os.ReadFile("../../res/" + name + ".ssz")
+	if err != nil {
+		panic("failed to load " + name + ".ssz: " + err.Error())
+	}
+	return data, loadHTR("../../res/" + name + "-meta.json")
 }
 
 func loadHTR(path string) common.Root {
@@ -83,6 +150,20 @@ func loadHTR(path string) common.Root {
 	return htr
 }
 
+// loadSnappy loads the framed (res/<name>.ssz.sz) and block
+// (res/<name>.ssz_snappy) snappy encodings of res/<name>.ssz.
+func loadSnappy(name string) ([]byte, []byte) {
+	framed, err := os.ReadFile("../../res/" + name + ".ssz.sz")
+	if err != nil {
+		panic("failed to load " + name + ".ssz.sz: " + err.Error())
+	}
+	block, err := os.ReadFile("../../res/" + name + ".ssz_snappy")
+	if err != nil {
+		panic("failed to load " + name + ".ssz_snappy: " + err.Error())
+	}
+	return framed, block
+}
+
 // ========================= BLOCK MAINNET BENCHMARKS =========================
 
 func BenchmarkBlockMainnet_Unmarshal(b *testing.B) {
@@ -130,6 +211,32 @@ func BenchmarkBlockMainnet_Marshal(b *testing.B) {
 	}
 }
 
+func BenchmarkBlockMainnet_MarshalTo(b *testing.B) {
+	block := new(deneb.SignedBeaconBlock)
+	err := block.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(blockMainnetData),
+		uint64(len(blockMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	// ztyp only encodes to writers, so the reused buffer is a bytes.Buffer
+	buf := bytes.NewBuffer(make([]byte, 0, len(blockMainnetData)))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+		if err := block.Serialize(specMainnet, codec.NewEncodingWriter(buf)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf.Bytes(), blockMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
 func BenchmarkBlockMainnet_HashTreeRoot(b *testing.B) {
 	block := new(deneb.SignedBeaconBlock)
 	err := block.Deserialize(specMainnet, codec.NewDecodingReader(
@@ -151,6 +258,50 @@ func BenchmarkBlockMainnet_HashTreeRoot(b *testing.B) {
 	}
 }
 
+func BenchmarkBlockMainnet_HashTreeRootWith(b *testing.B) {
+	block := new(deneb.SignedBeaconBlock)
+	err := block.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(blockMainnetData),
+		uint64(len(blockMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	// One hash function, and with it its sha256 state, for all iterations
+	hFn := tree.GetHashFn()
+	var htr common.Root
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = block.Message.HashTreeRoot(specMainnet, hFn)
+	}
+	b.StopTimer()
+	if htr != blockMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMainnetHTR)
+	}
+}
+
+func BenchmarkBlockMainnet_SizeSSZ(b *testing.B) {
+	block := new(deneb.SignedBeaconBlock)
+	err := block.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(blockMainnetData),
+		uint64(len(blockMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var size uint64
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		size = block.ByteLength(specMainnet)
+	}
+	b.StopTimer()
+	if int(size) != len(blockMainnetData) {
+		b.Fatalf("size mismatch: got %d, want %d", size, len(blockMainnetData))
+	}
+}
+
 // ========================= STATE MAINNET BENCHMARKS =========================
 
 func BenchmarkStateMainnet_Unmarshal(b *testing.B) {
@@ -198,6 +349,32 @@ func BenchmarkStateMainnet_Marshal(b *testing.B) {
 	}
 }
 
+func BenchmarkStateMainnet_MarshalTo(b *testing.B) {
+	state := new(deneb.BeaconState)
+	err := state.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(stateMainnetData),
+		uint64(len(stateMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	// ztyp only encodes to writers, so the reused buffer is a bytes.Buffer
+	buf := bytes.NewBuffer(make([]byte, 0, len(stateMainnetData)))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+		if err := state.Serialize(specMainnet, codec.NewEncodingWriter(buf)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf.Bytes(), stateMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
 func BenchmarkStateMainnet_HashTreeRoot(b *testing.B) {
 	state := new(deneb.BeaconState)
 	err := state.Deserialize(specMainnet, codec.NewDecodingReader(
@@ -219,6 +396,50 @@ func BenchmarkStateMainnet_HashTreeRoot(b *testing.B) {
 	}
 }
 
+func BenchmarkStateMainnet_HashTreeRootWith(b *testing.B) {
+	state := new(deneb.BeaconState)
+	err := state.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(stateMainnetData),
+		uint64(len(stateMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	// One hash function, and with it its sha256 state, for all iterations
+	hFn := tree.GetHashFn()
+	var htr common.Root
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = state.HashTreeRoot(specMainnet, hFn)
+	}
+	b.StopTimer()
+	if htr != stateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, stateMainnetHTR)
+	}
+}
+
+func BenchmarkStateMainnet_SizeSSZ(b *testing.B) {
+	state := new(deneb.BeaconState)
+	err := state.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(stateMainnetData),
+		uint64(len(stateMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var size uint64
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		size = state.ByteLength(specMainnet)
+	}
+	b.StopTimer()
+	if int(size) != len(stateMainnetData) {
+		b.Fatalf("size mismatch: got %d, want %d", size, len(stateMainnetData))
+	}
+}
+
 // ========================= BLOCK MINIMAL BENCHMARKS =========================
 // Minimal preset support is currently broken in zrnt - keeping for future use
 
@@ -354,3 +575,2248 @@ func BenchmarkStateMainnet_HashTreeRoot(b *testing.B) {
 // 		b.Fatalf("HTR mismatch: got %x, want %x", htr, stateMinimalHTR)
 // 	}
 // }
+
+// ===================== BLOCK ELECTRA MAINNET BENCHMARKS =====================
+
+func BenchmarkBlockElectraMainnet_Unmarshal(b *testing.B) {
+	var block *electra.SignedBeaconBlock
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(electra.SignedBeaconBlock)
+		err := block.Deserialize(specMainnet, codec.NewDecodingReader(
+			bytes.NewReader(blockElectraMainnetData),
+			uint64(len(blockElectraMainnetData)),
+		))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := block.Message.HashTreeRoot(specMainnet, tree.GetHashFn())
+	if htr != blockElectraMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockElectraMainnetHTR)
+	}
+}
+
+func BenchmarkBlockElectraMainnet_Marshal(b *testing.B) {
+	block := new(electra.SignedBeaconBlock)
+	err := block.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(blockElectraMainnetData),
+		uint64(len(blockElectraMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var buf *bytes.Buffer
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = new(bytes.Buffer)
+		if err := block.Serialize(specMainnet, codec.NewEncodingWriter(buf)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf.Bytes(), blockElectraMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockElectraMainnet_HashTreeRoot(b *testing.B) {
+	block := new(electra.SignedBeaconBlock)
+	err := block.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(blockElectraMainnetData),
+		uint64(len(blockElectraMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var htr common.Root
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = block.Message.HashTreeRoot(specMainnet, tree.GetHashFn())
+	}
+	b.StopTimer()
+	if htr != blockElectraMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockElectraMainnetHTR)
+	}
+}
+
+// ===================== STATE ELECTRA MAINNET BENCHMARKS =====================
+
+func BenchmarkStateElectraMainnet_Unmarshal(b *testing.B) {
+	var state *electra.BeaconState
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		state = new(electra.BeaconState)
+		err := state.Deserialize(specMainnet, codec.NewDecodingReader(
+			bytes.NewReader(stateElectraMainnetData),
+			uint64(len(stateElectraMainnetData)),
+		))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := state.HashTreeRoot(specMainnet, tree.GetHashFn())
+	if htr != stateElectraMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, stateElectraMainnetHTR)
+	}
+}
+
+func BenchmarkStateElectraMainnet_Marshal(b *testing.B) {
+	state := new(electra.BeaconState)
+	err := state.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(stateElectraMainnetData),
+		uint64(len(stateElectraMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var buf *bytes.Buffer
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = new(bytes.Buffer)
+
+		if err := state.Serialize(specMainnet, codec.NewEncodingWriter(buf)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf.Bytes(), stateElectraMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkStateElectraMainnet_HashTreeRoot(b *testing.B) {
+	state := new(electra.BeaconState)
+	err := state.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(stateElectraMainnetData),
+		uint64(len(stateElectraMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var htr common.Root
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = state.HashTreeRoot(specMainnet, tree.GetHashFn())
+	}
+	b.StopTimer()
+	if htr != stateElectraMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, stateElectraMainnetHTR)
+	}
+}
+
+// ====================== SUB-OBJECT MAINNET BENCHMARKS =======================
+
+func BenchmarkAttestationMainnet_Unmarshal(b *testing.B) {
+	var attestation *phase0.Attestation
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		attestation = new(phase0.Attestation)
+		err := attestation.Deserialize(specMainnet, codec.NewDecodingReader(
+			bytes.NewReader(attestationMainnetData),
+			uint64(len(attestationMainnetData)),
+		))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := attestation.HashTreeRoot(specMainnet, tree.GetHashFn())
+	if htr != attestationMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, attestationMainnetHTR)
+	}
+}
+
+func BenchmarkAttestationMainnet_Marshal(b *testing.B) {
+	attestation := new(phase0.Attestation)
+	err := attestation.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(attestationMainnetData),
+		uint64(len(attestationMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var buf *bytes.Buffer
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = new(bytes.Buffer)
+		if err := attestation.Serialize(specMainnet, codec.NewEncodingWriter(buf)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf.Bytes(), attestationMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkAttestationMainnet_HashTreeRoot(b *testing.B) {
+	attestation := new(phase0.Attestation)
+	err := attestation.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(attestationMainnetData),
+		uint64(len(attestationMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var htr common.Root
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = attestation.HashTreeRoot(specMainnet, tree.GetHashFn())
+	}
+	b.StopTimer()
+	if htr != attestationMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, attestationMainnetHTR)
+	}
+}
+
+func BenchmarkVoluntaryExitMainnet_Unmarshal(b *testing.B) {
+	var exit *phase0.SignedVoluntaryExit
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		exit = new(phase0.SignedVoluntaryExit)
+		err := exit.Deserialize(codec.NewDecodingReader(
+			bytes.NewReader(voluntaryExitMainnetData),
+			uint64(len(voluntaryExitMainnetData)),
+		))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := exit.HashTreeRoot(tree.GetHashFn())
+	if htr != voluntaryExitMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, voluntaryExitMainnetHTR)
+	}
+}
+
+func BenchmarkVoluntaryExitMainnet_Marshal(b *testing.B) {
+	exit := new(phase0.SignedVoluntaryExit)
+	err := exit.Deserialize(codec.NewDecodingReader(
+		bytes.NewReader(voluntaryExitMainnetData),
+		uint64(len(voluntaryExitMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var buf *bytes.Buffer
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = new(bytes.Buffer)
+		if err := exit.Serialize(codec.NewEncodingWriter(buf)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf.Bytes(), voluntaryExitMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkVoluntaryExitMainnet_HashTreeRoot(b *testing.B) {
+	exit := new(phase0.SignedVoluntaryExit)
+	err := exit.Deserialize(codec.NewDecodingReader(
+		bytes.NewReader(voluntaryExitMainnetData),
+		uint64(len(voluntaryExitMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var htr common.Root
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = exit.HashTreeRoot(tree.GetHashFn())
+	}
+	b.StopTimer()
+	if htr != voluntaryExitMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, voluntaryExitMainnetHTR)
+	}
+}
+
+func BenchmarkBLSChangeMainnet_Unmarshal(b *testing.B) {
+	var change *common.SignedBLSToExecutionChange
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		change = new(common.SignedBLSToExecutionChange)
+		err := change.Deserialize(codec.NewDecodingReader(
+			bytes.NewReader(blsChangeMainnetData),
+			uint64(len(blsChangeMainnetData)),
+		))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := change.HashTreeRoot(tree.GetHashFn())
+	if htr != blsChangeMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blsChangeMainnetHTR)
+	}
+}
+
+func BenchmarkBLSChangeMainnet_Marshal(b *testing.B) {
+	change := new(common.SignedBLSToExecutionChange)
+	err := change.Deserialize(codec.NewDecodingReader(
+		bytes.NewReader(blsChangeMainnetData),
+		uint64(len(blsChangeMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var buf *bytes.Buffer
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = new(bytes.Buffer)
+		if err := change.Serialize(codec.NewEncodingWriter(buf)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf.Bytes(), blsChangeMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBLSChangeMainnet_HashTreeRoot(b *testing.B) {
+	change := new(common.SignedBLSToExecutionChange)
+	err := change.Deserialize(codec.NewDecodingReader(
+		bytes.NewReader(blsChangeMainnetData),
+		uint64(len(blsChangeMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var htr common.Root
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = change.HashTreeRoot(tree.GetHashFn())
+	}
+	b.StopTimer()
+	if htr != blsChangeMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blsChangeMainnetHTR)
+	}
+}
+
+func BenchmarkSyncCommitteeMainnet_Unmarshal(b *testing.B) {
+	var committee *common.SyncCommittee
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		committee = new(common.SyncCommittee)
+		err := committee.Deserialize(specMainnet, codec.NewDecodingReader(
+			bytes.NewReader(syncCommitteeMainnetData),
+			uint64(len(syncCommitteeMainnetData)),
+		))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := committee.HashTreeRoot(specMainnet, tree.GetHashFn())
+	if htr != syncCommitteeMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, syncCommitteeMainnetHTR)
+	}
+}
+
+func BenchmarkSyncCommitteeMainnet_Marshal(b *testing.B) {
+	committee := new(common.SyncCommittee)
+	err := committee.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(syncCommitteeMainnetData),
+		uint64(len(syncCommitteeMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var buf *bytes.Buffer
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = new(bytes.Buffer)
+		if err := committee.Serialize(specMainnet, codec.NewEncodingWriter(buf)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf.Bytes(), syncCommitteeMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkSyncCommitteeMainnet_HashTreeRoot(b *testing.B) {
+	committee := new(common.SyncCommittee)
+	err := committee.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(syncCommitteeMainnetData),
+		uint64(len(syncCommitteeMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var htr common.Root
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = committee.HashTreeRoot(specMainnet, tree.GetHashFn())
+	}
+	b.StopTimer()
+	if htr != syncCommitteeMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, syncCommitteeMainnetHTR)
+	}
+}
+
+func BenchmarkExecutionPayloadMainnet_Unmarshal(b *testing.B) {
+	var payload *deneb.ExecutionPayload
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		payload = new(deneb.ExecutionPayload)
+		err := payload.Deserialize(specMainnet, codec.NewDecodingReader(
+			bytes.NewReader(executionPayloadMainnetData),
+			uint64(len(executionPayloadMainnetData)),
+		))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := payload.HashTreeRoot(specMainnet, tree.GetHashFn())
+	if htr != executionPayloadMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, executionPayloadMainnetHTR)
+	}
+}
+
+func BenchmarkExecutionPayloadMainnet_Marshal(b *testing.B) {
+	payload := new(deneb.ExecutionPayload)
+	err := payload.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(executionPayloadMainnetData),
+		uint64(len(executionPayloadMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var buf *bytes.Buffer
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = new(bytes.Buffer)
+		if err := payload.Serialize(specMainnet, codec.NewEncodingWriter(buf)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf.Bytes(), executionPayloadMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkExecutionPayloadMainnet_HashTreeRoot(b *testing.B) {
+	payload := new(deneb.ExecutionPayload)
+	err := payload.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(executionPayloadMainnetData),
+		uint64(len(executionPayloadMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var htr common.Root
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = payload.HashTreeRoot(specMainnet, tree.GetHashFn())
+	}
+	b.StopTimer()
+	if htr != executionPayloadMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, executionPayloadMainnetHTR)
+	}
+}
+
+func BenchmarkValidatorMainnet_Unmarshal(b *testing.B) {
+	var validator *phase0.Validator
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		validator = new(phase0.Validator)
+		err := validator.Deserialize(codec.NewDecodingReader(
+			bytes.NewReader(validatorMainnetData),
+			uint64(len(validatorMainnetData)),
+		))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := validator.HashTreeRoot(tree.GetHashFn())
+	if htr != validatorMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, validatorMainnetHTR)
+	}
+}
+
+func BenchmarkValidatorMainnet_Marshal(b *testing.B) {
+	validator := new(phase0.Validator)
+	err := validator.Deserialize(codec.NewDecodingReader(
+		bytes.NewReader(validatorMainnetData),
+		uint64(len(validatorMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var buf *bytes.Buffer
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = new(bytes.Buffer)
+		if err := validator.Serialize(codec.NewEncodingWriter(buf)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf.Bytes(), validatorMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkValidatorMainnet_HashTreeRoot(b *testing.B) {
+	validator := new(phase0.Validator)
+	err := validator.Deserialize(codec.NewDecodingReader(
+		bytes.NewReader(validatorMainnetData),
+		uint64(len(validatorMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var htr common.Root
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = validator.HashTreeRoot(tree.GetHashFn())
+	}
+	b.StopTimer()
+	if htr != validatorMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, validatorMainnetHTR)
+	}
+}
+
+func BenchmarkBlobSidecarMainnet_Unmarshal(b *testing.B) {
+	var sidecar *BlobSidecar
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sidecar = new(BlobSidecar)
+		err := sidecar.Deserialize(codec.NewDecodingReader(
+			bytes.NewReader(blobSidecarMainnetData),
+			uint64(len(blobSidecarMainnetData)),
+		))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := sidecar.HashTreeRoot(tree.GetHashFn())
+	if htr != blobSidecarMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blobSidecarMainnetHTR)
+	}
+}
+
+func BenchmarkBlobSidecarMainnet_Marshal(b *testing.B) {
+	sidecar := new(BlobSidecar)
+	err := sidecar.Deserialize(codec.NewDecodingReader(
+		bytes.NewReader(blobSidecarMainnetData),
+		uint64(len(blobSidecarMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var buf *bytes.Buffer
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = new(bytes.Buffer)
+		if err := sidecar.Serialize(codec.NewEncodingWriter(buf)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf.Bytes(), blobSidecarMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlobSidecarMainnet_HashTreeRoot(b *testing.B) {
+	sidecar := new(BlobSidecar)
+	err := sidecar.Deserialize(codec.NewDecodingReader(
+		bytes.NewReader(blobSidecarMainnetData),
+		uint64(len(blobSidecarMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var htr common.Root
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = sidecar.HashTreeRoot(tree.GetHashFn())
+	}
+	b.StopTimer()
+	if htr != blobSidecarMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blobSidecarMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientBootstrapMainnet_Unmarshal(b *testing.B) {
+	var bootstrap *LightClientBootstrap
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bootstrap = new(LightClientBootstrap)
+		err := bootstrap.Deserialize(specMainnet, codec.NewDecodingReader(
+			bytes.NewReader(lightClientBootstrapMainnetData),
+			uint64(len(lightClientBootstrapMainnetData)),
+		))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := bootstrap.HashTreeRoot(specMainnet, tree.GetHashFn())
+	if htr != lightClientBootstrapMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientBootstrapMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientBootstrapMainnet_Marshal(b *testing.B) {
+	bootstrap := new(LightClientBootstrap)
+	err := bootstrap.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(lightClientBootstrapMainnetData),
+		uint64(len(lightClientBootstrapMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var buf *bytes.Buffer
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = new(bytes.Buffer)
+		if err := bootstrap.Serialize(specMainnet, codec.NewEncodingWriter(buf)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf.Bytes(), lightClientBootstrapMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkLightClientBootstrapMainnet_HashTreeRoot(b *testing.B) {
+	bootstrap := new(LightClientBootstrap)
+	err := bootstrap.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(lightClientBootstrapMainnetData),
+		uint64(len(lightClientBootstrapMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var htr common.Root
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = bootstrap.HashTreeRoot(specMainnet, tree.GetHashFn())
+	}
+	b.StopTimer()
+	if htr != lightClientBootstrapMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientBootstrapMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientUpdateMainnet_Unmarshal(b *testing.B) {
+	var update *LightClientUpdate
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		update = new(LightClientUpdate)
+		err := update.Deserialize(specMainnet, codec.NewDecodingReader(
+			bytes.NewReader(lightClientUpdateMainnetData),
+			uint64(len(lightClientUpdateMainnetData)),
+		))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := update.HashTreeRoot(specMainnet, tree.GetHashFn())
+	if htr != lightClientUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientUpdateMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientUpdateMainnet_Marshal(b *testing.B) {
+	update := new(LightClientUpdate)
+	err := update.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(lightClientUpdateMainnetData),
+		uint64(len(lightClientUpdateMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var buf *bytes.Buffer
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = new(bytes.Buffer)
+		if err := update.Serialize(specMainnet, codec.NewEncodingWriter(buf)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf.Bytes(), lightClientUpdateMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkLightClientUpdateMainnet_HashTreeRoot(b *testing.B) {
+	update := new(LightClientUpdate)
+	err := update.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(lightClientUpdateMainnetData),
+		uint64(len(lightClientUpdateMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var htr common.Root
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = update.HashTreeRoot(specMainnet, tree.GetHashFn())
+	}
+	b.StopTimer()
+	if htr != lightClientUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientUpdateMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientFinalityUpdateMainnet_Unmarshal(b *testing.B) {
+	var update *LightClientFinalityUpdate
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		update = new(LightClientFinalityUpdate)
+		err := update.Deserialize(specMainnet, codec.NewDecodingReader(
+			bytes.NewReader(lightClientFinalityUpdateMainnetData),
+			uint64(len(lightClientFinalityUpdateMainnetData)),
+		))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := update.HashTreeRoot(specMainnet, tree.GetHashFn())
+	if htr != lightClientFinalityUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientFinalityUpdateMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientFinalityUpdateMainnet_Marshal(b *testing.B) {
+	update := new(LightClientFinalityUpdate)
+	err := update.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(lightClientFinalityUpdateMainnetData),
+		uint64(len(lightClientFinalityUpdateMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var buf *bytes.Buffer
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = new(bytes.Buffer)
+		if err := update.Serialize(specMainnet, codec.NewEncodingWriter(buf)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf.Bytes(), lightClientFinalityUpdateMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkLightClientFinalityUpdateMainnet_HashTreeRoot(b *testing.B) {
+	update := new(LightClientFinalityUpdate)
+	err := update.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(lightClientFinalityUpdateMainnetData),
+		uint64(len(lightClientFinalityUpdateMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var htr common.Root
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = update.HashTreeRoot(specMainnet, tree.GetHashFn())
+	}
+	b.StopTimer()
+	if htr != lightClientFinalityUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientFinalityUpdateMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientOptimisticUpdateMainnet_Unmarshal(b *testing.B) {
+	var update *LightClientOptimisticUpdate
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		update = new(LightClientOptimisticUpdate)
+		err := update.Deserialize(specMainnet, codec.NewDecodingReader(
+			bytes.NewReader(lightClientOptimisticUpdateMainnetData),
+			uint64(len(lightClientOptimisticUpdateMainnetData)),
+		))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := update.HashTreeRoot(specMainnet, tree.GetHashFn())
+	if htr != lightClientOptimisticUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientOptimisticUpdateMainnetHTR)
+	}
+}
+
+func BenchmarkLightClientOptimisticUpdateMainnet_Marshal(b *testing.B) {
+	update := new(LightClientOptimisticUpdate)
+	err := update.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(lightClientOptimisticUpdateMainnetData),
+		uint64(len(lightClientOptimisticUpdateMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var buf *bytes.Buffer
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = new(bytes.Buffer)
+		if err := update.Serialize(specMainnet, codec.NewEncodingWriter(buf)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf.Bytes(), lightClientOptimisticUpdateMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkLightClientOptimisticUpdateMainnet_HashTreeRoot(b *testing.B) {
+	update := new(LightClientOptimisticUpdate)
+	err := update.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(lightClientOptimisticUpdateMainnetData),
+		uint64(len(lightClientOptimisticUpdateMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var htr common.Root
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = update.HashTreeRoot(specMainnet, tree.GetHashFn())
+	}
+	b.StopTimer()
+	if htr != lightClientOptimisticUpdateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, lightClientOptimisticUpdateMainnetHTR)
+	}
+}
+
+// ====================== BLOCK EDGE-CASE BENCHMARKS =======================
+
+// skipFullBitlist skips blocks zrnt cannot decode: it caps bitlists at
+// (limit+7)/8 bytes, one byte short of a full bitlist with its delimiter bit
+// (e.g. 257 bytes for a full 2048 bit aggregation bitlist).
+func skipFullBitlist(b *testing.B, err error) {
+	if strings.Contains(err.Error(), "bitlist is too big") {
+		b.Skipf("zrnt rejects full bitlists: %v", err)
+	}
+}
+
+func BenchmarkBlockEmptyMainnet_Unmarshal(b *testing.B) {
+	var block *deneb.SignedBeaconBlock
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(deneb.SignedBeaconBlock)
+		err := block.Deserialize(specMainnet, codec.NewDecodingReader(
+			bytes.NewReader(blockEmptyMainnetData),
+			uint64(len(blockEmptyMainnetData)),
+		))
+		if err != nil {
+			skipFullBitlist(b, err)
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := block.Message.HashTreeRoot(specMainnet, tree.GetHashFn())
+	if htr != blockEmptyMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockEmptyMainnetHTR)
+	}
+}
+
+func BenchmarkBlockEmptyMainnet_Marshal(b *testing.B) {
+	block := new(deneb.SignedBeaconBlock)
+	err := block.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(blockEmptyMainnetData),
+		uint64(len(blockEmptyMainnetData)),
+	))
+	if err != nil {
+		skipFullBitlist(b, err)
+		b.Fatal(err)
+	}
+
+	var buf *bytes.Buffer
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = new(bytes.Buffer)
+		if err := block.Serialize(specMainnet, codec.NewEncodingWriter(buf)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf.Bytes(), blockEmptyMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockEmptyMainnet_HashTreeRoot(b *testing.B) {
+	block := new(deneb.SignedBeaconBlock)
+	err := block.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(blockEmptyMainnetData),
+		uint64(len(blockEmptyMainnetData)),
+	))
+	if err != nil {
+		skipFullBitlist(b, err)
+		b.Fatal(err)
+	}
+
+	var htr common.Root
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = block.Message.HashTreeRoot(specMainnet, tree.GetHashFn())
+	}
+	b.StopTimer()
+	if htr != blockEmptyMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockEmptyMainnetHTR)
+	}
+}
+
+func BenchmarkBlockMaxMainnet_Unmarshal(b *testing.B) {
+	var block *deneb.SignedBeaconBlock
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(deneb.SignedBeaconBlock)
+		err := block.Deserialize(specMainnet, codec.NewDecodingReader(
+			bytes.NewReader(blockMaxMainnetData),
+			uint64(len(blockMaxMainnetData)),
+		))
+		if err != nil {
+			skipFullBitlist(b, err)
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := block.Message.HashTreeRoot(specMainnet, tree.GetHashFn())
+	if htr != blockMaxMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMaxMainnetHTR)
+	}
+}
+
+func BenchmarkBlockMaxMainnet_Marshal(b *testing.B) {
+	block := new(deneb.SignedBeaconBlock)
+	err := block.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(blockMaxMainnetData),
+		uint64(len(blockMaxMainnetData)),
+	))
+	if err != nil {
+		skipFullBitlist(b, err)
+		b.Fatal(err)
+	}
+
+	var buf *bytes.Buffer
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = new(bytes.Buffer)
+		if err := block.Serialize(specMainnet, codec.NewEncodingWriter(buf)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf.Bytes(), blockMaxMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockMaxMainnet_HashTreeRoot(b *testing.B) {
+	block := new(deneb.SignedBeaconBlock)
+	err := block.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(blockMaxMainnetData),
+		uint64(len(blockMaxMainnetData)),
+	))
+	if err != nil {
+		skipFullBitlist(b, err)
+		b.Fatal(err)
+	}
+
+	var htr common.Root
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = block.Message.HashTreeRoot(specMainnet, tree.GetHashFn())
+	}
+	b.StopTimer()
+	if htr != blockMaxMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMaxMainnetHTR)
+	}
+}
+
+func BenchmarkBlockBoundaryMainnet_Unmarshal(b *testing.B) {
+	var block *deneb.SignedBeaconBlock
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(deneb.SignedBeaconBlock)
+		err := block.Deserialize(specMainnet, codec.NewDecodingReader(
+			bytes.NewReader(blockBoundaryMainnetData),
+			uint64(len(blockBoundaryMainnetData)),
+		))
+		if err != nil {
+			skipFullBitlist(b, err)
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := block.Message.HashTreeRoot(specMainnet, tree.GetHashFn())
+	if htr != blockBoundaryMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockBoundaryMainnetHTR)
+	}
+}
+
+func BenchmarkBlockBoundaryMainnet_Marshal(b *testing.B) {
+	block := new(deneb.SignedBeaconBlock)
+	err := block.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(blockBoundaryMainnetData),
+		uint64(len(blockBoundaryMainnetData)),
+	))
+	if err != nil {
+		skipFullBitlist(b, err)
+		b.Fatal(err)
+	}
+
+	var buf *bytes.Buffer
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = new(bytes.Buffer)
+		if err := block.Serialize(specMainnet, codec.NewEncodingWriter(buf)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf.Bytes(), blockBoundaryMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockBoundaryMainnet_HashTreeRoot(b *testing.B) {
+	block := new(deneb.SignedBeaconBlock)
+	err := block.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(blockBoundaryMainnetData),
+		uint64(len(blockBoundaryMainnetData)),
+	))
+	if err != nil {
+		skipFullBitlist(b, err)
+		b.Fatal(err)
+	}
+
+	var htr common.Root
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = block.Message.HashTreeRoot(specMainnet, tree.GetHashFn())
+	}
+	b.StopTimer()
+	if htr != blockBoundaryMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockBoundaryMainnetHTR)
+	}
+}
+
+func BenchmarkBlockTypedMainnet_Unmarshal(b *testing.B) {
+	var block *deneb.SignedBeaconBlock
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(deneb.SignedBeaconBlock)
+		err := block.Deserialize(specMainnet, codec.NewDecodingReader(
+			bytes.NewReader(blockTypedMainnetData),
+			uint64(len(blockTypedMainnetData)),
+		))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := block.Message.HashTreeRoot(specMainnet, tree.GetHashFn())
+	if htr != blockTypedMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockTypedMainnetHTR)
+	}
+}
+
+func BenchmarkBlockTypedMainnet_Marshal(b *testing.B) {
+	block := new(deneb.SignedBeaconBlock)
+	err := block.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(blockTypedMainnetData),
+		uint64(len(blockTypedMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var buf *bytes.Buffer
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = new(bytes.Buffer)
+		if err := block.Serialize(specMainnet, codec.NewEncodingWriter(buf)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	if !bytes.Equal(buf.Bytes(), blockTypedMainnetData) {
+		b.Fatal("marshaled data does not match original")
+	}
+}
+
+func BenchmarkBlockTypedMainnet_HashTreeRoot(b *testing.B) {
+	block := new(deneb.SignedBeaconBlock)
+	err := block.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(blockTypedMainnetData),
+		uint64(len(blockTypedMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var htr common.Root
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		htr = block.Message.HashTreeRoot(specMainnet, tree.GetHashFn())
+	}
+	b.StopTimer()
+	if htr != blockTypedMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockTypedMainnetHTR)
+	}
+}
+
+// ======================= SNAPPY PIPELINE BENCHMARKS =======================
+//
+// Decompress+unmarshal and marshal+compress end-to-end, for the framed snappy
+// encoding of req/resp (_Snappy*) and the block encoding of gossip
+// (_SnappyBlock*). The framed variants decode from and encode to the snappy
+// stream directly (codec.NewDecodingReader / NewEncodingWriter).
+
+// checkSnappyFramed decompresses a framed snappy stream and compares it with
+// the uncompressed corpus.
+func checkSnappyFramed(b *testing.B, framed []byte, want []byte) {
+	data, err := io.ReadAll(snappy.NewReader(bytes.NewReader(framed)))
+	if err != nil {
+		b.Fatal(err)
+	}
+	if !bytes.Equal(data, want) {
+		b.Fatal("decompressed data does not match original")
+	}
+}
+
+// checkSnappyBlock decompresses a snappy block and compares it with the
+// uncompressed corpus.
+func checkSnappyBlock(b *testing.B, block []byte, want []byte) {
+	data, err := snappy.Decode(nil, block)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if !bytes.Equal(data, want) {
+		b.Fatal("decompressed data does not match original")
+	}
+}
+
+func BenchmarkBlockMainnet_SnappyUnmarshal(b *testing.B) {
+	var block *deneb.SignedBeaconBlock
+	reader := snappy.NewReader(nil)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(deneb.SignedBeaconBlock)
+		reader.Reset(bytes.NewReader(blockMainnetSnappyFramed))
+		if err := block.Deserialize(specMainnet, codec.NewDecodingReader(reader, uint64(len(blockMainnetData)))); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := block.Message.HashTreeRoot(specMainnet, tree.GetHashFn())
+	if htr != blockMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMainnetHTR)
+	}
+}
+
+func BenchmarkBlockMainnet_SnappyMarshal(b *testing.B) {
+	block := new(deneb.SignedBeaconBlock)
+	if err := block.Deserialize(specMainnet, codec.NewDecodingReader(bytes.NewReader(blockMainnetData), uint64(len(blockMainnetData)))); err != nil {
+		b.Fatal(err)
+	}
+	var buf bytes.Buffer
+	writer := snappy.NewBufferedWriter(nil)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+		writer.Reset(&buf)
+		if err := block.Serialize(specMainnet, codec.NewEncodingWriter(writer)); err != nil {
+			b.Fatal(err)
+		}
+		if err := writer.Close(); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	checkSnappyFramed(b, buf.Bytes(), blockMainnetData)
+}
+
+func BenchmarkBlockMainnet_SnappyBlockUnmarshal(b *testing.B) {
+	var block *deneb.SignedBeaconBlock
+	buf := make([]byte, len(blockMainnetData))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block = new(deneb.SignedBeaconBlock)
+		data, err := snappy.Decode(buf, blockMainnetSnappyBlock)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if err := block.Deserialize(specMainnet, codec.NewDecodingReader(bytes.NewReader(data), uint64(len(data)))); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := block.Message.HashTreeRoot(specMainnet, tree.GetHashFn())
+	if htr != blockMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMainnetHTR)
+	}
+}
+
+func BenchmarkBlockMainnet_SnappyBlockMarshal(b *testing.B) {
+	block := new(deneb.SignedBeaconBlock)
+	if err := block.Deserialize(specMainnet, codec.NewDecodingReader(bytes.NewReader(blockMainnetData), uint64(len(blockMainnetData)))); err != nil {
+		b.Fatal(err)
+	}
+	buf := make([]byte, snappy.MaxEncodedLen(len(blockMainnetData)))
+	var compressed []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		encoded := new(bytes.Buffer)
+		if err := block.Serialize(specMainnet, codec.NewEncodingWriter(encoded)); err != nil {
+			b.Fatal(err)
+		}
+		data := encoded.Bytes()
+		compressed = snappy.Encode(buf, data)
+	}
+	b.StopTimer()
+	checkSnappyBlock(b, compressed, blockMainnetData)
+}
+
+func BenchmarkStateMainnet_SnappyUnmarshal(b *testing.B) {
+	var state *deneb.BeaconState
+	reader := snappy.NewReader(nil)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		state = new(deneb.BeaconState)
+		reader.Reset(bytes.NewReader(stateMainnetSnappyFramed))
+		if err := state.Deserialize(specMainnet, codec.NewDecodingReader(reader, uint64(len(stateMainnetData)))); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := state.HashTreeRoot(specMainnet, tree.GetHashFn())
+	if htr != stateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, stateMainnetHTR)
+	}
+}
+
+func BenchmarkStateMainnet_SnappyMarshal(b *testing.B) {
+	state := new(deneb.BeaconState)
+	if err := state.Deserialize(specMainnet, codec.NewDecodingReader(bytes.NewReader(stateMainnetData), uint64(len(stateMainnetData)))); err != nil {
+		b.Fatal(err)
+	}
+	var buf bytes.Buffer
+	writer := snappy.NewBufferedWriter(nil)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+		writer.Reset(&buf)
+		if err := state.Serialize(specMainnet, codec.NewEncodingWriter(writer)); err != nil {
+			b.Fatal(err)
+		}
+		if err := writer.Close(); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	checkSnappyFramed(b, buf.Bytes(), stateMainnetData)
+}
+
+func BenchmarkStateMainnet_SnappyBlockUnmarshal(b *testing.B) {
+	var state *deneb.BeaconState
+	buf := make([]byte, len(stateMainnetData))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		state = new(deneb.BeaconState)
+		data, err := snappy.Decode(buf, stateMainnetSnappyBlock)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if err := state.Deserialize(specMainnet, codec.NewDecodingReader(bytes.NewReader(data), uint64(len(data)))); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	htr := state.HashTreeRoot(specMainnet, tree.GetHashFn())
+	if htr != stateMainnetHTR {
+		b.Fatalf("HTR mismatch: got %x, want %x", htr, stateMainnetHTR)
+	}
+}
+
+func BenchmarkStateMainnet_SnappyBlockMarshal(b *testing.B) {
+	state := new(deneb.BeaconState)
+	if err := state.Deserialize(specMainnet, codec.NewDecodingReader(bytes.NewReader(stateMainnetData), uint64(len(stateMainnetData)))); err != nil {
+		b.Fatal(err)
+	}
+	buf := make([]byte, snappy.MaxEncodedLen(len(stateMainnetData)))
+	var compressed []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		encoded := new(bytes.Buffer)
+		if err := state.Serialize(specMainnet, codec.NewEncodingWriter(encoded)); err != nil {
+			b.Fatal(err)
+		}
+		data := encoded.Bytes()
+		compressed = snappy.Encode(buf, data)
+	}
+	b.StopTimer()
+	checkSnappyBlock(b, compressed, stateMainnetData)
+}
+
+// =========================== ERA FILE BENCHMARKS ===========================
+//
+// Decode a whole era file (e2store records with snappy compressed blocks and
+// the boundary state) the way archive and sync tooling reads it. Records do
+// not store their uncompressed size, so each is decompressed into a reused
+// buffer and decoded from there.
+
+// eraManifest mirrors the manifest.json written by `generator era`
+type eraManifest struct {
+	Entries []*eraEntry `json:"entries"`
+}
+
+type eraEntry struct {
+	File         string `json:"file"`
+	Preset       string `json:"preset"`
+	Blocks       int    `json:"blocks"`
+	LastBlockHTR string `json:"last_block_htr"`
+	StateHTR     string `json:"state_htr"`
+}
+
+// runEra reads the era file of preset listed in res/era/manifest.json b.N
+// times and reports the decoded blocks per second, plus the peak heap growth
+// while reading it from an extra untimed pass. It skips when no era corpus
+// has been generated and returns the manifest entry for the HTR checks.
+func runEra(b *testing.B, preset string, decodeBlock func(data []byte) error, decodeState func(data []byte) error) *eraEntry {
+	manifestData, err := os.ReadFile("../../res/era/manifest.json")
+	if err != nil {
+		b.Skip("no era corpus (see scripts/generate-era.sh): " + err.Error())
+	}
+	var manifest eraManifest
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		b.Fatal(err)
+	}
+	var entry *eraEntry
+	for _, e := range manifest.Entries {
+		if e.Preset == preset {
+			entry = e
+		}
+	}
+	if entry == nil {
+		b.Skip("no " + preset + " era in res/era/manifest.json")
+	}
+	path := "../../res/era/" + entry.File
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		blocks, err := readEra(path, decodeBlock, decodeState, nil)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if blocks != entry.Blocks {
+			b.Fatalf("decoded %d blocks, want %d", blocks, entry.Blocks)
+		}
+	}
+	b.StopTimer()
+	b.ReportMetric(float64(entry.Blocks*b.N)/b.Elapsed().Seconds(), "blocks/s")
+
+	var baseline, stats runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&baseline)
+	peak := baseline.HeapAlloc
+	_, err = readEra(path, decodeBlock, decodeState, func() {
+		runtime.ReadMemStats(&stats)
+		peak = max(peak, stats.HeapAlloc)
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportMetric(float64(peak-baseline.HeapAlloc), "peak-heap-B")
+	return entry
+}
+
+// readEra reads an era file record by record, decoding the compressed blocks
+// and the state and skipping all other records. sample, if set, runs after
+// every decoded record. It returns the number of blocks.
+func readEra(path string, decodeBlock func(data []byte) error, decodeState func(data []byte) error, sample func()) (int, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	reader := bufio.NewReaderSize(file, 1<<20)
+	snappyReader := snappy.NewReader(nil)
+	var header [8]byte
+	var buf bytes.Buffer
+	blocks := 0
+	for {
+		if _, err := io.ReadFull(reader, header[:]); err == io.EOF {
+			return blocks, nil
+		} else if err != nil {
+			return 0, err
+		}
+		length := int64(binary.LittleEndian.Uint32(header[2:6]))
+
+		var decode func(data []byte) error
+		switch {
+		case header[0] == 0x01 && header[1] == 0x00:
+			decode = decodeBlock
+			blocks++
+		case header[0] == 0x02 && header[1] == 0x00:
+			decode = decodeState
+		default:
+			if _, err := reader.Discard(int(length)); err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		snappyReader.Reset(io.LimitReader(reader, length))
+		buf.Reset()
+		if _, err := buf.ReadFrom(snappyReader); err != nil {
+			return 0, err
+		}
+		if err := decode(buf.Bytes()); err != nil {
+			return 0, err
+		}
+		if sample != nil {
+			sample()
+		}
+	}
+}
+
+func BenchmarkEraMainnet_Decode(b *testing.B) {
+	var block *deneb.SignedBeaconBlock
+	var state *deneb.BeaconState
+	era := runEra(b, "mainnet", func(data []byte) error {
+		block = new(deneb.SignedBeaconBlock)
+		return block.Deserialize(specMainnet, codec.NewDecodingReader(bytes.NewReader(data), uint64(len(data))))
+	}, func(data []byte) error {
+		state = new(deneb.BeaconState)
+		return state.Deserialize(specMainnet, codec.NewDecodingReader(bytes.NewReader(data), uint64(len(data))))
+	})
+	htr := block.Message.HashTreeRoot(specMainnet, tree.GetHashFn())
+	if hex.EncodeToString(htr[:]) != era.LastBlockHTR {
+		b.Fatalf("HTR mismatch: got %x, want %s", htr, era.LastBlockHTR)
+	}
+	htr = state.HashTreeRoot(specMainnet, tree.GetHashFn())
+	if hex.EncodeToString(htr[:]) != era.StateHTR {
+		b.Fatalf("HTR mismatch: got %x, want %s", htr, era.StateHTR)
+	}
+}
+
+// ========================= STATE SWEEP BENCHMARKS =========================
+
+// sweepManifest mirrors the manifest.json written by `generator sweep`
+type sweepManifest struct {
+	Entries []struct {
+		File       string `json:"file"`
+		Validators int    `json:"validators"`
+		Preset     string `json:"preset"`
+		Fork       string `json:"fork"`
+		HTR        string `json:"htr"`
+	} `json:"entries"`
+}
+
+// runSweep runs fn as a sub-benchmark for every mainnet deneb state listed in
+// res/sweep/manifest.json. It skips when no sweep has been generated.
+func runSweep(b *testing.B, fn func(b *testing.B, stateData []byte, stateHTR common.Root)) {
+	manifestData, err := os.ReadFile("../../res/sweep/manifest.json")
+	if err != nil {
+		b.Skip("no sweep corpus (see scripts/generate-sweep.sh): " + err.Error())
+	}
+	var manifest sweepManifest
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		b.Fatal(err)
+	}
+	for _, entry := range manifest.Entries {
+		if entry.Preset != "mainnet" || entry.Fork != "deneb" {
+			continue
+		}
+		stateData, err := os.ReadFile("../../res/sweep/" + entry.File)
+		if err != nil {
+			b.Fatal(err)
+		}
+		htrBytes, err := hex.DecodeString(entry.HTR)
+		if err != nil {
+			b.Fatal(err)
+		}
+		var stateHTR common.Root
+		copy(stateHTR[:], htrBytes)
+		b.Run(fmt.Sprintf("validators=%d", entry.Validators), func(b *testing.B) {
+			fn(b, stateData, stateHTR)
+		})
+	}
+}
+
+func BenchmarkStateSweep_Unmarshal(b *testing.B) {
+	runSweep(b, func(b *testing.B, stateData []byte, stateHTR common.Root) {
+		var state *deneb.BeaconState
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			state = new(deneb.BeaconState)
+			err := state.Deserialize(specMainnet, codec.NewDecodingReader(
+				bytes.NewReader(stateData),
+				uint64(len(stateData)),
+			))
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.StopTimer()
+		htr := state.HashTreeRoot(specMainnet, tree.GetHashFn())
+		if htr != stateHTR {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, stateHTR)
+		}
+	})
+}
+
+func BenchmarkStateSweep_Marshal(b *testing.B) {
+	runSweep(b, func(b *testing.B, stateData []byte, stateHTR common.Root) {
+		state := new(deneb.BeaconState)
+		err := state.Deserialize(specMainnet, codec.NewDecodingReader(
+			bytes.NewReader(stateData),
+			uint64(len(stateData)),
+		))
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		var buf *bytes.Buffer
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			buf = new(bytes.Buffer)
+
+			if err := state.Serialize(specMainnet, codec.NewEncodingWriter(buf)); err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.StopTimer()
+		if !bytes.Equal(buf.Bytes(), stateData) {
+			b.Fatal("marshaled data does not match original")
+		}
+	})
+}
+
+func BenchmarkStateSweep_HashTreeRoot(b *testing.B) {
+	runSweep(b, func(b *testing.B, stateData []byte, stateHTR common.Root) {
+		state := new(deneb.BeaconState)
+		err := state.Deserialize(specMainnet, codec.NewDecodingReader(
+			bytes.NewReader(stateData),
+			uint64(len(stateData)),
+		))
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		var htr common.Root
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			htr = state.HashTreeRoot(specMainnet, tree.GetHashFn())
+		}
+		b.StopTimer()
+		if htr != stateHTR {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, stateHTR)
+		}
+	})
+}
+
+// ======================= STATE SEQUENCE BENCHMARKS =======================
+
+// sequenceManifest mirrors the manifest.json written by `generator sequence`
+type sequenceManifest struct {
+	Entries []struct {
+		File   string `json:"file"`
+		Step   int    `json:"step"`
+		Preset string `json:"preset"`
+		Fork   string `json:"fork"`
+		HTR    string `json:"htr"`
+	} `json:"entries"`
+}
+
+// loadSequence loads the mainnet deneb states listed in
+// res/sequence/manifest.json in step order, together with their HTRs. Every
+// state differs from its predecessor by one slot of changes. It skips when no
+// sequence has been generated.
+func loadSequence(b *testing.B) ([][]byte, []common.Root) {
+	manifestData, err := os.ReadFile("../../res/sequence/manifest.json")
+	if err != nil {
+		b.Skip("no state sequence (see scripts/generate-sequence.sh): " + err.Error())
+	}
+	var manifest sequenceManifest
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		b.Fatal(err)
+	}
+	var stepData [][]byte
+	var stepHTRs []common.Root
+	for _, entry := range manifest.Entries {
+		if entry.Preset != "mainnet" || entry.Fork != "deneb" {
+			continue
+		}
+		if entry.Step != len(stepData) {
+			b.Fatalf("sequence step %d out of order", entry.Step)
+		}
+		data, err := os.ReadFile("../../res/sequence/" + entry.File)
+		if err != nil {
+			b.Fatal(err)
+		}
+		htrBytes, err := hex.DecodeString(entry.HTR)
+		if err != nil {
+			b.Fatal(err)
+		}
+		var htr common.Root
+		copy(htr[:], htrBytes)
+		stepData = append(stepData, data)
+		stepHTRs = append(stepHTRs, htr)
+	}
+	if len(stepData) < 2 {
+		b.Skip("no mainnet state sequence in res/sequence/manifest.json")
+	}
+	return stepData, stepHTRs
+}
+
+// BenchmarkStateSequence_HashTreeRoot re-roots the successor states one after
+// the other. The plain structs keep no hash cache, so every state is hashed
+// from scratch; _HashTreeRootCached uses the tree-backed view instead.
+func BenchmarkStateSequence_HashTreeRoot(b *testing.B) {
+	stepData, stepHTRs := loadSequence(b)
+	states := make([]*deneb.BeaconState, len(stepData))
+	for step, data := range stepData {
+		states[step] = new(deneb.BeaconState)
+		if err := states[step].Deserialize(specMainnet, codec.NewDecodingReader(bytes.NewReader(data), uint64(len(data)))); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		step := 1 + i%(len(states)-1)
+		htr := states[step].HashTreeRoot(specMainnet, tree.GetHashFn())
+		if htr != stepHTRs[step] {
+			b.Fatalf("step %d HTR mismatch: got %x, want %x", step, htr, stepHTRs[step])
+		}
+	}
+}
+
+// BenchmarkStateSequence_HashTreeRootCached applies the changes of each step
+// to a tree-backed state view and re-roots it, which only re-hashes the paths
+// to the changed leaves. The view is reset to the base state between rounds.
+func BenchmarkStateSequence_HashTreeRootCached(b *testing.B) {
+	stepData, stepHTRs := loadSequence(b)
+	states := make([]*deneb.BeaconState, len(stepData))
+	for step, data := range stepData {
+		states[step] = new(deneb.BeaconState)
+		if err := states[step].Deserialize(specMainnet, codec.NewDecodingReader(bytes.NewReader(data), uint64(len(data)))); err != nil {
+			b.Fatal(err)
+		}
+	}
+	diffs := make([]*stateDiff, len(states))
+	for step := 1; step < len(states); step++ {
+		diffs[step] = diffStates(states[step-1], states[step])
+	}
+
+	base, err := deneb.AsBeaconStateView(deneb.BeaconStateType(specMainnet).Deserialize(
+		codec.NewDecodingReader(bytes.NewReader(stepData[0]), uint64(len(stepData[0]))),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+	// Fill the hash cache, as a node has after rooting the previous slot
+	base.HashTreeRoot(tree.GetHashFn())
+
+	var view *deneb.BeaconStateView
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		step := 1 + i%(len(states)-1)
+		if step == 1 {
+			b.StopTimer()
+			view, err = deneb.AsBeaconStateView(base.Copy())
+			if err != nil {
+				b.Fatal(err)
+			}
+			b.StartTimer()
+		}
+		if err := diffs[step].apply(view); err != nil {
+			b.Fatal(err)
+		}
+		htr := view.HashTreeRoot(tree.GetHashFn())
+		if htr != stepHTRs[step] {
+			b.Fatalf("step %d HTR mismatch: got %x, want %x", step, htr, stepHTRs[step])
+		}
+	}
+}
+
+type rootChange struct {
+	index int
+	root  common.Root
+}
+
+// stateDiff holds the fields a sequence step changes, see `generator sequence`
+type stateDiff struct {
+	slot          common.Slot
+	blockRoots    []rootChange
+	stateRoots    []rootChange
+	randaoMixes   []rootChange
+	balances      map[common.ValidatorIndex]common.Gwei
+	participation map[common.ValidatorIndex]altair.ParticipationFlags
+}
+
+func diffStates(prev, next *deneb.BeaconState) *stateDiff {
+	diff := &stateDiff{
+		slot:          next.Slot,
+		blockRoots:    diffRoots(prev.BlockRoots, next.BlockRoots),
+		stateRoots:    diffRoots(prev.StateRoots, next.StateRoots),
+		randaoMixes:   diffRoots(prev.RandaoMixes, next.RandaoMixes),
+		balances:      make(map[common.ValidatorIndex]common.Gwei),
+		participation: make(map[common.ValidatorIndex]altair.ParticipationFlags),
+	}
+	for i, balance := range next.Balances {
+		if balance != prev.Balances[i] {
+			diff.balances[common.ValidatorIndex(i)] = balance
+		}
+	}
+	for i, flags := range next.CurrentEpochParticipation {
+		if flags != prev.CurrentEpochParticipation[i] {
+			diff.participation[common.ValidatorIndex(i)] = flags
+		}
+	}
+	return diff
+}
+
+func diffRoots(prev, next []common.Root) []rootChange {
+	var changes []rootChange
+	for i, root := range next {
+		if root != prev[i] {
+			changes = append(changes, rootChange{index: i, root: root})
+		}
+	}
+	return changes
+}
+
+// apply writes the changes through the view setters
+func (d *stateDiff) apply(state *deneb.BeaconStateView) error {
+	if err := state.SetSlot(d.slot); err != nil {
+		return err
+	}
+	blockRoots, err := state.BlockRoots()
+	if err != nil {
+		return err
+	}
+	for _, change := range d.blockRoots {
+		if err := blockRoots.SetRoot(common.Slot(change.index), change.root); err != nil {
+			return err
+		}
+	}
+	stateRoots, err := state.StateRoots()
+	if err != nil {
+		return err
+	}
+	for _, change := range d.stateRoots {
+		if err := stateRoots.SetRoot(common.Slot(change.index), change.root); err != nil {
+			return err
+		}
+	}
+	randaoMixes, err := state.RandaoMixes()
+	if err != nil {
+		return err
+	}
+	for _, change := range d.randaoMixes {
+		if err := randaoMixes.SetRandomMix(common.Epoch(change.index), change.root); err != nil {
+			return err
+		}
+	}
+	balances, err := state.Balances()
+	if err != nil {
+		return err
+	}
+	for index, balance := range d.balances {
+		if err := balances.SetBalance(index, balance); err != nil {
+			return err
+		}
+	}
+	participation, err := state.CurrentEpochParticipation()
+	if err != nil {
+		return err
+	}
+	for index, flags := range d.participation {
+		if err := participation.SetFlags(index, flags); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ===================== STATE REHASH MAINNET BENCHMARKS ======================
+//
+// The rehash benchmarks apply one of the updates in res/rehash-mainnet.json
+// to the mainnet state and re-root it, which is the work behind the state root
+// of every slot. Iterations alternate between the updated and the original
+// value, so every root follows a change.
+// The state is a tree-backed view whose hash cache is filled before the timer
+// starts, as a node has after rooting the previous slot, so only the path from
+// the changed leaf to the root is re-hashed.
+
+// rehashManifest mirrors the rehash-mainnet.json written by `generator rehash`
+type rehashManifest struct {
+	HTR     string `json:"htr"`
+	Balance struct {
+		Index   uint64 `json:"index"`
+		Balance uint64 `json:"balance"`
+		HTR     string `json:"htr"`
+	} `json:"balance"`
+	BlockRoot struct {
+		Index uint64 `json:"index"`
+		Root  string `json:"root"`
+		HTR   string `json:"htr"`
+	} `json:"block_root"`
+	Validator struct {
+		Index             uint64 `json:"index"`
+		ExitEpoch         uint64 `json:"exit_epoch"`
+		WithdrawableEpoch uint64 `json:"withdrawable_epoch"`
+		HTR               string `json:"htr"`
+	} `json:"validator"`
+}
+
+// loadRehash loads res/rehash-mainnet.json and checks that it describes the
+// state-mainnet.ssz corpus.
+func loadRehash(b *testing.B) *rehashManifest {
+	data, err := os.ReadFile("../../res/rehash-mainnet.json")
+	if err != nil {
+		b.Fatal(err)
+	}
+	var manifest rehashManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		b.Fatal(err)
+	}
+	if htr := rehashRoot(b, manifest.HTR); htr != stateMainnetHTR {
+		b.Fatalf("rehash-mainnet.json is for state %x, want %x", htr, stateMainnetHTR)
+	}
+	return &manifest
+}
+
+func rehashRoot(b *testing.B, s string) common.Root {
+	rootBytes, err := hex.DecodeString(s)
+	if err != nil {
+		b.Fatal(err)
+	}
+	var root common.Root
+	copy(root[:], rootBytes)
+	return root
+}
+
+// loadRehashView decodes the mainnet state into a tree-backed view and fills
+// its hash cache.
+func loadRehashView(b *testing.B) *deneb.BeaconStateView {
+	view, err := deneb.AsBeaconStateView(deneb.BeaconStateType(specMainnet).Deserialize(
+		codec.NewDecodingReader(bytes.NewReader(stateMainnetData), uint64(len(stateMainnetData))),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+	view.HashTreeRoot(tree.GetHashFn())
+	return view
+}
+
+func BenchmarkStateMainnet_RehashAfterBalanceUpdate(b *testing.B) {
+	update := loadRehash(b).Balance
+	view := loadRehashView(b)
+	index := common.ValidatorIndex(update.Index)
+	balances, err := view.Balances()
+	if err != nil {
+		b.Fatal(err)
+	}
+	balance, err := balances.GetBalance(index)
+	if err != nil {
+		b.Fatal(err)
+	}
+	values := [2]common.Gwei{common.Gwei(update.Balance), balance}
+	htrs := [2]common.Root{rehashRoot(b, update.HTR), stateMainnetHTR}
+	hFn := tree.GetHashFn()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := balances.SetBalance(index, values[i%2]); err != nil {
+			b.Fatal(err)
+		}
+		htr := view.HashTreeRoot(hFn)
+		if htr != htrs[i%2] {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, htrs[i%2])
+		}
+	}
+}
+
+func BenchmarkStateMainnet_RehashAfterBlockRootsUpdate(b *testing.B) {
+	update := loadRehash(b).BlockRoot
+	view := loadRehashView(b)
+	slot := common.Slot(update.Index)
+	blockRoots, err := view.BlockRoots()
+	if err != nil {
+		b.Fatal(err)
+	}
+	root, err := blockRoots.GetRoot(slot)
+	if err != nil {
+		b.Fatal(err)
+	}
+	roots := [2]common.Root{rehashRoot(b, update.Root), root}
+	htrs := [2]common.Root{rehashRoot(b, update.HTR), stateMainnetHTR}
+	hFn := tree.GetHashFn()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := blockRoots.SetRoot(slot, roots[i%2]); err != nil {
+			b.Fatal(err)
+		}
+		htr := view.HashTreeRoot(hFn)
+		if htr != htrs[i%2] {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, htrs[i%2])
+		}
+	}
+}
+
+func BenchmarkStateMainnet_RehashAfterValidatorUpdate(b *testing.B) {
+	update := loadRehash(b).Validator
+	view := loadRehashView(b)
+	validators, err := view.Validators()
+	if err != nil {
+		b.Fatal(err)
+	}
+	validator, err := validators.Validator(common.ValidatorIndex(update.Index))
+	if err != nil {
+		b.Fatal(err)
+	}
+	exitEpoch, err := validator.ExitEpoch()
+	if err != nil {
+		b.Fatal(err)
+	}
+	withdrawableEpoch, err := validator.WithdrawableEpoch()
+	if err != nil {
+		b.Fatal(err)
+	}
+	exitEpochs := [2]common.Epoch{common.Epoch(update.ExitEpoch), exitEpoch}
+	withdrawableEpochs := [2]common.Epoch{common.Epoch(update.WithdrawableEpoch), withdrawableEpoch}
+	htrs := [2]common.Root{rehashRoot(b, update.HTR), stateMainnetHTR}
+	hFn := tree.GetHashFn()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := validator.SetExitEpoch(exitEpochs[i%2]); err != nil {
+			b.Fatal(err)
+		}
+		if err := validator.SetWithdrawableEpoch(withdrawableEpochs[i%2]); err != nil {
+			b.Fatal(err)
+		}
+		htr := view.HashTreeRoot(hFn)
+		if htr != htrs[i%2] {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, htrs[i%2])
+		}
+	}
+}
+
+// ======================= MALFORMED BLOCK BENCHMARKS =======================
+
+// malformedManifest mirrors the manifest.json written by `generator malformed`
+type malformedManifest struct {
+	Entries []struct {
+		File    string `json:"file"`
+		Source  string `json:"source"`
+		Variant string `json:"variant"`
+		Class   string `json:"class"`
+	} `json:"entries"`
+}
+
+// runMalformed runs a sub-benchmark for every malformed variant of source
+// listed in res/malformed/manifest.json, asserting that decode rejects it
+// with an error rather than accepting it or panicking.
+func runMalformed(b *testing.B, source string, decode func(data []byte) error) {
+	manifestData, err := os.ReadFile("../../res/malformed/manifest.json")
+	if err != nil {
+		b.Skip("no malformed corpus (see scripts/generate-corpus.sh): " + err.Error())
+	}
+	var manifest malformedManifest
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		b.Fatal(err)
+	}
+	for _, entry := range manifest.Entries {
+		if entry.Source != source {
+			continue
+		}
+		data, err := os.ReadFile("../../res/malformed/" + entry.File)
+		if err != nil {
+			b.Fatal(err)
+		}
+		class := entry.Class
+		b.Run(entry.Variant, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				rejectMalformed(b, class, decode, data)
+			}
+		})
+	}
+}
+
+// rejectMalformed fails the benchmark unless decode returns an error for data
+func rejectMalformed(b *testing.B, class string, decode func(data []byte) error, data []byte) {
+	defer func() {
+		if r := recover(); r != nil {
+			b.Fatalf("panicked on malformed input (%s): %v", class, r)
+		}
+	}()
+	if err := decode(data); err == nil {
+		b.Fatalf("accepted malformed input (%s)", class)
+	}
+}
+
+func BenchmarkBlockMalformed_Unmarshal(b *testing.B) {
+	runMalformed(b, "block-mainnet.ssz", func(data []byte) error {
+		return new(deneb.SignedBeaconBlock).Deserialize(specMainnet, codec.NewDecodingReader(
+			bytes.NewReader(data),
+			uint64(len(data)),
+		))
+	})
+}
+
+// ======================== PARALLEL MAINNET BENCHMARKS ========================
+//
+// The parallel benchmarks run the block and attestation operations on
+// GOMAXPROCS goroutines at once, as a beacon node does, and expose contention
+// in shared hasher pools and global state. ns/op is wall time per operation
+// over all goroutines. run-benchmarks.sh runs them unpinned with -cpu 1,2,4,8.
+
+// parallelResults collects the last result of every RunParallel goroutine, so
+// that they can be checked once the timer is stopped
+type parallelResults[T any] struct {
+	mu     sync.Mutex
+	values []T
+}
+
+func (r *parallelResults[T]) add(value T) {
+	r.mu.Lock()
+	r.values = append(r.values, value)
+	r.mu.Unlock()
+}
+
+func BenchmarkBlockMainnet_UnmarshalParallel(b *testing.B) {
+	var results parallelResults[*deneb.SignedBeaconBlock]
+	b.RunParallel(func(pb *testing.PB) {
+		var block *deneb.SignedBeaconBlock
+		for pb.Next() {
+			block = new(deneb.SignedBeaconBlock)
+			err := block.Deserialize(specMainnet, codec.NewDecodingReader(
+				bytes.NewReader(blockMainnetData),
+				uint64(len(blockMainnetData)),
+			))
+			if err != nil {
+				b.Error(err)
+				return
+			}
+		}
+		results.add(block)
+	})
+	b.StopTimer()
+	for _, block := range results.values {
+		if block == nil {
+			continue // no iterations on that goroutine
+		}
+		htr := block.Message.HashTreeRoot(specMainnet, tree.GetHashFn())
+		if htr != blockMainnetHTR {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMainnetHTR)
+		}
+	}
+}
+
+func BenchmarkBlockMainnet_MarshalParallel(b *testing.B) {
+	block := new(deneb.SignedBeaconBlock)
+	err := block.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(blockMainnetData),
+		uint64(len(blockMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var results parallelResults[[]byte]
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var data []byte
+		for pb.Next() {
+			buf := new(bytes.Buffer)
+			if err := block.Serialize(specMainnet, codec.NewEncodingWriter(buf)); err != nil {
+				b.Error(err)
+				return
+			}
+			data = buf.Bytes()
+		}
+		results.add(data)
+	})
+	b.StopTimer()
+	for _, data := range results.values {
+		if data != nil && !bytes.Equal(data, blockMainnetData) {
+			b.Fatal("marshaled data does not match original")
+		}
+	}
+}
+
+func BenchmarkBlockMainnet_HashTreeRootParallel(b *testing.B) {
+	block := new(deneb.SignedBeaconBlock)
+	err := block.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(blockMainnetData),
+		uint64(len(blockMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var results parallelResults[common.Root]
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var htr common.Root
+		for pb.Next() {
+			htr = block.Message.HashTreeRoot(specMainnet, tree.GetHashFn())
+		}
+		results.add(htr)
+	})
+	b.StopTimer()
+	for _, htr := range results.values {
+		if htr != (common.Root{}) && htr != blockMainnetHTR {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, blockMainnetHTR)
+		}
+	}
+}
+
+func BenchmarkAttestationMainnet_UnmarshalParallel(b *testing.B) {
+	var results parallelResults[*phase0.Attestation]
+	b.RunParallel(func(pb *testing.PB) {
+		var attestation *phase0.Attestation
+		for pb.Next() {
+			attestation = new(phase0.Attestation)
+			err := attestation.Deserialize(specMainnet, codec.NewDecodingReader(
+				bytes.NewReader(attestationMainnetData),
+				uint64(len(attestationMainnetData)),
+			))
+			if err != nil {
+				b.Error(err)
+				return
+			}
+		}
+		results.add(attestation)
+	})
+	b.StopTimer()
+	for _, attestation := range results.values {
+		if attestation == nil {
+			continue // no iterations on that goroutine
+		}
+		htr := attestation.HashTreeRoot(specMainnet, tree.GetHashFn())
+		if htr != attestationMainnetHTR {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, attestationMainnetHTR)
+		}
+	}
+}
+
+func BenchmarkAttestationMainnet_MarshalParallel(b *testing.B) {
+	attestation := new(phase0.Attestation)
+	err := attestation.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(attestationMainnetData),
+		uint64(len(attestationMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var results parallelResults[[]byte]
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var data []byte
+		for pb.Next() {
+			buf := new(bytes.Buffer)
+			if err := attestation.Serialize(specMainnet, codec.NewEncodingWriter(buf)); err != nil {
+				b.Error(err)
+				return
+			}
+			data = buf.Bytes()
+		}
+		results.add(data)
+	})
+	b.StopTimer()
+	for _, data := range results.values {
+		if data != nil && !bytes.Equal(data, attestationMainnetData) {
+			b.Fatal("marshaled data does not match original")
+		}
+	}
+}
+
+func BenchmarkAttestationMainnet_HashTreeRootParallel(b *testing.B) {
+	attestation := new(phase0.Attestation)
+	err := attestation.Deserialize(specMainnet, codec.NewDecodingReader(
+		bytes.NewReader(attestationMainnetData),
+		uint64(len(attestationMainnetData)),
+	))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var results parallelResults[common.Root]
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var htr common.Root
+		for pb.Next() {
+			htr = attestation.HashTreeRoot(specMainnet, tree.GetHashFn())
+		}
+		results.add(htr)
+	})
+	b.StopTimer()
+	for _, htr := range results.values {
+		if htr != (common.Root{}) && htr != attestationMainnetHTR {
+			b.Fatalf("HTR mismatch: got %x, want %x", htr, attestationMainnetHTR)
+		}
+	}
+}
@@ -0,0 +1,285 @@
+package ztyp
+
+import (
+	"github.com/protolambda/zrnt/eth2/beacon/altair"
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/protolambda/zrnt/eth2/beacon/deneb"
+	"github.com/protolambda/ztyp/codec"
+	"github.com/protolambda/ztyp/tree"
+	"github.com/protolambda/ztyp/view"
+)
+
+// zrnt has no blob sidecar, so it is defined here on top of the zrnt types,
+// the way zrnt defines its own fixed-size containers (mainnet sizes).
+
+const (
+	// FIELD_ELEMENTS_PER_BLOB * BYTES_PER_FIELD_ELEMENT
+	blobSize = 4096 * 32
+	// KZG_COMMITMENT_INCLUSION_PROOF_DEPTH
+	kzgCommitmentInclusionProofDepth = 17
+	// floorlog2(EXECUTION_PAYLOAD_GINDEX)
+	executionBranchDepth = 4
+)
+
+// Blob is a deneb blob
+type Blob [blobSize]byte
+
+func (b *Blob) Deserialize(dr *codec.DecodingReader) error {
+	_, err := dr.Read(b[:])
+	return err
+}
+
+func (b *Blob) Serialize(w *codec.EncodingWriter) error {
+	return w.Write(b[:])
+}
+
+func (*Blob) ByteLength() uint64 {
+	return blobSize
+}
+
+func (*Blob) FixedLength() uint64 {
+	return blobSize
+}
+
+func (b *Blob) HashTreeRoot(hFn tree.HashFn) common.Root {
+	return hFn.ByteVectorHTR(b[:])
+}
+
+// KZGCommitmentInclusionProof is the branch of a blob's commitment in the
+// block body
+type KZGCommitmentInclusionProof [kzgCommitmentInclusionProofDepth]common.Root
+
+func (p *KZGCommitmentInclusionProof) Deserialize(dr *codec.DecodingReader) error {
+	return dr.Vector(func(i uint64) codec.Deserializable {
+		return &p[i]
+	}, 32, kzgCommitmentInclusionProofDepth)
+}
+
+func (p *KZGCommitmentInclusionProof) Serialize(w *codec.EncodingWriter) error {
+	return w.Vector(func(i uint64) codec.Serializable {
+		return &p[i]
+	}, 32, kzgCommitmentInclusionProofDepth)
+}
+
+func (*KZGCommitmentInclusionProof) ByteLength() uint64 {
+	return 32 * kzgCommitmentInclusionProofDepth
+}
+
+func (*KZGCommitmentInclusionProof) FixedLength() uint64 {
+	return 32 * kzgCommitmentInclusionProofDepth
+}
+
+func (p *KZGCommitmentInclusionProof) HashTreeRoot(hFn tree.HashFn) common.Root {
+	return hFn.ComplexVectorHTR(func(i uint64) tree.HTR {
+		return &p[i]
+	}, kzgCommitmentInclusionProofDepth)
+}
+
+// BlobSidecar represents a blob sidecar (Deneb). The KZG proof is a 48 byte
+// vector like the commitment, zrnt has no type of its own for it.
+type BlobSidecar struct {
+	Index                       view.Uint64View
+	Blob                        Blob
+	KZGCommitment               common.KZGCommitment
+	KZGProof                    common.KZGCommitment
+	SignedBlockHeader           common.SignedBeaconBlockHeader
+	KZGCommitmentInclusionProof KZGCommitmentInclusionProof
+}
+
+func (s *BlobSidecar) Deserialize(dr *codec.DecodingReader) error {
+	return dr.FixedLenContainer(&s.Index, &s.Blob, &s.KZGCommitment, &s.KZGProof, &s.SignedBlockHeader, &s.KZGCommitmentInclusionProof)
+}
+
+func (s *BlobSidecar) Serialize(w *codec.EncodingWriter) error {
+	return w.FixedLenContainer(s.Index, &s.Blob, &s.KZGCommitment, &s.KZGProof, &s.SignedBlockHeader, &s.KZGCommitmentInclusionProof)
+}
+
+func (s *BlobSidecar) ByteLength() uint64 {
+	return s.FixedLength()
+}
+
+func (s *BlobSidecar) FixedLength() uint64 {
+	return 8 + blobSize + common.KZGCommitmentSize*2 + s.SignedBlockHeader.FixedLength() + s.KZGCommitmentInclusionProof.FixedLength()
+}
+
+func (s *BlobSidecar) HashTreeRoot(hFn tree.HashFn) common.Root {
+	return hFn.HashTreeRoot(s.Index, &s.Blob, s.KZGCommitment, s.KZGProof, &s.SignedBlockHeader, &s.KZGCommitmentInclusionProof)
+}
+
+// zrnt only has the altair light client update over plain block headers, the
+// capella+ light client objects below use its sync committee and finality
+// branches.
+
+// ExecutionBranch is the branch of the execution payload in the block body
+type ExecutionBranch [executionBranchDepth]common.Root
+
+func (b *ExecutionBranch) Deserialize(dr *codec.DecodingReader) error {
+	return dr.Vector(func(i uint64) codec.Deserializable {
+		return &b[i]
+	}, 32, executionBranchDepth)
+}
+
+func (b *ExecutionBranch) Serialize(w *codec.EncodingWriter) error {
+	return w.Vector(func(i uint64) codec.Serializable {
+		return &b[i]
+	}, 32, executionBranchDepth)
+}
+
+func (*ExecutionBranch) ByteLength() uint64 {
+	return 32 * executionBranchDepth
+}
+
+func (*ExecutionBranch) FixedLength() uint64 {
+	return 32 * executionBranchDepth
+}
+
+func (b *ExecutionBranch) HashTreeRoot(hFn tree.HashFn) common.Root {
+	return hFn.ComplexVectorHTR(func(i uint64) tree.HTR {
+		return &b[i]
+	}, executionBranchDepth)
+}
+
+// LightClientHeader represents a light client header (Deneb)
+type LightClientHeader struct {
+	Beacon          common.BeaconBlockHeader
+	Execution       deneb.ExecutionPayloadHeader
+	ExecutionBranch ExecutionBranch
+}
+
+func (h *LightClientHeader) Deserialize(dr *codec.DecodingReader) error {
+	return dr.Container(&h.Beacon, &h.Execution, &h.ExecutionBranch)
+}
+
+func (h *LightClientHeader) Serialize(w *codec.EncodingWriter) error {
+	return w.Container(&h.Beacon, &h.Execution, &h.ExecutionBranch)
+}
+
+func (h *LightClientHeader) ByteLength() uint64 {
+	return codec.ContainerLength(&h.Beacon, &h.Execution, &h.ExecutionBranch)
+}
+
+func (*LightClientHeader) FixedLength() uint64 {
+	return 0
+}
+
+func (h *LightClientHeader) HashTreeRoot(hFn tree.HashFn) common.Root {
+	return hFn.HashTreeRoot(&h.Beacon, &h.Execution, &h.ExecutionBranch)
+}
+
+// LightClientBootstrap represents a light client bootstrap (Deneb)
+type LightClientBootstrap struct {
+	Header                     LightClientHeader
+	CurrentSyncCommittee       common.SyncCommittee
+	CurrentSyncCommitteeBranch altair.SyncCommitteeProofBranch
+}
+
+func (b *LightClientBootstrap) Deserialize(spec *common.Spec, dr *codec.DecodingReader) error {
+	return dr.Container(&b.Header, spec.Wrap(&b.CurrentSyncCommittee), &b.CurrentSyncCommitteeBranch)
+}
+
+func (b *LightClientBootstrap) Serialize(spec *common.Spec, w *codec.EncodingWriter) error {
+	return w.Container(&b.Header, spec.Wrap(&b.CurrentSyncCommittee), &b.CurrentSyncCommitteeBranch)
+}
+
+func (b *LightClientBootstrap) ByteLength(spec *common.Spec) uint64 {
+	return codec.ContainerLength(&b.Header, spec.Wrap(&b.CurrentSyncCommittee), &b.CurrentSyncCommitteeBranch)
+}
+
+func (*LightClientBootstrap) FixedLength(*common.Spec) uint64 {
+	return 0
+}
+
+func (b *LightClientBootstrap) HashTreeRoot(spec *common.Spec, hFn tree.HashFn) common.Root {
+	return hFn.HashTreeRoot(&b.Header, spec.Wrap(&b.CurrentSyncCommittee), &b.CurrentSyncCommitteeBranch)
+}
+
+// LightClientUpdate represents a light client update (Deneb)
+type LightClientUpdate struct {
+	AttestedHeader          LightClientHeader
+	NextSyncCommittee       common.SyncCommittee
+	NextSyncCommitteeBranch altair.SyncCommitteeProofBranch
+	FinalizedHeader         LightClientHeader
+	FinalityBranch          altair.FinalizedRootProofBranch
+	SyncAggregate           altair.SyncAggregate
+	SignatureSlot           common.Slot
+}
+
+func (u *LightClientUpdate) Deserialize(spec *common.Spec, dr *codec.DecodingReader) error {
+	return dr.Container(&u.AttestedHeader, spec.Wrap(&u.NextSyncCommittee), &u.NextSyncCommitteeBranch,
+		&u.FinalizedHeader, &u.FinalityBranch, spec.Wrap(&u.SyncAggregate), &u.SignatureSlot)
+}
+
+func (u *LightClientUpdate) Serialize(spec *common.Spec, w *codec.EncodingWriter) error {
+	return w.Container(&u.AttestedHeader, spec.Wrap(&u.NextSyncCommittee), &u.NextSyncCommitteeBranch,
+		&u.FinalizedHeader, &u.FinalityBranch, spec.Wrap(&u.SyncAggregate), &u.SignatureSlot)
+}
+
+func (u *LightClientUpdate) ByteLength(spec *common.Spec) uint64 {
+	return codec.ContainerLength(&u.AttestedHeader, spec.Wrap(&u.NextSyncCommittee), &u.NextSyncCommitteeBranch,
+		&u.FinalizedHeader, &u.FinalityBranch, spec.Wrap(&u.SyncAggregate), &u.SignatureSlot)
+}
+
+func (*LightClientUpdate) FixedLength(*common.Spec) uint64 {
+	return 0
+}
+
+func (u *LightClientUpdate) HashTreeRoot(spec *common.Spec, hFn tree.HashFn) common.Root {
+	return hFn.HashTreeRoot(&u.AttestedHeader, spec.Wrap(&u.NextSyncCommittee), &u.NextSyncCommitteeBranch,
+		&u.FinalizedHeader, &u.FinalityBranch, spec.Wrap(&u.SyncAggregate), &u.SignatureSlot)
+}
+
+// LightClientFinalityUpdate represents a light client finality update (Deneb)
+type LightClientFinalityUpdate struct {
+	AttestedHeader  LightClientHeader
+	FinalizedHeader LightClientHeader
+	FinalityBranch  altair.FinalizedRootProofBranch
+	SyncAggregate   altair.SyncAggregate
+	SignatureSlot   common.Slot
+}
+
+func (u *LightClientFinalityUpdate) Deserialize(spec *common.Spec, dr *codec.DecodingReader) error {
+	return dr.Container(&u.AttestedHeader, &u.FinalizedHeader, &u.FinalityBranch, spec.Wrap(&u.SyncAggregate), &u.SignatureSlot)
+}
+
+func (u *LightClientFinalityUpdate) Serialize(spec *common.Spec, w *codec.EncodingWriter) error {
+	return w.Container(&u.AttestedHeader, &u.FinalizedHeader, &u.FinalityBranch, spec.Wrap(&u.SyncAggregate), &u.SignatureSlot)
+}
+
+func (u *LightClientFinalityUpdate) ByteLength(spec *common.Spec) uint64 {
+	return codec.ContainerLength(&u.AttestedHeader, &u.FinalizedHeader, &u.FinalityBranch, spec.Wrap(&u.SyncAggregate), &u.SignatureSlot)
+}
+
+func (*LightClientFinalityUpdate) FixedLength(*common.Spec) uint64 {
+	return 0
+}
+
+func (u *LightClientFinalityUpdate) HashTreeRoot(spec *common.Spec, hFn tree.HashFn) common.Root {
+	return hFn.HashTreeRoot(&u.AttestedHeader, &u.FinalizedHeader, &u.FinalityBranch, spec.Wrap(&u.SyncAggregate), &u.SignatureSlot)
+}
+
+// LightClientOptimisticUpdate represents a light client optimistic update (Deneb)
+type LightClientOptimisticUpdate struct {
+	AttestedHeader LightClientHeader
+	SyncAggregate  altair.SyncAggregate
+	SignatureSlot  common.Slot
+}
+
+func (u *LightClientOptimisticUpdate) Deserialize(spec *common.Spec, dr *codec.DecodingReader) error {
+	return dr.Container(&u.AttestedHeader, spec.Wrap(&u.SyncAggregate), &u.SignatureSlot)
+}
+
+func (u *LightClientOptimisticUpdate) Serialize(spec *common.Spec, w *codec.EncodingWriter) error {
+	return w.Container(&u.AttestedHeader, spec.Wrap(&u.SyncAggregate), &u.SignatureSlot)
+}
+
+func (u *LightClientOptimisticUpdate) ByteLength(spec *common.Spec) uint64 {
+	return codec.ContainerLength(&u.AttestedHeader, spec.Wrap(&u.SyncAggregate), &u.SignatureSlot)
+}
+
+func (*LightClientOptimisticUpdate) FixedLength(*common.Spec) uint64 {
+	return 0
+}
+
+func (u *LightClientOptimisticUpdate) HashTreeRoot(spec *common.Spec, hFn tree.HashFn) common.Root {
+	return hFn.HashTreeRoot(&u.AttestedHeader, spec.Wrap(&u.SyncAggregate), &u.SignatureSlot)
+}
@@ -0,0 +1,264 @@
+// Package corpus generates the beacon blocks, states and objects the benchmark
+// modules decode. Everything is derived from a seed, so tests can generate in
+// process the same fixtures the generator command writes to res/:
+//
+//	specs, preset, err := corpus.LoadPreset("mainnet")
+//	if err != nil {
+//		return err
+//	}
+//	block := corpus.GenerateBlock(corpus.NewRNG(seed, "block"), corpus.DefaultConfig(), preset)
+//	data, err := dynssz.NewDynSsz(specs).MarshalSSZ(block)
+//
+// The Run functions write complete corpora with their metadata to
+// Config.OutputDir and report progress to Config.Log; the generator command
+// is a thin CLI around them.
+package corpus
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	dynssz "github.com/pk910/dynamic-ssz"
+)
+
+// Config holds the generator configuration
+type Config struct {
+	ValidatorCount           int
+	TransactionCount         int
+	TransactionMinSize       int
+	TransactionMaxSize       int
+	TxModel                  string
+	TxSizeHistogram          []string
+	MaxAttestations          int
+	MaxDeposits              int
+	MaxProposerSlashings     int
+	MaxAttesterSlashings     int
+	MaxVoluntaryExits        int
+	MaxBLSToExecChanges      int
+	MaxDepositRequests       int
+	MaxWithdrawalRequests    int
+	MaxConsolidationRequests int
+	Slot                     uint64
+	OutputDir                string
+	Seed                     int64
+	Forks                    []string
+	Profile                  string
+	Presets                  []string
+	JSON                     bool
+	Snappy                   bool
+	// FlagValues holds the effective flag values recorded in the metadata
+	FlagValues map[string]string
+	// Log receives the progress output of the Run functions; nil discards it
+	Log io.Writer
+	// txSizeBuckets is the parsed TxSizeHistogram
+	txSizeBuckets []txSizeBucket
+}
+
+// DefaultConfig returns the configuration the generator command runs with
+// when no flags are given. Its seed is 0, which the Run functions replace by
+// a random one.
+func DefaultConfig() *Config {
+	cfg := &Config{
+		ValidatorCount:           100000,
+		TransactionCount:         100,
+		TransactionMinSize:       500,
+		TransactionMaxSize:       700,
+		TxModel:                  txModelUniform,
+		TxSizeHistogram:          defaultTxSizeHistogram,
+		MaxAttestations:          128,
+		MaxDeposits:              16,
+		MaxProposerSlashings:     16,
+		MaxAttesterSlashings:     2,
+		MaxVoluntaryExits:        16,
+		MaxBLSToExecChanges:      16,
+		MaxDepositRequests:       16,
+		MaxWithdrawalRequests:    16,
+		MaxConsolidationRequests: 2,
+		Slot:                     1000,
+		OutputDir:                ".",
+		Profile:                  profileUniform,
+		Presets:                  []string{"minimal-preset.yaml", "mainnet-preset.yaml"},
+		JSON:                     true,
+		Snappy:                   true,
+	}
+	if err := cfg.Validate(); err != nil {
+		panic(err) // the defaults are valid
+	}
+	return cfg
+}
+
+// Validate checks the profile and transaction model and parses the
+// transaction size histogram the typed model draws from. Call it again after
+// changing any of them on a Config that is used for generation.
+func (cfg *Config) Validate() error {
+	if err := validateProfile(cfg.Profile); err != nil {
+		return err
+	}
+	if err := validateTxModel(cfg.TxModel); err != nil {
+		return err
+	}
+	buckets, err := parseTxSizeHistogram(cfg.TxSizeHistogram)
+	if err != nil {
+		return err
+	}
+	cfg.txSizeBuckets = buckets
+	return nil
+}
+
+// logf writes progress output to cfg.Log
+func (cfg *Config) logf(format string, args ...any) {
+	if cfg.Log != nil {
+		fmt.Fprintf(cfg.Log, format, args...)
+	}
+}
+
+// Run writes the block and state corpora of every preset and fork in cfg to
+// its output directory, which is what the generator command does by default.
+func Run(cfg *Config) error {
+	forks, err := prepareRun(cfg)
+	if err != nil {
+		return err
+	}
+
+	for _, presetFile := range cfg.Presets {
+		presetName := PresetName(presetFile)
+		cfg.logf("Generating %s preset payloads...\n", presetName)
+
+		// Load preset
+		specs, preset, err := LoadPreset(presetFile)
+		if err != nil {
+			return fmt.Errorf("failed to load %s preset: %w", presetName, err)
+		}
+
+		// Create DynSsz instance
+		dynSsz := dynssz.NewDynSsz(specs)
+
+		for _, fork := range forks {
+			// Without --fork the deneb corpora keep their legacy names
+			name := fork.Name + "-" + presetName
+			if len(cfg.Forks) == 0 {
+				name = presetName
+			}
+
+			block, blockMessage := fork.GenerateBlock(NewRNG(cfg.Seed, RNGLabel("block", fork, presetName)), cfg, preset)
+			blockMeta := &Metadata{Kind: "block", Fork: fork.Name, Preset: presetName}
+			if _, err := WriteCorpus(dynSsz, cfg, "block-"+name, blockMeta, block, blockMessage); err != nil {
+				return err
+			}
+
+			state := fork.GenerateState(NewRNG(cfg.Seed, RNGLabel("state", fork, presetName)), cfg, preset)
+			stateMeta := &Metadata{Kind: "state", Fork: fork.Name, Preset: presetName}
+			if _, err := WriteCorpus(dynSsz, cfg, "state-"+name, stateMeta, state, state); err != nil {
+				return err
+			}
+		}
+	}
+
+	cfg.logf("Generation complete!\n")
+	return nil
+}
+
+// prepareRun creates the output directory, resolves the seed and validates
+// the flags shared by all commands. It returns the forks to generate.
+func prepareRun(cfg *Config) ([]*ForkSpec, error) {
+	// Create output directory if it doesn't exist
+	if err := os.MkdirAll(cfg.OutputDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	// Resolve the seed up front so a random run can be reproduced later
+	if cfg.Seed == 0 {
+		var b [8]byte
+		if _, err := crand.Read(b[:]); err != nil {
+			return nil, fmt.Errorf("failed to read random seed: %w", err)
+		}
+		cfg.Seed = int64(binary.LittleEndian.Uint64(b[:]) >> 1)
+		cfg.logf("Using random seed %d\n", cfg.Seed)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	forkNames := cfg.Forks
+	if len(forkNames) == 0 {
+		forkNames = []string{"deneb"}
+	}
+	return ResolveForks(forkNames)
+}
+
+// RNGLabel names the random stream of a generated object. Deneb keeps the
+// unqualified labels so its corpora are identical with and without --fork.
+func RNGLabel(kind string, fork *ForkSpec, preset string) string {
+	if fork.Name == "deneb" {
+		return kind + "-" + preset
+	}
+	return kind + "-" + fork.Name + "-" + preset
+}
+
+// CorpusFile describes a written corpus file
+type CorpusFile struct {
+	Path string
+	Size int
+	HTR  [32]byte
+}
+
+// WriteCorpus marshals obj to <name>.ssz and stores the HTR of root (the block
+// message for signed blocks) with the rest of meta in <name>-meta.json. With
+// --json, blocks and states are also written to <name>.json, with --snappy
+// every corpus is also written snappy compressed.
+func WriteCorpus(dynSsz *dynssz.DynSsz, cfg *Config, name string, meta *Metadata, obj any, root any) (*CorpusFile, error) {
+	data, err := dynSsz.MarshalSSZ(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s: %w", name, err)
+	}
+
+	htr, err := dynSsz.HashTreeRoot(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute %s HTR: %w", name, err)
+	}
+
+	path := filepath.Join(cfg.OutputDir, name+".ssz")
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return nil, fmt.Errorf("failed to write %s: %w", name, err)
+	}
+
+	if cfg.Snappy {
+		if err := writeSnappy(strings.TrimSuffix(path, ".ssz"), data); err != nil {
+			return nil, fmt.Errorf("failed to write %s snappy encodings: %w", name, err)
+		}
+	}
+
+	if cfg.JSON && (meta.Kind == "block" || meta.Kind == "state") {
+		// Encode the decoded corpus, so short fixed-size fields of obj
+		// show up as the zero padded bytes the .ssz holds
+		decoded := reflect.New(reflect.TypeOf(obj).Elem()).Interface()
+		if err := dynSsz.UnmarshalSSZ(decoded, data); err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", name, err)
+		}
+		jsonData, err := marshalBeaconJSON(decoded)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %s as JSON: %w", name, err)
+		}
+		if err := os.WriteFile(filepath.Join(cfg.OutputDir, name+".json"), jsonData, 0644); err != nil {
+			return nil, fmt.Errorf("failed to write %s JSON: %w", name, err)
+		}
+	}
+
+	if err := FillMetadata(dynSsz, cfg, meta, obj, root, len(data), htr); err != nil {
+		return nil, fmt.Errorf("failed to collect %s metadata: %w", name, err)
+	}
+	if err := writeMetadata(filepath.Join(cfg.OutputDir, name+"-meta.json"), meta); err != nil {
+		return nil, fmt.Errorf("failed to write %s metadata: %w", name, err)
+	}
+
+	cfg.logf("  %s: %s (%d bytes, HTR: %s)\n", name, path, len(data), hex.EncodeToString(htr[:]))
+	return &CorpusFile{Path: path, Size: len(data), HTR: htr}, nil
+}
@@ -0,0 +1,269 @@
+package corpus
+
+import (
+	"fmt"
+	"maps"
+	"math/rand"
+	"sort"
+
+	dynssz "github.com/pk910/dynamic-ssz"
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// Edge-case block shapes
+const (
+	shapeEmpty    = "empty"
+	shapeMax      = "max"
+	shapeBoundary = "boundary"
+	shapeTyped    = "typed"
+)
+
+var blockShapes = []string{shapeEmpty, shapeMax, shapeBoundary, shapeTyped}
+
+// boundaryByteLengths are the transaction sizes of the boundary block: powers
+// of two and powers of two plus one, where the chunk count of a byte list
+// (and with it the depth of its tree) changes.
+var boundaryByteLengths = []int{32, 33, 64, 65, 128, 129, 256, 257, 512, 513, 1024, 1025}
+
+// boundaryBitLengths are the aggregation bitlist lengths of the boundary block
+var boundaryBitLengths = []int{256, 257, 512, 513, 1024, 1025, 2048}
+
+func isBlockShape(name string) bool {
+	for _, shape := range blockShapes {
+		if shape == name {
+			return true
+		}
+	}
+	return false
+}
+
+func RunEdgeCases(cfg *Config) error {
+	forks, err := prepareRun(cfg)
+	if err != nil {
+		return err
+	}
+	if len(forks) != 1 || forks[0].Name != "deneb" {
+		return fmt.Errorf("edge-case blocks are only generated for deneb")
+	}
+	fork := forks[0]
+
+	for _, presetFile := range cfg.Presets {
+		presetName := PresetName(presetFile)
+		cfg.logf("Generating %s preset edge-case blocks...\n", presetName)
+
+		specs, preset, err := LoadPreset(presetFile)
+		if err != nil {
+			return fmt.Errorf("failed to load %s preset: %w", presetName, err)
+		}
+
+		dynSsz := dynssz.NewDynSsz(specs)
+
+		for _, shape := range blockShapes {
+			shapeCfg := cfg
+			if shape == shapeTyped {
+				shapeCfg = typedTxConfig(cfg)
+			}
+
+			rng := NewRNG(cfg.Seed, RNGLabel("block-"+shape, fork, presetName))
+			block := generateEdgeCaseBlock(rng, shapeCfg, preset, shape)
+
+			name := "block-" + shape + "-" + presetName
+			meta := &Metadata{Kind: "block", Fork: fork.Name, Preset: presetName}
+			if _, err := WriteCorpus(dynSsz, shapeCfg, name, meta, block, block.Message); err != nil {
+				return err
+			}
+		}
+	}
+
+	cfg.logf("Generation complete!\n")
+	return nil
+}
+
+// generateEdgeCaseBlock builds a deneb block of the given shape
+func generateEdgeCaseBlock(rng *rand.Rand, cfg *Config, preset *PresetValues, shape string) *SignedBeaconBlock {
+	var body *BeaconBlockBody
+	switch shape {
+	case shapeEmpty:
+		body = generateEmptyBlockBody(rng, cfg)
+	case shapeMax:
+		body = generateMaxBlockBody(rng, cfg, preset)
+	case shapeBoundary:
+		body = generateBoundaryBlockBody(rng, cfg, preset)
+	case shapeTyped:
+		body = generateBeaconBlockBody(rng, cfg, preset)
+	default:
+		panic("unknown block shape " + shape)
+	}
+
+	return &SignedBeaconBlock{
+		Message: &BeaconBlock{
+			Slot:          cfg.Slot,
+			ProposerIndex: randomValidatorIndex(rng, cfg.ValidatorCount),
+			ParentRoot:    randomRoot(rng),
+			StateRoot:     randomRoot(rng),
+			Body:          body,
+		},
+		Signature: randomBLSSignature(rng),
+	}
+}
+
+// typedTxConfig returns a copy of cfg that generates typed transactions, with
+// the flag values recorded in the metadata to match
+func typedTxConfig(cfg *Config) *Config {
+	typedCfg := *cfg
+	typedCfg.TxModel = txModelTyped
+	typedCfg.FlagValues = maps.Clone(cfg.FlagValues)
+	typedCfg.FlagValues["tx-model"] = txModelTyped
+	return &typedCfg
+}
+
+// generateEmptyBlockBody returns a body without any operations, transactions,
+// withdrawals or blobs, and a sync aggregate nobody participated in.
+func generateEmptyBlockBody(rng *rand.Rand, cfg *Config) *BeaconBlockBody {
+	emptyCfg := *cfg
+	emptyCfg.TransactionCount = 0
+	payload := generateExecutionPayload(rng, &emptyCfg, 0)
+	payload.ExtraData = []byte{}
+
+	// An empty sync aggregate carries the point at infinity as signature
+	var infinity BLSSignature
+	infinity[0] = 0xc0
+
+	return &BeaconBlockBody{
+		RANDAOReveal:          randomBLSSignature(rng),
+		ETH1Data:              generateETH1Data(rng),
+		Graffiti:              randomHash32(rng),
+		ProposerSlashings:     []*ProposerSlashing{},
+		AttesterSlashings:     []*AttesterSlashing{},
+		Attestations:          []*Attestation{},
+		Deposits:              []*Deposit{},
+		VoluntaryExits:        []*SignedVoluntaryExit{},
+		SyncAggregate:         &SyncAggregate{SyncCommitteeSignature: infinity},
+		ExecutionPayload:      payload,
+		BLSToExecutionChanges: []*SignedBLSToExecutionChange{},
+		BlobKZGCommitments:    []KZGCommitment{},
+	}
+}
+
+// generateMaxBlockBody returns a body with every operation list at its preset
+// limit, fully set aggregation and sync committee bits, maximal attester
+// slashings and the maximum withdrawals and blob commitments.
+func generateMaxBlockBody(rng *rand.Rand, cfg *Config, preset *PresetValues) *BeaconBlockBody {
+	attestations := make([]*Attestation, preset.MaxAttestations)
+	for i := range attestations {
+		attestations[i] = generateFullAttestation(rng, preset.MaxValidatorsPerCommittee, cfg)
+	}
+
+	attesterSlashings := make([]*AttesterSlashing, preset.MaxAttesterSlashings)
+	for i := range attesterSlashings {
+		attesterSlashings[i] = &AttesterSlashing{
+			Attestation1: generateIndexedAttestation(rng, preset.MaxValidatorsPerCommittee, cfg.ValidatorCount),
+			Attestation2: generateIndexedAttestation(rng, preset.MaxValidatorsPerCommittee, cfg.ValidatorCount),
+		}
+	}
+
+	payload := generateExecutionPayload(rng, cfg, preset.MaxWithdrawals)
+	payload.ExtraData = randomBytes(rng, preset.MaxExtraDataBytes)
+
+	// Sized by hand, SetBitAt only works on full 512 bit vectors
+	syncBits := make(bitfield.Bitvector512, preset.SyncCommitteeSize/8)
+	for i := range syncBits {
+		syncBits[i] = 0xff
+	}
+	syncAggregate := &SyncAggregate{
+		SyncCommitteeBits:      syncBits,
+		SyncCommitteeSignature: randomBLSSignature(rng),
+	}
+
+	return &BeaconBlockBody{
+		RANDAOReveal:          randomBLSSignature(rng),
+		ETH1Data:              generateETH1Data(rng),
+		Graffiti:              randomHash32(rng),
+		ProposerSlashings:     generateProposerSlashings(rng, preset.MaxProposerSlashings, cfg.ValidatorCount),
+		AttesterSlashings:     attesterSlashings,
+		Attestations:          attestations,
+		Deposits:              generateDeposits(rng, preset.MaxDeposits),
+		VoluntaryExits:        generateVoluntaryExits(rng, preset.MaxVoluntaryExits, cfg.ValidatorCount),
+		SyncAggregate:         syncAggregate,
+		ExecutionPayload:      payload,
+		BLSToExecutionChanges: generateBLSToExecChanges(rng, preset.MaxBLSToExecutionChanges, cfg.ValidatorCount),
+		BlobKZGCommitments:    generateBlobCommitments(rng, preset.MaxBlobCommitments),
+	}
+}
+
+// generateBoundaryBlockBody returns a body whose lists sit exactly at a power
+// of two or one past it, where the merkle tree of a list gains a level. The
+// preset limits are powers of two, so limit/2+1 is the largest such length
+// below the limit.
+func generateBoundaryBlockBody(rng *rand.Rand, cfg *Config, preset *PresetValues) *BeaconBlockBody {
+	attestations := make([]*Attestation, min(65, preset.MaxAttestations))
+	for i := range attestations {
+		bits := min(boundaryBitLengths[i%len(boundaryBitLengths)], preset.MaxValidatorsPerCommittee)
+		attestations[i] = generateFullAttestation(rng, bits, cfg)
+	}
+
+	// 4 indices fill exactly one chunk, 5 spill into a second one
+	attesterSlashings := []*AttesterSlashing{{
+		Attestation1: generateIndexedAttestation(rng, 4, cfg.ValidatorCount),
+		Attestation2: generateIndexedAttestation(rng, 5, cfg.ValidatorCount),
+	}}
+
+	payload := generateExecutionPayload(rng, cfg, preset.MaxWithdrawals/2+1)
+	payload.ExtraData = randomBytes(rng, 16)
+	payload.Transactions = make([][]byte, 33)
+	for i := range payload.Transactions {
+		payload.Transactions[i] = randomBytes(rng, boundaryByteLengths[i%len(boundaryByteLengths)])
+	}
+
+	return &BeaconBlockBody{
+		RANDAOReveal:          randomBLSSignature(rng),
+		ETH1Data:              generateETH1Data(rng),
+		Graffiti:              randomHash32(rng),
+		ProposerSlashings:     generateProposerSlashings(rng, 8, cfg.ValidatorCount),
+		AttesterSlashings:     attesterSlashings,
+		Attestations:          attestations,
+		Deposits:              generateDeposits(rng, 4),
+		VoluntaryExits:        generateVoluntaryExits(rng, 9, cfg.ValidatorCount),
+		SyncAggregate:         generateSyncAggregate(rng, preset.SyncCommitteeSize),
+		ExecutionPayload:      payload,
+		BLSToExecutionChanges: generateBLSToExecChanges(rng, 16, cfg.ValidatorCount),
+		BlobKZGCommitments:    generateBlobCommitments(rng, preset.MaxBlobCommitments/2+1),
+	}
+}
+
+// generateFullAttestation returns an attestation with all bits of a bitlist
+// of the given length set
+func generateFullAttestation(rng *rand.Rand, bits int, cfg *Config) *Attestation {
+	aggBits := bitfield.NewBitlist(uint64(bits))
+	for i := 0; i < bits; i++ {
+		aggBits.SetBitAt(uint64(i), true)
+	}
+
+	return &Attestation{
+		AggregationBits: aggBits,
+		Data:            generateAttestationData(rng, cfg.Slot-1, cfg.ValidatorCount),
+		Signature:       randomBLSSignature(rng),
+	}
+}
+
+// generateIndexedAttestation returns an indexed attestation over count
+// distinct, sorted validator indices
+func generateIndexedAttestation(rng *rand.Rand, count int, maxValidators int) *IndexedAttestation {
+	seen := make(map[uint64]bool, count)
+	indices := make([]uint64, 0, count)
+	for len(indices) < count {
+		idx := uint64(randomValidatorIndex(rng, maxValidators))
+		if seen[idx] && len(seen) < maxValidators {
+			continue
+		}
+		seen[idx] = true
+		indices = append(indices, idx)
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+
+	return &IndexedAttestation{
+		AttestingIndices: indices,
+		Data:             generateAttestationData(rng, randomUint64(rng)%1000, maxValidators),
+		Signature:        randomBLSSignature(rng),
+	}
+}
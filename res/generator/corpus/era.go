@@ -0,0 +1,280 @@
+package corpus
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	dynssz "github.com/pk910/dynamic-ssz"
+)
+
+// e2store record types used by era files
+var (
+	e2Version         = [2]byte{0x65, 0x32}
+	e2CompressedBlock = [2]byte{0x01, 0x00}
+	e2CompressedState = [2]byte{0x02, 0x00}
+	e2SlotIndex       = [2]byte{0x69, 0x32}
+)
+
+// EraManifest lists the era files of an era corpus
+type EraManifest struct {
+	Seed        int64       `json:"seed"`
+	MissedSlots float64     `json:"missed_slots"`
+	Entries     []*EraEntry `json:"entries"`
+}
+
+// EraEntry describes a single era file. The block HTR is taken over the
+// message of the last block in the file.
+type EraEntry struct {
+	File         string `json:"file"`
+	Preset       string `json:"preset"`
+	Fork         string `json:"fork"`
+	Era          uint64 `json:"era"`
+	StartSlot    uint64 `json:"start_slot"`
+	Blocks       int    `json:"blocks"`
+	Size         int64  `json:"size"`
+	LastBlockHTR string `json:"last_block_htr"`
+	StateHTR     string `json:"state_htr"`
+}
+
+// historicalBatch is the phase0 HistoricalBatch, whose root names era files
+type historicalBatch struct {
+	BlockRoots []Root `dynssz-size:"SLOTS_PER_HISTORICAL_ROOT,32" ssz-size:"8192,32"`
+	StateRoots []Root `dynssz-size:"SLOTS_PER_HISTORICAL_ROOT,32" ssz-size:"8192,32"`
+}
+
+func RunEra(cfg *Config, missedSlots float64) error {
+	forks, err := prepareRun(cfg)
+	if err != nil {
+		return err
+	}
+	if len(forks) != 1 || forks[0].Name != "deneb" {
+		return fmt.Errorf("era files are only generated for deneb")
+	}
+	if missedSlots < 0 || missedSlots >= 1 {
+		return fmt.Errorf("invalid missed slot fraction %v", missedSlots)
+	}
+	fork := forks[0]
+
+	manifest := &EraManifest{
+		Seed:        cfg.Seed,
+		MissedSlots: missedSlots,
+	}
+
+	for _, presetFile := range cfg.Presets {
+		presetName := PresetName(presetFile)
+		cfg.logf("Generating %s preset era...\n", presetName)
+
+		specs, preset, err := LoadPreset(presetFile)
+		if err != nil {
+			return fmt.Errorf("failed to load %s preset: %w", presetName, err)
+		}
+
+		dynSsz := dynssz.NewDynSsz(specs)
+
+		entry, err := generateEra(dynSsz, cfg, fork, presetName, preset, missedSlots)
+		if err != nil {
+			return err
+		}
+		manifest.Entries = append(manifest.Entries, entry)
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(cfg.OutputDir, "manifest.json"), data, 0644); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+
+	cfg.logf("Era generation complete!\n")
+	return nil
+}
+
+// generateEra writes the era file of a single preset. Blocks are written as
+// they are generated, so only the block roots of the era are kept in memory.
+func generateEra(dynSsz *dynssz.DynSsz, cfg *Config, fork *ForkSpec, presetName string, preset *PresetValues, missedSlots float64) (*EraEntry, error) {
+	slotsPerEra := uint64(preset.SlotsPerHistoricalRoot)
+	era := cfg.Slot/slotsPerEra + 1
+	startSlot := (era - 1) * slotsPerEra
+	endSlot := era * slotsPerEra
+
+	// The file name needs the historical root, which is only known once all
+	// blocks are written
+	tmpPath := filepath.Join(cfg.OutputDir, presetName+".era.tmp")
+	file, err := os.Create(tmpPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s era: %w", presetName, err)
+	}
+	defer os.Remove(tmpPath)
+	defer file.Close()
+
+	writer := &e2storeWriter{w: bufio.NewWriter(file)}
+	if _, err := writer.writeRecord(e2Version, nil); err != nil {
+		return nil, err
+	}
+
+	rng := NewRNG(cfg.Seed, RNGLabel("era", fork, presetName))
+	slotCfg := *cfg
+	blockRoots := make([]Root, slotsPerEra)
+	blockOffsets := make([]int64, slotsPerEra)
+	parentRoot := randomRoot(rng)
+	var lastBlock *SignedBeaconBlock
+	blocks := 0
+
+	for slot := startSlot; slot < endSlot; slot++ {
+		// Slot 0 holds the genesis block, which era files leave out
+		if slot == 0 || rng.Float64() < missedSlots {
+			blockRoots[slot%slotsPerEra] = parentRoot
+			continue
+		}
+
+		slotCfg.Slot = slot
+		block := GenerateBlock(rng, &slotCfg, preset)
+		block.Message.ParentRoot = parentRoot
+
+		root, err := dynSsz.HashTreeRoot(block.Message)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute block %d HTR: %w", slot, err)
+		}
+		data, err := dynSsz.MarshalSSZ(block)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal block %d: %w", slot, err)
+		}
+		framed, err := encodeSnappyFramed(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compress block %d: %w", slot, err)
+		}
+		offset, err := writer.writeRecord(e2CompressedBlock, framed)
+		if err != nil {
+			return nil, err
+		}
+
+		blockRoots[slot%slotsPerEra] = root
+		blockOffsets[slot-startSlot] = offset
+		parentRoot = root
+		lastBlock = block
+		blocks++
+	}
+	if lastBlock == nil {
+		return nil, fmt.Errorf("%s era has no blocks", presetName)
+	}
+
+	stateCfg := *cfg
+	stateCfg.Slot = endSlot
+	state := GenerateState(NewRNG(cfg.Seed, RNGLabel("era-state", fork, presetName)), &stateCfg, preset)
+	state.BlockRoots = blockRoots
+	bodyRoot, err := dynSsz.HashTreeRoot(lastBlock.Message.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute block body HTR: %w", err)
+	}
+	state.LatestBlockHeader = &BeaconBlockHeader{
+		Slot:          lastBlock.Message.Slot,
+		ProposerIndex: lastBlock.Message.ProposerIndex,
+		ParentRoot:    lastBlock.Message.ParentRoot,
+		StateRoot:     lastBlock.Message.StateRoot,
+		BodyRoot:      bodyRoot,
+	}
+
+	stateData, err := dynSsz.MarshalSSZ(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal state: %w", err)
+	}
+	framed, err := encodeSnappyFramed(stateData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compress state: %w", err)
+	}
+	stateOffset, err := writer.writeRecord(e2CompressedState, framed)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := writer.writeSlotIndex(startSlot, blockOffsets); err != nil {
+		return nil, err
+	}
+	if err := writer.writeSlotIndex(endSlot, []int64{stateOffset}); err != nil {
+		return nil, err
+	}
+	if err := writer.w.Flush(); err != nil {
+		return nil, fmt.Errorf("failed to write %s era: %w", presetName, err)
+	}
+	if err := file.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write %s era: %w", presetName, err)
+	}
+
+	historicalRoot, err := dynSsz.HashTreeRoot(&historicalBatch{BlockRoots: state.BlockRoots, StateRoots: state.StateRoots})
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute historical root: %w", err)
+	}
+	name := fmt.Sprintf("%s-%05d-%s.era", presetName, era, hex.EncodeToString(historicalRoot[:4]))
+	if err := os.Rename(tmpPath, filepath.Join(cfg.OutputDir, name)); err != nil {
+		return nil, fmt.Errorf("failed to write %s era: %w", presetName, err)
+	}
+
+	lastBlockHTR, err := dynSsz.HashTreeRoot(lastBlock.Message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute block HTR: %w", err)
+	}
+	stateHTR, err := dynSsz.HashTreeRoot(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute state HTR: %w", err)
+	}
+
+	cfg.logf("  %s: %d blocks, slots %d-%d (%d bytes)\n", name, blocks, startSlot, endSlot-1, writer.offset)
+	return &EraEntry{
+		File:         name,
+		Preset:       presetName,
+		Fork:         fork.Name,
+		Era:          era,
+		StartSlot:    startSlot,
+		Blocks:       blocks,
+		Size:         writer.offset,
+		LastBlockHTR: hex.EncodeToString(lastBlockHTR[:]),
+		StateHTR:     hex.EncodeToString(stateHTR[:]),
+	}, nil
+}
+
+// e2storeWriter writes e2store records: a 2 byte type, a 4 byte little-endian
+// length and 2 reserved zero bytes, followed by the data.
+type e2storeWriter struct {
+	w      *bufio.Writer
+	offset int64
+}
+
+// writeRecord writes a record and returns its offset in the file
+func (e *e2storeWriter) writeRecord(typ [2]byte, data []byte) (int64, error) {
+	var header [8]byte
+	copy(header[:2], typ[:])
+	binary.LittleEndian.PutUint32(header[2:6], uint32(len(data)))
+
+	offset := e.offset
+	if _, err := e.w.Write(header[:]); err != nil {
+		return 0, err
+	}
+	if _, err := e.w.Write(data); err != nil {
+		return 0, err
+	}
+	e.offset += int64(len(header) + len(data))
+	return offset, nil
+}
+
+// writeSlotIndex writes a slot index record for the records at offsets, one
+// per slot from startSlot on (0 for empty slots). The index stores them
+// relative to its own offset.
+func (e *e2storeWriter) writeSlotIndex(startSlot uint64, offsets []int64) error {
+	data := make([]byte, 0, 8*(len(offsets)+2))
+	data = binary.LittleEndian.AppendUint64(data, startSlot)
+	for _, offset := range offsets {
+		if offset != 0 {
+			offset -= e.offset
+		}
+		data = binary.LittleEndian.AppendUint64(data, uint64(offset))
+	}
+	data = binary.LittleEndian.AppendUint64(data, uint64(len(offsets)))
+	_, err := e.writeRecord(e2SlotIndex, data)
+	return err
+}
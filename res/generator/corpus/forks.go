@@ -0,0 +1,749 @@
+package corpus
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// Mainnet fork versions
+var (
+	forkVersionPhase0    = [4]byte{0x00, 0x00, 0x00, 0x00}
+	forkVersionAltair    = [4]byte{0x01, 0x00, 0x00, 0x00}
+	forkVersionBellatrix = [4]byte{0x02, 0x00, 0x00, 0x00}
+	forkVersionCapella   = [4]byte{0x03, 0x00, 0x00, 0x00}
+	forkVersionDeneb     = [4]byte{0x04, 0x00, 0x00, 0x00}
+	forkVersionElectra   = [4]byte{0x05, 0x00, 0x00, 0x00}
+	forkVersionFulu      = [4]byte{0x06, 0x00, 0x00, 0x00}
+)
+
+// ForkSpec describes how to generate the block and state corpora for a fork
+type ForkSpec struct {
+	Name string
+	// GenerateBlock returns the signed block and the message its HTR is computed over
+	GenerateBlock func(rng *rand.Rand, cfg *Config, preset *PresetValues) (any, any)
+	GenerateState func(rng *rand.Rand, cfg *Config, preset *PresetValues) any
+	// NewBlock and NewState return empty objects to decode corpora into
+	NewBlock func() SignedBlock
+	NewState func() any
+}
+
+// SignedBlock is a signed block of any fork. GetMessage returns the message
+// its HTR is computed over (the blocks' Message field takes the plain name).
+type SignedBlock interface {
+	GetMessage() any
+}
+
+func (b *SignedBeaconBlockPhase0) GetMessage() any    { return b.Message }
+func (b *SignedBeaconBlockAltair) GetMessage() any    { return b.Message }
+func (b *SignedBeaconBlockBellatrix) GetMessage() any { return b.Message }
+func (b *SignedBeaconBlockCapella) GetMessage() any   { return b.Message }
+func (b *SignedBeaconBlock) GetMessage() any          { return b.Message }
+func (b *SignedBeaconBlockElectra) GetMessage() any   { return b.Message }
+
+// forkSpecs lists all supported forks in chronological order
+var forkSpecs = []*ForkSpec{
+	{
+		Name: "phase0",
+		GenerateBlock: func(rng *rand.Rand, cfg *Config, preset *PresetValues) (any, any) {
+			block := generateBlockPhase0(rng, cfg, preset)
+			return block, block.Message
+		},
+		GenerateState: func(rng *rand.Rand, cfg *Config, preset *PresetValues) any {
+			return generateStatePhase0(rng, cfg, preset)
+		},
+		NewBlock: func() SignedBlock { return new(SignedBeaconBlockPhase0) },
+		NewState: func() any { return new(BeaconStatePhase0) },
+	},
+	{
+		Name: "altair",
+		GenerateBlock: func(rng *rand.Rand, cfg *Config, preset *PresetValues) (any, any) {
+			block := generateBlockAltair(rng, cfg, preset)
+			return block, block.Message
+		},
+		GenerateState: func(rng *rand.Rand, cfg *Config, preset *PresetValues) any {
+			return generateStateAltair(rng, cfg, preset)
+		},
+		NewBlock: func() SignedBlock { return new(SignedBeaconBlockAltair) },
+		NewState: func() any { return new(BeaconStateAltair) },
+	},
+	{
+		Name: "bellatrix",
+		GenerateBlock: func(rng *rand.Rand, cfg *Config, preset *PresetValues) (any, any) {
+			block := generateBlockBellatrix(rng, cfg, preset)
+			return block, block.Message
+		},
+		GenerateState: func(rng *rand.Rand, cfg *Config, preset *PresetValues) any {
+			return generateStateBellatrix(rng, cfg, preset)
+		},
+		NewBlock: func() SignedBlock { return new(SignedBeaconBlockBellatrix) },
+		NewState: func() any { return new(BeaconStateBellatrix) },
+	},
+	{
+		Name: "capella",
+		GenerateBlock: func(rng *rand.Rand, cfg *Config, preset *PresetValues) (any, any) {
+			block := generateBlockCapella(rng, cfg, preset)
+			return block, block.Message
+		},
+		GenerateState: func(rng *rand.Rand, cfg *Config, preset *PresetValues) any {
+			return generateStateCapella(rng, cfg, preset)
+		},
+		NewBlock: func() SignedBlock { return new(SignedBeaconBlockCapella) },
+		NewState: func() any { return new(BeaconStateCapella) },
+	},
+	{
+		Name: "deneb",
+		GenerateBlock: func(rng *rand.Rand, cfg *Config, preset *PresetValues) (any, any) {
+			block := GenerateBlock(rng, cfg, preset)
+			return block, block.Message
+		},
+		GenerateState: func(rng *rand.Rand, cfg *Config, preset *PresetValues) any {
+			return GenerateState(rng, cfg, preset)
+		},
+		NewBlock: func() SignedBlock { return new(SignedBeaconBlock) },
+		NewState: func() any { return new(BeaconState) },
+	},
+	{
+		Name: "electra",
+		GenerateBlock: func(rng *rand.Rand, cfg *Config, preset *PresetValues) (any, any) {
+			block := generateBlockElectra(rng, cfg, preset)
+			return block, block.Message
+		},
+		GenerateState: func(rng *rand.Rand, cfg *Config, preset *PresetValues) any {
+			return generateStateElectra(rng, cfg, preset)
+		},
+		NewBlock: func() SignedBlock { return new(SignedBeaconBlockElectra) },
+		NewState: func() any { return new(BeaconStateElectra) },
+	},
+	{
+		Name: "fulu",
+		GenerateBlock: func(rng *rand.Rand, cfg *Config, preset *PresetValues) (any, any) {
+			// Fulu keeps the Electra block types, only the state differs
+			block := generateBlockElectra(rng, cfg, preset)
+			return block, block.Message
+		},
+		GenerateState: func(rng *rand.Rand, cfg *Config, preset *PresetValues) any {
+			return generateStateFulu(rng, cfg, preset)
+		},
+		NewBlock: func() SignedBlock { return new(SignedBeaconBlockElectra) },
+		NewState: func() any { return new(BeaconStateFulu) },
+	},
+}
+
+// ResolveForks maps the --fork flag values to fork specs ("all" selects every fork)
+func ResolveForks(names []string) ([]*ForkSpec, error) {
+	var forks []*ForkSpec
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "all" {
+			return forkSpecs, nil
+		}
+		fork := FindFork(name)
+		if fork == nil {
+			return nil, fmt.Errorf("unknown fork: %s", name)
+		}
+		forks = append(forks, fork)
+	}
+	return forks, nil
+}
+
+// FindFork returns the spec of the named fork, or nil if there is none
+func FindFork(name string) *ForkSpec {
+	for _, fork := range forkSpecs {
+		if fork.Name == name {
+			return fork
+		}
+	}
+	return nil
+}
+
+// Older forks are derived from the Deneb generators by dropping the fields
+// that did not exist yet, newer forks by adding the fields they introduced.
+
+func generateBlockPhase0(rng *rand.Rand, cfg *Config, preset *PresetValues) *SignedBeaconBlockPhase0 {
+	block := GenerateBlock(rng, cfg, preset)
+	body := block.Message.Body
+	return &SignedBeaconBlockPhase0{
+		Message: &BeaconBlockPhase0{
+			Slot:          block.Message.Slot,
+			ProposerIndex: block.Message.ProposerIndex,
+			ParentRoot:    block.Message.ParentRoot,
+			StateRoot:     block.Message.StateRoot,
+			Body: &BeaconBlockBodyPhase0{
+				RANDAOReveal:      body.RANDAOReveal,
+				ETH1Data:          body.ETH1Data,
+				Graffiti:          body.Graffiti,
+				ProposerSlashings: body.ProposerSlashings,
+				AttesterSlashings: body.AttesterSlashings,
+				Attestations:      body.Attestations,
+				Deposits:          body.Deposits,
+				VoluntaryExits:    body.VoluntaryExits,
+			},
+		},
+		Signature: block.Signature,
+	}
+}
+
+func generateBlockAltair(rng *rand.Rand, cfg *Config, preset *PresetValues) *SignedBeaconBlockAltair {
+	block := GenerateBlock(rng, cfg, preset)
+	body := block.Message.Body
+	return &SignedBeaconBlockAltair{
+		Message: &BeaconBlockAltair{
+			Slot:          block.Message.Slot,
+			ProposerIndex: block.Message.ProposerIndex,
+			ParentRoot:    block.Message.ParentRoot,
+			StateRoot:     block.Message.StateRoot,
+			Body: &BeaconBlockBodyAltair{
+				RANDAOReveal:      body.RANDAOReveal,
+				ETH1Data:          body.ETH1Data,
+				Graffiti:          body.Graffiti,
+				ProposerSlashings: body.ProposerSlashings,
+				AttesterSlashings: body.AttesterSlashings,
+				Attestations:      body.Attestations,
+				Deposits:          body.Deposits,
+				VoluntaryExits:    body.VoluntaryExits,
+				SyncAggregate:     body.SyncAggregate,
+			},
+		},
+		Signature: block.Signature,
+	}
+}
+
+func generateBlockBellatrix(rng *rand.Rand, cfg *Config, preset *PresetValues) *SignedBeaconBlockBellatrix {
+	block := GenerateBlock(rng, cfg, preset)
+	body := block.Message.Body
+	payload := body.ExecutionPayload
+	return &SignedBeaconBlockBellatrix{
+		Message: &BeaconBlockBellatrix{
+			Slot:          block.Message.Slot,
+			ProposerIndex: block.Message.ProposerIndex,
+			ParentRoot:    block.Message.ParentRoot,
+			StateRoot:     block.Message.StateRoot,
+			Body: &BeaconBlockBodyBellatrix{
+				RANDAOReveal:      body.RANDAOReveal,
+				ETH1Data:          body.ETH1Data,
+				Graffiti:          body.Graffiti,
+				ProposerSlashings: body.ProposerSlashings,
+				AttesterSlashings: body.AttesterSlashings,
+				Attestations:      body.Attestations,
+				Deposits:          body.Deposits,
+				VoluntaryExits:    body.VoluntaryExits,
+				SyncAggregate:     body.SyncAggregate,
+				ExecutionPayload: &ExecutionPayloadBellatrix{
+					ParentHash:    payload.ParentHash,
+					FeeRecipient:  payload.FeeRecipient,
+					StateRoot:     payload.StateRoot,
+					ReceiptsRoot:  payload.ReceiptsRoot,
+					LogsBloom:     payload.LogsBloom,
+					PrevRandao:    payload.PrevRandao,
+					BlockNumber:   payload.BlockNumber,
+					GasLimit:      payload.GasLimit,
+					GasUsed:       payload.GasUsed,
+					Timestamp:     payload.Timestamp,
+					ExtraData:     payload.ExtraData,
+					BaseFeePerGas: payload.BaseFeePerGas,
+					BlockHash:     payload.BlockHash,
+					Transactions:  payload.Transactions,
+				},
+			},
+		},
+		Signature: block.Signature,
+	}
+}
+
+func generateBlockCapella(rng *rand.Rand, cfg *Config, preset *PresetValues) *SignedBeaconBlockCapella {
+	block := GenerateBlock(rng, cfg, preset)
+	body := block.Message.Body
+	payload := body.ExecutionPayload
+	return &SignedBeaconBlockCapella{
+		Message: &BeaconBlockCapella{
+			Slot:          block.Message.Slot,
+			ProposerIndex: block.Message.ProposerIndex,
+			ParentRoot:    block.Message.ParentRoot,
+			StateRoot:     block.Message.StateRoot,
+			Body: &BeaconBlockBodyCapella{
+				RANDAOReveal:      body.RANDAOReveal,
+				ETH1Data:          body.ETH1Data,
+				Graffiti:          body.Graffiti,
+				ProposerSlashings: body.ProposerSlashings,
+				AttesterSlashings: body.AttesterSlashings,
+				Attestations:      body.Attestations,
+				Deposits:          body.Deposits,
+				VoluntaryExits:    body.VoluntaryExits,
+				SyncAggregate:     body.SyncAggregate,
+				ExecutionPayload: &ExecutionPayloadCapella{
+					ParentHash:    payload.ParentHash,
+					FeeRecipient:  payload.FeeRecipient,
+					StateRoot:     payload.StateRoot,
+					ReceiptsRoot:  payload.ReceiptsRoot,
+					LogsBloom:     payload.LogsBloom,
+					PrevRandao:    payload.PrevRandao,
+					BlockNumber:   payload.BlockNumber,
+					GasLimit:      payload.GasLimit,
+					GasUsed:       payload.GasUsed,
+					Timestamp:     payload.Timestamp,
+					ExtraData:     payload.ExtraData,
+					BaseFeePerGas: payload.BaseFeePerGas,
+					BlockHash:     payload.BlockHash,
+					Transactions:  payload.Transactions,
+					Withdrawals:   payload.Withdrawals,
+				},
+				BLSToExecutionChanges: body.BLSToExecutionChanges,
+			},
+		},
+		Signature: block.Signature,
+	}
+}
+
+func generateBlockElectra(rng *rand.Rand, cfg *Config, preset *PresetValues) *SignedBeaconBlockElectra {
+	block := GenerateBlock(rng, cfg, preset)
+	body := block.Message.Body
+	return &SignedBeaconBlockElectra{
+		Message: &BeaconBlockElectra{
+			Slot:          block.Message.Slot,
+			ProposerIndex: block.Message.ProposerIndex,
+			ParentRoot:    block.Message.ParentRoot,
+			StateRoot:     block.Message.StateRoot,
+			Body: &BeaconBlockBodyElectra{
+				RANDAOReveal:          body.RANDAOReveal,
+				ETH1Data:              body.ETH1Data,
+				Graffiti:              body.Graffiti,
+				ProposerSlashings:     body.ProposerSlashings,
+				AttesterSlashings:     generateAttesterSlashingsElectra(rng, min(cfg.MaxAttesterSlashings, preset.MaxAttesterSlashingsElectra), cfg.ValidatorCount),
+				Attestations:          generateAttestationsElectra(rng, min(cfg.MaxAttestations, preset.MaxAttestationsElectra), cfg.ValidatorCount, cfg.Slot, preset),
+				Deposits:              body.Deposits,
+				VoluntaryExits:        body.VoluntaryExits,
+				SyncAggregate:         body.SyncAggregate,
+				ExecutionPayload:      body.ExecutionPayload,
+				BLSToExecutionChanges: body.BLSToExecutionChanges,
+				BlobKZGCommitments:    body.BlobKZGCommitments,
+				ExecutionRequests:     generateExecutionRequests(rng, cfg, preset),
+			},
+		},
+		Signature: block.Signature,
+	}
+}
+
+func generateStatePhase0(rng *rand.Rand, cfg *Config, preset *PresetValues) *BeaconStatePhase0 {
+	state := GenerateState(rng, cfg, preset)
+
+	// Phase0 tracks participation as pending attestations instead of flags
+	maxPending := preset.SlotsPerEpoch * min(cfg.MaxAttestations, 128)
+	prevAttestations := generatePendingAttestations(rng, maxPending, cfg.ValidatorCount, cfg.Slot-uint64(preset.SlotsPerEpoch))
+	currAttestations := generatePendingAttestations(rng, maxPending/2, cfg.ValidatorCount, cfg.Slot)
+
+	return &BeaconStatePhase0{
+		GenesisTime:                 state.GenesisTime,
+		GenesisValidatorsRoot:       state.GenesisValidatorsRoot,
+		Slot:                        state.Slot,
+		Fork:                        generateFork(forkVersionPhase0, state.Fork.Epoch),
+		LatestBlockHeader:           state.LatestBlockHeader,
+		BlockRoots:                  state.BlockRoots,
+		StateRoots:                  state.StateRoots,
+		HistoricalRoots:             state.HistoricalRoots,
+		ETH1Data:                    state.ETH1Data,
+		ETH1DataVotes:               state.ETH1DataVotes,
+		ETH1DepositIndex:            state.ETH1DepositIndex,
+		Validators:                  state.Validators,
+		Balances:                    state.Balances,
+		RANDAOMixes:                 state.RANDAOMixes,
+		Slashings:                   state.Slashings,
+		PreviousEpochAttestations:   prevAttestations,
+		CurrentEpochAttestations:    currAttestations,
+		JustificationBits:           state.JustificationBits,
+		PreviousJustifiedCheckpoint: state.PreviousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:  state.CurrentJustifiedCheckpoint,
+		FinalizedCheckpoint:         state.FinalizedCheckpoint,
+	}
+}
+
+func generateStateAltair(rng *rand.Rand, cfg *Config, preset *PresetValues) *BeaconStateAltair {
+	state := GenerateState(rng, cfg, preset)
+	return &BeaconStateAltair{
+		GenesisTime:                 state.GenesisTime,
+		GenesisValidatorsRoot:       state.GenesisValidatorsRoot,
+		Slot:                        state.Slot,
+		Fork:                        generateFork(forkVersionAltair, state.Fork.Epoch),
+		LatestBlockHeader:           state.LatestBlockHeader,
+		BlockRoots:                  state.BlockRoots,
+		StateRoots:                  state.StateRoots,
+		HistoricalRoots:             state.HistoricalRoots,
+		ETH1Data:                    state.ETH1Data,
+		ETH1DataVotes:               state.ETH1DataVotes,
+		ETH1DepositIndex:            state.ETH1DepositIndex,
+		Validators:                  state.Validators,
+		Balances:                    state.Balances,
+		RANDAOMixes:                 state.RANDAOMixes,
+		Slashings:                   state.Slashings,
+		PreviousEpochParticipation:  state.PreviousEpochParticipation,
+		CurrentEpochParticipation:   state.CurrentEpochParticipation,
+		JustificationBits:           state.JustificationBits,
+		PreviousJustifiedCheckpoint: state.PreviousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:  state.CurrentJustifiedCheckpoint,
+		FinalizedCheckpoint:         state.FinalizedCheckpoint,
+		InactivityScores:            state.InactivityScores,
+		CurrentSyncCommittee:        state.CurrentSyncCommittee,
+		NextSyncCommittee:           state.NextSyncCommittee,
+	}
+}
+
+func generateStateBellatrix(rng *rand.Rand, cfg *Config, preset *PresetValues) *BeaconStateBellatrix {
+	state := GenerateState(rng, cfg, preset)
+	header := state.LatestExecutionPayloadHeader
+	return &BeaconStateBellatrix{
+		GenesisTime:                 state.GenesisTime,
+		GenesisValidatorsRoot:       state.GenesisValidatorsRoot,
+		Slot:                        state.Slot,
+		Fork:                        generateFork(forkVersionBellatrix, state.Fork.Epoch),
+		LatestBlockHeader:           state.LatestBlockHeader,
+		BlockRoots:                  state.BlockRoots,
+		StateRoots:                  state.StateRoots,
+		HistoricalRoots:             state.HistoricalRoots,
+		ETH1Data:                    state.ETH1Data,
+		ETH1DataVotes:               state.ETH1DataVotes,
+		ETH1DepositIndex:            state.ETH1DepositIndex,
+		Validators:                  state.Validators,
+		Balances:                    state.Balances,
+		RANDAOMixes:                 state.RANDAOMixes,
+		Slashings:                   state.Slashings,
+		PreviousEpochParticipation:  state.PreviousEpochParticipation,
+		CurrentEpochParticipation:   state.CurrentEpochParticipation,
+		JustificationBits:           state.JustificationBits,
+		PreviousJustifiedCheckpoint: state.PreviousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:  state.CurrentJustifiedCheckpoint,
+		FinalizedCheckpoint:         state.FinalizedCheckpoint,
+		InactivityScores:            state.InactivityScores,
+		CurrentSyncCommittee:        state.CurrentSyncCommittee,
+		NextSyncCommittee:           state.NextSyncCommittee,
+		LatestExecutionPayloadHeader: &ExecutionPayloadHeaderBellatrix{
+			ParentHash:       header.ParentHash,
+			FeeRecipient:     header.FeeRecipient,
+			StateRoot:        header.StateRoot,
+			ReceiptsRoot:     header.ReceiptsRoot,
+			LogsBloom:        header.LogsBloom,
+			PrevRandao:       header.PrevRandao,
+			BlockNumber:      header.BlockNumber,
+			GasLimit:         header.GasLimit,
+			GasUsed:          header.GasUsed,
+			Timestamp:        header.Timestamp,
+			ExtraData:        header.ExtraData,
+			BaseFeePerGas:    header.BaseFeePerGas,
+			BlockHash:        header.BlockHash,
+			TransactionsRoot: header.TransactionsRoot,
+		},
+	}
+}
+
+func generateStateCapella(rng *rand.Rand, cfg *Config, preset *PresetValues) *BeaconStateCapella {
+	state := GenerateState(rng, cfg, preset)
+	header := state.LatestExecutionPayloadHeader
+	return &BeaconStateCapella{
+		GenesisTime:                 state.GenesisTime,
+		GenesisValidatorsRoot:       state.GenesisValidatorsRoot,
+		Slot:                        state.Slot,
+		Fork:                        generateFork(forkVersionCapella, state.Fork.Epoch),
+		LatestBlockHeader:           state.LatestBlockHeader,
+		BlockRoots:                  state.BlockRoots,
+		StateRoots:                  state.StateRoots,
+		HistoricalRoots:             state.HistoricalRoots,
+		ETH1Data:                    state.ETH1Data,
+		ETH1DataVotes:               state.ETH1DataVotes,
+		ETH1DepositIndex:            state.ETH1DepositIndex,
+		Validators:                  state.Validators,
+		Balances:                    state.Balances,
+		RANDAOMixes:                 state.RANDAOMixes,
+		Slashings:                   state.Slashings,
+		PreviousEpochParticipation:  state.PreviousEpochParticipation,
+		CurrentEpochParticipation:   state.CurrentEpochParticipation,
+		JustificationBits:           state.JustificationBits,
+		PreviousJustifiedCheckpoint: state.PreviousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:  state.CurrentJustifiedCheckpoint,
+		FinalizedCheckpoint:         state.FinalizedCheckpoint,
+		InactivityScores:            state.InactivityScores,
+		CurrentSyncCommittee:        state.CurrentSyncCommittee,
+		NextSyncCommittee:           state.NextSyncCommittee,
+		LatestExecutionPayloadHeader: &ExecutionPayloadHeaderCapella{
+			ParentHash:       header.ParentHash,
+			FeeRecipient:     header.FeeRecipient,
+			StateRoot:        header.StateRoot,
+			ReceiptsRoot:     header.ReceiptsRoot,
+			LogsBloom:        header.LogsBloom,
+			PrevRandao:       header.PrevRandao,
+			BlockNumber:      header.BlockNumber,
+			GasLimit:         header.GasLimit,
+			GasUsed:          header.GasUsed,
+			Timestamp:        header.Timestamp,
+			ExtraData:        header.ExtraData,
+			BaseFeePerGas:    header.BaseFeePerGas,
+			BlockHash:        header.BlockHash,
+			TransactionsRoot: header.TransactionsRoot,
+			WithdrawalsRoot:  header.WithdrawalsRoot,
+		},
+		NextWithdrawalIndex:          state.NextWithdrawalIndex,
+		NextWithdrawalValidatorIndex: state.NextWithdrawalValidatorIndex,
+		HistoricalSummaries:          state.HistoricalSummaries,
+	}
+}
+
+func generateStateElectra(rng *rand.Rand, cfg *Config, preset *PresetValues) *BeaconStateElectra {
+	state := GenerateState(rng, cfg, preset)
+	currentEpoch := state.Fork.Epoch
+
+	return &BeaconStateElectra{
+		GenesisTime:                   state.GenesisTime,
+		GenesisValidatorsRoot:         state.GenesisValidatorsRoot,
+		Slot:                          state.Slot,
+		Fork:                          generateFork(forkVersionElectra, currentEpoch),
+		LatestBlockHeader:             state.LatestBlockHeader,
+		BlockRoots:                    state.BlockRoots,
+		StateRoots:                    state.StateRoots,
+		HistoricalRoots:               state.HistoricalRoots,
+		ETH1Data:                      state.ETH1Data,
+		ETH1DataVotes:                 state.ETH1DataVotes,
+		ETH1DepositIndex:              state.ETH1DepositIndex,
+		Validators:                    state.Validators,
+		Balances:                      state.Balances,
+		RANDAOMixes:                   state.RANDAOMixes,
+		Slashings:                     state.Slashings,
+		PreviousEpochParticipation:    state.PreviousEpochParticipation,
+		CurrentEpochParticipation:     state.CurrentEpochParticipation,
+		JustificationBits:             state.JustificationBits,
+		PreviousJustifiedCheckpoint:   state.PreviousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:    state.CurrentJustifiedCheckpoint,
+		FinalizedCheckpoint:           state.FinalizedCheckpoint,
+		InactivityScores:              state.InactivityScores,
+		CurrentSyncCommittee:          state.CurrentSyncCommittee,
+		NextSyncCommittee:             state.NextSyncCommittee,
+		LatestExecutionPayloadHeader:  state.LatestExecutionPayloadHeader,
+		NextWithdrawalIndex:           state.NextWithdrawalIndex,
+		NextWithdrawalValidatorIndex:  state.NextWithdrawalValidatorIndex,
+		HistoricalSummaries:           state.HistoricalSummaries,
+		DepositRequestsStartIndex:     state.ETH1DepositIndex,
+		DepositBalanceToConsume:       randomUint64(rng) % 256000000000,
+		ExitBalanceToConsume:          randomUint64(rng) % 256000000000,
+		EarliestExitEpoch:             currentEpoch + randomUint64(rng)%64,
+		ConsolidationBalanceToConsume: randomUint64(rng) % 256000000000,
+		EarliestConsolidationEpoch:    currentEpoch + randomUint64(rng)%64,
+		PendingDeposits:               generatePendingDeposits(rng, min(cfg.ValidatorCount/100, preset.PendingDepositsLimit), cfg.Slot),
+		PendingPartialWithdrawals:     generatePendingPartialWithdrawals(rng, min(cfg.ValidatorCount/1000, preset.PendingPartialWithdrawalsLimit), cfg.ValidatorCount, currentEpoch),
+		PendingConsolidations:         generatePendingConsolidations(rng, min(cfg.ValidatorCount/2000, preset.PendingConsolidationsLimit), cfg.ValidatorCount),
+	}
+}
+
+func generateStateFulu(rng *rand.Rand, cfg *Config, preset *PresetValues) *BeaconStateFulu {
+	state := generateStateElectra(rng, cfg, preset)
+
+	lookahead := make([]ValidatorIndex, (preset.MinSeedLookahead+1)*preset.SlotsPerEpoch)
+	for i := range lookahead {
+		lookahead[i] = randomValidatorIndex(rng, cfg.ValidatorCount)
+	}
+
+	return &BeaconStateFulu{
+		GenesisTime:                   state.GenesisTime,
+		GenesisValidatorsRoot:         state.GenesisValidatorsRoot,
+		Slot:                          state.Slot,
+		Fork:                          generateFork(forkVersionFulu, state.Fork.Epoch),
+		LatestBlockHeader:             state.LatestBlockHeader,
+		BlockRoots:                    state.BlockRoots,
+		StateRoots:                    state.StateRoots,
+		HistoricalRoots:               state.HistoricalRoots,
+		ETH1Data:                      state.ETH1Data,
+		ETH1DataVotes:                 state.ETH1DataVotes,
+		ETH1DepositIndex:              state.ETH1DepositIndex,
+		Validators:                    state.Validators,
+		Balances:                      state.Balances,
+		RANDAOMixes:                   state.RANDAOMixes,
+		Slashings:                     state.Slashings,
+		PreviousEpochParticipation:    state.PreviousEpochParticipation,
+		CurrentEpochParticipation:     state.CurrentEpochParticipation,
+		JustificationBits:             state.JustificationBits,
+		PreviousJustifiedCheckpoint:   state.PreviousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:    state.CurrentJustifiedCheckpoint,
+		FinalizedCheckpoint:           state.FinalizedCheckpoint,
+		InactivityScores:              state.InactivityScores,
+		CurrentSyncCommittee:          state.CurrentSyncCommittee,
+		NextSyncCommittee:             state.NextSyncCommittee,
+		LatestExecutionPayloadHeader:  state.LatestExecutionPayloadHeader,
+		NextWithdrawalIndex:           state.NextWithdrawalIndex,
+		NextWithdrawalValidatorIndex:  state.NextWithdrawalValidatorIndex,
+		HistoricalSummaries:           state.HistoricalSummaries,
+		DepositRequestsStartIndex:     state.DepositRequestsStartIndex,
+		DepositBalanceToConsume:       state.DepositBalanceToConsume,
+		ExitBalanceToConsume:          state.ExitBalanceToConsume,
+		EarliestExitEpoch:             state.EarliestExitEpoch,
+		ConsolidationBalanceToConsume: state.ConsolidationBalanceToConsume,
+		EarliestConsolidationEpoch:    state.EarliestConsolidationEpoch,
+		PendingDeposits:               state.PendingDeposits,
+		PendingPartialWithdrawals:     state.PendingPartialWithdrawals,
+		PendingConsolidations:         state.PendingConsolidations,
+		ProposerLookahead:             lookahead,
+	}
+}
+
+func generatePendingAttestations(rng *rand.Rand, count int, maxValidators int, slot uint64) []*PendingAttestation {
+	attestations := make([]*PendingAttestation, count)
+	for i := 0; i < count; i++ {
+		numBits := 64 + int(randomUint64(rng)%200)
+		aggBits := bitfield.NewBitlist(uint64(numBits))
+		for j := 0; j < numBits*2/3; j++ {
+			aggBits.SetBitAt(uint64(j), true)
+		}
+
+		attestations[i] = &PendingAttestation{
+			AggregationBits: aggBits,
+			Data:            generateAttestationData(rng, slot-1-randomUint64(rng)%32, maxValidators),
+			InclusionDelay:  1 + randomUint64(rng)%4,
+			ProposerIndex:   randomValidatorIndex(rng, maxValidators),
+		}
+	}
+	return attestations
+}
+
+// committeeLayout returns the number of committees per slot and the size of
+// each committee for the given validator count, following the spec's
+// get_committee_count_per_slot.
+func committeeLayout(validatorCount int, preset *PresetValues) (int, int) {
+	committees := validatorCount / preset.SlotsPerEpoch / preset.TargetCommitteeSize
+	committees = max(1, min(committees, preset.MaxCommitteesPerSlot))
+	size := max(1, validatorCount/preset.SlotsPerEpoch/committees)
+	return committees, min(size, preset.MaxValidatorsPerCommittee)
+}
+
+func generateAttestationsElectra(rng *rand.Rand, count int, maxValidators int, slot uint64, preset *PresetValues) []*AttestationElectra {
+	committees, committeeSize := committeeLayout(maxValidators, preset)
+
+	attestations := make([]*AttestationElectra, count)
+	for i := 0; i < count; i++ {
+		// On-chain aggregates cover all committees of a slot, with ~2/3 of each
+		// committee participating
+		aggBits := bitfield.NewBitlist(uint64(committees * committeeSize))
+		for j := 0; j < committees*committeeSize; j++ {
+			if randomUint64(rng)%3 != 0 {
+				aggBits.SetBitAt(uint64(j), true)
+			}
+		}
+
+		committeeBits := bitfield.NewBitvector64()
+		for j := 0; j < committees; j++ {
+			committeeBits.SetBitAt(uint64(j), true)
+		}
+		if preset.MaxCommitteesPerSlot < 64 {
+			committeeBits = committeeBits[:(preset.MaxCommitteesPerSlot+7)/8]
+		}
+
+		data := generateAttestationData(rng, slot-1, maxValidators)
+		data.Index = 0 // EIP-7549 moves the committee index out of the signed data
+
+		attestations[i] = &AttestationElectra{
+			AggregationBits: aggBits,
+			Data:            data,
+			Signature:       randomBLSSignature(rng),
+			CommitteeBits:   committeeBits,
+		}
+	}
+	return attestations
+}
+
+func generateAttesterSlashingsElectra(rng *rand.Rand, count int, maxValidators int) []*AttesterSlashingElectra {
+	slashings := make([]*AttesterSlashingElectra, count)
+	for i := 0; i < count; i++ {
+		numIndices := 10 + int(randomUint64(rng)%50)
+		indices := make([]uint64, numIndices)
+		for j := 0; j < numIndices; j++ {
+			indices[j] = uint64(randomValidatorIndex(rng, maxValidators))
+		}
+
+		slashings[i] = &AttesterSlashingElectra{
+			Attestation1: &IndexedAttestationElectra{
+				AttestingIndices: indices,
+				Data:             generateAttestationData(rng, randomUint64(rng)%1000, maxValidators),
+				Signature:        randomBLSSignature(rng),
+			},
+			Attestation2: &IndexedAttestationElectra{
+				AttestingIndices: indices,
+				Data:             generateAttestationData(rng, randomUint64(rng)%1000, maxValidators),
+				Signature:        randomBLSSignature(rng),
+			},
+		}
+	}
+	return slashings
+}
+
+func generateExecutionRequests(rng *rand.Rand, cfg *Config, preset *PresetValues) *ExecutionRequests {
+	deposits := make([]*DepositRequest, min(cfg.MaxDepositRequests, preset.MaxDepositRequests))
+	for i := range deposits {
+		deposits[i] = &DepositRequest{
+			Pubkey:                randomBLSPubKey(rng),
+			WithdrawalCredentials: randomHash32(rng),
+			Amount:                32000000000,
+			Signature:             randomBLSSignature(rng),
+			Index:                 uint64(cfg.ValidatorCount + i),
+		}
+	}
+
+	withdrawals := make([]*WithdrawalRequest, min(cfg.MaxWithdrawalRequests, preset.MaxWithdrawalRequests))
+	for i := range withdrawals {
+		withdrawals[i] = &WithdrawalRequest{
+			SourceAddress:   randomExecutionAddress(rng),
+			ValidatorPubkey: randomBLSPubKey(rng),
+			Amount:          randomUint64(rng) % 32000000000,
+		}
+	}
+
+	consolidations := make([]*ConsolidationRequest, min(cfg.MaxConsolidationRequests, preset.MaxConsolidationRequests))
+	for i := range consolidations {
+		consolidations[i] = &ConsolidationRequest{
+			SourceAddress: randomExecutionAddress(rng),
+			SourcePubkey:  randomBLSPubKey(rng),
+			TargetPubkey:  randomBLSPubKey(rng),
+		}
+	}
+
+	return &ExecutionRequests{
+		Deposits:       deposits,
+		Withdrawals:    withdrawals,
+		Consolidations: consolidations,
+	}
+}
+
+func generatePendingDeposits(rng *rand.Rand, count int, slot uint64) []*PendingDeposit {
+	deposits := make([]*PendingDeposit, count)
+	for i := 0; i < count; i++ {
+		deposits[i] = &PendingDeposit{
+			Pubkey:                randomBLSPubKey(rng),
+			WithdrawalCredentials: randomHash32(rng),
+			Amount:                32000000000,
+			Signature:             randomBLSSignature(rng),
+		}
+		// Deposits are queued in the 8192 slots up to the current one
+		if slot > 0 {
+			deposits[i].Slot = slot - randomUint64(rng)%min(slot, 8192)
+		}
+	}
+	return deposits
+}
+
+func generatePendingPartialWithdrawals(rng *rand.Rand, count int, maxValidators int, currentEpoch uint64) []*PendingPartialWithdrawal {
+	withdrawals := make([]*PendingPartialWithdrawal, count)
+	for i := 0; i < count; i++ {
+		withdrawals[i] = &PendingPartialWithdrawal{
+			ValidatorIndex:    randomValidatorIndex(rng, maxValidators),
+			Amount:            1000000000 + randomUint64(rng)%31000000000,
+			WithdrawableEpoch: currentEpoch + randomUint64(rng)%256,
+		}
+	}
+	return withdrawals
+}
+
+func generatePendingConsolidations(rng *rand.Rand, count int, maxValidators int) []*PendingConsolidation {
+	consolidations := make([]*PendingConsolidation, count)
+	for i := 0; i < count; i++ {
+		consolidations[i] = &PendingConsolidation{
+			SourceIndex: randomValidatorIndex(rng, maxValidators),
+			TargetIndex: randomValidatorIndex(rng, maxValidators),
+		}
+	}
+	return consolidations
+}
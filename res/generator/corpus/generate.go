@@ -0,0 +1,515 @@
+package corpus
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+func GenerateBlock(rng *rand.Rand, cfg *Config, preset *PresetValues) *SignedBeaconBlock {
+	return &SignedBeaconBlock{
+		Message:   GenerateBeaconBlock(rng, cfg, preset),
+		Signature: randomBLSSignature(rng),
+	}
+}
+
+func GenerateBeaconBlock(rng *rand.Rand, cfg *Config, preset *PresetValues) *BeaconBlock {
+	return &BeaconBlock{
+		Slot:          cfg.Slot,
+		ProposerIndex: randomValidatorIndex(rng, cfg.ValidatorCount),
+		ParentRoot:    randomRoot(rng),
+		StateRoot:     randomRoot(rng),
+		Body:          generateBeaconBlockBody(rng, cfg, preset),
+	}
+}
+
+func generateBeaconBlockBody(rng *rand.Rand, cfg *Config, preset *PresetValues) *BeaconBlockBody {
+	// Use minimum of configured value and preset max
+	maxWithdrawals := min(preset.MaxWithdrawals, 16)
+	maxBlobCommitments := min(preset.MaxBlobCommitments, 32) // cap for reasonable file size
+
+	return &BeaconBlockBody{
+		RANDAOReveal:          randomBLSSignature(rng),
+		ETH1Data:              generateETH1Data(rng),
+		Graffiti:              randomHash32(rng),
+		ProposerSlashings:     generateProposerSlashings(rng, min(cfg.MaxProposerSlashings, 16), cfg.ValidatorCount),
+		AttesterSlashings:     generateAttesterSlashings(rng, min(cfg.MaxAttesterSlashings, 2), cfg.ValidatorCount),
+		Attestations:          generateAttestations(rng, min(cfg.MaxAttestations, 128), cfg.ValidatorCount, cfg.Slot),
+		Deposits:              generateDeposits(rng, min(cfg.MaxDeposits, 16)),
+		VoluntaryExits:        generateVoluntaryExits(rng, min(cfg.MaxVoluntaryExits, 16), cfg.ValidatorCount),
+		SyncAggregate:         generateSyncAggregate(rng, preset.SyncCommitteeSize),
+		ExecutionPayload:      generateExecutionPayload(rng, cfg, maxWithdrawals),
+		BLSToExecutionChanges: generateBLSToExecChanges(rng, min(cfg.MaxBLSToExecChanges, 16), cfg.ValidatorCount),
+		BlobKZGCommitments:    generateBlobCommitments(rng, maxBlobCommitments),
+	}
+}
+
+func GenerateState(rng *rand.Rand, cfg *Config, preset *PresetValues) *BeaconState {
+	var validators []*Validator
+	var balances []Gwei
+	var prevParticipation, currParticipation []ParticipationFlags
+	var inactivityScores []uint64
+
+	if cfg.Profile == profileMainnetRealistic {
+		validators, balances, prevParticipation, currParticipation, inactivityScores = generateRealisticValidators(rng, cfg, preset)
+	} else {
+		validators = make([]*Validator, cfg.ValidatorCount)
+		balances = make([]Gwei, cfg.ValidatorCount)
+		prevParticipation = make([]ParticipationFlags, cfg.ValidatorCount)
+		currParticipation = make([]ParticipationFlags, cfg.ValidatorCount)
+		inactivityScores = make([]uint64, cfg.ValidatorCount)
+
+		for i := 0; i < cfg.ValidatorCount; i++ {
+			validators[i] = generateValidator(rng)
+			balances[i] = 32000000000 + Gwei(randomUint64(rng)%1000000000)
+			prevParticipation[i] = ParticipationFlags(randomByte(rng) & 0x07)
+			currParticipation[i] = ParticipationFlags(randomByte(rng) & 0x07)
+			inactivityScores[i] = randomUint64(rng) % 100
+		}
+	}
+
+	// Generate historical roots (block and state roots)
+	blockRoots := make([]Root, preset.SlotsPerHistoricalRoot)
+	stateRoots := make([]Root, preset.SlotsPerHistoricalRoot)
+	for i := 0; i < preset.SlotsPerHistoricalRoot; i++ {
+		blockRoots[i] = randomRoot(rng)
+		stateRoots[i] = randomRoot(rng)
+	}
+
+	// Generate RANDAO mixes
+	randaoMixes := make([]Root, preset.EpochsPerHistVector)
+	for i := 0; i < preset.EpochsPerHistVector; i++ {
+		randaoMixes[i] = randomRoot(rng)
+	}
+
+	// Generate slashings
+	slashings := make([]Gwei, preset.EpochsPerSlashVector)
+	for i := 0; i < preset.EpochsPerSlashVector; i++ {
+		slashings[i] = randomUint64(rng) % 32000000000
+	}
+
+	// Generate ETH1 data votes
+	maxEth1Votes := preset.SlotsPerEpoch * preset.EpochsPerEth1Voting
+	eth1Votes := make([]*ETH1Data, maxEth1Votes)
+	for i := 0; i < maxEth1Votes; i++ {
+		eth1Votes[i] = generateETH1Data(rng)
+	}
+
+	currentEpoch := cfg.Slot / uint64(preset.SlotsPerEpoch)
+
+	// A new chain has no history; the realistic profile sizes it to the slot
+	historicalRoots := []Root{}
+	historicalSummaries := []*HistoricalSummary{}
+	if cfg.Profile == profileMainnetRealistic {
+		historicalRoots, historicalSummaries = generateHistoricalAccumulators(rng, cfg.Slot, preset)
+	}
+
+	return &BeaconState{
+		GenesisTime:                  1606824023,
+		GenesisValidatorsRoot:        randomRoot(rng),
+		Slot:                         cfg.Slot,
+		Fork:                         generateFork(forkVersionDeneb, currentEpoch),
+		LatestBlockHeader:            generateBeaconBlockHeader(rng, cfg.Slot, cfg.ValidatorCount),
+		BlockRoots:                   blockRoots,
+		StateRoots:                   stateRoots,
+		HistoricalRoots:              historicalRoots,
+		ETH1Data:                     generateETH1Data(rng),
+		ETH1DataVotes:                eth1Votes,
+		ETH1DepositIndex:             uint64(cfg.ValidatorCount),
+		Validators:                   validators,
+		Balances:                     balances,
+		RANDAOMixes:                  randaoMixes,
+		Slashings:                    slashings,
+		PreviousEpochParticipation:   prevParticipation,
+		CurrentEpochParticipation:    currParticipation,
+		JustificationBits:            bitfield.NewBitvector4(),
+		PreviousJustifiedCheckpoint:  generateCheckpoint(rng, currentEpoch-2),
+		CurrentJustifiedCheckpoint:   generateCheckpoint(rng, currentEpoch-1),
+		FinalizedCheckpoint:          generateCheckpoint(rng, currentEpoch-2),
+		InactivityScores:             inactivityScores,
+		CurrentSyncCommittee:         generateSyncCommittee(rng, preset.SyncCommitteeSize),
+		NextSyncCommittee:            generateSyncCommittee(rng, preset.SyncCommitteeSize),
+		LatestExecutionPayloadHeader: generateExecutionPayloadHeader(rng),
+		NextWithdrawalIndex:          randomUint64(rng) % 1000000,
+		NextWithdrawalValidatorIndex: randomValidatorIndex(rng, cfg.ValidatorCount),
+		HistoricalSummaries:          historicalSummaries,
+	}
+}
+
+// Helper functions for generating random data
+
+// NewRNG returns a deterministic random source for a single generated object.
+// Every object gets its own stream derived from the seed, so e.g. the state
+// does not change when block-only flags like --transactions are adjusted.
+func NewRNG(seed int64, label string) *rand.Rand {
+	h := sha256.Sum256(fmt.Appendf(nil, "%d/%s", seed, label))
+	return rand.New(rand.NewSource(int64(binary.LittleEndian.Uint64(h[:8]))))
+}
+
+func randomBytes(rng *rand.Rand, n int) []byte {
+	b := make([]byte, n)
+	_, _ = rng.Read(b)
+	return b
+}
+
+func randomByte(rng *rand.Rand) byte {
+	return byte(rng.Intn(256))
+}
+
+func randomUint64(rng *rand.Rand) uint64 {
+	return rng.Uint64()
+}
+
+func randomRoot(rng *rand.Rand) Root {
+	var root Root
+	_, _ = rng.Read(root[:])
+	return root
+}
+
+func randomHash32(rng *rand.Rand) Hash32 {
+	var hash Hash32
+	_, _ = rng.Read(hash[:])
+	return hash
+}
+
+func randomBLSPubKey(rng *rand.Rand) BLSPubKey {
+	var key BLSPubKey
+	_, _ = rng.Read(key[:])
+	return key
+}
+
+func randomBLSSignature(rng *rand.Rand) BLSSignature {
+	var sig BLSSignature
+	_, _ = rng.Read(sig[:])
+	return sig
+}
+
+func randomExecutionAddress(rng *rand.Rand) ExecutionAddress {
+	var addr ExecutionAddress
+	_, _ = rng.Read(addr[:])
+	return addr
+}
+
+func randomKZGCommitment(rng *rand.Rand) KZGCommitment {
+	var commitment KZGCommitment
+	_, _ = rng.Read(commitment[:])
+	return commitment
+}
+
+func randomValidatorIndex(rng *rand.Rand, maxValidators int) ValidatorIndex {
+	return ValidatorIndex(randomUint64(rng) % uint64(maxValidators))
+}
+
+func randomLogsBloom(rng *rand.Rand) LogsBloom {
+	var bloom LogsBloom
+	_, _ = rng.Read(bloom[:])
+	return bloom
+}
+
+func randomUint256(rng *rand.Rand) Uint256 {
+	var u Uint256
+	_, _ = rng.Read(u[:])
+	return u
+}
+
+func generateETH1Data(rng *rand.Rand) *ETH1Data {
+	return &ETH1Data{
+		DepositRoot:  randomRoot(rng),
+		DepositCount: randomUint64(rng) % 1000000,
+		BlockHash:    randomHash32(rng),
+	}
+}
+
+func generateValidator(rng *rand.Rand) *Validator {
+	return &Validator{
+		Pubkey:                     randomBLSPubKey(rng),
+		WithdrawalCredentials:      randomHash32(rng),
+		EffectiveBalance:           32000000000,
+		Slashed:                    false,
+		ActivationEligibilityEpoch: 0,
+		ActivationEpoch:            0,
+		ExitEpoch:                  ^uint64(0),
+		WithdrawableEpoch:          ^uint64(0),
+	}
+}
+
+func generateFork(version [4]byte, currentEpoch uint64) *Fork {
+	return &Fork{
+		PreviousVersion: version,
+		CurrentVersion:  version,
+		Epoch:           currentEpoch,
+	}
+}
+
+func generateCheckpoint(rng *rand.Rand, epoch uint64) *Checkpoint {
+	return &Checkpoint{
+		Epoch: epoch,
+		Root:  randomRoot(rng),
+	}
+}
+
+func generateBeaconBlockHeader(rng *rand.Rand, slot uint64, maxValidators int) *BeaconBlockHeader {
+	return &BeaconBlockHeader{
+		Slot:          slot - 1,
+		ProposerIndex: randomValidatorIndex(rng, maxValidators),
+		ParentRoot:    randomRoot(rng),
+		StateRoot:     randomRoot(rng),
+		BodyRoot:      randomRoot(rng),
+	}
+}
+
+func generateProposerSlashings(rng *rand.Rand, count int, maxValidators int) []*ProposerSlashing {
+	slashings := make([]*ProposerSlashing, count)
+	for i := 0; i < count; i++ {
+		validatorIdx := randomValidatorIndex(rng, maxValidators)
+		slashings[i] = &ProposerSlashing{
+			SignedHeader1: &SignedBeaconBlockHeader{
+				Message: &BeaconBlockHeader{
+					Slot:          randomUint64(rng) % 1000,
+					ProposerIndex: validatorIdx,
+					ParentRoot:    randomRoot(rng),
+					StateRoot:     randomRoot(rng),
+					BodyRoot:      randomRoot(rng),
+				},
+				Signature: randomBLSSignature(rng),
+			},
+			SignedHeader2: &SignedBeaconBlockHeader{
+				Message: &BeaconBlockHeader{
+					Slot:          randomUint64(rng) % 1000,
+					ProposerIndex: validatorIdx,
+					ParentRoot:    randomRoot(rng),
+					StateRoot:     randomRoot(rng),
+					BodyRoot:      randomRoot(rng),
+				},
+				Signature: randomBLSSignature(rng),
+			},
+		}
+	}
+	return slashings
+}
+
+func generateAttesterSlashings(rng *rand.Rand, count int, maxValidators int) []*AttesterSlashing {
+	slashings := make([]*AttesterSlashing, count)
+	for i := 0; i < count; i++ {
+		// Generate overlapping attesting indices for a valid attester slashing
+		numIndices := 10 + int(randomUint64(rng)%50)
+		indices := make([]uint64, numIndices)
+		for j := 0; j < numIndices; j++ {
+			indices[j] = uint64(randomValidatorIndex(rng, maxValidators))
+		}
+
+		slashings[i] = &AttesterSlashing{
+			Attestation1: &IndexedAttestation{
+				AttestingIndices: indices,
+				Data:             generateAttestationData(rng, randomUint64(rng)%1000, maxValidators),
+				Signature:        randomBLSSignature(rng),
+			},
+			Attestation2: &IndexedAttestation{
+				AttestingIndices: indices,
+				Data:             generateAttestationData(rng, randomUint64(rng)%1000, maxValidators),
+				Signature:        randomBLSSignature(rng),
+			},
+		}
+	}
+	return slashings
+}
+
+func generateAttestationData(rng *rand.Rand, slot uint64, _ int) *AttestationData {
+	epoch := slot / 32
+	return &AttestationData{
+		Slot:            slot,
+		Index:           randomUint64(rng) % 64,
+		BeaconBlockRoot: randomRoot(rng),
+		Source: &Checkpoint{
+			Epoch: epoch - 1,
+			Root:  randomRoot(rng),
+		},
+		Target: &Checkpoint{
+			Epoch: epoch,
+			Root:  randomRoot(rng),
+		},
+	}
+}
+
+func generateAttestations(rng *rand.Rand, count int, maxValidators int, slot uint64) []*Attestation {
+	attestations := make([]*Attestation, count)
+	for i := 0; i < count; i++ {
+		// Generate aggregation bits with some validators participating
+		numBits := 64 + int(randomUint64(rng)%200)
+		aggBits := bitfield.NewBitlist(uint64(numBits))
+		// Set ~2/3 of bits
+		for j := 0; j < numBits*2/3; j++ {
+			aggBits.SetBitAt(uint64(j), true)
+		}
+
+		attestations[i] = &Attestation{
+			AggregationBits: aggBits,
+			Data:            generateAttestationData(rng, slot-1, maxValidators),
+			Signature:       randomBLSSignature(rng),
+		}
+	}
+	return attestations
+}
+
+func generateDeposits(rng *rand.Rand, count int) []*Deposit {
+	deposits := make([]*Deposit, count)
+	for i := 0; i < count; i++ {
+		// Generate merkle proof (33 x 32-byte hashes)
+		proof := make([][]byte, 33)
+		for j := 0; j < 33; j++ {
+			proof[j] = randomBytes(rng, 32)
+		}
+
+		deposits[i] = &Deposit{
+			Proof: proof,
+			Data: &DepositData{
+				Pubkey:                randomBLSPubKey(rng),
+				WithdrawalCredentials: randomHash32(rng),
+				Amount:                32000000000,
+				Signature:             randomBLSSignature(rng),
+			},
+		}
+	}
+	return deposits
+}
+
+func generateVoluntaryExits(rng *rand.Rand, count int, maxValidators int) []*SignedVoluntaryExit {
+	exits := make([]*SignedVoluntaryExit, count)
+	for i := 0; i < count; i++ {
+		exits[i] = &SignedVoluntaryExit{
+			Message: &VoluntaryExit{
+				Epoch:          randomUint64(rng) % 1000,
+				ValidatorIndex: randomValidatorIndex(rng, maxValidators),
+			},
+			Signature: randomBLSSignature(rng),
+		}
+	}
+	return exits
+}
+
+func generateSyncAggregate(rng *rand.Rand, syncCommitteeSize int) *SyncAggregate {
+	// Create sync committee bits based on committee size
+	var bits bitfield.Bitvector512
+	if syncCommitteeSize <= 512 {
+		// Set ~2/3 of bits to simulate participation
+		for i := 0; i < syncCommitteeSize*2/3; i++ {
+			bits.SetBitAt(uint64(i), true)
+		}
+	}
+
+	return &SyncAggregate{
+		SyncCommitteeBits:      bits,
+		SyncCommitteeSignature: randomBLSSignature(rng),
+	}
+}
+
+func generateSyncCommittee(rng *rand.Rand, size int) *SyncCommittee {
+	pubkeys := make([]BLSPubKey, size)
+	for i := 0; i < size; i++ {
+		pubkeys[i] = randomBLSPubKey(rng)
+	}
+
+	return &SyncCommittee{
+		Pubkeys:         pubkeys,
+		AggregatePubkey: randomBLSPubKey(rng),
+	}
+}
+
+func generateExecutionPayload(rng *rand.Rand, cfg *Config, maxWithdrawals int) *ExecutionPayload {
+	// Generate transactions
+	var transactions [][]byte
+	if cfg.TxModel == txModelTyped {
+		transactions = generateTypedTransactions(rng, cfg.TransactionCount, cfg.txSizeBuckets)
+	} else {
+		transactions = make([][]byte, cfg.TransactionCount)
+		for i := 0; i < cfg.TransactionCount; i++ {
+			txSize := cfg.TransactionMinSize + int(randomUint64(rng)%uint64(cfg.TransactionMaxSize-cfg.TransactionMinSize+1))
+			transactions[i] = randomBytes(rng, txSize)
+		}
+	}
+
+	// Generate withdrawals
+	withdrawals := make([]*Withdrawal, maxWithdrawals)
+	for i := 0; i < maxWithdrawals; i++ {
+		withdrawals[i] = &Withdrawal{
+			Index:          uint64(i),
+			ValidatorIndex: randomValidatorIndex(rng, cfg.ValidatorCount),
+			Address:        randomExecutionAddress(rng),
+			Amount:         randomUint64(rng) % 32000000000,
+		}
+	}
+
+	return &ExecutionPayload{
+		ParentHash:    randomHash32(rng),
+		FeeRecipient:  randomExecutionAddress(rng),
+		StateRoot:     randomHash32(rng),
+		ReceiptsRoot:  randomHash32(rng),
+		LogsBloom:     randomLogsBloom(rng),
+		PrevRandao:    randomHash32(rng),
+		BlockNumber:   randomUint64(rng) % 10000000,
+		GasLimit:      30000000,
+		GasUsed:       15000000 + randomUint64(rng)%10000000,
+		Timestamp:     1700000000 + randomUint64(rng)%10000000,
+		ExtraData:     randomBytes(rng, 32),
+		BaseFeePerGas: randomUint256(rng),
+		BlockHash:     randomHash32(rng),
+		Transactions:  transactions,
+		Withdrawals:   withdrawals,
+		BlobGasUsed:   randomUint64(rng) % 1000000,
+		ExcessBlobGas: randomUint64(rng) % 1000000,
+	}
+}
+
+func generateExecutionPayloadHeader(rng *rand.Rand) *ExecutionPayloadHeader {
+	// Compute a fake transactions root
+	txRoot := sha256.Sum256(randomBytes(rng, 32))
+
+	// Compute a fake withdrawals root
+	wdRoot := sha256.Sum256(randomBytes(rng, 32))
+
+	return &ExecutionPayloadHeader{
+		ParentHash:       randomHash32(rng),
+		FeeRecipient:     randomExecutionAddress(rng),
+		StateRoot:        randomHash32(rng),
+		ReceiptsRoot:     randomHash32(rng),
+		LogsBloom:        randomLogsBloom(rng),
+		PrevRandao:       randomHash32(rng),
+		BlockNumber:      randomUint64(rng) % 10000000,
+		GasLimit:         30000000,
+		GasUsed:          15000000 + randomUint64(rng)%10000000,
+		Timestamp:        1700000000 + randomUint64(rng)%10000000,
+		ExtraData:        randomBytes(rng, 32),
+		BaseFeePerGas:    randomUint256(rng),
+		BlockHash:        randomHash32(rng),
+		TransactionsRoot: txRoot,
+		WithdrawalsRoot:  wdRoot,
+		BlobGasUsed:      randomUint64(rng) % 1000000,
+		ExcessBlobGas:    randomUint64(rng) % 1000000,
+	}
+}
+
+func generateBLSToExecChanges(rng *rand.Rand, count int, maxValidators int) []*SignedBLSToExecutionChange {
+	changes := make([]*SignedBLSToExecutionChange, count)
+	for i := 0; i < count; i++ {
+		changes[i] = &SignedBLSToExecutionChange{
+			Message: &BLSToExecutionChange{
+				ValidatorIndex:     randomValidatorIndex(rng, maxValidators),
+				FromBLSPubkey:      randomBLSPubKey(rng),
+				ToExecutionAddress: randomExecutionAddress(rng),
+			},
+			Signature: randomBLSSignature(rng),
+		}
+	}
+	return changes
+}
+
+func generateBlobCommitments(rng *rand.Rand, count int) []KZGCommitment {
+	commitments := make([]KZGCommitment, count)
+	for i := 0; i < count; i++ {
+		commitments[i] = randomKZGCommitment(rng)
+	}
+	return commitments
+}
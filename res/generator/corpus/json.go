@@ -0,0 +1,98 @@
+package corpus
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// marshalBeaconJSON encodes obj the way the beacon API does: containers as
+// objects keyed by their json tag, integers as decimal strings, byte vectors,
+// byte lists and bitfields as 0x-prefixed hex and all other lists as arrays.
+// Two tag options cover the fields the Go type alone cannot tell apart:
+// "uint256" for a little-endian 32 byte integer (base_fee_per_gas) and
+// "list" for a uint8 list encoded element by element (participation flags).
+//
+// The output is compact and in field order, so encoding/json produces the
+// same bytes for an equivalent struct.
+func marshalBeaconJSON(obj any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := encodeBeaconJSON(&buf, reflect.ValueOf(obj), ""); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func encodeBeaconJSON(buf *bytes.Buffer, v reflect.Value, option string) error {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			buf.WriteString("null")
+			return nil
+		}
+		return encodeBeaconJSON(buf, v.Elem(), option)
+
+	case reflect.Struct:
+		buf.WriteByte('{')
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			name, fieldOption, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if name == "" {
+				return fmt.Errorf("%s.%s has no json tag", v.Type().Name(), field.Name)
+			}
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			buf.WriteString(strconv.Quote(name))
+			buf.WriteByte(':')
+			if err := encodeBeaconJSON(buf, v.Field(i), fieldOption); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+		}
+		buf.WriteByte('}')
+
+	case reflect.Bool:
+		buf.WriteString(strconv.FormatBool(v.Bool()))
+
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		buf.WriteByte('"')
+		buf.WriteString(strconv.FormatUint(v.Uint(), 10))
+		buf.WriteByte('"')
+
+	case reflect.Array, reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Uint8 || option == "list" {
+			buf.WriteByte('[')
+			for i := 0; i < v.Len(); i++ {
+				if i > 0 {
+					buf.WriteByte(',')
+				}
+				if err := encodeBeaconJSON(buf, v.Index(i), ""); err != nil {
+					return err
+				}
+			}
+			buf.WriteByte(']')
+			return nil
+		}
+
+		data := make([]byte, v.Len())
+		reflect.Copy(reflect.ValueOf(data), v)
+		buf.WriteByte('"')
+		if option == "uint256" {
+			slices.Reverse(data)
+			buf.WriteString(new(big.Int).SetBytes(data).String())
+		} else {
+			buf.WriteString("0x")
+			buf.WriteString(hex.EncodeToString(data))
+		}
+		buf.WriteByte('"')
+
+	default:
+		return fmt.Errorf("unsupported kind %s", v.Kind())
+	}
+	return nil
+}
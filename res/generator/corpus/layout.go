@@ -0,0 +1,200 @@
+package corpus
+
+import (
+	"encoding/binary"
+	"fmt"
+	"reflect"
+
+	dynssz "github.com/pk910/dynamic-ssz"
+)
+
+// sszLayout records where the variable parts of an encoded object sit, so
+// malformed variants can be derived by editing the bytes in place.
+type sszLayout struct {
+	// FixedSize is the size of the fixed part of the top-level container
+	FixedSize int
+	// Static are the static fields of the top-level container
+	Static []sszRegion
+	// Offsets are all offsets, in encoding order
+	Offsets []*offsetSlot
+	// Lists are the lists with fixed-size elements
+	Lists []*listRegion
+	// Bitlists are all bitlists, including their delimiter byte
+	Bitlists []sszRegion
+}
+
+// sszRegion is a byte range [Start, End) of the encoding
+type sszRegion struct {
+	Start int
+	End   int
+}
+
+// offsetSlot is a 4 byte offset at Pos, relative to Base (the start of its
+// container or list)
+type offsetSlot struct {
+	Pos   int
+	Base  int
+	Value uint32
+}
+
+// listRegion is a list with fixed-size elements
+type listRegion struct {
+	sszRegion
+	ElemSize int
+	Limit    uint64
+}
+
+// readLayout walks the encoding of a value of type t
+func readLayout(dynSsz *dynssz.DynSsz, t reflect.Type, data []byte) (*sszLayout, error) {
+	desc, err := dynSsz.GetTypeCache().GetTypeDescriptor(t, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	layout := &sszLayout{FixedSize: len(data)}
+	if err := layout.walk(desc, data, 0, len(data), true); err != nil {
+		return nil, err
+	}
+	return layout, nil
+}
+
+func (l *sszLayout) walk(desc *dynssz.TypeDescriptor, data []byte, start, end int, root bool) error {
+	switch desc.SszType {
+	case dynssz.SszContainerType:
+		return l.walkContainer(desc, data, start, end, root)
+
+	case dynssz.SszListType, dynssz.SszVectorType:
+		elem := desc.ElemDesc
+		if elem.SszTypeFlags&dynssz.SszTypeFlagIsDynamic != 0 {
+			return l.walkOffsetTable(elem, data, start, end)
+		}
+		if desc.SszType == dynssz.SszListType {
+			l.Lists = append(l.Lists, &listRegion{
+				sszRegion: sszRegion{start, end},
+				ElemSize:  int(elem.Size),
+				Limit:     desc.Limit,
+			})
+		}
+
+	case dynssz.SszBitlistType:
+		l.Bitlists = append(l.Bitlists, sszRegion{start, end})
+	}
+	return nil
+}
+
+func (l *sszLayout) walkContainer(desc *dynssz.TypeDescriptor, data []byte, start, end int, root bool) error {
+	type dynField struct {
+		desc   *dynssz.TypeDescriptor
+		offset int
+	}
+	var dynFields []dynField
+
+	pos := start
+	for _, field := range desc.ContainerDesc.Fields {
+		if field.Type.SszTypeFlags&dynssz.SszTypeFlagIsDynamic != 0 {
+			offset, err := l.readOffset(data, pos, start, end)
+			if err != nil {
+				return fmt.Errorf("%s: %w", field.Name, err)
+			}
+			dynFields = append(dynFields, dynField{field.Type, start + offset})
+			pos += 4
+			continue
+		}
+
+		size := int(field.Type.Size)
+		if root {
+			l.Static = append(l.Static, sszRegion{pos, pos + size})
+		}
+		if err := l.walk(field.Type, data, pos, pos+size, false); err != nil {
+			return fmt.Errorf("%s: %w", field.Name, err)
+		}
+		pos += size
+	}
+
+	if root {
+		l.FixedSize = pos - start
+	}
+
+	for i, field := range dynFields {
+		fieldEnd := end
+		if i+1 < len(dynFields) {
+			fieldEnd = dynFields[i+1].offset
+		}
+		if fieldEnd < field.offset {
+			return fmt.Errorf("offsets out of order")
+		}
+		if err := l.walk(field.desc, data, field.offset, fieldEnd, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walkOffsetTable walks a list or vector of dynamic elements, which starts
+// with one offset per element
+func (l *sszLayout) walkOffsetTable(elem *dynssz.TypeDescriptor, data []byte, start, end int) error {
+	if start == end {
+		return nil
+	}
+
+	first, err := l.readOffset(data, start, start, end)
+	if err != nil {
+		return err
+	}
+	offsets := []int{start + first}
+	for pos := start + 4; pos < start+first; pos += 4 {
+		offset, err := l.readOffset(data, pos, start, end)
+		if err != nil {
+			return err
+		}
+		offsets = append(offsets, start+offset)
+	}
+
+	for i, offset := range offsets {
+		elemEnd := end
+		if i+1 < len(offsets) {
+			elemEnd = offsets[i+1]
+		}
+		if elemEnd < offset {
+			return fmt.Errorf("offsets out of order")
+		}
+		if err := l.walk(elem, data, offset, elemEnd, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *sszLayout) readOffset(data []byte, pos, base, end int) (int, error) {
+	if pos+4 > end {
+		return 0, fmt.Errorf("offset at %d past end", pos)
+	}
+	value := binary.LittleEndian.Uint32(data[pos:])
+	if base+int(value) > end {
+		return 0, fmt.Errorf("offset %d at %d past end", value, pos)
+	}
+	l.Offsets = append(l.Offsets, &offsetSlot{Pos: pos, Base: base, Value: value})
+	return int(value), nil
+}
+
+// splice replaces del bytes at pos with ins and fixes up every offset that
+// points behind the edit, so the result differs from data only in the edited
+// bytes. Edits must not touch an offset slot.
+func (l *sszLayout) splice(data []byte, pos, del int, ins []byte) []byte {
+	out := make([]byte, 0, len(data)-del+len(ins))
+	out = append(out, data[:pos]...)
+	out = append(out, ins...)
+	out = append(out, data[pos+del:]...)
+
+	delta := len(ins) - del
+	for _, slot := range l.Offsets {
+		slotPos := slot.Pos
+		if slotPos >= pos+del {
+			slotPos += delta
+		}
+		if slot.Base < pos && slot.Base+int(slot.Value) >= pos+del {
+			binary.LittleEndian.PutUint32(out[slotPos:], uint32(int(slot.Value)+delta))
+		}
+	}
+	return out
+}
@@ -0,0 +1,173 @@
+package corpus
+
+import (
+	"fmt"
+	"math/rand"
+
+	dynssz "github.com/pk910/dynamic-ssz"
+	"github.com/pk910/dynamic-ssz/treeproof"
+)
+
+// Generalized indices of the light client branches (deneb). The BeaconState's
+// 28 fields make a 32 leaf tree, the BeaconBlockBody's 12 fields a 16 leaf
+// tree and the ExecutionPayload's 17 fields a 32 leaf tree.
+const (
+	currentSyncCommitteeGindex = 32 + 22
+	nextSyncCommitteeGindex    = 32 + 23
+	// finalized_checkpoint.root, the right child of finalized_checkpoint
+	finalizedRootGindex    = (32+20)*2 + 1
+	executionPayloadGindex = 16 + 9
+	transactionsGindex     = executionPayloadGindex*32 + 13
+	withdrawalsGindex      = executionPayloadGindex*32 + 14
+)
+
+// lightClientScenario is the chain all light client objects of a preset are
+// taken from: the default state, the block it is the post-state of and the
+// block its finalized checkpoint points to. Every branch is proven against
+// the state or body root in the matching header.
+type lightClientScenario struct {
+	AttestedHeader             *LightClientHeader
+	FinalizedHeader            *LightClientHeader
+	CurrentSyncCommittee       *SyncCommittee
+	CurrentSyncCommitteeBranch []Root
+	NextSyncCommittee          *SyncCommittee
+	NextSyncCommitteeBranch    []Root
+	FinalityBranch             []Root
+}
+
+// generateLightClientScenario builds the scenario on top of state, which is
+// modified to point at the generated blocks: its latest block header is the
+// attested block and its finalized checkpoint the finalized block.
+func generateLightClientScenario(dynSsz *dynssz.DynSsz, rng *rand.Rand, cfg *Config, preset *PresetValues, state *BeaconState) (*lightClientScenario, error) {
+	// The finalized block is the first of the finalized checkpoint's epoch
+	finalizedCfg := *cfg
+	finalizedCfg.Slot = state.FinalizedCheckpoint.Epoch * uint64(preset.SlotsPerEpoch)
+	finalizedHeader, err := generateLightClientHeader(dynSsz, GenerateBeaconBlock(rng, &finalizedCfg, preset))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate finalized header: %w", err)
+	}
+	finalizedRoot, err := dynSsz.HashTreeRoot(finalizedHeader.Beacon)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute finalized header HTR: %w", err)
+	}
+	state.FinalizedCheckpoint.Root = finalizedRoot
+
+	// The state is the post-state of the attested block, so it holds the
+	// block's header with the state root left empty
+	attestedHeader, err := generateLightClientHeader(dynSsz, GenerateBeaconBlock(rng, cfg, preset))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate attested header: %w", err)
+	}
+	latestBlockHeader := *attestedHeader.Beacon
+	latestBlockHeader.StateRoot = Root{}
+	state.LatestBlockHeader = &latestBlockHeader
+
+	tree, err := dynSsz.GetTree(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build state tree: %w", err)
+	}
+	attestedHeader.Beacon.StateRoot = Root(tree.Hash())
+
+	scenario := &lightClientScenario{
+		AttestedHeader:       attestedHeader,
+		FinalizedHeader:      finalizedHeader,
+		CurrentSyncCommittee: state.CurrentSyncCommittee,
+		NextSyncCommittee:    state.NextSyncCommittee,
+	}
+	currentRoot, err := dynSsz.HashTreeRoot(state.CurrentSyncCommittee)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute sync committee HTR: %w", err)
+	}
+	if scenario.CurrentSyncCommitteeBranch, err = proveBranch(tree, currentSyncCommitteeGindex, currentRoot); err != nil {
+		return nil, err
+	}
+	nextRoot, err := dynSsz.HashTreeRoot(state.NextSyncCommittee)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute sync committee HTR: %w", err)
+	}
+	if scenario.NextSyncCommitteeBranch, err = proveBranch(tree, nextSyncCommitteeGindex, nextRoot); err != nil {
+		return nil, err
+	}
+	if scenario.FinalityBranch, err = proveBranch(tree, finalizedRootGindex, finalizedRoot); err != nil {
+		return nil, err
+	}
+	return scenario, nil
+}
+
+// generateLightClientHeader returns the light client header of block. The
+// execution header is derived from the block's payload and proven against
+// its body root.
+func generateLightClientHeader(dynSsz *dynssz.DynSsz, block *BeaconBlock) (*LightClientHeader, error) {
+	tree, err := dynSsz.GetTree(block.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build body tree: %w", err)
+	}
+
+	// The list roots are not exposed by the payload, take them from the tree
+	payload := block.Body.ExecutionPayload
+	transactions, err := tree.Get(transactionsGindex)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions root: %w", err)
+	}
+	withdrawals, err := tree.Get(withdrawalsGindex)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get withdrawals root: %w", err)
+	}
+	execution := &ExecutionPayloadHeader{
+		ParentHash:       payload.ParentHash,
+		FeeRecipient:     payload.FeeRecipient,
+		StateRoot:        payload.StateRoot,
+		ReceiptsRoot:     payload.ReceiptsRoot,
+		LogsBloom:        payload.LogsBloom,
+		PrevRandao:       payload.PrevRandao,
+		BlockNumber:      payload.BlockNumber,
+		GasLimit:         payload.GasLimit,
+		GasUsed:          payload.GasUsed,
+		Timestamp:        payload.Timestamp,
+		ExtraData:        payload.ExtraData,
+		BaseFeePerGas:    payload.BaseFeePerGas,
+		BlockHash:        payload.BlockHash,
+		TransactionsRoot: Root(transactions.Hash()),
+		WithdrawalsRoot:  Root(withdrawals.Hash()),
+		BlobGasUsed:      payload.BlobGasUsed,
+		ExcessBlobGas:    payload.ExcessBlobGas,
+	}
+
+	executionRoot, err := dynSsz.HashTreeRoot(execution)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute execution header HTR: %w", err)
+	}
+	executionBranch, err := proveBranch(tree, executionPayloadGindex, executionRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LightClientHeader{
+		Beacon: &BeaconBlockHeader{
+			Slot:          block.Slot,
+			ProposerIndex: block.ProposerIndex,
+			ParentRoot:    block.ParentRoot,
+			StateRoot:     block.StateRoot,
+			BodyRoot:      Root(tree.Hash()),
+		},
+		Execution:       execution,
+		ExecutionBranch: executionBranch,
+	}, nil
+}
+
+// proveBranch returns the branch of the node at gindex in tree and checks
+// that it proves leaf against the tree's root
+func proveBranch(tree *treeproof.Node, gindex int, leaf Root) ([]Root, error) {
+	proof, err := tree.Prove(gindex)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prove gindex %d: %w", gindex, err)
+	}
+	branch := make([]Root, len(proof.Hashes))
+	for i, hash := range proof.Hashes {
+		copy(branch[i][:], hash)
+	}
+	if !verifyMerkleBranch(leaf, branch, gindex, Root(tree.Hash())) {
+		return nil, fmt.Errorf("branch of gindex %d does not verify", gindex)
+	}
+	return branch, nil
+}
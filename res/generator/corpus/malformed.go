@@ -0,0 +1,250 @@
+package corpus
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	dynssz "github.com/pk910/dynamic-ssz"
+)
+
+// Failure classes of malformed corpora
+const (
+	failureTruncated = "truncated"
+	failureOffset    = "offset"
+	failureBitlist   = "bitlist"
+	failureListLimit = "list_limit"
+	failureTrailing  = "trailing"
+	failureFixedSize = "fixed_size"
+)
+
+// MalformedManifest lists the malformed corpora derived from valid ones
+type MalformedManifest struct {
+	Entries []*MalformedEntry `json:"entries"`
+}
+
+// MalformedEntry describes a single malformed corpus and why it is invalid
+type MalformedEntry struct {
+	File        string `json:"file"`
+	Source      string `json:"source"`
+	Variant     string `json:"variant"`
+	Kind        string `json:"kind"`
+	Fork        string `json:"fork"`
+	Preset      string `json:"preset"`
+	Class       string `json:"class"`
+	Description string `json:"description"`
+}
+
+// malformedVariant is an invalid encoding derived from a valid corpus
+type malformedVariant struct {
+	Name        string
+	Class       string
+	Description string
+	Data        []byte
+}
+
+func RunMalformed(cfg *Config, sources []string) error {
+	if err := os.MkdirAll(cfg.OutputDir, 0755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	presetFiles := make(map[string]string, len(cfg.Presets))
+	for _, presetFile := range cfg.Presets {
+		presetFiles[PresetName(presetFile)] = presetFile
+	}
+
+	manifest := &MalformedManifest{}
+	for _, source := range sources {
+		file := filepath.Base(source)
+		name, err := parseCorpusName(file)
+		if err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+		dynSsz, err := loadVerifyPreset(presetFiles, name.Preset)
+		if err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+
+		entries, err := writeMalformed(dynSsz, cfg, source, name)
+		if err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+		manifest.Entries = append(manifest.Entries, entries...)
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(cfg.OutputDir, "manifest.json"), data, 0644); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+
+	cfg.logf("Wrote %d malformed corpora\n", len(manifest.Entries))
+	return nil
+}
+
+// writeMalformed derives and writes the malformed variants of one corpus
+func writeMalformed(dynSsz *dynssz.DynSsz, cfg *Config, source string, name *corpusName) ([]*MalformedEntry, error) {
+	data, err := os.ReadFile(source)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := dynSsz.UnmarshalSSZ(name.newObject(), data); err != nil {
+		return nil, fmt.Errorf("source corpus is invalid: %w", err)
+	}
+	layout, err := readLayout(dynSsz, reflect.TypeOf(name.newObject()), data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read layout: %w", err)
+	}
+
+	stem := strings.TrimSuffix(filepath.Base(source), ".ssz")
+	var entries []*MalformedEntry
+	for _, variant := range deriveMalformed(data, layout) {
+		file := stem + "-" + variant.Name + ".ssz"
+		if err := os.WriteFile(filepath.Join(cfg.OutputDir, file), variant.Data, 0644); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", file, err)
+		}
+		cfg.logf("  %s: %s (%d bytes)\n", file, variant.Description, len(variant.Data))
+
+		if err := dynSsz.UnmarshalSSZ(name.newObject(), variant.Data); err == nil {
+			cfg.logf("    note: dynamic-ssz accepts %s\n", file)
+		}
+
+		entries = append(entries, &MalformedEntry{
+			File:        file,
+			Source:      filepath.Base(source),
+			Variant:     variant.Name,
+			Kind:        name.Kind,
+			Fork:        name.Fork.Name,
+			Preset:      name.Preset,
+			Class:       variant.Class,
+			Description: variant.Description,
+		})
+	}
+	return entries, nil
+}
+
+// deriveMalformed returns the invalid variants of data that its layout
+// allows; e.g. a corpus without bitlists has no bitlist variant.
+func deriveMalformed(data []byte, layout *sszLayout) []*malformedVariant {
+	variants := []*malformedVariant{
+		{
+			Name:        "truncated-last-byte",
+			Class:       failureTruncated,
+			Description: "last byte cut off",
+			Data:        bytes.Clone(data[:len(data)-1]),
+		},
+		{
+			Name:        "truncated-half",
+			Class:       failureTruncated,
+			Description: "cut off after half of the bytes",
+			Data:        bytes.Clone(data[:len(data)/2]),
+		},
+		{
+			Name:        "truncated-fixed-part",
+			Class:       failureTruncated,
+			Description: "cut off inside the fixed part",
+			Data:        bytes.Clone(data[:layout.FixedSize-1]),
+		},
+		{
+			Name:        "trailing-byte",
+			Class:       failureTrailing,
+			Description: "one zero byte appended",
+			Data:        append(bytes.Clone(data), 0x00),
+		},
+	}
+
+	// Offsets of the first container with several dynamic fields
+	for i := 0; i+1 < len(layout.Offsets); i++ {
+		a, b := layout.Offsets[i], layout.Offsets[i+1]
+		if a.Base != b.Base || a.Value >= b.Value {
+			continue
+		}
+
+		swapped := bytes.Clone(data)
+		binary.LittleEndian.PutUint32(swapped[a.Pos:], b.Value)
+		binary.LittleEndian.PutUint32(swapped[b.Pos:], a.Value)
+
+		pastEnd := bytes.Clone(data)
+		binary.LittleEndian.PutUint32(pastEnd[b.Pos:], uint32(len(data)))
+
+		variants = append(variants, &malformedVariant{
+			Name:        "offset-out-of-order",
+			Class:       failureOffset,
+			Description: fmt.Sprintf("offsets at %d and %d swapped", a.Pos, b.Pos),
+			Data:        swapped,
+		}, &malformedVariant{
+			Name:        "offset-past-end",
+			Class:       failureOffset,
+			Description: fmt.Sprintf("offset at %d points past the end", b.Pos),
+			Data:        pastEnd,
+		})
+		break
+	}
+
+	for _, bitlist := range layout.Bitlists {
+		if bitlist.End == bitlist.Start || data[bitlist.End-1] == 0 {
+			continue
+		}
+		noDelimiter := bytes.Clone(data)
+		noDelimiter[bitlist.End-1] = 0
+		variants = append(variants, &malformedVariant{
+			Name:        "bitlist-no-delimiter",
+			Class:       failureBitlist,
+			Description: fmt.Sprintf("last byte of the bitlist at %d cleared, dropping its delimiter bit", bitlist.Start),
+			Data:        noDelimiter,
+		})
+		break
+	}
+
+	for _, list := range layout.Lists {
+		count := (list.End - list.Start) / list.ElemSize
+		if count == 0 || uint64(count) > list.Limit || (list.Limit+1)*uint64(list.ElemSize) > 1<<20 {
+			continue
+		}
+		// Repeat the first element until the list is one over its limit
+		extra := bytes.Repeat(data[list.Start:list.Start+list.ElemSize], int(list.Limit)+1-count)
+		variants = append(variants, &malformedVariant{
+			Name:        "list-over-limit",
+			Class:       failureListLimit,
+			Description: fmt.Sprintf("list at %d grown to %d elements (limit %d)", list.Start, list.Limit+1, list.Limit),
+			Data:        layout.splice(data, list.End, 0, extra),
+		})
+		break
+	}
+
+	for _, list := range layout.Lists {
+		if list.End == list.Start || list.ElemSize < 2 {
+			continue
+		}
+		variants = append(variants, &malformedVariant{
+			Name:        "list-element-short",
+			Class:       failureFixedSize,
+			Description: fmt.Sprintf("first %d byte element of the list at %d one byte short", list.ElemSize, list.Start),
+			Data:        layout.splice(data, list.Start+list.ElemSize-1, 1, nil),
+		})
+		break
+	}
+
+	for _, field := range layout.Static {
+		if field.End-field.Start < 2 {
+			continue
+		}
+		variants = append(variants, &malformedVariant{
+			Name:        "static-field-short",
+			Class:       failureFixedSize,
+			Description: fmt.Sprintf("%d byte static field at %d one byte short", field.End-field.Start, field.Start),
+			Data:        layout.splice(data, field.End-1, 1, nil),
+		})
+		break
+	}
+
+	return variants
+}
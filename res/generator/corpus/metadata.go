@@ -0,0 +1,176 @@
+package corpus
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+
+	dynssz "github.com/pk910/dynamic-ssz"
+)
+
+// generatorVersion is recorded in every metadata file. Bump it whenever the
+// output for a given seed and flag set changes.
+const generatorVersion = "1.1.0"
+
+// Metadata for the generated files. The benchmark modules only read HTR, the
+// rest is there to narrow down HTR mismatches without re-running the
+// generator: a wrong body or validators root points at the sub-structure a
+// library gets wrong.
+type Metadata struct {
+	HTR       string            `json:"htr"`
+	Kind      string            `json:"kind"`
+	Fork      string            `json:"fork"`
+	Preset    string            `json:"preset"`
+	Seed      int64             `json:"seed"`
+	Generator string            `json:"generator"`
+	Flags     map[string]string `json:"flags"`
+	Size      int               `json:"size"`
+	Lengths   map[string]int    `json:"lengths"`
+	Roots     map[string]string `json:"roots"`
+}
+
+// metadataRoots are the sub-trees whose roots are recorded, by field path
+// from the corpus object. Paths missing in a fork are skipped.
+var metadataRoots = []struct {
+	Key  string
+	Path []string
+}{
+	{"body", []string{"Message", "Body"}},
+	{"execution_payload", []string{"Message", "Body", "ExecutionPayload"}},
+	{"validators", []string{"Validators"}},
+	{"balances", []string{"Balances"}},
+}
+
+// FillMetadata completes meta (kind, fork and preset are set by the caller)
+// for the corpus obj, whose HTR is taken over root.
+func FillMetadata(dynSsz *dynssz.DynSsz, cfg *Config, meta *Metadata, obj any, root any, size int, htr [32]byte) error {
+	meta.HTR = hex.EncodeToString(htr[:])
+	meta.Seed = cfg.Seed
+	meta.Generator = generatorVersion
+	meta.Flags = cfg.FlagValues
+	meta.Size = size
+	meta.Lengths = make(map[string]int)
+	collectListLengths(reflect.ValueOf(obj), "", meta.Lengths)
+
+	roots, err := subtreeRoots(dynSsz, obj, root)
+	if err != nil {
+		return err
+	}
+	meta.Roots = roots
+	return nil
+}
+
+// collectListLengths records the length of every SSZ list reachable through
+// container fields of v. Vectors have a fixed length and list elements are
+// not descended into.
+func collectListLengths(v reflect.Value, prefix string, lengths map[string]int) {
+	v = reflect.Indirect(v)
+	if v.Kind() != reflect.Struct {
+		return
+	}
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		value := v.Field(i)
+		path := prefix + field.Name
+
+		switch {
+		case value.Kind() == reflect.Slice:
+			if _, ok := field.Tag.Lookup("ssz-max"); ok {
+				lengths[path] = value.Len()
+			}
+		case value.Kind() == reflect.Ptr && !value.IsNil():
+			collectListLengths(value, path+".", lengths)
+		}
+	}
+}
+
+// subtreeRoots computes the roots listed in metadataRoots, plus the signed
+// block and message roots for blocks.
+func subtreeRoots(dynSsz *dynssz.DynSsz, obj any, root any) (map[string]string, error) {
+	roots := make(map[string]string)
+
+	if obj != root {
+		signedRoot, err := dynSsz.HashTreeRoot(obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute signed block root: %w", err)
+		}
+		messageRoot, err := dynSsz.HashTreeRoot(root)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute message root: %w", err)
+		}
+		roots["signed_block"] = hex.EncodeToString(signedRoot[:])
+		roots["message"] = hex.EncodeToString(messageRoot[:])
+	}
+
+	for _, sub := range metadataRoots {
+		parent, field, ok := lookupField(reflect.ValueOf(obj), sub.Path)
+		if !ok {
+			continue
+		}
+		fieldRoot, err := hashField(dynSsz, parent, field)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute %s root: %w", sub.Key, err)
+		}
+		roots[sub.Key] = hex.EncodeToString(fieldRoot[:])
+	}
+
+	return roots, nil
+}
+
+// lookupField follows path through nested containers and returns the
+// container holding the last field together with that field.
+func lookupField(v reflect.Value, path []string) (reflect.Value, reflect.StructField, bool) {
+	for i, name := range path {
+		v = reflect.Indirect(v)
+		if v.Kind() != reflect.Struct {
+			return reflect.Value{}, reflect.StructField{}, false
+		}
+		field, ok := v.Type().FieldByName(name)
+		if !ok {
+			return reflect.Value{}, reflect.StructField{}, false
+		}
+		if i == len(path)-1 {
+			return v, field, true
+		}
+		v = v.FieldByIndex(field.Index)
+	}
+	return reflect.Value{}, reflect.StructField{}, false
+}
+
+// hashField computes the root of a single container field. A container with
+// one field has that field's root as its own, so the field is copied into a
+// one-field struct carrying the same size tags and hashed as a whole.
+func hashField(dynSsz *dynssz.DynSsz, parent reflect.Value, field reflect.StructField) ([32]byte, error) {
+	wrapperType := reflect.StructOf([]reflect.StructField{{
+		Name: field.Name,
+		Type: field.Type,
+		Tag:  field.Tag,
+	}})
+	wrapper := reflect.New(wrapperType)
+	wrapper.Elem().Field(0).Set(parent.FieldByIndex(field.Index))
+	return dynSsz.HashTreeRoot(wrapper.Interface())
+}
+
+func writeMetadata(path string, meta *Metadata) error {
+	data, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// differingRoots lists the sub-trees whose roots differ between got and want
+func differingRoots(got, want map[string]string) []string {
+	var keys []string
+	for key, wantRoot := range want {
+		if gotRoot, ok := got[key]; ok && gotRoot != wantRoot {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
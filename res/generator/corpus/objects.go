@@ -0,0 +1,284 @@
+package corpus
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/bits"
+	"math/rand"
+
+	dynssz "github.com/pk910/dynamic-ssz"
+)
+
+// ObjectSpec describes a standalone sub-object corpus (deneb types)
+type ObjectSpec struct {
+	Name     string
+	Generate func(ctx *objectContext, rng *rand.Rand) (any, error)
+	New      func() any
+}
+
+// objectContext is what the object generators of a single preset share.
+// Objects that carry proofs of other objects use its dynSsz, the light client
+// objects share one scenario that is built on first use.
+type objectContext struct {
+	dynSsz      *dynssz.DynSsz
+	cfg         *Config
+	preset      *PresetValues
+	presetName  string
+	lightClient *lightClientScenario
+}
+
+// lightClientScenario returns the preset's light client scenario, built on top
+// of the state the default run writes as state-<preset>.ssz
+func (ctx *objectContext) lightClientScenario() (*lightClientScenario, error) {
+	if ctx.lightClient == nil {
+		fork := FindFork("deneb")
+		state := GenerateState(NewRNG(ctx.cfg.Seed, RNGLabel("state", fork, ctx.presetName)), ctx.cfg, ctx.preset)
+		rng := NewRNG(ctx.cfg.Seed, RNGLabel("light-client", fork, ctx.presetName))
+		scenario, err := generateLightClientScenario(ctx.dynSsz, rng, ctx.cfg, ctx.preset, state)
+		if err != nil {
+			return nil, err
+		}
+		ctx.lightClient = scenario
+	}
+	return ctx.lightClient, nil
+}
+
+// objectSpecs lists the gossip-sized objects with their own corpora
+var objectSpecs = []*ObjectSpec{
+	{
+		Name: "attestation",
+		Generate: func(ctx *objectContext, rng *rand.Rand) (any, error) {
+			return generateAttestations(rng, 1, ctx.cfg.ValidatorCount, ctx.cfg.Slot)[0], nil
+		},
+		New: func() any { return new(Attestation) },
+	},
+	{
+		Name: "voluntary-exit",
+		Generate: func(ctx *objectContext, rng *rand.Rand) (any, error) {
+			return generateVoluntaryExits(rng, 1, ctx.cfg.ValidatorCount)[0], nil
+		},
+		New: func() any { return new(SignedVoluntaryExit) },
+	},
+	{
+		Name: "bls-change",
+		Generate: func(ctx *objectContext, rng *rand.Rand) (any, error) {
+			return generateBLSToExecChanges(rng, 1, ctx.cfg.ValidatorCount)[0], nil
+		},
+		New: func() any { return new(SignedBLSToExecutionChange) },
+	},
+	{
+		Name: "sync-committee",
+		Generate: func(ctx *objectContext, rng *rand.Rand) (any, error) {
+			return generateSyncCommittee(rng, ctx.preset.SyncCommitteeSize), nil
+		},
+		New: func() any { return new(SyncCommittee) },
+	},
+	{
+		Name: "execution-payload",
+		Generate: func(ctx *objectContext, rng *rand.Rand) (any, error) {
+			return generateExecutionPayload(rng, ctx.cfg, min(ctx.preset.MaxWithdrawals, 16)), nil
+		},
+		New: func() any { return new(ExecutionPayload) },
+	},
+	{
+		Name: "validator",
+		Generate: func(_ *objectContext, rng *rand.Rand) (any, error) {
+			return generateValidator(rng), nil
+		},
+		New: func() any { return new(Validator) },
+	},
+	{
+		Name: "blob-sidecar",
+		Generate: func(ctx *objectContext, rng *rand.Rand) (any, error) {
+			return generateBlobSidecar(ctx.dynSsz, rng, ctx.cfg, ctx.preset)
+		},
+		New: func() any { return new(BlobSidecar) },
+	},
+	{
+		Name: "light-client-bootstrap",
+		Generate: func(ctx *objectContext, _ *rand.Rand) (any, error) {
+			scenario, err := ctx.lightClientScenario()
+			if err != nil {
+				return nil, err
+			}
+			return &LightClientBootstrap{
+				Header:                     scenario.AttestedHeader,
+				CurrentSyncCommittee:       scenario.CurrentSyncCommittee,
+				CurrentSyncCommitteeBranch: scenario.CurrentSyncCommitteeBranch,
+			}, nil
+		},
+		New: func() any { return new(LightClientBootstrap) },
+	},
+	{
+		Name: "light-client-update",
+		Generate: func(ctx *objectContext, rng *rand.Rand) (any, error) {
+			scenario, err := ctx.lightClientScenario()
+			if err != nil {
+				return nil, err
+			}
+			return &LightClientUpdate{
+				AttestedHeader:          scenario.AttestedHeader,
+				NextSyncCommittee:       scenario.NextSyncCommittee,
+				NextSyncCommitteeBranch: scenario.NextSyncCommitteeBranch,
+				FinalizedHeader:         scenario.FinalizedHeader,
+				FinalityBranch:          scenario.FinalityBranch,
+				SyncAggregate:           generateSyncAggregate(rng, ctx.preset.SyncCommitteeSize),
+				SignatureSlot:           scenario.AttestedHeader.Beacon.Slot + 1,
+			}, nil
+		},
+		New: func() any { return new(LightClientUpdate) },
+	},
+	{
+		Name: "light-client-finality-update",
+		Generate: func(ctx *objectContext, rng *rand.Rand) (any, error) {
+			scenario, err := ctx.lightClientScenario()
+			if err != nil {
+				return nil, err
+			}
+			return &LightClientFinalityUpdate{
+				AttestedHeader:  scenario.AttestedHeader,
+				FinalizedHeader: scenario.FinalizedHeader,
+				FinalityBranch:  scenario.FinalityBranch,
+				SyncAggregate:   generateSyncAggregate(rng, ctx.preset.SyncCommitteeSize),
+				SignatureSlot:   scenario.AttestedHeader.Beacon.Slot + 1,
+			}, nil
+		},
+		New: func() any { return new(LightClientFinalityUpdate) },
+	},
+	{
+		Name: "light-client-optimistic-update",
+		Generate: func(ctx *objectContext, rng *rand.Rand) (any, error) {
+			scenario, err := ctx.lightClientScenario()
+			if err != nil {
+				return nil, err
+			}
+			return &LightClientOptimisticUpdate{
+				AttestedHeader: scenario.AttestedHeader,
+				SyncAggregate:  generateSyncAggregate(rng, ctx.preset.SyncCommitteeSize),
+				SignatureSlot:  scenario.AttestedHeader.Beacon.Slot + 1,
+			}, nil
+		},
+		New: func() any { return new(LightClientOptimisticUpdate) },
+	},
+}
+
+func RunObjects(cfg *Config) error {
+	if _, err := prepareRun(cfg); err != nil {
+		return err
+	}
+	fork := FindFork("deneb")
+
+	for _, presetFile := range cfg.Presets {
+		presetName := PresetName(presetFile)
+		cfg.logf("Generating %s preset objects...\n", presetName)
+
+		specs, preset, err := LoadPreset(presetFile)
+		if err != nil {
+			return fmt.Errorf("failed to load %s preset: %w", presetName, err)
+		}
+
+		dynSsz := dynssz.NewDynSsz(specs)
+		ctx := &objectContext{dynSsz: dynSsz, cfg: cfg, preset: preset, presetName: presetName}
+
+		for _, object := range objectSpecs {
+			obj, err := object.Generate(ctx, NewRNG(cfg.Seed, RNGLabel(object.Name, fork, presetName)))
+			if err != nil {
+				return fmt.Errorf("failed to generate %s: %w", object.Name, err)
+			}
+			meta := &Metadata{Kind: object.Name, Fork: fork.Name, Preset: presetName}
+			if _, err := WriteCorpus(dynSsz, cfg, object.Name+"-"+presetName, meta, obj, obj); err != nil {
+				return err
+			}
+		}
+	}
+
+	cfg.logf("Generation complete!\n")
+	return nil
+}
+
+// blobCommitmentsGindex is the generalized index of blob_kzg_commitments in
+// the deneb BeaconBlockBody, whose 12 fields make a 16 leaf tree
+const blobCommitmentsGindex = 16 + 11
+
+// generateBlobSidecar generates a block and a sidecar for one of its blobs. The
+// blob holds random field elements and the KZG commitment and proof are random
+// as well, but the header and the commitment inclusion proof are those of the
+// block, so the sidecar passes the inclusion check of gossip validation.
+func generateBlobSidecar(dynSsz *dynssz.DynSsz, rng *rand.Rand, cfg *Config, preset *PresetValues) (*BlobSidecar, error) {
+	block := GenerateBeaconBlock(rng, cfg, preset)
+	commitments := block.Body.BlobKZGCommitments
+	if len(commitments) == 0 {
+		return nil, fmt.Errorf("block has no blob commitments")
+	}
+	index := rng.Intn(len(commitments))
+
+	bodyRoot, err := dynSsz.HashTreeRoot(block.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute body HTR: %w", err)
+	}
+	tree, err := dynSsz.GetTree(block.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build body tree: %w", err)
+	}
+
+	// The commitment's leaf sits below the list's data root, the left child of
+	// the blob_kzg_commitments node
+	listDepth := bits.Len(uint(preset.MaxBlobCommitments - 1))
+	gindex := (blobCommitmentsGindex*2)<<listDepth + index
+	proof, err := tree.Prove(gindex)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prove commitment %d: %w", index, err)
+	}
+
+	sidecar := &BlobSidecar{
+		Index:         uint64(index),
+		Blob:          randomBlob(rng, preset.FieldElementsPerBlob),
+		KZGCommitment: commitments[index],
+		KZGProof:      randomKZGCommitment(rng),
+		SignedBlockHeader: &SignedBeaconBlockHeader{
+			Message: &BeaconBlockHeader{
+				Slot:          block.Slot,
+				ProposerIndex: block.ProposerIndex,
+				ParentRoot:    block.ParentRoot,
+				StateRoot:     block.StateRoot,
+				BodyRoot:      bodyRoot,
+			},
+			Signature: randomBLSSignature(rng),
+		},
+		KZGCommitmentInclusionProof: make([]Root, len(proof.Hashes)),
+	}
+	for i, hash := range proof.Hashes {
+		copy(sidecar.KZGCommitmentInclusionProof[i][:], hash)
+	}
+
+	leaf := sha256.Sum256(append(commitments[index][:], make([]byte, 16)...))
+	if !verifyMerkleBranch(leaf, sidecar.KZGCommitmentInclusionProof, gindex, bodyRoot) {
+		return nil, fmt.Errorf("commitment %d inclusion proof does not verify", index)
+	}
+	return sidecar, nil
+}
+
+// randomBlob returns a blob of random field elements. Clearing the top two
+// bits keeps every big-endian element below the BLS12-381 modulus.
+func randomBlob(rng *rand.Rand, fieldElements int) []byte {
+	blob := randomBytes(rng, fieldElements*32)
+	for i := 0; i < len(blob); i += 32 {
+		blob[i] &= 0x3f
+	}
+	return blob
+}
+
+// verifyMerkleBranch is the spec's is_valid_merkle_branch for the leaf at
+// generalized index gindex
+func verifyMerkleBranch(leaf Root, branch []Root, gindex int, root Root) bool {
+	value := leaf
+	for _, sibling := range branch {
+		if gindex&1 == 1 {
+			value = sha256.Sum256(append(sibling[:], value[:]...))
+		} else {
+			value = sha256.Sum256(append(value[:], sibling[:]...))
+		}
+		gindex >>= 1
+	}
+	return gindex == 1 && value == root
+}
@@ -0,0 +1,181 @@
+package corpus
+
+import (
+	"embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// bundledPresets are the preset files shipped with the generator. Bare file
+// and preset names fall back to them, so they resolve from any working
+// directory, including tests of other modules.
+//
+//go:embed *-preset.yaml
+var bundledPresets embed.FS
+
+// PresetValues holds the preset values the generator sizes its payloads
+// with. They are read from the preset YAML, see presetValuesFromSpecs.
+type PresetValues struct {
+	MaxWithdrawals         int
+	MaxBlobCommitments     int
+	FieldElementsPerBlob   int
+	SyncCommitteeSize      int
+	SlotsPerHistoricalRoot int
+	EpochsPerHistVector    int
+	EpochsPerSlashVector   int
+	SlotsPerEpoch          int
+	EpochsPerEth1Voting    int
+	MinSeedLookahead       int
+	CapellaForkEpoch       uint64
+	// Block operation limits
+	MaxProposerSlashings     int
+	MaxAttesterSlashings     int
+	MaxAttestations          int
+	MaxDeposits              int
+	MaxVoluntaryExits        int
+	MaxBLSToExecutionChanges int
+	MaxExtraDataBytes        int
+	// Committee layout
+	MaxCommitteesPerSlot      int
+	TargetCommitteeSize       int
+	MaxValidatorsPerCommittee int
+	// Electra
+	MaxAttestationsElectra         int
+	MaxAttesterSlashingsElectra    int
+	MaxDepositRequests             int
+	MaxWithdrawalRequests          int
+	MaxConsolidationRequests       int
+	PendingDepositsLimit           int
+	PendingPartialWithdrawalsLimit int
+	PendingConsolidationsLimit     int
+}
+
+// LoadPreset loads a preset YAML and the generator values derived from it
+func LoadPreset(filename string) (map[string]any, *PresetValues, error) {
+	specs, err := loadPresetSpecs(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	preset, err := presetValuesFromSpecs(specs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return specs, preset, nil
+}
+
+func loadPresetSpecs(filename string) (map[string]any, error) {
+	// A bare preset name refers to the bundled <name>-preset.yaml
+	if filepath.Ext(filename) == "" {
+		filename += "-preset.yaml"
+	}
+
+	// A file in the working directory takes precedence over a bundled one
+	data, err := os.ReadFile(filename)
+	if err != nil && filepath.Base(filename) == filename {
+		data, err = bundledPresets.ReadFile(filename)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("preset file not found: %s", filename)
+	}
+
+	var specs map[string]any
+	if err := yaml.Unmarshal(data, &specs); err != nil {
+		return nil, fmt.Errorf("failed to parse preset: %w", err)
+	}
+
+	return specs, nil
+}
+
+// PresetName derives the output name of a preset from its file name,
+// e.g. "res/gnosis-preset.yaml" -> "gnosis".
+func PresetName(filename string) string {
+	name := filepath.Base(filename)
+	name = strings.TrimSuffix(name, filepath.Ext(name))
+	return strings.TrimSuffix(name, "-preset")
+}
+
+// presetValuesFromSpecs reads the values the generator needs from a loaded
+// preset. CAPELLA_FORK_EPOCH is a network config value rather than a preset
+// value, so it is optional and defaults to 0 (Capella from genesis).
+func presetValuesFromSpecs(specs map[string]any) (*PresetValues, error) {
+	values := &PresetValues{}
+	fields := []struct {
+		key string
+		dst *int
+	}{
+		{"MAX_WITHDRAWALS_PER_PAYLOAD", &values.MaxWithdrawals},
+		{"MAX_BLOB_COMMITMENTS_PER_BLOCK", &values.MaxBlobCommitments},
+		{"FIELD_ELEMENTS_PER_BLOB", &values.FieldElementsPerBlob},
+		{"SYNC_COMMITTEE_SIZE", &values.SyncCommitteeSize},
+		{"SLOTS_PER_HISTORICAL_ROOT", &values.SlotsPerHistoricalRoot},
+		{"EPOCHS_PER_HISTORICAL_VECTOR", &values.EpochsPerHistVector},
+		{"EPOCHS_PER_SLASHINGS_VECTOR", &values.EpochsPerSlashVector},
+		{"SLOTS_PER_EPOCH", &values.SlotsPerEpoch},
+		{"EPOCHS_PER_ETH1_VOTING_PERIOD", &values.EpochsPerEth1Voting},
+		{"MIN_SEED_LOOKAHEAD", &values.MinSeedLookahead},
+		{"MAX_PROPOSER_SLASHINGS", &values.MaxProposerSlashings},
+		{"MAX_ATTESTER_SLASHINGS", &values.MaxAttesterSlashings},
+		{"MAX_ATTESTATIONS", &values.MaxAttestations},
+		{"MAX_DEPOSITS", &values.MaxDeposits},
+		{"MAX_VOLUNTARY_EXITS", &values.MaxVoluntaryExits},
+		{"MAX_BLS_TO_EXECUTION_CHANGES", &values.MaxBLSToExecutionChanges},
+		{"MAX_EXTRA_DATA_BYTES", &values.MaxExtraDataBytes},
+		{"MAX_COMMITTEES_PER_SLOT", &values.MaxCommitteesPerSlot},
+		{"TARGET_COMMITTEE_SIZE", &values.TargetCommitteeSize},
+		{"MAX_VALIDATORS_PER_COMMITTEE", &values.MaxValidatorsPerCommittee},
+		{"MAX_ATTESTATIONS_ELECTRA", &values.MaxAttestationsElectra},
+		{"MAX_ATTESTER_SLASHINGS_ELECTRA", &values.MaxAttesterSlashingsElectra},
+		{"MAX_DEPOSIT_REQUESTS_PER_PAYLOAD", &values.MaxDepositRequests},
+		{"MAX_WITHDRAWAL_REQUESTS_PER_PAYLOAD", &values.MaxWithdrawalRequests},
+		{"MAX_CONSOLIDATION_REQUESTS_PER_PAYLOAD", &values.MaxConsolidationRequests},
+		{"PENDING_DEPOSITS_LIMIT", &values.PendingDepositsLimit},
+		{"PENDING_PARTIAL_WITHDRAWALS_LIMIT", &values.PendingPartialWithdrawalsLimit},
+		{"PENDING_CONSOLIDATIONS_LIMIT", &values.PendingConsolidationsLimit},
+	}
+
+	for _, field := range fields {
+		raw, ok := specs[field.key]
+		if !ok {
+			return nil, fmt.Errorf("missing %s", field.key)
+		}
+		value, err := specUint(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", field.key, err)
+		}
+		*field.dst = int(value)
+	}
+
+	if raw, ok := specs["CAPELLA_FORK_EPOCH"]; ok {
+		value, err := specUint(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CAPELLA_FORK_EPOCH: %w", err)
+		}
+		values.CapellaForkEpoch = value
+	}
+
+	return values, nil
+}
+
+// specUint converts a YAML scalar to an unsigned integer
+func specUint(raw any) (uint64, error) {
+	switch v := raw.(type) {
+	case int:
+		if v < 0 {
+			return 0, fmt.Errorf("negative value %d", v)
+		}
+		return uint64(v), nil
+	case uint64:
+		return v, nil
+	case string:
+		return strconv.ParseUint(v, 0, 64)
+	default:
+		return 0, fmt.Errorf("unsupported value %v (%T)", raw, raw)
+	}
+}
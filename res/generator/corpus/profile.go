@@ -0,0 +1,228 @@
+package corpus
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// Value distribution profiles for generated states
+const (
+	profileUniform          = "uniform"
+	profileMainnetRealistic = "mainnet-realistic"
+)
+
+const (
+	farFutureEpoch                   = ^uint64(0)
+	effectiveBalanceIncrement        = 1000000000
+	maxEffectiveBalance              = 32 * effectiveBalanceIncrement
+	minValidatorWithdrawabilityDelay = 256
+)
+
+// Participation flag bits (timely source, target, head)
+const (
+	timelySourceFlag ParticipationFlags = 1 << 0
+	timelyTargetFlag ParticipationFlags = 1 << 1
+	timelyHeadFlag   ParticipationFlags = 1 << 2
+)
+
+func validateProfile(profile string) error {
+	switch profile {
+	case profileUniform, profileMainnetRealistic:
+		return nil
+	default:
+		return fmt.Errorf("unknown profile %q (want %s or %s)", profile, profileUniform, profileMainnetRealistic)
+	}
+}
+
+// validatorStatus is the lifecycle bucket a realistic validator is drawn from
+type validatorStatus int
+
+const (
+	statusActive validatorStatus = iota
+	statusExiting
+	statusExited
+	statusSlashed
+	statusPending
+)
+
+// pickValidatorStatus draws a status with roughly the mix seen on mainnet:
+// most validators active, a sizeable withdrawn tail, a short activation
+// queue and a handful of slashed validators.
+func pickValidatorStatus(rng *rand.Rand) validatorStatus {
+	n := rng.Intn(10000)
+	switch {
+	case n < 8450:
+		return statusActive
+	case n < 8550:
+		return statusExiting
+	case n < 9845:
+		return statusExited
+	case n < 9850:
+		return statusSlashed
+	default:
+		return statusPending
+	}
+}
+
+// randomEpochUpTo returns an epoch in [0, epoch]
+func randomEpochUpTo(rng *rand.Rand, epoch uint64) uint64 {
+	return randomUint64(rng) % (epoch + 1)
+}
+
+// generateWithdrawalCredentials returns 0x01 execution credentials for most
+// validators and legacy 0x00 BLS credentials for the rest.
+func generateWithdrawalCredentials(rng *rand.Rand) Hash32 {
+	var creds Hash32
+	if rng.Intn(100) < 70 {
+		creds[0] = 0x01
+		addr := randomExecutionAddress(rng)
+		copy(creds[12:], addr[:])
+		return creds
+	}
+	creds = randomHash32(rng)
+	creds[0] = 0x00
+	return creds
+}
+
+// generateRealisticValidator builds a validator in the given status. Only
+// the epochs that have actually happened are set, everything else stays at
+// FAR_FUTURE_EPOCH like on chain.
+func generateRealisticValidator(rng *rand.Rand, status validatorStatus, currentEpoch uint64, preset *PresetValues) *Validator {
+	v := &Validator{
+		Pubkey:                     randomBLSPubKey(rng),
+		WithdrawalCredentials:      generateWithdrawalCredentials(rng),
+		EffectiveBalance:           maxEffectiveBalance,
+		ActivationEligibilityEpoch: farFutureEpoch,
+		ActivationEpoch:            farFutureEpoch,
+		ExitEpoch:                  farFutureEpoch,
+		WithdrawableEpoch:          farFutureEpoch,
+	}
+
+	if status == statusPending {
+		// Half of the queue has already been marked eligible
+		if rng.Intn(2) == 0 {
+			v.ActivationEligibilityEpoch = currentEpoch - min(currentEpoch, randomUint64(rng)%8)
+		}
+		return v
+	}
+
+	v.ActivationEpoch = randomEpochUpTo(rng, currentEpoch)
+	v.ActivationEligibilityEpoch = v.ActivationEpoch - min(v.ActivationEpoch, 1+randomUint64(rng)%4)
+
+	// A few percent of active validators are below 32 ETH after penalties
+	if rng.Intn(100) < 3 {
+		v.EffectiveBalance -= (1 + randomUint64(rng)%2) * effectiveBalanceIncrement
+	}
+
+	switch status {
+	case statusExiting:
+		v.ExitEpoch = currentEpoch + 1 + randomUint64(rng)%16
+		v.WithdrawableEpoch = v.ExitEpoch + minValidatorWithdrawabilityDelay
+	case statusExited:
+		v.ExitEpoch = v.ActivationEpoch + randomUint64(rng)%(currentEpoch-v.ActivationEpoch+1)
+		v.WithdrawableEpoch = v.ExitEpoch + minValidatorWithdrawabilityDelay
+		if v.WithdrawableEpoch <= currentEpoch {
+			v.EffectiveBalance = 0
+		}
+	case statusSlashed:
+		v.Slashed = true
+		v.ExitEpoch = v.ActivationEpoch + randomUint64(rng)%(currentEpoch-v.ActivationEpoch+1)
+		v.WithdrawableEpoch = v.ExitEpoch + uint64(preset.EpochsPerSlashVector)
+		if v.WithdrawableEpoch <= currentEpoch {
+			v.EffectiveBalance = 0
+		} else {
+			v.EffectiveBalance = (31 - randomUint64(rng)%2) * effectiveBalanceIncrement
+		}
+	}
+	return v
+}
+
+// isActiveValidator reports whether v is active in epoch
+func isActiveValidator(v *Validator, epoch uint64) bool {
+	return v.ActivationEpoch <= epoch && epoch < v.ExitEpoch
+}
+
+// realisticBalance returns the actual balance for a validator: the effective
+// balance plus a small amount of accrued rewards, or nothing once withdrawn.
+func realisticBalance(rng *rand.Rand, v *Validator) Gwei {
+	if v.EffectiveBalance == 0 {
+		return 0
+	}
+	return v.EffectiveBalance + randomUint64(rng)%(effectiveBalanceIncrement/4)
+}
+
+// realisticParticipation returns the previous epoch flags of an active
+// validator: almost everyone hits all three, some miss the head vote and a
+// few percent are offline.
+func realisticParticipation(rng *rand.Rand) ParticipationFlags {
+	n := rng.Intn(1000)
+	switch {
+	case n < 900:
+		return timelySourceFlag | timelyTargetFlag | timelyHeadFlag
+	case n < 950:
+		return timelySourceFlag | timelyTargetFlag
+	case n < 960:
+		return timelySourceFlag
+	default:
+		return 0
+	}
+}
+
+// generateRealisticValidators fills the registry and the per-validator lists
+// of the state with the mainnet-realistic profile.
+func generateRealisticValidators(rng *rand.Rand, cfg *Config, preset *PresetValues) ([]*Validator, []Gwei, []ParticipationFlags, []ParticipationFlags, []uint64) {
+	validators := make([]*Validator, cfg.ValidatorCount)
+	balances := make([]Gwei, cfg.ValidatorCount)
+	prevParticipation := make([]ParticipationFlags, cfg.ValidatorCount)
+	currParticipation := make([]ParticipationFlags, cfg.ValidatorCount)
+	inactivityScores := make([]uint64, cfg.ValidatorCount)
+
+	currentEpoch := cfg.Slot / uint64(preset.SlotsPerEpoch)
+	// Only validators whose committee slot has passed have voted this epoch
+	epochProgress := int(cfg.Slot%uint64(preset.SlotsPerEpoch)) + 1
+
+	for i := 0; i < cfg.ValidatorCount; i++ {
+		v := generateRealisticValidator(rng, pickValidatorStatus(rng), currentEpoch, preset)
+		validators[i] = v
+		balances[i] = realisticBalance(rng, v)
+
+		if currentEpoch > 0 && isActiveValidator(v, currentEpoch-1) {
+			prevParticipation[i] = realisticParticipation(rng)
+		}
+		if isActiveValidator(v, currentEpoch) && rng.Intn(preset.SlotsPerEpoch) < epochProgress {
+			currParticipation[i] = realisticParticipation(rng)
+		}
+
+		// Scores drain quickly outside of a leak, so only validators that are
+		// offline right now carry a small score
+		if isActiveValidator(v, currentEpoch) && prevParticipation[i]&timelyTargetFlag == 0 {
+			inactivityScores[i] = 4 * (randomUint64(rng) % 4)
+		}
+	}
+
+	return validators, balances, prevParticipation, currParticipation, inactivityScores
+}
+
+// generateHistoricalAccumulators returns the historical roots and summaries
+// a chain at slot would have accumulated: one entry per completed
+// SLOTS_PER_HISTORICAL_ROOT period, in historical_roots before the Capella
+// fork and in historical_summaries after it.
+func generateHistoricalAccumulators(rng *rand.Rand, slot uint64, preset *PresetValues) ([]Root, []*HistoricalSummary) {
+	periods := slot / uint64(preset.SlotsPerHistoricalRoot)
+	capellaPeriod := min(periods, preset.CapellaForkEpoch*uint64(preset.SlotsPerEpoch)/uint64(preset.SlotsPerHistoricalRoot))
+
+	roots := make([]Root, capellaPeriod)
+	for i := range roots {
+		roots[i] = randomRoot(rng)
+	}
+
+	summaries := make([]*HistoricalSummary, periods-capellaPeriod)
+	for i := range summaries {
+		summaries[i] = &HistoricalSummary{
+			BlockSummaryRoot: randomRoot(rng),
+			StateSummaryRoot: randomRoot(rng),
+		}
+	}
+
+	return roots, summaries
+}
@@ -0,0 +1,157 @@
+package corpus
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+
+	dynssz "github.com/pk910/dynamic-ssz"
+)
+
+// RehashManifest describes single field updates of the default deneb state of
+// a preset together with the state root after each of them
+type RehashManifest struct {
+	Seed      int64            `json:"seed"`
+	Preset    string           `json:"preset"`
+	Fork      string           `json:"fork"`
+	State     string           `json:"state"`
+	HTR       string           `json:"htr"`
+	Balance   *BalanceUpdate   `json:"balance"`
+	BlockRoot *BlockRootUpdate `json:"block_root"`
+	Validator *ValidatorUpdate `json:"validator"`
+}
+
+// BalanceUpdate sets the balance of one validator, as a withdrawal or reward does
+type BalanceUpdate struct {
+	Index   uint64 `json:"index"`
+	Balance uint64 `json:"balance"`
+	HTR     string `json:"htr"`
+}
+
+// BlockRootUpdate sets the block root process_slot caches for the state's slot
+type BlockRootUpdate struct {
+	Index uint64 `json:"index"`
+	Root  string `json:"root"`
+	HTR   string `json:"htr"`
+}
+
+// ValidatorUpdate sets the exit and withdrawable epoch of one validator, as
+// initiate_validator_exit does
+type ValidatorUpdate struct {
+	Index             uint64 `json:"index"`
+	ExitEpoch         uint64 `json:"exit_epoch"`
+	WithdrawableEpoch uint64 `json:"withdrawable_epoch"`
+	HTR               string `json:"htr"`
+}
+
+func RunRehash(cfg *Config) error {
+	forks, err := prepareRun(cfg)
+	if err != nil {
+		return err
+	}
+	if len(forks) != 1 || forks[0].Name != "deneb" {
+		return fmt.Errorf("state updates are only generated for deneb")
+	}
+	fork := forks[0]
+
+	for _, presetFile := range cfg.Presets {
+		presetName := PresetName(presetFile)
+		cfg.logf("Generating %s preset state updates...\n", presetName)
+
+		specs, preset, err := LoadPreset(presetFile)
+		if err != nil {
+			return fmt.Errorf("failed to load %s preset: %w", presetName, err)
+		}
+
+		dynSsz := dynssz.NewDynSsz(specs)
+
+		state := GenerateState(NewRNG(cfg.Seed, RNGLabel("state", fork, presetName)), cfg, preset)
+		rng := NewRNG(cfg.Seed, RNGLabel("rehash", fork, presetName))
+		manifest, err := generateRehash(dynSsz, rng, preset, state)
+		if err != nil {
+			return err
+		}
+		manifest.Seed = cfg.Seed
+		manifest.Preset = presetName
+		manifest.Fork = fork.Name
+		manifest.State = "state-" + presetName
+
+		data, err := json.MarshalIndent(manifest, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal manifest: %w", err)
+		}
+		path := filepath.Join(cfg.OutputDir, "rehash-"+presetName+".json")
+		if err := os.WriteFile(path, data, 0644); err != nil {
+			return fmt.Errorf("failed to write manifest: %w", err)
+		}
+		cfg.logf("  %s: %s\n", presetName, path)
+	}
+
+	cfg.logf("State updates complete!\n")
+	return nil
+}
+
+// generateRehash picks the updates and roots the state after each of them.
+// Every update is undone before the next, so each root differs from the base
+// state's by that update alone.
+func generateRehash(dynSsz *dynssz.DynSsz, rng *rand.Rand, preset *PresetValues, state *BeaconState) (*RehashManifest, error) {
+	manifest := &RehashManifest{}
+
+	root, err := dynSsz.HashTreeRoot(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute state HTR: %w", err)
+	}
+	manifest.HTR = hex.EncodeToString(root[:])
+
+	balanceIndex := rng.Intn(len(state.Balances))
+	balance := state.Balances[balanceIndex]
+	state.Balances[balanceIndex] = balance + 1 + Gwei(rng.Intn(1000000000))
+	manifest.Balance = &BalanceUpdate{Index: uint64(balanceIndex), Balance: state.Balances[balanceIndex]}
+	if manifest.Balance.HTR, err = rehashRoot(dynSsz, state); err != nil {
+		return nil, err
+	}
+	state.Balances[balanceIndex] = balance
+
+	historicalIndex := state.Slot % uint64(preset.SlotsPerHistoricalRoot)
+	blockRoot := state.BlockRoots[historicalIndex]
+	state.BlockRoots[historicalIndex] = randomRoot(rng)
+	manifest.BlockRoot = &BlockRootUpdate{
+		Index: historicalIndex,
+		Root:  hex.EncodeToString(state.BlockRoots[historicalIndex][:]),
+	}
+	if manifest.BlockRoot.HTR, err = rehashRoot(dynSsz, state); err != nil {
+		return nil, err
+	}
+	state.BlockRoots[historicalIndex] = blockRoot
+
+	validatorIndex := rng.Intn(len(state.Validators))
+	validator := *state.Validators[validatorIndex]
+	exitEpoch := Epoch(state.Slot/uint64(preset.SlotsPerEpoch)) + 1 + Epoch(preset.MinSeedLookahead)
+	if exitEpoch == validator.ExitEpoch {
+		exitEpoch++
+	}
+	state.Validators[validatorIndex].ExitEpoch = exitEpoch
+	state.Validators[validatorIndex].WithdrawableEpoch = exitEpoch + minValidatorWithdrawabilityDelay
+	manifest.Validator = &ValidatorUpdate{
+		Index:             uint64(validatorIndex),
+		ExitEpoch:         uint64(exitEpoch),
+		WithdrawableEpoch: uint64(exitEpoch + minValidatorWithdrawabilityDelay),
+	}
+	if manifest.Validator.HTR, err = rehashRoot(dynSsz, state); err != nil {
+		return nil, err
+	}
+	*state.Validators[validatorIndex] = validator
+
+	return manifest, nil
+}
+
+func rehashRoot(dynSsz *dynssz.DynSsz, state *BeaconState) (string, error) {
+	root, err := dynSsz.HashTreeRoot(state)
+	if err != nil {
+		return "", fmt.Errorf("failed to compute updated state HTR: %w", err)
+	}
+	return hex.EncodeToString(root[:]), nil
+}
@@ -0,0 +1,94 @@
+package corpus
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	dynssz "github.com/pk910/dynamic-ssz"
+)
+
+// TestBlockRoundTrip generates random blocks of every fork and preset with
+// randomised operation counts and checks that decoding and re-encoding them
+// reproduces the same bytes and hash tree root.
+func TestBlockRoundTrip(t *testing.T) {
+	blocks := 200
+	if testing.Short() {
+		blocks = 10
+	}
+
+	for _, presetName := range []string{"minimal", "mainnet"} {
+		specs, preset, err := LoadPreset(presetName)
+		if err != nil {
+			t.Fatalf("failed to load %s preset: %v", presetName, err)
+		}
+		dynSsz := dynssz.NewDynSsz(specs)
+
+		for _, fork := range forkSpecs {
+			t.Run(fork.Name+"-"+presetName, func(t *testing.T) {
+				rng := NewRNG(1, RNGLabel("roundtrip", fork, presetName))
+				for i := range blocks {
+					cfg := randomConfig(t, rng)
+					block, message := fork.GenerateBlock(NewRNG(rng.Int63(), "block"), cfg, preset)
+					if err := checkBlockRoundTrip(dynSsz, fork, block, message); err != nil {
+						t.Fatalf("block %d: %v", i, err)
+					}
+				}
+			})
+		}
+	}
+}
+
+// randomConfig returns a default config with random operation counts
+func randomConfig(t *testing.T, rng *rand.Rand) *Config {
+	cfg := DefaultConfig()
+	cfg.TransactionCount = rng.Intn(cfg.TransactionCount + 1)
+	cfg.MaxAttestations = rng.Intn(cfg.MaxAttestations + 1)
+	cfg.MaxDeposits = rng.Intn(cfg.MaxDeposits + 1)
+	cfg.MaxProposerSlashings = rng.Intn(cfg.MaxProposerSlashings + 1)
+	cfg.MaxAttesterSlashings = rng.Intn(cfg.MaxAttesterSlashings + 1)
+	cfg.MaxVoluntaryExits = rng.Intn(cfg.MaxVoluntaryExits + 1)
+	cfg.MaxBLSToExecChanges = rng.Intn(cfg.MaxBLSToExecChanges + 1)
+	cfg.MaxDepositRequests = rng.Intn(cfg.MaxDepositRequests + 1)
+	cfg.MaxWithdrawalRequests = rng.Intn(cfg.MaxWithdrawalRequests + 1)
+	cfg.MaxConsolidationRequests = rng.Intn(cfg.MaxConsolidationRequests + 1)
+	if rng.Intn(2) == 1 {
+		cfg.TxModel = txModelTyped
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	return cfg
+}
+
+func checkBlockRoundTrip(dynSsz *dynssz.DynSsz, fork *ForkSpec, block, message any) error {
+	data, err := dynSsz.MarshalSSZ(block)
+	if err != nil {
+		return fmt.Errorf("marshal: %w", err)
+	}
+	htr, err := dynSsz.HashTreeRoot(message)
+	if err != nil {
+		return fmt.Errorf("hash tree root: %w", err)
+	}
+
+	decoded := fork.NewBlock()
+	if err := dynSsz.UnmarshalSSZ(decoded, data); err != nil {
+		return fmt.Errorf("unmarshal: %w", err)
+	}
+	reencoded, err := dynSsz.MarshalSSZ(decoded)
+	if err != nil {
+		return fmt.Errorf("re-marshal: %w", err)
+	}
+	if !bytes.Equal(data, reencoded) {
+		return fmt.Errorf("re-encoding differs (%d vs %d bytes)", len(data), len(reencoded))
+	}
+	decodedHTR, err := dynSsz.HashTreeRoot(decoded.GetMessage())
+	if err != nil {
+		return fmt.Errorf("decoded hash tree root: %w", err)
+	}
+	if decodedHTR != htr {
+		return fmt.Errorf("hash tree root changed from %x to %x", htr, decodedHTR)
+	}
+	return nil
+}
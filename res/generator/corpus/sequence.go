@@ -0,0 +1,140 @@
+package corpus
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+
+	dynssz "github.com/pk910/dynamic-ssz"
+)
+
+// SequenceManifest lists the states of a mutated state sequence in order
+type SequenceManifest struct {
+	Seed    int64            `json:"seed"`
+	Slot    uint64           `json:"slot"`
+	Entries []*SequenceEntry `json:"entries"`
+}
+
+// SequenceEntry describes a single state of a sequence. Step 0 is the base
+// state, every later step is its predecessor advanced by one slot.
+type SequenceEntry struct {
+	File   string `json:"file"`
+	Step   int    `json:"step"`
+	Slot   uint64 `json:"slot"`
+	Preset string `json:"preset"`
+	Fork   string `json:"fork"`
+	Size   int    `json:"size"`
+	HTR    string `json:"htr"`
+}
+
+// SequenceMutations is how much of the state a single step changes
+type SequenceMutations struct {
+	Balances      int
+	Participation int
+}
+
+func RunSequence(cfg *Config, steps int, mutations SequenceMutations) error {
+	forks, err := prepareRun(cfg)
+	if err != nil {
+		return err
+	}
+	if len(forks) != 1 || forks[0].Name != "deneb" {
+		return fmt.Errorf("state sequences are only generated for deneb")
+	}
+	if steps <= 0 {
+		return fmt.Errorf("invalid step count %d", steps)
+	}
+	fork := forks[0]
+
+	manifest := &SequenceManifest{
+		Seed: cfg.Seed,
+		Slot: cfg.Slot,
+	}
+
+	for _, presetFile := range cfg.Presets {
+		presetName := PresetName(presetFile)
+		cfg.logf("Generating %s preset state sequence...\n", presetName)
+
+		specs, preset, err := LoadPreset(presetFile)
+		if err != nil {
+			return fmt.Errorf("failed to load %s preset: %w", presetName, err)
+		}
+
+		dynSsz := dynssz.NewDynSsz(specs)
+
+		stepMutations := mutations
+		if stepMutations.Participation == 0 {
+			stepMutations.Participation = max(cfg.ValidatorCount/preset.SlotsPerEpoch, 1)
+		}
+
+		state := GenerateState(NewRNG(cfg.Seed, RNGLabel("state", fork, presetName)), cfg, preset)
+		for step := 0; step <= steps; step++ {
+			if step > 0 {
+				rng := NewRNG(cfg.Seed, fmt.Sprintf("%s-%d", RNGLabel("sequence", fork, presetName), step))
+				mutateState(rng, state, preset, stepMutations)
+			}
+
+			name := fmt.Sprintf("state-%s-%d", presetName, step)
+			meta := &Metadata{Kind: "state", Fork: fork.Name, Preset: presetName}
+			file, err := WriteCorpus(dynSsz, cfg, name, meta, state, state)
+			if err != nil {
+				return err
+			}
+
+			manifest.Entries = append(manifest.Entries, &SequenceEntry{
+				File:   filepath.Base(file.Path),
+				Step:   step,
+				Slot:   state.Slot,
+				Preset: presetName,
+				Fork:   fork.Name,
+				Size:   file.Size,
+				HTR:    hex.EncodeToString(file.HTR[:]),
+			})
+		}
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(cfg.OutputDir, "manifest.json"), data, 0644); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+
+	cfg.logf("Sequence complete!\n")
+	return nil
+}
+
+// mutateState advances state by one slot, changing the fields a slot of block
+// processing changes and leaving everything else as is.
+func mutateState(rng *rand.Rand, state *BeaconState, preset *PresetValues, mutations SequenceMutations) {
+	// process_slot caches the roots of the slot it leaves
+	historicalIndex := state.Slot % uint64(preset.SlotsPerHistoricalRoot)
+	state.StateRoots[historicalIndex] = randomRoot(rng)
+	state.BlockRoots[historicalIndex] = randomRoot(rng)
+	state.Slot++
+
+	// The block mixes its randao reveal into the current epoch's mix
+	epoch := state.Slot / uint64(preset.SlotsPerEpoch)
+	state.RANDAOMixes[epoch%uint64(preset.EpochsPerHistVector)] = randomRoot(rng)
+
+	// Withdrawals and slashings touch a handful of balances
+	for i := 0; i < mutations.Balances; i++ {
+		index := rng.Intn(len(state.Balances))
+		delta := Gwei(rng.Intn(2000000000))
+		if rng.Intn(2) == 0 {
+			state.Balances[index] += delta
+		} else {
+			state.Balances[index] -= min(delta, state.Balances[index])
+		}
+	}
+
+	// Attestations set the source, target and head flags of a committee
+	for i := 0; i < mutations.Participation; i++ {
+		index := rng.Intn(len(state.CurrentEpochParticipation))
+		state.CurrentEpochParticipation[index] |= ParticipationFlags(1 + rng.Intn(7))
+	}
+}
@@ -0,0 +1,34 @@
+package corpus
+
+import (
+	"testing"
+
+	dynssz "github.com/pk910/dynamic-ssz"
+)
+
+// TestGenesisSlot generates a block and state at slot 0 for every fork and
+// checks that they encode, since nothing can lie before the genesis slot.
+func TestGenesisSlot(t *testing.T) {
+	specs, preset, err := LoadPreset("minimal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dynSsz := dynssz.NewDynSsz(specs)
+
+	cfg := DefaultConfig()
+	cfg.Slot = 0
+	cfg.ValidatorCount = 1000
+
+	for _, fork := range forkSpecs {
+		t.Run(fork.Name, func(t *testing.T) {
+			block, _ := fork.GenerateBlock(NewRNG(1, RNGLabel("block", fork, "minimal")), cfg, preset)
+			if _, err := dynSsz.MarshalSSZ(block); err != nil {
+				t.Fatalf("block: %v", err)
+			}
+			state := fork.GenerateState(NewRNG(1, RNGLabel("state", fork, "minimal")), cfg, preset)
+			if _, err := dynSsz.MarshalSSZ(state); err != nil {
+				t.Fatalf("state: %v", err)
+			}
+		})
+	}
+}
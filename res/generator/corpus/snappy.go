@@ -0,0 +1,73 @@
+package corpus
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/golang/snappy"
+)
+
+// Snappy encodings written next to <name>.ssz with --snappy. The framed
+// encoding is what the req/resp protocol sends, the block encoding is what
+// gossip sends (and what the consensus spec tests store as .ssz_snappy).
+const (
+	SnappyFramedExt = ".ssz.sz"
+	SnappyBlockExt  = ".ssz_snappy"
+)
+
+// writeSnappy writes the framed and block snappy encodings of the corpus
+// stored at <stem>.ssz.
+func writeSnappy(stem string, data []byte) error {
+	framed, err := encodeSnappyFramed(data)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(stem+SnappyFramedExt, framed, 0644); err != nil {
+		return err
+	}
+	return os.WriteFile(stem+SnappyBlockExt, snappy.Encode(nil, data), 0644)
+}
+
+func encodeSnappyFramed(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := snappy.NewBufferedWriter(&buf)
+	if _, err := writer.Write(data); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// verifySnappy checks that the snappy encodings next to <stem>.ssz, where
+// present, decompress to data.
+func verifySnappy(stem string, data []byte) error {
+	if framed, err := os.ReadFile(stem + SnappyFramedExt); err == nil {
+		decoded, err := io.ReadAll(snappy.NewReader(bytes.NewReader(framed)))
+		if err != nil {
+			return fmt.Errorf("failed to decompress %s: %w", SnappyFramedExt, err)
+		}
+		if !bytes.Equal(decoded, data) {
+			return fmt.Errorf("%s does not decompress to the corpus", SnappyFramedExt)
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	if block, err := os.ReadFile(stem + SnappyBlockExt); err == nil {
+		decoded, err := snappy.Decode(nil, block)
+		if err != nil {
+			return fmt.Errorf("failed to decompress %s: %w", SnappyBlockExt, err)
+		}
+		if !bytes.Equal(decoded, data) {
+			return fmt.Errorf("%s does not decompress to the corpus", SnappyBlockExt)
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
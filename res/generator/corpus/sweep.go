@@ -0,0 +1,105 @@
+package corpus
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	dynssz "github.com/pk910/dynamic-ssz"
+)
+
+// SweepManifest lists the states of a validator-count sweep
+type SweepManifest struct {
+	Seed    int64         `json:"seed"`
+	Profile string        `json:"profile"`
+	Slot    uint64        `json:"slot"`
+	Entries []*SweepEntry `json:"entries"`
+}
+
+// SweepEntry describes a single state of a sweep
+type SweepEntry struct {
+	File       string `json:"file"`
+	Validators int    `json:"validators"`
+	Preset     string `json:"preset"`
+	Fork       string `json:"fork"`
+	Size       int    `json:"size"`
+	HTR        string `json:"htr"`
+}
+
+func RunSweep(cfg *Config, counts []int) error {
+	forks, err := prepareRun(cfg)
+	if err != nil {
+		return err
+	}
+
+	manifest := &SweepManifest{
+		Seed:    cfg.Seed,
+		Profile: cfg.Profile,
+		Slot:    cfg.Slot,
+	}
+
+	for _, presetFile := range cfg.Presets {
+		presetName := PresetName(presetFile)
+		cfg.logf("Generating %s preset sweep...\n", presetName)
+
+		specs, preset, err := LoadPreset(presetFile)
+		if err != nil {
+			return fmt.Errorf("failed to load %s preset: %w", presetName, err)
+		}
+
+		dynSsz := dynssz.NewDynSsz(specs)
+
+		for _, fork := range forks {
+			for _, count := range counts {
+				entry, err := generateSweepState(dynSsz, cfg, fork, presetName, preset, count)
+				if err != nil {
+					return err
+				}
+				manifest.Entries = append(manifest.Entries, entry)
+			}
+		}
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(cfg.OutputDir, "manifest.json"), data, 0644); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+
+	cfg.logf("Sweep complete!\n")
+	return nil
+}
+
+// generateSweepState writes the state of a single sweep point. Every point
+// has its own random stream, so adding counts does not change existing files.
+func generateSweepState(dynSsz *dynssz.DynSsz, cfg *Config, fork *ForkSpec, presetName string, preset *PresetValues, count int) (*SweepEntry, error) {
+	if count <= 0 {
+		return nil, fmt.Errorf("invalid validator count %d", count)
+	}
+
+	pointCfg := *cfg
+	pointCfg.ValidatorCount = count
+
+	name := fmt.Sprintf("state-%s-%s-%d", fork.Name, presetName, count)
+	rng := NewRNG(cfg.Seed, fmt.Sprintf("%s-%d", RNGLabel("state", fork, presetName), count))
+
+	state := fork.GenerateState(rng, &pointCfg, preset)
+	meta := &Metadata{Kind: "state", Fork: fork.Name, Preset: presetName}
+	file, err := WriteCorpus(dynSsz, &pointCfg, name, meta, state, state)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SweepEntry{
+		File:       filepath.Base(file.Path),
+		Validators: count,
+		Preset:     presetName,
+		Fork:       fork.Name,
+		Size:       file.Size,
+		HTR:        hex.EncodeToString(file.HTR[:]),
+	}, nil
+}
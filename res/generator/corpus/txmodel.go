@@ -0,0 +1,284 @@
+package corpus
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+)
+
+// Transaction models for generated execution payloads
+const (
+	txModelUniform = "uniform"
+	txModelTyped   = "typed"
+)
+
+// defaultTxSizeHistogram roughly follows mainnet: most transactions are a
+// few hundred bytes, with a long tail of large calldata transactions.
+var defaultTxSizeHistogram = []string{"128:10", "256:30", "512:30", "1024:15", "4096:10", "32768:4", "131072:1"}
+
+// EIP-2718 transaction types
+const (
+	txTypeLegacy  = 0x00
+	txTypeDynamic = 0x02
+	txTypeBlob    = 0x03
+	txTypeSetCode = 0x04
+)
+
+const mainnetChainID = 1
+
+// txSizeBucket is a bucket of the transaction size histogram: sizes above the
+// previous bucket and up to Max bytes are drawn with the given Weight.
+type txSizeBucket struct {
+	Max    int
+	Weight int
+}
+
+func validateTxModel(model string) error {
+	switch model {
+	case txModelUniform, txModelTyped:
+		return nil
+	default:
+		return fmt.Errorf("unknown transaction model %q (want %s or %s)", model, txModelUniform, txModelTyped)
+	}
+}
+
+// parseTxSizeHistogram parses "<max bytes>:<weight>" buckets, given in
+// ascending order of their max size.
+func parseTxSizeHistogram(entries []string) ([]txSizeBucket, error) {
+	buckets := make([]txSizeBucket, 0, len(entries))
+	total := 0
+	for _, entry := range entries {
+		maxStr, weightStr, ok := strings.Cut(entry, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid tx size bucket %q (want <max bytes>:<weight>)", entry)
+		}
+		maxSize, err := strconv.Atoi(maxStr)
+		if err != nil || maxSize <= 0 {
+			return nil, fmt.Errorf("invalid tx size bucket %q: bad size", entry)
+		}
+		weight, err := strconv.Atoi(weightStr)
+		if err != nil || weight < 0 {
+			return nil, fmt.Errorf("invalid tx size bucket %q: bad weight", entry)
+		}
+		if len(buckets) > 0 && maxSize <= buckets[len(buckets)-1].Max {
+			return nil, fmt.Errorf("tx size buckets must be in ascending order of size")
+		}
+		buckets = append(buckets, txSizeBucket{Max: maxSize, Weight: weight})
+		total += weight
+	}
+	if total == 0 {
+		return nil, fmt.Errorf("tx size histogram has no weight")
+	}
+	return buckets, nil
+}
+
+// pickTxSize draws a transaction size from the histogram
+func pickTxSize(rng *rand.Rand, buckets []txSizeBucket) int {
+	total := 0
+	for _, bucket := range buckets {
+		total += bucket.Weight
+	}
+
+	n := rng.Intn(total)
+	lower := 1
+	for _, bucket := range buckets {
+		if n < bucket.Weight {
+			return lower + rng.Intn(bucket.Max-lower+1)
+		}
+		n -= bucket.Weight
+		lower = bucket.Max + 1
+	}
+	panic("unreachable")
+}
+
+// pickTxType draws a transaction type with roughly the mix seen on mainnet:
+// mostly EIP-1559, some legacy, a few blob and set-code transactions.
+func pickTxType(rng *rand.Rand) byte {
+	n := rng.Intn(100)
+	switch {
+	case n < 75:
+		return txTypeDynamic
+	case n < 90:
+		return txTypeLegacy
+	case n < 97:
+		return txTypeBlob
+	default:
+		return txTypeSetCode
+	}
+}
+
+// generateTypedTransactions returns count EIP-2718 transactions with sizes
+// drawn from the histogram
+func generateTypedTransactions(rng *rand.Rand, count int, buckets []txSizeBucket) [][]byte {
+	transactions := make([][]byte, count)
+	for i := range transactions {
+		txType := pickTxType(rng)
+		transactions[i] = generateTypedTransaction(rng, txType, pickTxSize(rng, buckets))
+	}
+	return transactions
+}
+
+// generateTypedTransaction returns a transaction of the given type whose
+// calldata is sized so it ends up at size bytes. Transactions whose other
+// fields already take more space carry no calldata.
+func generateTypedTransaction(rng *rand.Rand, txType byte, size int) []byte {
+	fields, dataIndex := typedTxFields(rng, txType)
+	tx := encodeTypedTx(txType, fields)
+	if len(tx) >= size {
+		return tx
+	}
+
+	calldata := generateCalldata(rng, size-len(tx))
+	for dataLen := len(calldata); dataLen > 0; {
+		fields[dataIndex] = rlpBytes(calldata[:dataLen])
+		tx = encodeTypedTx(txType, fields)
+		if len(tx) <= size {
+			break
+		}
+		// Longer length prefixes took the difference
+		dataLen = max(0, dataLen-(len(tx)-size))
+	}
+	return tx
+}
+
+// typedTxFields returns the RLP encoded fields of a transaction of the given
+// type with empty calldata, and the index of the calldata field.
+func typedTxFields(rng *rand.Rand, txType byte) ([][]byte, int) {
+	to := randomExecutionAddress(rng)
+	nonce := rlpUint(randomUint64(rng) % 100000)
+	gasLimit := rlpUint(21000 + randomUint64(rng)%1000000)
+	value := rlpUint(randomUint64(rng) % 10000000000000000000)
+	maxPriorityFee := rlpUint(randomUint64(rng) % 5000000000)
+	maxFee := rlpUint(1000000000 + randomUint64(rng)%100000000000)
+	r := rlpBytes(randomBytes(rng, 32))
+	s := rlpBytes(randomBytes(rng, 32))
+
+	if txType == txTypeLegacy {
+		// EIP-155 signature: v = chain id * 2 + 35 + parity
+		v := rlpUint(mainnetChainID*2 + 35 + uint64(rng.Intn(2)))
+		return [][]byte{nonce, maxFee, gasLimit, rlpBytes(to[:]), value, rlpBytes(nil), v, r, s}, 5
+	}
+
+	fields := [][]byte{
+		rlpUint(mainnetChainID), nonce, maxPriorityFee, maxFee, gasLimit,
+		rlpBytes(to[:]), value, rlpBytes(nil), generateAccessList(rng),
+	}
+	switch txType {
+	case txTypeBlob:
+		hashes := make([][]byte, 1+rng.Intn(6))
+		for i := range hashes {
+			hash := randomHash32(rng)
+			hash[0] = 0x01 // KZG versioned hash
+			hashes[i] = rlpBytes(hash[:])
+		}
+		fields = append(fields, rlpUint(1+randomUint64(rng)%10000000000), rlpList(hashes...))
+	case txTypeSetCode:
+		auths := make([][]byte, 1+rng.Intn(2))
+		for i := range auths {
+			address := randomExecutionAddress(rng)
+			auths[i] = rlpList(
+				rlpUint(mainnetChainID), rlpBytes(address[:]), rlpUint(randomUint64(rng)%100000),
+				rlpUint(uint64(rng.Intn(2))), rlpBytes(randomBytes(rng, 32)), rlpBytes(randomBytes(rng, 32)),
+			)
+		}
+		fields = append(fields, rlpList(auths...))
+	}
+	return append(fields, rlpUint(uint64(rng.Intn(2))), r, s), 7
+}
+
+// generateAccessList returns an EIP-2930 access list, empty for most
+// transactions
+func generateAccessList(rng *rand.Rand) []byte {
+	if rng.Intn(4) != 0 {
+		return rlpList()
+	}
+
+	entries := make([][]byte, 1+rng.Intn(3))
+	for i := range entries {
+		keys := make([][]byte, rng.Intn(5))
+		for j := range keys {
+			keys[j] = rlpBytes(randomBytes(rng, 32))
+		}
+		address := randomExecutionAddress(rng)
+		entries[i] = rlpList(rlpBytes(address[:]), rlpList(keys...))
+	}
+	return rlpList(entries...)
+}
+
+// generateCalldata returns ABI-shaped calldata: a 4 byte selector followed by
+// 32 byte words holding addresses, small integers or full-width values.
+func generateCalldata(rng *rand.Rand, size int) []byte {
+	data := randomBytes(rng, min(size, 4))
+	for len(data) < size {
+		var word [32]byte
+		switch rng.Intn(3) {
+		case 0:
+			_, _ = rng.Read(word[12:])
+		case 1:
+			binary.BigEndian.PutUint32(word[28:], rng.Uint32())
+		default:
+			_, _ = rng.Read(word[:])
+		}
+		data = append(data, word[:min(32, size-len(data))]...)
+	}
+	return data
+}
+
+// encodeTypedTx returns the EIP-2718 envelope of a transaction; legacy
+// transactions are the bare RLP list.
+func encodeTypedTx(txType byte, fields [][]byte) []byte {
+	payload := rlpList(fields...)
+	if txType == txTypeLegacy {
+		return payload
+	}
+	return append([]byte{txType}, payload...)
+}
+
+// rlpBytes encodes a byte string
+func rlpBytes(b []byte) []byte {
+	if len(b) == 1 && b[0] < 0x80 {
+		return []byte{b[0]}
+	}
+	return append(rlpLength(len(b), 0x80), b...)
+}
+
+// rlpUint encodes an integer as its minimal big-endian byte string
+func rlpUint(v uint64) []byte {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], v)
+	i := 0
+	for i < len(buf) && buf[i] == 0 {
+		i++
+	}
+	return rlpBytes(buf[i:])
+}
+
+// rlpList encodes a list of already encoded items
+func rlpList(items ...[]byte) []byte {
+	size := 0
+	for _, item := range items {
+		size += len(item)
+	}
+	out := rlpLength(size, 0xc0)
+	for _, item := range items {
+		out = append(out, item...)
+	}
+	return out
+}
+
+// rlpLength returns the prefix of a string (offset 0x80) or list (offset
+// 0xc0) of n bytes
+func rlpLength(n int, offset byte) []byte {
+	if n < 56 {
+		return []byte{offset + byte(n)}
+	}
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(n))
+	i := 0
+	for buf[i] == 0 {
+		i++
+	}
+	return append([]byte{offset + 55 + byte(8-i)}, buf[i:]...)
+}
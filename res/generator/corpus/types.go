@@ -0,0 +1,325 @@
+package corpus
+
+import (
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// Basic types - using plain types to ensure compatibility with generated code
+type Slot = uint64
+type Epoch = uint64
+type ValidatorIndex = uint64
+type Gwei = uint64
+type Root = [32]byte
+type Hash32 = [32]byte
+type BLSPubKey = [48]byte
+type BLSSignature = [96]byte
+type WithdrawalIndex = uint64
+type ParticipationFlags = uint8
+type KZGCommitment = [48]byte
+type KZGProof = [48]byte
+type ExecutionAddress = [20]byte
+type LogsBloom = [256]byte
+type Uint256 = [32]byte
+
+// Fork represents a fork
+type Fork struct {
+	PreviousVersion [4]byte `json:"previous_version"`
+	CurrentVersion  [4]byte `json:"current_version"`
+	Epoch           Epoch   `json:"epoch"`
+}
+
+// Checkpoint represents a checkpoint
+type Checkpoint struct {
+	Epoch Epoch `json:"epoch"`
+	Root  Root  `json:"root"`
+}
+
+// BeaconBlockHeader represents a beacon block header
+type BeaconBlockHeader struct {
+	Slot          Slot           `json:"slot"`
+	ProposerIndex ValidatorIndex `json:"proposer_index"`
+	ParentRoot    Root           `ssz-size:"32" json:"parent_root"`
+	StateRoot     Root           `ssz-size:"32" json:"state_root"`
+	BodyRoot      Root           `ssz-size:"32" json:"body_root"`
+}
+
+// SignedBeaconBlockHeader represents a signed beacon block header
+type SignedBeaconBlockHeader struct {
+	Message   *BeaconBlockHeader `json:"message"`
+	Signature BLSSignature       `ssz-size:"96" json:"signature"`
+}
+
+// ETH1Data represents eth1 data
+type ETH1Data struct {
+	DepositRoot  Root   `ssz-size:"32" json:"deposit_root"`
+	DepositCount uint64 `json:"deposit_count"`
+	BlockHash    Hash32 `ssz-size:"32" json:"block_hash"`
+}
+
+// Validator represents a validator
+type Validator struct {
+	Pubkey                     BLSPubKey `ssz-size:"48" json:"pubkey"`
+	WithdrawalCredentials      Hash32    `ssz-size:"32" json:"withdrawal_credentials"`
+	EffectiveBalance           Gwei      `json:"effective_balance"`
+	Slashed                    bool      `json:"slashed"`
+	ActivationEligibilityEpoch Epoch     `json:"activation_eligibility_epoch"`
+	ActivationEpoch            Epoch     `json:"activation_epoch"`
+	ExitEpoch                  Epoch     `json:"exit_epoch"`
+	WithdrawableEpoch          Epoch     `json:"withdrawable_epoch"`
+}
+
+// ProposerSlashing represents a proposer slashing
+type ProposerSlashing struct {
+	SignedHeader1 *SignedBeaconBlockHeader `json:"signed_header_1"`
+	SignedHeader2 *SignedBeaconBlockHeader `json:"signed_header_2"`
+}
+
+// AttestationData represents attestation data
+type AttestationData struct {
+	Slot            Slot        `json:"slot"`
+	Index           uint64      `json:"index"`
+	BeaconBlockRoot Root        `ssz-size:"32" json:"beacon_block_root"`
+	Source          *Checkpoint `json:"source"`
+	Target          *Checkpoint `json:"target"`
+}
+
+// IndexedAttestation represents an indexed attestation
+type IndexedAttestation struct {
+	AttestingIndices []uint64         `ssz-max:"2048" json:"attesting_indices"`
+	Data             *AttestationData `json:"data"`
+	Signature        BLSSignature     `ssz-size:"96" json:"signature"`
+}
+
+// AttesterSlashing represents an attester slashing
+type AttesterSlashing struct {
+	Attestation1 *IndexedAttestation `json:"attestation_1"`
+	Attestation2 *IndexedAttestation `json:"attestation_2"`
+}
+
+// Attestation represents an attestation
+type Attestation struct {
+	AggregationBits bitfield.Bitlist `ssz-max:"2048" ssz-type:"bitlist" json:"aggregation_bits"`
+	Data            *AttestationData `json:"data"`
+	Signature       BLSSignature     `ssz-size:"96" json:"signature"`
+}
+
+// DepositData represents deposit data
+type DepositData struct {
+	Pubkey                BLSPubKey    `ssz-size:"48" json:"pubkey"`
+	WithdrawalCredentials Hash32       `ssz-size:"32" json:"withdrawal_credentials"`
+	Amount                Gwei         `json:"amount"`
+	Signature             BLSSignature `ssz-size:"96" json:"signature"`
+}
+
+// Deposit represents a deposit
+type Deposit struct {
+	Proof [][]byte     `ssz-size:"33,32" json:"proof"`
+	Data  *DepositData `json:"data"`
+}
+
+// VoluntaryExit represents a voluntary exit
+type VoluntaryExit struct {
+	Epoch          Epoch          `json:"epoch"`
+	ValidatorIndex ValidatorIndex `json:"validator_index"`
+}
+
+// SignedVoluntaryExit represents a signed voluntary exit
+type SignedVoluntaryExit struct {
+	Message   *VoluntaryExit `json:"message"`
+	Signature BLSSignature   `ssz-size:"96" json:"signature"`
+}
+
+// SyncAggregate represents a sync aggregate
+type SyncAggregate struct {
+	SyncCommitteeBits      bitfield.Bitvector512 `dynssz-size:"SYNC_COMMITTEE_SIZE/8" ssz-size:"64" json:"sync_committee_bits"`
+	SyncCommitteeSignature BLSSignature          `ssz-size:"96" json:"sync_committee_signature"`
+}
+
+// SyncCommittee represents a sync committee
+type SyncCommittee struct {
+	Pubkeys         []BLSPubKey `dynssz-size:"SYNC_COMMITTEE_SIZE,48" ssz-size:"512,48" json:"pubkeys"`
+	AggregatePubkey BLSPubKey   `ssz-size:"48" json:"aggregate_pubkey"`
+}
+
+// Withdrawal represents a withdrawal
+type Withdrawal struct {
+	Index          WithdrawalIndex  `json:"index"`
+	ValidatorIndex ValidatorIndex   `json:"validator_index"`
+	Address        ExecutionAddress `ssz-size:"20" json:"address"`
+	Amount         Gwei             `json:"amount"`
+}
+
+// BLSToExecutionChange represents a BLS to execution change
+type BLSToExecutionChange struct {
+	ValidatorIndex     ValidatorIndex   `json:"validator_index"`
+	FromBLSPubkey      BLSPubKey        `ssz-size:"48" json:"from_bls_pubkey"`
+	ToExecutionAddress ExecutionAddress `ssz-size:"20" json:"to_execution_address"`
+}
+
+// SignedBLSToExecutionChange represents a signed BLS to execution change
+type SignedBLSToExecutionChange struct {
+	Message   *BLSToExecutionChange `json:"message"`
+	Signature BLSSignature          `ssz-size:"96" json:"signature"`
+}
+
+// HistoricalSummary represents a historical summary
+type HistoricalSummary struct {
+	BlockSummaryRoot Root `ssz-size:"32" json:"block_summary_root"`
+	StateSummaryRoot Root `ssz-size:"32" json:"state_summary_root"`
+}
+
+// ExecutionPayload represents an execution payload (Deneb)
+type ExecutionPayload struct {
+	ParentHash    Hash32           `ssz-size:"32" json:"parent_hash"`
+	FeeRecipient  ExecutionAddress `ssz-size:"20" json:"fee_recipient"`
+	StateRoot     Hash32           `ssz-size:"32" json:"state_root"`
+	ReceiptsRoot  Hash32           `ssz-size:"32" json:"receipts_root"`
+	LogsBloom     LogsBloom        `ssz-size:"256" json:"logs_bloom"`
+	PrevRandao    Hash32           `ssz-size:"32" json:"prev_randao"`
+	BlockNumber   uint64           `json:"block_number"`
+	GasLimit      uint64           `json:"gas_limit"`
+	GasUsed       uint64           `json:"gas_used"`
+	Timestamp     uint64           `json:"timestamp"`
+	ExtraData     []byte           `dynssz-max:"MAX_EXTRA_DATA_BYTES" ssz-max:"32" json:"extra_data"`
+	BaseFeePerGas Uint256          `ssz-size:"32" json:"base_fee_per_gas,uint256"`
+	BlockHash     Hash32           `ssz-size:"32" json:"block_hash"`
+	Transactions  [][]byte         `dynssz-max:"MAX_TRANSACTIONS_PER_PAYLOAD,MAX_BYTES_PER_TRANSACTION" ssz-max:"1048576,1073741824" ssz-size:"?,?" json:"transactions"`
+	Withdrawals   []*Withdrawal    `dynssz-max:"MAX_WITHDRAWALS_PER_PAYLOAD" ssz-max:"16" json:"withdrawals"`
+	BlobGasUsed   uint64           `json:"blob_gas_used"`
+	ExcessBlobGas uint64           `json:"excess_blob_gas"`
+}
+
+// ExecutionPayloadHeader represents an execution payload header (Deneb)
+type ExecutionPayloadHeader struct {
+	ParentHash       Hash32           `ssz-size:"32" json:"parent_hash"`
+	FeeRecipient     ExecutionAddress `ssz-size:"20" json:"fee_recipient"`
+	StateRoot        Hash32           `ssz-size:"32" json:"state_root"`
+	ReceiptsRoot     Hash32           `ssz-size:"32" json:"receipts_root"`
+	LogsBloom        LogsBloom        `ssz-size:"256" json:"logs_bloom"`
+	PrevRandao       Hash32           `ssz-size:"32" json:"prev_randao"`
+	BlockNumber      uint64           `json:"block_number"`
+	GasLimit         uint64           `json:"gas_limit"`
+	GasUsed          uint64           `json:"gas_used"`
+	Timestamp        uint64           `json:"timestamp"`
+	ExtraData        []byte           `dynssz-max:"MAX_EXTRA_DATA_BYTES" ssz-max:"32" json:"extra_data"`
+	BaseFeePerGas    Uint256          `ssz-size:"32" json:"base_fee_per_gas,uint256"`
+	BlockHash        Hash32           `ssz-size:"32" json:"block_hash"`
+	TransactionsRoot Root             `ssz-size:"32" json:"transactions_root"`
+	WithdrawalsRoot  Root             `ssz-size:"32" json:"withdrawals_root"`
+	BlobGasUsed      uint64           `json:"blob_gas_used"`
+	ExcessBlobGas    uint64           `json:"excess_blob_gas"`
+}
+
+// BeaconBlockBody represents a beacon block body (Deneb)
+type BeaconBlockBody struct {
+	RANDAOReveal          BLSSignature                  `ssz-size:"96" json:"randao_reveal"`
+	ETH1Data              *ETH1Data                     `json:"eth1_data"`
+	Graffiti              Hash32                        `ssz-size:"32" json:"graffiti"`
+	ProposerSlashings     []*ProposerSlashing           `dynssz-max:"MAX_PROPOSER_SLASHINGS" ssz-max:"16" json:"proposer_slashings"`
+	AttesterSlashings     []*AttesterSlashing           `dynssz-max:"MAX_ATTESTER_SLASHINGS" ssz-max:"2" json:"attester_slashings"`
+	Attestations          []*Attestation                `dynssz-max:"MAX_ATTESTATIONS" ssz-max:"128" json:"attestations"`
+	Deposits              []*Deposit                    `dynssz-max:"MAX_DEPOSITS" ssz-max:"16" json:"deposits"`
+	VoluntaryExits        []*SignedVoluntaryExit        `dynssz-max:"MAX_VOLUNTARY_EXITS" ssz-max:"16" json:"voluntary_exits"`
+	SyncAggregate         *SyncAggregate                `json:"sync_aggregate"`
+	ExecutionPayload      *ExecutionPayload             `json:"execution_payload"`
+	BLSToExecutionChanges []*SignedBLSToExecutionChange `dynssz-max:"MAX_BLS_TO_EXECUTION_CHANGES" ssz-max:"16" json:"bls_to_execution_changes"`
+	BlobKZGCommitments    []KZGCommitment               `dynssz-max:"MAX_BLOB_COMMITMENTS_PER_BLOCK" ssz-max:"4096" ssz-size:"?,48" json:"blob_kzg_commitments"`
+}
+
+// BeaconBlock represents a beacon block (Deneb)
+type BeaconBlock struct {
+	Slot          Slot             `json:"slot"`
+	ProposerIndex ValidatorIndex   `json:"proposer_index"`
+	ParentRoot    Root             `ssz-size:"32" json:"parent_root"`
+	StateRoot     Root             `ssz-size:"32" json:"state_root"`
+	Body          *BeaconBlockBody `json:"body"`
+}
+
+// SignedBeaconBlock represents a signed beacon block (Deneb)
+type SignedBeaconBlock struct {
+	Message   *BeaconBlock `json:"message"`
+	Signature BLSSignature `ssz-size:"96" json:"signature"`
+}
+
+// BlobSidecar represents a blob sidecar (Deneb)
+type BlobSidecar struct {
+	Index                       uint64                   `json:"index"`
+	Blob                        []byte                   `dynssz-size:"FIELD_ELEMENTS_PER_BLOB*32" ssz-size:"131072" json:"blob"`
+	KZGCommitment               KZGCommitment            `ssz-size:"48" json:"kzg_commitment"`
+	KZGProof                    KZGProof                 `ssz-size:"48" json:"kzg_proof"`
+	SignedBlockHeader           *SignedBeaconBlockHeader `json:"signed_block_header"`
+	KZGCommitmentInclusionProof []Root                   `dynssz-size:"KZG_COMMITMENT_INCLUSION_PROOF_DEPTH,32" ssz-size:"17,32" json:"kzg_commitment_inclusion_proof"`
+}
+
+// LightClientHeader represents a light client header (Deneb)
+type LightClientHeader struct {
+	Beacon          *BeaconBlockHeader      `json:"beacon"`
+	Execution       *ExecutionPayloadHeader `json:"execution"`
+	ExecutionBranch []Root                  `ssz-size:"4,32" json:"execution_branch"`
+}
+
+// LightClientBootstrap represents a light client bootstrap (Deneb)
+type LightClientBootstrap struct {
+	Header                     *LightClientHeader `json:"header"`
+	CurrentSyncCommittee       *SyncCommittee     `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch []Root             `ssz-size:"5,32" json:"current_sync_committee_branch"`
+}
+
+// LightClientUpdate represents a light client update (Deneb)
+type LightClientUpdate struct {
+	AttestedHeader          *LightClientHeader `json:"attested_header"`
+	NextSyncCommittee       *SyncCommittee     `json:"next_sync_committee"`
+	NextSyncCommitteeBranch []Root             `ssz-size:"5,32" json:"next_sync_committee_branch"`
+	FinalizedHeader         *LightClientHeader `json:"finalized_header"`
+	FinalityBranch          []Root             `ssz-size:"6,32" json:"finality_branch"`
+	SyncAggregate           *SyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot           Slot               `json:"signature_slot"`
+}
+
+// LightClientFinalityUpdate represents a light client finality update (Deneb)
+type LightClientFinalityUpdate struct {
+	AttestedHeader  *LightClientHeader `json:"attested_header"`
+	FinalizedHeader *LightClientHeader `json:"finalized_header"`
+	FinalityBranch  []Root             `ssz-size:"6,32" json:"finality_branch"`
+	SyncAggregate   *SyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot   Slot               `json:"signature_slot"`
+}
+
+// LightClientOptimisticUpdate represents a light client optimistic update (Deneb)
+type LightClientOptimisticUpdate struct {
+	AttestedHeader *LightClientHeader `json:"attested_header"`
+	SyncAggregate  *SyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot  Slot               `json:"signature_slot"`
+}
+
+// BeaconState represents a beacon state (Deneb)
+type BeaconState struct {
+	GenesisTime                  uint64                  `json:"genesis_time"`
+	GenesisValidatorsRoot        Root                    `ssz-size:"32" json:"genesis_validators_root"`
+	Slot                         Slot                    `json:"slot"`
+	Fork                         *Fork                   `json:"fork"`
+	LatestBlockHeader            *BeaconBlockHeader      `json:"latest_block_header"`
+	BlockRoots                   []Root                  `dynssz-size:"SLOTS_PER_HISTORICAL_ROOT,32" ssz-size:"8192,32" json:"block_roots"`
+	StateRoots                   []Root                  `dynssz-size:"SLOTS_PER_HISTORICAL_ROOT,32" ssz-size:"8192,32" json:"state_roots"`
+	HistoricalRoots              []Root                  `dynssz-max:"HISTORICAL_ROOTS_LIMIT" ssz-max:"16777216" ssz-size:"?,32" json:"historical_roots"`
+	ETH1Data                     *ETH1Data               `json:"eth1_data"`
+	ETH1DataVotes                []*ETH1Data             `dynssz-max:"EPOCHS_PER_ETH1_VOTING_PERIOD*SLOTS_PER_EPOCH" ssz-max:"2048" json:"eth1_data_votes"`
+	ETH1DepositIndex             uint64                  `json:"eth1_deposit_index"`
+	Validators                   []*Validator            `dynssz-max:"VALIDATOR_REGISTRY_LIMIT" ssz-max:"1099511627776" json:"validators"`
+	Balances                     []Gwei                  `dynssz-max:"VALIDATOR_REGISTRY_LIMIT" ssz-max:"1099511627776" json:"balances"`
+	RANDAOMixes                  []Root                  `dynssz-size:"EPOCHS_PER_HISTORICAL_VECTOR,32" ssz-size:"65536,32" json:"randao_mixes"`
+	Slashings                    []Gwei                  `dynssz-size:"EPOCHS_PER_SLASHINGS_VECTOR" ssz-size:"8192" json:"slashings"`
+	PreviousEpochParticipation   []ParticipationFlags    `dynssz-max:"VALIDATOR_REGISTRY_LIMIT" ssz-max:"1099511627776" json:"previous_epoch_participation,list"`
+	CurrentEpochParticipation    []ParticipationFlags    `dynssz-max:"VALIDATOR_REGISTRY_LIMIT" ssz-max:"1099511627776" json:"current_epoch_participation,list"`
+	JustificationBits            bitfield.Bitvector4     `ssz-size:"1" json:"justification_bits"`
+	PreviousJustifiedCheckpoint  *Checkpoint             `json:"previous_justified_checkpoint"`
+	CurrentJustifiedCheckpoint   *Checkpoint             `json:"current_justified_checkpoint"`
+	FinalizedCheckpoint          *Checkpoint             `json:"finalized_checkpoint"`
+	InactivityScores             []uint64                `dynssz-max:"VALIDATOR_REGISTRY_LIMIT" ssz-max:"1099511627776" json:"inactivity_scores"`
+	CurrentSyncCommittee         *SyncCommittee          `json:"current_sync_committee"`
+	NextSyncCommittee            *SyncCommittee          `json:"next_sync_committee"`
+	LatestExecutionPayloadHeader *ExecutionPayloadHeader `json:"latest_execution_payload_header"`
+	NextWithdrawalIndex          WithdrawalIndex         `json:"next_withdrawal_index"`
+	NextWithdrawalValidatorIndex ValidatorIndex          `json:"next_withdrawal_validator_index"`
+	HistoricalSummaries          []*HistoricalSummary    `dynssz-max:"HISTORICAL_ROOTS_LIMIT" ssz-max:"16777216" json:"historical_summaries"`
+}
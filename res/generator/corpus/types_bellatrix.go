@@ -0,0 +1,99 @@
+package corpus
+
+import (
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// ExecutionPayloadBellatrix represents an execution payload (Bellatrix)
+type ExecutionPayloadBellatrix struct {
+	ParentHash    Hash32           `ssz-size:"32" json:"parent_hash"`
+	FeeRecipient  ExecutionAddress `ssz-size:"20" json:"fee_recipient"`
+	StateRoot     Hash32           `ssz-size:"32" json:"state_root"`
+	ReceiptsRoot  Hash32           `ssz-size:"32" json:"receipts_root"`
+	LogsBloom     LogsBloom        `ssz-size:"256" json:"logs_bloom"`
+	PrevRandao    Hash32           `ssz-size:"32" json:"prev_randao"`
+	BlockNumber   uint64           `json:"block_number"`
+	GasLimit      uint64           `json:"gas_limit"`
+	GasUsed       uint64           `json:"gas_used"`
+	Timestamp     uint64           `json:"timestamp"`
+	ExtraData     []byte           `dynssz-max:"MAX_EXTRA_DATA_BYTES" ssz-max:"32" json:"extra_data"`
+	BaseFeePerGas Uint256          `ssz-size:"32" json:"base_fee_per_gas,uint256"`
+	BlockHash     Hash32           `ssz-size:"32" json:"block_hash"`
+	Transactions  [][]byte         `dynssz-max:"MAX_TRANSACTIONS_PER_PAYLOAD,MAX_BYTES_PER_TRANSACTION" ssz-max:"1048576,1073741824" ssz-size:"?,?" json:"transactions"`
+}
+
+// ExecutionPayloadHeaderBellatrix represents an execution payload header (Bellatrix)
+type ExecutionPayloadHeaderBellatrix struct {
+	ParentHash       Hash32           `ssz-size:"32" json:"parent_hash"`
+	FeeRecipient     ExecutionAddress `ssz-size:"20" json:"fee_recipient"`
+	StateRoot        Hash32           `ssz-size:"32" json:"state_root"`
+	ReceiptsRoot     Hash32           `ssz-size:"32" json:"receipts_root"`
+	LogsBloom        LogsBloom        `ssz-size:"256" json:"logs_bloom"`
+	PrevRandao       Hash32           `ssz-size:"32" json:"prev_randao"`
+	BlockNumber      uint64           `json:"block_number"`
+	GasLimit         uint64           `json:"gas_limit"`
+	GasUsed          uint64           `json:"gas_used"`
+	Timestamp        uint64           `json:"timestamp"`
+	ExtraData        []byte           `dynssz-max:"MAX_EXTRA_DATA_BYTES" ssz-max:"32" json:"extra_data"`
+	BaseFeePerGas    Uint256          `ssz-size:"32" json:"base_fee_per_gas,uint256"`
+	BlockHash        Hash32           `ssz-size:"32" json:"block_hash"`
+	TransactionsRoot Root             `ssz-size:"32" json:"transactions_root"`
+}
+
+// BeaconBlockBodyBellatrix represents a beacon block body (Bellatrix)
+type BeaconBlockBodyBellatrix struct {
+	RANDAOReveal      BLSSignature               `ssz-size:"96" json:"randao_reveal"`
+	ETH1Data          *ETH1Data                  `json:"eth1_data"`
+	Graffiti          Hash32                     `ssz-size:"32" json:"graffiti"`
+	ProposerSlashings []*ProposerSlashing        `dynssz-max:"MAX_PROPOSER_SLASHINGS" ssz-max:"16" json:"proposer_slashings"`
+	AttesterSlashings []*AttesterSlashing        `dynssz-max:"MAX_ATTESTER_SLASHINGS" ssz-max:"2" json:"attester_slashings"`
+	Attestations      []*Attestation             `dynssz-max:"MAX_ATTESTATIONS" ssz-max:"128" json:"attestations"`
+	Deposits          []*Deposit                 `dynssz-max:"MAX_DEPOSITS" ssz-max:"16" json:"deposits"`
+	VoluntaryExits    []*SignedVoluntaryExit     `dynssz-max:"MAX_VOLUNTARY_EXITS" ssz-max:"16" json:"voluntary_exits"`
+	SyncAggregate     *SyncAggregate             `json:"sync_aggregate"`
+	ExecutionPayload  *ExecutionPayloadBellatrix `json:"execution_payload"`
+}
+
+// BeaconBlockBellatrix represents a beacon block (Bellatrix)
+type BeaconBlockBellatrix struct {
+	Slot          Slot                      `json:"slot"`
+	ProposerIndex ValidatorIndex            `json:"proposer_index"`
+	ParentRoot    Root                      `ssz-size:"32" json:"parent_root"`
+	StateRoot     Root                      `ssz-size:"32" json:"state_root"`
+	Body          *BeaconBlockBodyBellatrix `json:"body"`
+}
+
+// SignedBeaconBlockBellatrix represents a signed beacon block (Bellatrix)
+type SignedBeaconBlockBellatrix struct {
+	Message   *BeaconBlockBellatrix `json:"message"`
+	Signature BLSSignature          `ssz-size:"96" json:"signature"`
+}
+
+// BeaconStateBellatrix represents a beacon state (Bellatrix)
+type BeaconStateBellatrix struct {
+	GenesisTime                  uint64                           `json:"genesis_time"`
+	GenesisValidatorsRoot        Root                             `ssz-size:"32" json:"genesis_validators_root"`
+	Slot                         Slot                             `json:"slot"`
+	Fork                         *Fork                            `json:"fork"`
+	LatestBlockHeader            *BeaconBlockHeader               `json:"latest_block_header"`
+	BlockRoots                   []Root                           `dynssz-size:"SLOTS_PER_HISTORICAL_ROOT,32" ssz-size:"8192,32" json:"block_roots"`
+	StateRoots                   []Root                           `dynssz-size:"SLOTS_PER_HISTORICAL_ROOT,32" ssz-size:"8192,32" json:"state_roots"`
+	HistoricalRoots              []Root                           `dynssz-max:"HISTORICAL_ROOTS_LIMIT" ssz-max:"16777216" ssz-size:"?,32" json:"historical_roots"`
+	ETH1Data                     *ETH1Data                        `json:"eth1_data"`
+	ETH1DataVotes                []*ETH1Data                      `dynssz-max:"EPOCHS_PER_ETH1_VOTING_PERIOD*SLOTS_PER_EPOCH" ssz-max:"2048" json:"eth1_data_votes"`
+	ETH1DepositIndex             uint64                           `json:"eth1_deposit_index"`
+	Validators                   []*Validator                     `dynssz-max:"VALIDATOR_REGISTRY_LIMIT" ssz-max:"1099511627776" json:"validators"`
+	Balances                     []Gwei                           `dynssz-max:"VALIDATOR_REGISTRY_LIMIT" ssz-max:"1099511627776" json:"balances"`
+	RANDAOMixes                  []Root                           `dynssz-size:"EPOCHS_PER_HISTORICAL_VECTOR,32" ssz-size:"65536,32" json:"randao_mixes"`
+	Slashings                    []Gwei                           `dynssz-size:"EPOCHS_PER_SLASHINGS_VECTOR" ssz-size:"8192" json:"slashings"`
+	PreviousEpochParticipation   []ParticipationFlags             `dynssz-max:"VALIDATOR_REGISTRY_LIMIT" ssz-max:"1099511627776" json:"previous_epoch_participation,list"`
+	CurrentEpochParticipation    []ParticipationFlags             `dynssz-max:"VALIDATOR_REGISTRY_LIMIT" ssz-max:"1099511627776" json:"current_epoch_participation,list"`
+	JustificationBits            bitfield.Bitvector4              `ssz-size:"1" json:"justification_bits"`
+	PreviousJustifiedCheckpoint  *Checkpoint                      `json:"previous_justified_checkpoint"`
+	CurrentJustifiedCheckpoint   *Checkpoint                      `json:"current_justified_checkpoint"`
+	FinalizedCheckpoint          *Checkpoint                      `json:"finalized_checkpoint"`
+	InactivityScores             []uint64                         `dynssz-max:"VALIDATOR_REGISTRY_LIMIT" ssz-max:"1099511627776" json:"inactivity_scores"`
+	CurrentSyncCommittee         *SyncCommittee                   `json:"current_sync_committee"`
+	NextSyncCommittee            *SyncCommittee                   `json:"next_sync_committee"`
+	LatestExecutionPayloadHeader *ExecutionPayloadHeaderBellatrix `json:"latest_execution_payload_header"`
+}
@@ -0,0 +1,151 @@
+package corpus
+
+import (
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// AttestationElectra represents an attestation (Electra, EIP-7549)
+type AttestationElectra struct {
+	AggregationBits bitfield.Bitlist     `dynssz-max:"MAX_VALIDATORS_PER_COMMITTEE*MAX_COMMITTEES_PER_SLOT" ssz-max:"131072" ssz-type:"bitlist" json:"aggregation_bits"`
+	Data            *AttestationData     `json:"data"`
+	Signature       BLSSignature         `ssz-size:"96" json:"signature"`
+	CommitteeBits   bitfield.Bitvector64 `dynssz-size:"MAX_COMMITTEES_PER_SLOT/8" ssz-size:"8" json:"committee_bits"`
+}
+
+// IndexedAttestationElectra represents an indexed attestation (Electra, EIP-7549)
+type IndexedAttestationElectra struct {
+	AttestingIndices []uint64         `dynssz-max:"MAX_VALIDATORS_PER_COMMITTEE*MAX_COMMITTEES_PER_SLOT" ssz-max:"131072" json:"attesting_indices"`
+	Data             *AttestationData `json:"data"`
+	Signature        BLSSignature     `ssz-size:"96" json:"signature"`
+}
+
+// AttesterSlashingElectra represents an attester slashing (Electra)
+type AttesterSlashingElectra struct {
+	Attestation1 *IndexedAttestationElectra `json:"attestation_1"`
+	Attestation2 *IndexedAttestationElectra `json:"attestation_2"`
+}
+
+// DepositRequest represents a deposit request (Electra, EIP-6110)
+type DepositRequest struct {
+	Pubkey                BLSPubKey    `ssz-size:"48" json:"pubkey"`
+	WithdrawalCredentials Hash32       `ssz-size:"32" json:"withdrawal_credentials"`
+	Amount                Gwei         `json:"amount"`
+	Signature             BLSSignature `ssz-size:"96" json:"signature"`
+	Index                 uint64       `json:"index"`
+}
+
+// WithdrawalRequest represents a withdrawal request (Electra, EIP-7002)
+type WithdrawalRequest struct {
+	SourceAddress   ExecutionAddress `ssz-size:"20" json:"source_address"`
+	ValidatorPubkey BLSPubKey        `ssz-size:"48" json:"validator_pubkey"`
+	Amount          Gwei             `json:"amount"`
+}
+
+// ConsolidationRequest represents a consolidation request (Electra, EIP-7251)
+type ConsolidationRequest struct {
+	SourceAddress ExecutionAddress `ssz-size:"20" json:"source_address"`
+	SourcePubkey  BLSPubKey        `ssz-size:"48" json:"source_pubkey"`
+	TargetPubkey  BLSPubKey        `ssz-size:"48" json:"target_pubkey"`
+}
+
+// ExecutionRequests represents the execution layer requests of a block (Electra)
+type ExecutionRequests struct {
+	Deposits       []*DepositRequest       `dynssz-max:"MAX_DEPOSIT_REQUESTS_PER_PAYLOAD" ssz-max:"8192" json:"deposits"`
+	Withdrawals    []*WithdrawalRequest    `dynssz-max:"MAX_WITHDRAWAL_REQUESTS_PER_PAYLOAD" ssz-max:"16" json:"withdrawals"`
+	Consolidations []*ConsolidationRequest `dynssz-max:"MAX_CONSOLIDATION_REQUESTS_PER_PAYLOAD" ssz-max:"2" json:"consolidations"`
+}
+
+// PendingDeposit represents a pending deposit (Electra)
+type PendingDeposit struct {
+	Pubkey                BLSPubKey    `ssz-size:"48" json:"pubkey"`
+	WithdrawalCredentials Hash32       `ssz-size:"32" json:"withdrawal_credentials"`
+	Amount                Gwei         `json:"amount"`
+	Signature             BLSSignature `ssz-size:"96" json:"signature"`
+	Slot                  Slot         `json:"slot"`
+}
+
+// PendingPartialWithdrawal represents a pending partial withdrawal (Electra)
+type PendingPartialWithdrawal struct {
+	ValidatorIndex    ValidatorIndex `json:"validator_index"`
+	Amount            Gwei           `json:"amount"`
+	WithdrawableEpoch Epoch          `json:"withdrawable_epoch"`
+}
+
+// PendingConsolidation represents a pending consolidation (Electra)
+type PendingConsolidation struct {
+	SourceIndex ValidatorIndex `json:"source_index"`
+	TargetIndex ValidatorIndex `json:"target_index"`
+}
+
+// BeaconBlockBodyElectra represents a beacon block body (Electra)
+type BeaconBlockBodyElectra struct {
+	RANDAOReveal          BLSSignature                  `ssz-size:"96" json:"randao_reveal"`
+	ETH1Data              *ETH1Data                     `json:"eth1_data"`
+	Graffiti              Hash32                        `ssz-size:"32" json:"graffiti"`
+	ProposerSlashings     []*ProposerSlashing           `dynssz-max:"MAX_PROPOSER_SLASHINGS" ssz-max:"16" json:"proposer_slashings"`
+	AttesterSlashings     []*AttesterSlashingElectra    `dynssz-max:"MAX_ATTESTER_SLASHINGS_ELECTRA" ssz-max:"1" json:"attester_slashings"`
+	Attestations          []*AttestationElectra         `dynssz-max:"MAX_ATTESTATIONS_ELECTRA" ssz-max:"8" json:"attestations"`
+	Deposits              []*Deposit                    `dynssz-max:"MAX_DEPOSITS" ssz-max:"16" json:"deposits"`
+	VoluntaryExits        []*SignedVoluntaryExit        `dynssz-max:"MAX_VOLUNTARY_EXITS" ssz-max:"16" json:"voluntary_exits"`
+	SyncAggregate         *SyncAggregate                `json:"sync_aggregate"`
+	ExecutionPayload      *ExecutionPayload             `json:"execution_payload"`
+	BLSToExecutionChanges []*SignedBLSToExecutionChange `dynssz-max:"MAX_BLS_TO_EXECUTION_CHANGES" ssz-max:"16" json:"bls_to_execution_changes"`
+	BlobKZGCommitments    []KZGCommitment               `dynssz-max:"MAX_BLOB_COMMITMENTS_PER_BLOCK" ssz-max:"4096" ssz-size:"?,48" json:"blob_kzg_commitments"`
+	ExecutionRequests     *ExecutionRequests            `json:"execution_requests"`
+}
+
+// BeaconBlockElectra represents a beacon block (Electra)
+type BeaconBlockElectra struct {
+	Slot          Slot                    `json:"slot"`
+	ProposerIndex ValidatorIndex          `json:"proposer_index"`
+	ParentRoot    Root                    `ssz-size:"32" json:"parent_root"`
+	StateRoot     Root                    `ssz-size:"32" json:"state_root"`
+	Body          *BeaconBlockBodyElectra `json:"body"`
+}
+
+// SignedBeaconBlockElectra represents a signed beacon block (Electra)
+type SignedBeaconBlockElectra struct {
+	Message   *BeaconBlockElectra `json:"message"`
+	Signature BLSSignature        `ssz-size:"96" json:"signature"`
+}
+
+// BeaconStateElectra represents a beacon state (Electra)
+type BeaconStateElectra struct {
+	GenesisTime                   uint64                      `json:"genesis_time"`
+	GenesisValidatorsRoot         Root                        `ssz-size:"32" json:"genesis_validators_root"`
+	Slot                          Slot                        `json:"slot"`
+	Fork                          *Fork                       `json:"fork"`
+	LatestBlockHeader             *BeaconBlockHeader          `json:"latest_block_header"`
+	BlockRoots                    []Root                      `dynssz-size:"SLOTS_PER_HISTORICAL_ROOT,32" ssz-size:"8192,32" json:"block_roots"`
+	StateRoots                    []Root                      `dynssz-size:"SLOTS_PER_HISTORICAL_ROOT,32" ssz-size:"8192,32" json:"state_roots"`
+	HistoricalRoots               []Root                      `dynssz-max:"HISTORICAL_ROOTS_LIMIT" ssz-max:"16777216" ssz-size:"?,32" json:"historical_roots"`
+	ETH1Data                      *ETH1Data                   `json:"eth1_data"`
+	ETH1DataVotes                 []*ETH1Data                 `dynssz-max:"EPOCHS_PER_ETH1_VOTING_PERIOD*SLOTS_PER_EPOCH" ssz-max:"2048" json:"eth1_data_votes"`
+	ETH1DepositIndex              uint64                      `json:"eth1_deposit_index"`
+	Validators                    []*Validator                `dynssz-max:"VALIDATOR_REGISTRY_LIMIT" ssz-max:"1099511627776" json:"validators"`
+	Balances                      []Gwei                      `dynssz-max:"VALIDATOR_REGISTRY_LIMIT" ssz-max:"1099511627776" json:"balances"`
+	RANDAOMixes                   []Root                      `dynssz-size:"EPOCHS_PER_HISTORICAL_VECTOR,32" ssz-size:"65536,32" json:"randao_mixes"`
+	Slashings                     []Gwei                      `dynssz-size:"EPOCHS_PER_SLASHINGS_VECTOR" ssz-size:"8192" json:"slashings"`
+	PreviousEpochParticipation    []ParticipationFlags        `dynssz-max:"VALIDATOR_REGISTRY_LIMIT" ssz-max:"1099511627776" json:"previous_epoch_participation,list"`
+	CurrentEpochParticipation     []ParticipationFlags        `dynssz-max:"VALIDATOR_REGISTRY_LIMIT" ssz-max:"1099511627776" json:"current_epoch_participation,list"`
+	JustificationBits             bitfield.Bitvector4         `ssz-size:"1" json:"justification_bits"`
+	PreviousJustifiedCheckpoint   *Checkpoint                 `json:"previous_justified_checkpoint"`
+	CurrentJustifiedCheckpoint    *Checkpoint                 `json:"current_justified_checkpoint"`
+	FinalizedCheckpoint           *Checkpoint                 `json:"finalized_checkpoint"`
+	InactivityScores              []uint64                    `dynssz-max:"VALIDATOR_REGISTRY_LIMIT" ssz-max:"1099511627776" json:"inactivity_scores"`
+	CurrentSyncCommittee          *SyncCommittee              `json:"current_sync_committee"`
+	NextSyncCommittee             *SyncCommittee              `json:"next_sync_committee"`
+	LatestExecutionPayloadHeader  *ExecutionPayloadHeader     `json:"latest_execution_payload_header"`
+	NextWithdrawalIndex           WithdrawalIndex             `json:"next_withdrawal_index"`
+	NextWithdrawalValidatorIndex  ValidatorIndex              `json:"next_withdrawal_validator_index"`
+	HistoricalSummaries           []*HistoricalSummary        `dynssz-max:"HISTORICAL_ROOTS_LIMIT" ssz-max:"16777216" json:"historical_summaries"`
+	DepositRequestsStartIndex     uint64                      `json:"deposit_requests_start_index"`
+	DepositBalanceToConsume       Gwei                        `json:"deposit_balance_to_consume"`
+	ExitBalanceToConsume          Gwei                        `json:"exit_balance_to_consume"`
+	EarliestExitEpoch             Epoch                       `json:"earliest_exit_epoch"`
+	ConsolidationBalanceToConsume Gwei                        `json:"consolidation_balance_to_consume"`
+	EarliestConsolidationEpoch    Epoch                       `json:"earliest_consolidation_epoch"`
+	PendingDeposits               []*PendingDeposit           `dynssz-max:"PENDING_DEPOSITS_LIMIT" ssz-max:"134217728" json:"pending_deposits"`
+	PendingPartialWithdrawals     []*PendingPartialWithdrawal `dynssz-max:"PENDING_PARTIAL_WITHDRAWALS_LIMIT" ssz-max:"134217728" json:"pending_partial_withdrawals"`
+	PendingConsolidations         []*PendingConsolidation     `dynssz-max:"PENDING_CONSOLIDATIONS_LIMIT" ssz-max:"262144" json:"pending_consolidations"`
+}
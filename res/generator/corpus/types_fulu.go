@@ -0,0 +1,55 @@
+package corpus
+
+import (
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// The Fulu block is unchanged from Electra, only the state gains the proposer
+// lookahead (EIP-7917).
+type (
+	BeaconBlockBodyFulu   = BeaconBlockBodyElectra
+	BeaconBlockFulu       = BeaconBlockElectra
+	SignedBeaconBlockFulu = SignedBeaconBlockElectra
+)
+
+// BeaconStateFulu represents a beacon state (Fulu)
+type BeaconStateFulu struct {
+	GenesisTime                   uint64                      `json:"genesis_time"`
+	GenesisValidatorsRoot         Root                        `ssz-size:"32" json:"genesis_validators_root"`
+	Slot                          Slot                        `json:"slot"`
+	Fork                          *Fork                       `json:"fork"`
+	LatestBlockHeader             *BeaconBlockHeader          `json:"latest_block_header"`
+	BlockRoots                    []Root                      `dynssz-size:"SLOTS_PER_HISTORICAL_ROOT,32" ssz-size:"8192,32" json:"block_roots"`
+	StateRoots                    []Root                      `dynssz-size:"SLOTS_PER_HISTORICAL_ROOT,32" ssz-size:"8192,32" json:"state_roots"`
+	HistoricalRoots               []Root                      `dynssz-max:"HISTORICAL_ROOTS_LIMIT" ssz-max:"16777216" ssz-size:"?,32" json:"historical_roots"`
+	ETH1Data                      *ETH1Data                   `json:"eth1_data"`
+	ETH1DataVotes                 []*ETH1Data                 `dynssz-max:"EPOCHS_PER_ETH1_VOTING_PERIOD*SLOTS_PER_EPOCH" ssz-max:"2048" json:"eth1_data_votes"`
+	ETH1DepositIndex              uint64                      `json:"eth1_deposit_index"`
+	Validators                    []*Validator                `dynssz-max:"VALIDATOR_REGISTRY_LIMIT" ssz-max:"1099511627776" json:"validators"`
+	Balances                      []Gwei                      `dynssz-max:"VALIDATOR_REGISTRY_LIMIT" ssz-max:"1099511627776" json:"balances"`
+	RANDAOMixes                   []Root                      `dynssz-size:"EPOCHS_PER_HISTORICAL_VECTOR,32" ssz-size:"65536,32" json:"randao_mixes"`
+	Slashings                     []Gwei                      `dynssz-size:"EPOCHS_PER_SLASHINGS_VECTOR" ssz-size:"8192" json:"slashings"`
+	PreviousEpochParticipation    []ParticipationFlags        `dynssz-max:"VALIDATOR_REGISTRY_LIMIT" ssz-max:"1099511627776" json:"previous_epoch_participation,list"`
+	CurrentEpochParticipation     []ParticipationFlags        `dynssz-max:"VALIDATOR_REGISTRY_LIMIT" ssz-max:"1099511627776" json:"current_epoch_participation,list"`
+	JustificationBits             bitfield.Bitvector4         `ssz-size:"1" json:"justification_bits"`
+	PreviousJustifiedCheckpoint   *Checkpoint                 `json:"previous_justified_checkpoint"`
+	CurrentJustifiedCheckpoint    *Checkpoint                 `json:"current_justified_checkpoint"`
+	FinalizedCheckpoint           *Checkpoint                 `json:"finalized_checkpoint"`
+	InactivityScores              []uint64                    `dynssz-max:"VALIDATOR_REGISTRY_LIMIT" ssz-max:"1099511627776" json:"inactivity_scores"`
+	CurrentSyncCommittee          *SyncCommittee              `json:"current_sync_committee"`
+	NextSyncCommittee             *SyncCommittee              `json:"next_sync_committee"`
+	LatestExecutionPayloadHeader  *ExecutionPayloadHeader     `json:"latest_execution_payload_header"`
+	NextWithdrawalIndex           WithdrawalIndex             `json:"next_withdrawal_index"`
+	NextWithdrawalValidatorIndex  ValidatorIndex              `json:"next_withdrawal_validator_index"`
+	HistoricalSummaries           []*HistoricalSummary        `dynssz-max:"HISTORICAL_ROOTS_LIMIT" ssz-max:"16777216" json:"historical_summaries"`
+	DepositRequestsStartIndex     uint64                      `json:"deposit_requests_start_index"`
+	DepositBalanceToConsume       Gwei                        `json:"deposit_balance_to_consume"`
+	ExitBalanceToConsume          Gwei                        `json:"exit_balance_to_consume"`
+	EarliestExitEpoch             Epoch                       `json:"earliest_exit_epoch"`
+	ConsolidationBalanceToConsume Gwei                        `json:"consolidation_balance_to_consume"`
+	EarliestConsolidationEpoch    Epoch                       `json:"earliest_consolidation_epoch"`
+	PendingDeposits               []*PendingDeposit           `dynssz-max:"PENDING_DEPOSITS_LIMIT" ssz-max:"134217728" json:"pending_deposits"`
+	PendingPartialWithdrawals     []*PendingPartialWithdrawal `dynssz-max:"PENDING_PARTIAL_WITHDRAWALS_LIMIT" ssz-max:"134217728" json:"pending_partial_withdrawals"`
+	PendingConsolidations         []*PendingConsolidation     `dynssz-max:"PENDING_CONSOLIDATIONS_LIMIT" ssz-max:"262144" json:"pending_consolidations"`
+	ProposerLookahead             []ValidatorIndex            `dynssz-size:"(MIN_SEED_LOOKAHEAD+1)*SLOTS_PER_EPOCH" ssz-size:"64" json:"proposer_lookahead"`
+}
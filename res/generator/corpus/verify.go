@@ -0,0 +1,203 @@
+package corpus
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	dynssz "github.com/pk910/dynamic-ssz"
+)
+
+// corpusName is a corpus file name split into its parts
+type corpusName struct {
+	Kind   string
+	Fork   *ForkSpec
+	Preset string
+	// Object is set for sub-object corpora
+	Object *ObjectSpec
+}
+
+// parseCorpusName splits e.g. "state-electra-mainnet-100000.ssz" into its
+// kind, fork and preset. Files without a fork are the legacy deneb corpora.
+func parseCorpusName(file string) (*corpusName, error) {
+	stem := strings.TrimSuffix(file, ".ssz")
+	for _, object := range objectSpecs {
+		if preset, ok := strings.CutPrefix(stem, object.Name+"-"); ok {
+			return &corpusName{Kind: object.Name, Fork: FindFork("deneb"), Preset: preset, Object: object}, nil
+		}
+	}
+
+	parts := strings.Split(stem, "-")
+	if len(parts) < 2 || (parts[0] != "block" && parts[0] != "state") {
+		return nil, fmt.Errorf("not a block, state or object corpus")
+	}
+
+	name := &corpusName{Kind: parts[0], Fork: FindFork("deneb")}
+	parts = parts[1:]
+
+	if len(parts) > 1 {
+		if fork := FindFork(parts[0]); fork != nil {
+			name.Fork = fork
+			parts = parts[1:]
+		}
+	}
+
+	// Edge-case blocks carry their shape after the fork
+	if name.Kind == "block" && len(parts) > 1 && isBlockShape(parts[0]) {
+		parts = parts[1:]
+	}
+
+	// Sweep states carry the validator count, sequence states the step as suffix
+	if len(parts) > 1 {
+		if _, err := strconv.Atoi(parts[len(parts)-1]); err == nil {
+			parts = parts[:len(parts)-1]
+		}
+	}
+
+	name.Preset = strings.Join(parts, "-")
+	return name, nil
+}
+
+// newObject returns an empty object to decode the corpus into
+func (n *corpusName) newObject() any {
+	switch {
+	case n.Object != nil:
+		return n.Object.New()
+	case n.Kind == "block":
+		return n.Fork.NewBlock()
+	default:
+		return n.Fork.NewState()
+	}
+}
+
+func RunVerify(cfg *Config, dir string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.ssz"))
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no .ssz files in %s", dir)
+	}
+	sort.Strings(files)
+
+	// Presets are loaded lazily, only the ones used in dir are needed
+	presetFiles := make(map[string]string, len(cfg.Presets))
+	for _, presetFile := range cfg.Presets {
+		presetFiles[PresetName(presetFile)] = presetFile
+	}
+	dynSszs := make(map[string]*dynssz.DynSsz)
+	presetErrs := make(map[string]error)
+
+	failed := 0
+	for _, path := range files {
+		file := filepath.Base(path)
+		name, err := parseCorpusName(file)
+		if err == nil {
+			if _, ok := dynSszs[name.Preset]; !ok && presetErrs[name.Preset] == nil {
+				dynSszs[name.Preset], presetErrs[name.Preset] = loadVerifyPreset(presetFiles, name.Preset)
+			}
+			err = presetErrs[name.Preset]
+		}
+		if err == nil {
+			err = verifyCorpus(dynSszs[name.Preset], path, name)
+		}
+
+		if err != nil {
+			failed++
+			cfg.logf("  FAIL %s: %v\n", file, err)
+			continue
+		}
+		cfg.logf("  PASS %s (%s %s, %s)\n", file, name.Fork.Name, name.Kind, name.Preset)
+	}
+
+	cfg.logf("%d passed, %d failed\n", len(files)-failed, failed)
+	if failed > 0 {
+		return fmt.Errorf("%d of %d corpora failed verification", failed, len(files))
+	}
+	return nil
+}
+
+func loadVerifyPreset(presetFiles map[string]string, preset string) (*dynssz.DynSsz, error) {
+	presetFile, ok := presetFiles[preset]
+	if !ok {
+		return nil, fmt.Errorf("unknown preset %q (pass its YAML with --preset)", preset)
+	}
+	specs, err := loadPresetSpecs(presetFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s preset: %w", preset, err)
+	}
+	return dynssz.NewDynSsz(specs), nil
+}
+
+// verifyCorpus decodes a corpus, checks that it re-marshals to the same bytes,
+// that its HTR matches the metadata and that its snappy encodings match.
+func verifyCorpus(dynSsz *dynssz.DynSsz, path string, name *corpusName) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	stem := strings.TrimSuffix(path, ".ssz")
+	meta, wantHTR, err := readMetadata(stem + "-meta.json")
+	if err != nil {
+		return err
+	}
+	if err := verifySnappy(stem, data); err != nil {
+		return err
+	}
+
+	obj := name.newObject()
+	if err := dynSsz.UnmarshalSSZ(obj, data); err != nil {
+		return fmt.Errorf("failed to decode: %w", err)
+	}
+	root := obj
+	if block, ok := obj.(SignedBlock); ok {
+		root = block.GetMessage()
+	}
+
+	remarshaled, err := dynSsz.MarshalSSZ(obj)
+	if err != nil {
+		return fmt.Errorf("failed to re-marshal: %w", err)
+	}
+	if !bytes.Equal(remarshaled, data) {
+		return fmt.Errorf("re-marshaled bytes differ (%d vs %d bytes)", len(remarshaled), len(data))
+	}
+
+	htr, err := dynSsz.HashTreeRoot(root)
+	if err != nil {
+		return fmt.Errorf("failed to compute HTR: %w", err)
+	}
+	if !bytes.Equal(htr[:], wantHTR) {
+		// Point at the sub-trees that changed if the metadata records them
+		if roots, err := subtreeRoots(dynSsz, obj, root); err == nil {
+			if diffs := differingRoots(roots, meta.Roots); len(diffs) > 0 {
+				return fmt.Errorf("HTR mismatch: got %x, want %x (differs in %s)", htr, wantHTR, strings.Join(diffs, ", "))
+			}
+		}
+		return fmt.Errorf("HTR mismatch: got %x, want %x", htr, wantHTR)
+	}
+
+	return nil
+}
+
+func readMetadata(path string) (*Metadata, []byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read metadata: %w", err)
+	}
+	var meta Metadata
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse metadata: %w", err)
+	}
+	htr, err := hex.DecodeString(meta.HTR)
+	if err != nil || len(htr) != 32 {
+		return nil, nil, fmt.Errorf("invalid HTR %q in metadata", meta.HTR)
+	}
+	return &meta, htr, nil
+}
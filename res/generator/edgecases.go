@@ -0,0 +1,23 @@
+package main
+
+import (
+	"github.com/pk910/ssz-benchmark/res/generator/corpus"
+	"github.com/spf13/cobra"
+)
+
+func newEdgeCasesCommand(cfg *corpus.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "edge-cases",
+		Short: "Generate empty, max-capacity, boundary and typed-transaction blocks",
+		Long: `Generate four deneb blocks per preset next to the typical one:
+  block-empty-<preset>     no operations, transactions, withdrawals or blobs
+  block-max-<preset>       every list at its preset limit, full bitlists
+  block-boundary-<preset>  lists at power-of-two and power-of-two+1 lengths
+  block-typed-<preset>     the typical block with typed RLP transactions
+Transactions of the max block follow the --transactions/--tx-* flags, those
+of the typed block follow --transactions and --tx-size-histogram.`,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return corpus.RunEdgeCases(cfg)
+		},
+	}
+}
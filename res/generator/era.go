@@ -0,0 +1,28 @@
+package main
+
+import (
+	"github.com/pk910/ssz-benchmark/res/generator/corpus"
+	"github.com/spf13/cobra"
+)
+
+func newEraCommand(cfg *corpus.Config) *cobra.Command {
+	var missedSlots float64
+
+	cmd := &cobra.Command{
+		Use:   "era",
+		Short: "Generate an era file with a chain segment and its boundary state",
+		Long: `Generate one deneb era file per preset into the output directory: the
+SLOTS_PER_HISTORICAL_ROOT slots of the era containing --slot as snappy
+compressed blocks, the state at the end of the era and the block and state
+slot indices. The blocks form a chain whose roots are stored in the state's
+block_roots. Files are named <preset>-<era>-<short historical root>.era, a
+manifest.json next to them lists block count and HTRs for the benchmarks.`,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return corpus.RunEra(cfg, missedSlots)
+		},
+	}
+
+	cmd.Flags().Float64Var(&missedSlots, "missed-slots", 0.01, "Fraction of slots without a block")
+
+	return cmd
+}
@@ -3,9 +3,11 @@ module github.com/pk910/ssz-benchmark/res/generator
 go 1.25.0
 
 require (
+	github.com/golang/snappy v1.0.0
 	github.com/pk910/dynamic-ssz v1.1.2
 	github.com/prysmaticlabs/go-bitfield v0.0.0-20240618144021-706c95b2dd15
 	github.com/spf13/cobra v1.8.1
+	github.com/spf13/pflag v1.0.5
 	gopkg.in/yaml.v2 v2.4.0
 )
 
@@ -14,6 +16,5 @@ require (
 	github.com/inconshreveable/mousetrap v1.1.0 // indirect
 	github.com/klauspost/cpuid/v2 v2.3.0 // indirect
 	github.com/pk910/hashtree-bindings v0.0.1 // indirect
-	github.com/spf13/pflag v1.0.5 // indirect
 	golang.org/x/sys v0.36.0 // indirect
 )
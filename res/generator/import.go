@@ -0,0 +1,38 @@
+package main
+
+import (
+	"github.com/pk910/ssz-benchmark/res/generator/corpus"
+	"github.com/spf13/cobra"
+)
+
+func newImportCommand(cfg *corpus.Config) *cobra.Command {
+	var in, kind, name string
+	var anonymise bool
+
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Turn a block or state saved from a beacon node into a corpus",
+		Long: `Decode the SSZ block or state in --in (e.g. from the beacon API's
+/eth/v2/debug/beacon/states/{id} or /eth/v2/beacon/blocks/{id} with
+Accept: application/octet-stream) under the single --preset and --fork (deneb
+by default) and write it to the output directory as a corpus with full
+metadata. With --anonymise (the default) pubkeys, signatures, withdrawal
+credentials and execution addresses (withdrawal, BLS change and request
+addresses and fee recipients) are replaced by keyed hashes of themselves:
+equal values stay equal, also between a credential and the address it names,
+the credential prefix and padding are kept, and sizes and list lengths do not
+change. The key is derived from --seed. Files are named
+<kind>-<fork>-<preset>-<slot>.ssz unless --name is given.`,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return corpus.RunImport(cfg, in, kind, name, anonymise)
+		},
+	}
+
+	cmd.Flags().StringVar(&in, "in", "", "SSZ encoded block or state to import")
+	cmd.Flags().StringVar(&kind, "kind", "", "Kind of the input (block or state); state is tried before block if empty")
+	cmd.Flags().StringVar(&name, "name", "", "Corpus name (defaults to <kind>-<fork>-<preset>-<slot>)")
+	cmd.Flags().BoolVar(&anonymise, "anonymise", true, "Scramble pubkeys, signatures, withdrawal credentials and execution addresses")
+	_ = cmd.MarkFlagRequired("in")
+
+	return cmd
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"github.com/pk910/ssz-benchmark/res/generator/corpus"
+	"github.com/spf13/cobra"
+)
+
+func newMalformedCommand(cfg *corpus.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "malformed <corpus.ssz>...",
+		Short: "Derive invalid variants of valid corpora",
+		Long: `Derive invalid encodings from valid corpora: truncated buffers, offsets out
+of order or past the end, bitlists without delimiter bit, lists over their
+limit, trailing bytes and fixed-size fields of the wrong size. The variants
+are written to the output directory as <corpus>-<variant>.ssz together with a
+manifest.json naming the failure class each decoder is expected to hit.
+Variants dynamic-ssz still decodes are reported, as they point at a gap in
+its validation.`,
+		Args:         cobra.MinimumNArgs(1),
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, args []string) error {
+			return corpus.RunMalformed(cfg, args)
+		},
+	}
+}
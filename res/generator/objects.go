@@ -0,0 +1,26 @@
+package main
+
+import (
+	"github.com/pk910/ssz-benchmark/res/generator/corpus"
+	"github.com/spf13/cobra"
+)
+
+func newObjectsCommand(cfg *corpus.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "objects",
+		Short: "Generate standalone sub-object corpora",
+		Long: `Generate one corpus per preset for each of the deneb objects nodes decode
+most often: attestation, voluntary-exit, bls-change, sync-committee,
+execution-payload, validator, blob-sidecar and the light client objects
+light-client-bootstrap, light-client-update, light-client-finality-update and
+light-client-optimistic-update. Files are named <object>-<preset>.ssz and
+their HTR is taken over the whole object. The blob sidecar's commitment is
+proven against the body root of a generated block. The light client objects
+share one attested and one finalized header, whose branches are proven
+against the default state (with its latest block header and finalized
+checkpoint pointed at them) and the headers' block bodies.`,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return corpus.RunObjects(cfg)
+		},
+	}
+}
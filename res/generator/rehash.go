@@ -0,0 +1,22 @@
+package main
+
+import (
+	"github.com/pk910/ssz-benchmark/res/generator/corpus"
+	"github.com/spf13/cobra"
+)
+
+func newRehashCommand(cfg *corpus.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rehash",
+		Short: "Generate single field updates of the default state and their roots",
+		Long: `Pick one balance, one block root and one validator exit of the deneb state
+the default run writes as state-<preset>.ssz and write them with the state
+root after each update to rehash-<preset>.json in the output directory. Each
+root differs from the base state's by that update alone. The
+BenchmarkStateMainnet_RehashAfter* benchmarks apply the updates and check the
+roots they compute against these.`,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return corpus.RunRehash(cfg)
+		},
+	}
+}
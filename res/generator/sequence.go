@@ -0,0 +1,32 @@
+package main
+
+import (
+	"github.com/pk910/ssz-benchmark/res/generator/corpus"
+	"github.com/spf13/cobra"
+)
+
+func newSequenceCommand(cfg *corpus.Config) *cobra.Command {
+	var steps int
+	var mutations corpus.SequenceMutations
+
+	cmd := &cobra.Command{
+		Use:   "sequence",
+		Short: "Generate a base state plus successors that differ by one slot each",
+		Long: `Generate a deneb base state per preset plus --steps successor states into
+the output directory, together with a manifest.json listing them in order.
+Each successor changes what a slot of block processing changes: the slot, one
+block and state root, the current randao mix, a few balances and the
+participation flags of one slot's committees. The base state is the one the
+default run writes as state-<preset>.ssz. Files are named
+state-<preset>-<step>.ssz and each has its own -meta.json.`,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return corpus.RunSequence(cfg, steps, mutations)
+		},
+	}
+
+	cmd.Flags().IntVar(&steps, "steps", 8, "Number of successor states")
+	cmd.Flags().IntVar(&mutations.Balances, "balance-changes", 16, "Balances changed per step")
+	cmd.Flags().IntVar(&mutations.Participation, "participation-changes", 0, "Participation flags set per step (0 for validators / SLOTS_PER_EPOCH)")
+
+	return cmd
+}
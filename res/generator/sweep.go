@@ -0,0 +1,25 @@
+package main
+
+import (
+	"github.com/pk910/ssz-benchmark/res/generator/corpus"
+	"github.com/spf13/cobra"
+)
+
+func newSweepCommand(cfg *corpus.Config) *cobra.Command {
+	var counts []int
+
+	cmd := &cobra.Command{
+		Use:   "sweep",
+		Short: "Generate a family of states with increasing validator counts",
+		Long: `Generate one state per validator count, preset and fork into the output
+directory, together with a manifest.json the benchmark modules iterate over
+to measure how each library scales with state size.`,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return corpus.RunSweep(cfg, counts)
+		},
+	}
+
+	cmd.Flags().IntSliceVar(&counts, "counts", []int{1000, 10000, 100000, 500000, 1000000, 2000000}, "Validator counts to generate")
+
+	return cmd
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"github.com/pk910/ssz-benchmark/res/generator/corpus"
+	"github.com/spf13/cobra"
+)
+
+func newVerifyCommand(cfg *corpus.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify <dir>",
+		Short: "Re-check the corpora in a directory against their metadata",
+		Long: `Decode every .ssz corpus in the directory with dynamic-ssz under its preset,
+re-marshal it and compare the bytes, and compare the recomputed HTR with the
+one in its -meta.json. Snappy encodings next to a corpus must decompress to
+it. The fork and preset are taken from the file name
+(block-<preset>, block-<fork>-<preset>, block-<shape>-<preset>,
+state-<fork>-<preset>-<validators>, state-<preset>-<step>, <object>-<preset>).
+Exits non-zero if any corpus fails.`,
+		Args: cobra.ExactArgs(1),
+		// A failed verification is not a usage error
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, args []string) error {
+			return corpus.RunVerify(cfg, args[0])
+		},
+	}
+}
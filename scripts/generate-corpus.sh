@@ -0,0 +1,27 @@
+#!/bin/bash
+# Regenerate the benchmark corpora in res/ with the pinned generator settings.
+# The generator is fully deterministic for a given seed and flag set, so this
+# reproduces the committed block-*.ssz / *-meta.json files byte for byte and
+# recreates the (git-ignored) state-*.ssz files they describe, along with the
+# snappy compressed .ssz.sz / .ssz_snappy copies of each. The malformed
+# variants in res/malformed/ are derived from block-mainnet.ssz, the
+# rehash-<preset>.json state updates from the regenerated states.
+# Usage: ./scripts/generate-corpus.sh
+#
+# Optional env:
+#   CORPUS_SEED  - generator seed (default 1, the seed the committed files use)
+
+set -e
+
+SCRIPT_DIR="$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)"
+ROOT_DIR="$(dirname "$SCRIPT_DIR")"
+
+CORPUS_SEED="${CORPUS_SEED:-1}"
+
+cd "$ROOT_DIR/res/generator"
+go run . --seed "$CORPUS_SEED" --output "$ROOT_DIR/res"
+go run . --seed "$CORPUS_SEED" --output "$ROOT_DIR/res" --fork electra
+go run . --seed "$CORPUS_SEED" --output "$ROOT_DIR/res" edge-cases
+go run . --output "$ROOT_DIR/res/malformed" malformed "$ROOT_DIR/res/block-mainnet.ssz"
+go run . --seed "$CORPUS_SEED" --output "$ROOT_DIR/res" objects
+go run . --seed "$CORPUS_SEED" --output "$ROOT_DIR/res" rehash
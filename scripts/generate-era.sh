@@ -0,0 +1,21 @@
+#!/bin/bash
+# Generate the era file corpus in res/era/ for the BenchmarkEra*_Decode
+# benchmarks: one era per preset (8192 blocks for mainnet, 64 for minimal)
+# plus the boundary state. The mainnet era is ~1 GB and is not committed.
+# Usage: ./scripts/generate-era.sh
+#
+# Optional env:
+#   CORPUS_SEED       - generator seed (default 1)
+#   ERA_MISSED_SLOTS  - fraction of slots without a block (default 0.01)
+
+set -e
+
+SCRIPT_DIR="$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)"
+ROOT_DIR="$(dirname "$SCRIPT_DIR")"
+
+CORPUS_SEED="${CORPUS_SEED:-1}"
+ERA_MISSED_SLOTS="${ERA_MISSED_SLOTS:-0.01}"
+
+cd "$ROOT_DIR/res/generator"
+go run . era --seed "$CORPUS_SEED" --output "$ROOT_DIR/res/era" \
+    --missed-slots "$ERA_MISSED_SLOTS"
@@ -0,0 +1,22 @@
+#!/bin/bash
+# Generate the mutated state sequence in res/sequence/ for the
+# BenchmarkStateSequence_* benchmarks: the base state of each preset plus one
+# successor per step, each one slot of changes further. The states are as
+# large as state-<preset>.ssz and are not committed.
+# Usage: ./scripts/generate-sequence.sh
+#
+# Optional env:
+#   CORPUS_SEED     - generator seed (default 1)
+#   SEQUENCE_STEPS  - number of successor states (default 8)
+
+set -e
+
+SCRIPT_DIR="$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)"
+ROOT_DIR="$(dirname "$SCRIPT_DIR")"
+
+CORPUS_SEED="${CORPUS_SEED:-1}"
+SEQUENCE_STEPS="${SEQUENCE_STEPS:-8}"
+
+cd "$ROOT_DIR/res/generator"
+go run . sequence --seed "$CORPUS_SEED" --output "$ROOT_DIR/res/sequence" \
+    --steps "$SEQUENCE_STEPS" --json=false --snappy=false
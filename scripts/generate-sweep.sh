@@ -0,0 +1,22 @@
+#!/bin/bash
+# Generate the validator-count sweep corpus in res/sweep/ for the
+# BenchmarkStateSweep_* benchmarks. The states are large (the 2M validator
+# state alone is ~280 MB) and are not committed.
+# Usage: ./scripts/generate-sweep.sh
+#
+# Optional env:
+#   CORPUS_SEED   - generator seed (default 1)
+#   SWEEP_COUNTS  - comma separated validator counts
+#                   (default 1000,10000,100000,500000,1000000,2000000)
+
+set -e
+
+SCRIPT_DIR="$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)"
+ROOT_DIR="$(dirname "$SCRIPT_DIR")"
+
+CORPUS_SEED="${CORPUS_SEED:-1}"
+SWEEP_COUNTS="${SWEEP_COUNTS:-1000,10000,100000,500000,1000000,2000000}"
+
+cd "$ROOT_DIR/res/generator"
+go run . sweep --seed "$CORPUS_SEED" --output "$ROOT_DIR/res/sweep" \
+    --preset mainnet-preset.yaml --counts "$SWEEP_COUNTS" --json=false --snappy=false
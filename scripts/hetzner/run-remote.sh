@@ -9,6 +9,9 @@
 #   REMOTE_DIR     - path on the server (default /root/ssz-benchmark)
 #   BENCH_COUNT    - -count for go test (passed through)
 #   BENCH_CPUS     - taskset CPU list (passed through; auto-derived if empty)
+#   BENCH_PARALLEL_CPUS - GOMAXPROCS values for the parallel benchmarks (passed through)
+#   BENCH_SWEEP    - 1 to run the validator-count sweep (passed through)
+#   BENCH_ERA      - 1 to run the era file benchmarks (passed through)
 #   GO_VERSION     - Go toolchain to install on the box (passed through)
 set -euo pipefail
 
@@ -42,6 +45,9 @@ $SSH_CMD "$REMOTE" \
     "cd $REMOTE_DIR && \
      BENCH_COUNT='${BENCH_COUNT:-10}' \
      BENCH_CPUS='${BENCH_CPUS:-}' \
+     BENCH_PARALLEL_CPUS='${BENCH_PARALLEL_CPUS-1,2,4,8}' \
+     BENCH_SWEEP='${BENCH_SWEEP:-0}' \
+     BENCH_ERA='${BENCH_ERA:-0}' \
      GO_VERSION='${GO_VERSION:-1.25.0}' \
      bash scripts/remote-bench.sh"
 
@@ -12,6 +12,12 @@
 #   BENCH_COUNT  - -count for go test (default 10)
 #   BENCH_CPUS   - taskset CPU list for go test. Defaults to a single core
 #                  (best stability; see run-benchmarks.sh / the pinning note).
+#   BENCH_PARALLEL_CPUS - GOMAXPROCS values for the unpinned *Parallel
+#                  benchmarks (default "1,2,4,8", see run-benchmarks.sh).
+#   BENCH_SWEEP  - set to 1 to also run the validator-count sweep
+#                  (see run-benchmarks.sh).
+#   BENCH_ERA    - set to 1 to also run the era file benchmarks
+#                  (see run-benchmarks.sh).
 #   SKIP_DEV     - set to 1 to run only the stable phase.
 set -euo pipefail
 
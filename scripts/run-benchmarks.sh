@@ -10,6 +10,17 @@
 #                  reduce timing jitter. A single core is best: it keeps the
 #                  benchmark goroutine cache-hot (pinning to 2+ cores lets it
 #                  migrate and measured ~3x noisier on a dedicated box).
+#   BENCH_PARALLEL_CPUS - GOMAXPROCS values (`go test -cpu`) for the *Parallel
+#                  benchmarks (default "1,2,4,8"; empty skips them). They run
+#                  on as many goroutines as GOMAXPROCS, so they always run
+#                  unpinned, after the pinned run of the other benchmarks.
+#   BENCH_SWEEP  - set to 1 to run the BenchmarkStateSweep_* validator-count
+#                  sweep, generating res/sweep first if it is missing. Off by
+#                  default: the sweep states take several GB and minutes to
+#                  hash.
+#   BENCH_ERA    - set to 1 to run the BenchmarkEra*_Decode era file
+#                  benchmarks, generating res/era first if it is missing. Off
+#                  by default: the mainnet era is ~1 GB.
 
 set -e
 
@@ -20,6 +31,9 @@ cd "$ROOT_DIR"
 
 BENCH_COUNT="${BENCH_COUNT:-5}"
 BENCH_CPUS="${BENCH_CPUS:-}"
+BENCH_PARALLEL_CPUS="${BENCH_PARALLEL_CPUS-1,2,4,8}"
+BENCH_SWEEP="${BENCH_SWEEP:-0}"
+BENCH_ERA="${BENCH_ERA:-0}"
 
 # Build an optional taskset prefix for pinning to dedicated cores.
 RUN_PREFIX=()
@@ -32,16 +46,63 @@ if [ -n "$BENCH_CPUS" ]; then
     fi
 fi
 echo "Benchmark iterations (-count): $BENCH_COUNT"
+if [ -n "$BENCH_PARALLEL_CPUS" ]; then
+    echo "Parallel benchmarks at GOMAXPROCS: $BENCH_PARALLEL_CPUS (unpinned)"
+fi
 
-# Libraries to benchmark, in run order.
-LIBS="fastssz-v1 fastssz-v2 dynamicssz-codegen dynamicssz-reflection karalabessz prysmssz ztyp"
+# Benchmarks left out of the pinned run. The sweep and era benchmarks skip
+# themselves without their corpora, but stale local corpora must not slip into
+# the results either.
+BENCH_SKIP="Parallel"
+if [ "$BENCH_SWEEP" = "1" ]; then
+    echo "Running the validator-count sweep"
+else
+    BENCH_SKIP="$BENCH_SKIP|StateSweep"
+fi
+if [ "$BENCH_ERA" = "1" ]; then
+    echo "Running the era file benchmarks"
+else
+    BENCH_SKIP="$BENCH_SKIP|BenchmarkEra"
+fi
+
+# The state corpora and the state sequence are too large to commit. The
+# generator is deterministic, so recreate them from the pinned seed if they are
+# missing.
+if [ ! -f res/state-mainnet.ssz ] || [ ! -f res/state-minimal.ssz ] || [ ! -f res/state-electra-mainnet.ssz ] || [ ! -f res/state-mainnet.json ] || [ ! -f res/state-mainnet.ssz.sz ]; then
+    echo "State corpora missing, regenerating..."
+    "$SCRIPT_DIR/generate-corpus.sh"
+fi
+if [ ! -f res/sequence/manifest.json ]; then
+    echo "State sequence missing, regenerating..."
+    "$SCRIPT_DIR/generate-sequence.sh"
+fi
+if [ "$BENCH_SWEEP" = "1" ] && [ ! -f res/sweep/manifest.json ]; then
+    echo "Sweep corpus missing, generating..."
+    "$SCRIPT_DIR/generate-sweep.sh"
+fi
+if [ "$BENCH_ERA" = "1" ] && [ ! -f res/era/manifest.json ]; then
+    echo "Era corpus missing, generating..."
+    "$SCRIPT_DIR/generate-era.sh"
+fi
+
+# Refuse to benchmark against corrupted or stale corpora.
+echo "Verifying corpora..."
+(cd res/generator && go run . verify "$ROOT_DIR/res")
+
+# Libraries to benchmark, in run order. encodingjson is the encoding/json
+# baseline on the beacon-API JSON of the same corpora.
+LIBS="fastssz-v1 fastssz-v2 dynamicssz-codegen dynamicssz-reflection karalabessz prysmssz ztyp encodingjson"
 
 for lib in $LIBS; do
     echo "Running $lib benchmarks..."
     cd "benchmarks/$lib"
     go mod download
-    "${RUN_PREFIX[@]}" go test -run=^$ -bench=. -benchmem -count="$BENCH_COUNT" \
+    "${RUN_PREFIX[@]}" go test -run=^$ -bench=. -skip="$BENCH_SKIP" -benchmem -count="$BENCH_COUNT" \
         > "$ROOT_DIR/${lib}_results.txt"
+    if [ -n "$BENCH_PARALLEL_CPUS" ]; then
+        go test -run=^$ -bench=Parallel -cpu="$BENCH_PARALLEL_CPUS" -benchmem -count="$BENCH_COUNT" \
+            >> "$ROOT_DIR/${lib}_results.txt"
+    fi
     cd "$ROOT_DIR"
 done
 
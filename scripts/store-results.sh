@@ -98,26 +98,40 @@ def parse_benchmark_results(filename):
         with open(filename, 'r') as f:
             content = f.read()
 
-        # Parse benchmark lines. The `-N` suffix is the GOMAXPROCS count, which
-        # Go omits when GOMAXPROCS=1 (e.g. when pinned to a single core), so it
-        # is matched optionally.
-        pattern = r'(Benchmark\w+)(?:-\d+)?\s+(\d+)\s+([\d.]+)\s+ns/op\s+(\d+)\s+B/op\s+(\d+)\s+allocs/op'
-        matches = re.findall(pattern, content)
+        # Parse benchmark lines, including sub-benchmarks such as
+        # BenchmarkStateSweep_Unmarshal/validators=1000. The `-N` suffix is the
+        # GOMAXPROCS count, which Go omits when GOMAXPROCS=1 (e.g. when pinned
+        # to a single core), so it is matched optionally. The *Parallel
+        # benchmarks run at several GOMAXPROCS values (-cpu), so they keep it
+        # as `-N` (1 if omitted).
+        # After the iteration count every line has value/unit pairs: ns/op,
+        # the custom metrics a benchmark reports (e.g. blocks/s), then B/op
+        # and allocs/op from -benchmem.
+        pattern = r'^(Benchmark[\w/=-]+?)(?:-(\d+))?\s+(\d+)((?:\s+[\d.e+-]+\s+\S+)+)\s*$'
+        matches = re.findall(pattern, content, re.MULTILINE)
 
         for match in matches:
-            name, iterations, ns_op, bytes_op, allocs = match
+            name, procs, iterations, columns = match
+            values = {unit: float(value) for value, unit in re.findall(r'([\d.e+-]+)\s+(\S+)', columns)}
+            if not {'ns/op', 'B/op', 'allocs/op'} <= values.keys():
+                continue
+            if name.endswith('Parallel'):
+                name = f"{name}-{procs or 1}"
             if name not in results:
-                results[name] = {'ns_op': [], 'bytes_op': [], 'allocs': []}
-            results[name]['ns_op'].append(float(ns_op))
-            results[name]['bytes_op'].append(int(bytes_op))
-            results[name]['allocs'].append(int(allocs))
+                results[name] = {'ns_op': [], 'bytes_op': [], 'allocs': [], 'metrics': {}}
+            results[name]['ns_op'].append(values.pop('ns/op'))
+            results[name]['bytes_op'].append(int(values.pop('B/op')))
+            results[name]['allocs'].append(int(values.pop('allocs/op')))
+            for unit, value in values.items():
+                results[name]['metrics'].setdefault(unit, []).append(value)
 
         # Average the results
         for name in results:
             results[name] = {
                 'ns_op': sum(results[name]['ns_op']) / len(results[name]['ns_op']),
                 'bytes_op': sum(results[name]['bytes_op']) / len(results[name]['bytes_op']),
-                'allocs': sum(results[name]['allocs']) / len(results[name]['allocs'])
+                'allocs': sum(results[name]['allocs']) / len(results[name]['allocs']),
+                'metrics': {unit: sum(values) / len(values) for unit, values in results[name]['metrics'].items()}
             }
     except FileNotFoundError:
         print(f"Warning: {filename} not found")
@@ -129,6 +143,10 @@ def extract_version(go_mod_path, package_pattern):
     try:
         with open(go_mod_path, 'r') as f:
             content = f.read()
+        # Standard library baselines are versioned by the go directive
+        if package_pattern is None:
+            match = re.search(r'^go\s+([\d.]+)', content, re.MULTILINE)
+            return f"go{match.group(1)}" if match else "unknown"
         # Match versions like v1.0.0 or pseudo-versions like v0.0.0-20251126100127-9cb620c1e0d0
         match = re.search(rf'{package_pattern}\s+(v[\d.]+(?:-[\w.-]+)?)', content)
         if match:
@@ -141,13 +159,35 @@ def convert_benchmark_name(bench_name):
     """Convert benchmark name to result key format."""
     # BenchmarkBlockMainnet_Unmarshal -> UnmarshalMainnetBlock
     # BenchmarkStateMainnet_Marshal -> MarshalMainnetState
-    match = re.match(r'Benchmark(Block|State)(Mainnet|Minimal)_(\w+)', bench_name)
+    # BenchmarkBlockElectraMainnet_Unmarshal -> UnmarshalElectraMainnetBlock
+    # BenchmarkBlockMainnet_UnmarshalParallel-8 -> UnmarshalParallel8MainnetBlock
+    # Sub-benchmarks keep their name after the converted parent:
+    # BenchmarkStateSweep_Unmarshal/validators=1000 -> UnmarshalSweepState/validators=1000
+    procs = ""
+    match = re.match(r'(\w+)-(\d+)$', bench_name)
+    if match:
+        bench_name, procs = match.group(1), match.group(2)
+    sub = ""
+    if '/' in bench_name:
+        bench_name, sub = bench_name.split('/', 1)
+        sub = '/' + sub
+    match = re.match(r'Benchmark(Block|State)([A-Z][a-z]+?)?(Mainnet|Minimal)_(\w+)', bench_name)
     if match:
         data_type = match.group(1)
-        preset = match.group(2)
-        operation = match.group(3)
-        return f"{operation}{preset}{data_type}"
-    return bench_name
+        fork = match.group(2) or ""
+        preset = match.group(3)
+        operation = match.group(4) + procs
+        return f"{operation}{fork}{preset}{data_type}{sub}"
+    # BenchmarkStateSweep_Unmarshal -> UnmarshalSweepState
+    match = re.match(r'Benchmark(Block|State)([A-Z]\w*?)_(\w+)', bench_name)
+    if match:
+        return f"{match.group(3)}{procs}{match.group(2)}{match.group(1)}{sub}"
+    # BenchmarkAttestationMainnet_Unmarshal -> UnmarshalMainnetAttestation
+    # BenchmarkEraMainnet_Decode -> DecodeMainnetEra
+    match = re.match(r'Benchmark(Era|Attestation|VoluntaryExit|BLSChange|SyncCommittee|ExecutionPayload|Validator|BlobSidecar|LightClientBootstrap|LightClientUpdate|LightClientFinalityUpdate|LightClientOptimisticUpdate)(Mainnet|Minimal)_(\w+)', bench_name)
+    if match:
+        return f"{match.group(3)}{procs}{match.group(2)}{match.group(1)}{sub}"
+    return bench_name + sub
 
 def load_existing_json(filepath):
     """Load existing JSON file or return empty structure."""
@@ -279,8 +319,10 @@ def process_benchmark(name, results_file, go_mod_path, package_pattern, json_fil
     version = extract_version(go_mod_path, package_pattern)
     print(f"  Version: {version}")
 
-    # Convert results to the desired format
+    # Convert results to the desired format. Custom metrics (blocks/s and
+    # peak-heap-B of the era benchmarks) are kept next to the results.
     formatted_results = {}
+    formatted_metrics = {}
     for bench_name, data in results.items():
         key = convert_benchmark_name(bench_name)
         formatted_results[key] = [
@@ -288,6 +330,8 @@ def process_benchmark(name, results_file, go_mod_path, package_pattern, json_fil
             data['bytes_op'],
             data['allocs']
         ]
+        if data['metrics']:
+            formatted_metrics[key] = data['metrics']
 
     # Create new benchmark entry
     new_entry = {
@@ -295,6 +339,8 @@ def process_benchmark(name, results_file, go_mod_path, package_pattern, json_fil
         "version": version,
         "results": formatted_results
     }
+    if formatted_metrics:
+        new_entry["metrics"] = formatted_metrics
     if DEV_MODE:
         new_entry["dev"] = True
 
@@ -380,6 +426,13 @@ benchmarks = [
         "go_mod_path": "benchmarks/ztyp/go.mod",
         "package_pattern": r"github\.com/protolambda/zrnt",
         "json_file": "results/ztyp.json"
+    },
+    {
+        "name": "encodingjson",
+        "results_file": "encodingjson_results.txt",
+        "go_mod_path": "benchmarks/encodingjson/go.mod",
+        "package_pattern": None,
+        "json_file": "results/encodingjson.json"
     }
 ]
 
@@ -396,6 +449,6 @@ print("\nAll results processed successfully!")
 EOF
 
 # Clean up temporary result files
-rm -f fastssz-v1_results.txt fastssz-v2_results.txt dynamicssz-codegen_results.txt dynamicssz-reflection_results.txt karalabessz_results.txt prysmssz_results.txt ztyp_results.txt
+rm -f fastssz-v1_results.txt fastssz-v2_results.txt dynamicssz-codegen_results.txt dynamicssz-reflection_results.txt karalabessz_results.txt prysmssz_results.txt ztyp_results.txt encodingjson_results.txt
 
 echo "Done!"
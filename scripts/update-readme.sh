@@ -25,24 +25,37 @@ def parse_benchmark_results(filename):
         with open(filename, 'r') as f:
             content = f.read()
 
-        # Parse benchmark lines
-        pattern = r'(Benchmark\w+)-\d+\s+(\d+)\s+([\d.]+)\s+ns/op\s+(\d+)\s+B/op\s+(\d+)\s+allocs/op'
-        matches = re.findall(pattern, content)
+        # Parse benchmark lines, including sub-benchmarks (<name>/<sub>). The
+        # *Parallel benchmarks keep their GOMAXPROCS suffix (-N, omitted by Go
+        # for 1).
+        # After the iteration count every line has value/unit pairs: ns/op,
+        # the custom metrics a benchmark reports (e.g. blocks/s), then B/op
+        # and allocs/op from -benchmem.
+        pattern = r'^(Benchmark[\w/=-]+?)(?:-(\d+))?\s+(\d+)((?:\s+[\d.e+-]+\s+\S+)+)\s*$'
+        matches = re.findall(pattern, content, re.MULTILINE)
 
         for match in matches:
-            name, iterations, ns_op, bytes_op, allocs = match
+            name, procs, iterations, columns = match
+            values = {unit: float(value) for value, unit in re.findall(r'([\d.e+-]+)\s+(\S+)', columns)}
+            if not {'ns/op', 'B/op', 'allocs/op'} <= values.keys():
+                continue
+            if name.endswith('Parallel'):
+                name = f"{name}-{procs or 1}"
             if name not in results:
-                results[name] = {'ns_op': [], 'bytes_op': [], 'allocs': []}
-            results[name]['ns_op'].append(float(ns_op))
-            results[name]['bytes_op'].append(int(bytes_op))
-            results[name]['allocs'].append(int(allocs))
+                results[name] = {'ns_op': [], 'bytes_op': [], 'allocs': [], 'metrics': {}}
+            results[name]['ns_op'].append(values.pop('ns/op'))
+            results[name]['bytes_op'].append(int(values.pop('B/op')))
+            results[name]['allocs'].append(int(values.pop('allocs/op')))
+            for unit, value in values.items():
+                results[name]['metrics'].setdefault(unit, []).append(value)
 
         # Average the results
         for name in results:
             results[name] = {
                 'ns_op': sum(results[name]['ns_op']) / len(results[name]['ns_op']),
                 'bytes_op': sum(results[name]['bytes_op']) / len(results[name]['bytes_op']),
-                'allocs': sum(results[name]['allocs']) / len(results[name]['allocs'])
+                'allocs': sum(results[name]['allocs']) / len(results[name]['allocs']),
+                'metrics': {unit: sum(values) / len(values) for unit, values in results[name]['metrics'].items()}
             }
     except FileNotFoundError:
         print(f"Warning: {filename} not found")
@@ -76,6 +89,7 @@ dynamicssz_codegen = parse_benchmark_results('dynamicssz-codegen_results.txt')
 dynamicssz_refl = parse_benchmark_results('dynamicssz-reflection_results.txt')
 karalabessz = parse_benchmark_results('karalabessz_results.txt')
 prysmssz = parse_benchmark_results('prysmssz_results.txt')
+encodingjson = parse_benchmark_results('encodingjson_results.txt')
 
 def get_benchmark_value(results, key, field):
     if key in results:
@@ -91,6 +105,45 @@ def make_table_row(lib_name, results, bench_name, op):
         return f"| {lib_name} | {op} | {format_ns(val)} | {format_bytes(mem)} | {int(allocs)} |\n"
     return ""
 
+def make_sweep_row(lib_name, results, op, validators):
+    """Generate a table row for one state size of a sweep benchmark."""
+    val = get_benchmark_value(results, f'BenchmarkStateSweep_{op}/validators={validators}', 'ns_op')
+    if val is not None:
+        return f"| {lib_name} | {op} | {validators:,} | {format_ns(val)} | {format_ns(val / validators)} |\n"
+    return ""
+
+def make_malformed_row(lib_name, results, variant):
+    """Generate a table row with the rejection time of one malformed variant."""
+    val = get_benchmark_value(results, f'BenchmarkBlockMalformed_Unmarshal/{variant}', 'ns_op')
+    mem = get_benchmark_value(results, f'BenchmarkBlockMalformed_Unmarshal/{variant}', 'bytes_op')
+    if val is not None:
+        return f"| {lib_name} | {variant} | {format_ns(val)} | {format_bytes(mem)} |\n"
+    return ""
+
+def make_era_row(lib_name, results, preset):
+    """Generate a table row for reading a whole era file."""
+    key = f'BenchmarkEra{preset}_Decode'
+    if key in results:
+        metrics = results[key]['metrics']
+        return (f"| {lib_name} | {preset.lower()} | {format_ns(results[key]['ns_op'])} | "
+                f"{metrics.get('blocks/s', 0):,.0f} | {format_bytes(metrics.get('peak-heap-B', 0))} |\n")
+    return ""
+
+def make_json_row(lib_name, results, bench_name, op):
+    """Generate a table row comparing a benchmark with the encoding/json baseline."""
+    val = get_benchmark_value(results, bench_name, 'ns_op')
+    baseline = get_benchmark_value(encodingjson, bench_name, 'ns_op')
+    if val is not None and baseline is not None:
+        return f"| {lib_name} | {op} | {format_ns(val)} | {format_ns(baseline)} | {baseline / val:.1f}x |\n"
+    return ""
+
+def make_parallel_row(lib_name, results, bench_name, op, procs):
+    """Generate a table row with the aggregate throughput of a parallel benchmark."""
+    val = get_benchmark_value(results, f'{bench_name}-{procs}', 'ns_op')
+    if val is not None:
+        return f"| {lib_name} | {op} | {procs} | {format_ns(val)} | {1_000_000_000 / val:,.0f} |\n"
+    return ""
+
 # Build the results section
 results_md = f"""## Benchmark Results
 
@@ -103,18 +156,20 @@ Last updated: {datetime.now().strftime('%Y-%m-%d %H:%M:%S UTC')}
 """
 
 # Block Mainnet
-for op in ['Unmarshal', 'Marshal', 'HashTreeRoot']:
+for op in ['Unmarshal', 'Marshal', 'MarshalTo', 'HashTreeRoot', 'HashTreeRootWith', 'SizeSSZ']:
     results_md += make_table_row('fastssz (v1)', fastssz_v1, f'BenchmarkBlockMainnet_{op}', op)
-for op in ['Unmarshal', 'Marshal', 'HashTreeRoot']:
+for op in ['Unmarshal', 'Marshal', 'MarshalTo', 'HashTreeRoot', 'HashTreeRootWith', 'SizeSSZ']:
     results_md += make_table_row('fastssz (v2)', fastssz_v2, f'BenchmarkBlockMainnet_{op}', op)
-for op in ['Unmarshal', 'UnmarshalReader', 'Marshal', 'MarshalWriter', 'HashTreeRoot']:
+for op in ['Unmarshal', 'UnmarshalReader', 'Marshal', 'MarshalWriter', 'MarshalTo', 'HashTreeRoot', 'HashTreeRootWith', 'SizeSSZ']:
     results_md += make_table_row('dynamic-ssz (codegen)', dynamicssz_codegen, f'BenchmarkBlockMainnet_{op}', op)
-for op in ['Unmarshal', 'UnmarshalReader', 'Marshal', 'MarshalWriter', 'HashTreeRoot']:
+for op in ['Unmarshal', 'UnmarshalReader', 'Marshal', 'MarshalWriter', 'MarshalTo', 'HashTreeRoot', 'HashTreeRootWith', 'SizeSSZ']:
     results_md += make_table_row('dynamic-ssz (reflection)', dynamicssz_refl, f'BenchmarkBlockMainnet_{op}', op)
-for op in ['Unmarshal', 'UnmarshalReader', 'Marshal', 'MarshalWriter', 'HashTreeRoot']:
+for op in ['Unmarshal', 'UnmarshalReader', 'Marshal', 'MarshalWriter', 'MarshalTo', 'HashTreeRoot', 'HashTreeRootWith', 'SizeSSZ']:
     results_md += make_table_row('karalabe-ssz', karalabessz, f'BenchmarkBlockMainnet_{op}', op)
-for op in ['Unmarshal', 'Marshal', 'HashTreeRoot']:
+for op in ['Unmarshal', 'Marshal', 'MarshalTo', 'HashTreeRoot', 'HashTreeRootWith', 'SizeSSZ']:
     results_md += make_table_row('prysm-ssz', prysmssz, f'BenchmarkBlockMainnet_{op}', op)
+for op in ['Unmarshal', 'Marshal']:
+    results_md += make_table_row('encoding/json (baseline)', encodingjson, f'BenchmarkBlockMainnet_{op}', op)
 
 results_md += """
 ### State Mainnet Benchmarks
@@ -124,18 +179,111 @@ results_md += """
 """
 
 # State Mainnet
-for op in ['Unmarshal', 'Marshal', 'HashTreeRoot']:
+for op in ['Unmarshal', 'Marshal', 'MarshalTo', 'HashTreeRoot', 'HashTreeRootWith', 'SizeSSZ',
+           'RehashAfterBalanceUpdate', 'RehashAfterBlockRootsUpdate', 'RehashAfterValidatorUpdate']:
     results_md += make_table_row('fastssz (v1)', fastssz_v1, f'BenchmarkStateMainnet_{op}', op)
-for op in ['Unmarshal', 'Marshal', 'HashTreeRoot']:
+for op in ['Unmarshal', 'Marshal', 'MarshalTo', 'HashTreeRoot', 'HashTreeRootWith', 'SizeSSZ',
+           'RehashAfterBalanceUpdate', 'RehashAfterBlockRootsUpdate', 'RehashAfterValidatorUpdate']:
     results_md += make_table_row('fastssz (v2)', fastssz_v2, f'BenchmarkStateMainnet_{op}', op)
-for op in ['Unmarshal', 'UnmarshalReader', 'Marshal', 'MarshalWriter', 'HashTreeRoot']:
+for op in ['Unmarshal', 'UnmarshalReader', 'Marshal', 'MarshalWriter', 'MarshalTo', 'HashTreeRoot', 'HashTreeRootWith', 'SizeSSZ',
+           'RehashAfterBalanceUpdate', 'RehashAfterBlockRootsUpdate', 'RehashAfterValidatorUpdate']:
     results_md += make_table_row('dynamic-ssz (codegen)', dynamicssz_codegen, f'BenchmarkStateMainnet_{op}', op)
-for op in ['Unmarshal', 'UnmarshalReader', 'Marshal', 'MarshalWriter', 'HashTreeRoot']:
+for op in ['Unmarshal', 'UnmarshalReader', 'Marshal', 'MarshalWriter', 'MarshalTo', 'HashTreeRoot', 'HashTreeRootWith', 'SizeSSZ',
+           'RehashAfterBalanceUpdate', 'RehashAfterBlockRootsUpdate', 'RehashAfterValidatorUpdate']:
     results_md += make_table_row('dynamic-ssz (reflection)', dynamicssz_refl, f'BenchmarkStateMainnet_{op}', op)
-for op in ['Unmarshal', 'UnmarshalReader', 'Marshal', 'MarshalWriter', 'HashTreeRoot']:
+for op in ['Unmarshal', 'UnmarshalReader', 'Marshal', 'MarshalWriter', 'MarshalTo', 'HashTreeRoot', 'HashTreeRootWith', 'SizeSSZ',
+           'RehashAfterBalanceUpdate', 'RehashAfterBlockRootsUpdate', 'RehashAfterValidatorUpdate']:
     results_md += make_table_row('karalabe-ssz', karalabessz, f'BenchmarkStateMainnet_{op}', op)
-for op in ['Unmarshal', 'Marshal', 'HashTreeRoot']:
+for op in ['Unmarshal', 'Marshal', 'MarshalTo', 'HashTreeRoot', 'HashTreeRootWith', 'SizeSSZ',
+           'RehashAfterBalanceUpdate', 'RehashAfterBlockRootsUpdate', 'RehashAfterValidatorUpdate']:
     results_md += make_table_row('prysm-ssz', prysmssz, f'BenchmarkStateMainnet_{op}', op)
+for op in ['Unmarshal', 'Marshal']:
+    results_md += make_table_row('encoding/json (baseline)', encodingjson, f'BenchmarkStateMainnet_{op}', op)
+
+results_md += """
+### SSZ vs encoding/json
+
+SSZ against the beacon-API JSON of the same mainnet block and state, decoded and
+encoded with encoding/json.
+
+| Library | Operation | SSZ | JSON | Speedup |
+|---------|-----------|-----|------|---------|
+"""
+
+# SSZ vs encoding/json
+for lib_name, results in [('fastssz (v1)', fastssz_v1), ('fastssz (v2)', fastssz_v2),
+                          ('dynamic-ssz (codegen)', dynamicssz_codegen), ('dynamic-ssz (reflection)', dynamicssz_refl),
+                          ('karalabe-ssz', karalabessz), ('prysm-ssz', prysmssz)]:
+    for data_type in ['Block', 'State']:
+        for op in ['Unmarshal', 'Marshal']:
+            results_md += make_json_row(lib_name, results, f'Benchmark{data_type}Mainnet_{op}', f'{op} {data_type.lower()}')
+
+results_md += """
+### Parallel Block Mainnet Throughput
+
+Aggregate throughput of all goroutines at each GOMAXPROCS.
+
+| Library | Operation | GOMAXPROCS | Time | ops/s |
+|---------|-----------|------------|------|-------|
+"""
+
+# Parallel Block Mainnet
+for lib_name, results in [('fastssz (v1)', fastssz_v1), ('fastssz (v2)', fastssz_v2),
+                          ('dynamic-ssz (codegen)', dynamicssz_codegen), ('dynamic-ssz (reflection)', dynamicssz_refl),
+                          ('karalabe-ssz', karalabessz), ('prysm-ssz', prysmssz)]:
+    for op in ['Unmarshal', 'Marshal', 'HashTreeRoot']:
+        for procs in [1, 2, 4, 8]:
+            results_md += make_parallel_row(lib_name, results, f'BenchmarkBlockMainnet_{op}Parallel', op, procs)
+
+# State size scaling, only present when the sweep ran (BENCH_SWEEP=1)
+ssz_libs = [('fastssz (v1)', fastssz_v1), ('fastssz (v2)', fastssz_v2),
+            ('dynamic-ssz (codegen)', dynamicssz_codegen), ('dynamic-ssz (reflection)', dynamicssz_refl),
+            ('karalabe-ssz', karalabessz), ('prysm-ssz', prysmssz)]
+sweep_counts = sorted({int(name.split('=')[1]) for _, results in ssz_libs for name in results
+                       if name.startswith('BenchmarkStateSweep_')})
+if sweep_counts:
+    results_md += """
+### State Size Scaling
+
+Time per operation on mainnet states with growing validator counts.
+
+| Library | Operation | Validators | Time | Time / validator |
+|---------|-----------|------------|------|------------------|
+"""
+    for lib_name, results in ssz_libs:
+        for op in ['Unmarshal', 'Marshal', 'HashTreeRoot']:
+            for validators in sweep_counts:
+                results_md += make_sweep_row(lib_name, results, op, validators)
+
+# Era files, only present when the era benchmarks ran (BENCH_ERA=1)
+if any(f'BenchmarkEra{preset}_Decode' in results for _, results in ssz_libs for preset in ['Mainnet', 'Minimal']):
+    results_md += """
+### Era File Decoding
+
+Reading a whole era file: every block and the boundary state decompressed and decoded.
+
+| Library | Preset | Time | blocks/s | Peak heap |
+|---------|--------|------|----------|-----------|
+"""
+    for lib_name, results in ssz_libs:
+        for preset in ['Mainnet', 'Minimal']:
+            results_md += make_era_row(lib_name, results, preset)
+
+# Malformed block rejection, in the order of res/malformed/manifest.json
+malformed_variants = list(dict.fromkeys(name.split('/', 1)[1] for _, results in ssz_libs for name in results
+                                        if name.startswith('BenchmarkBlockMalformed_Unmarshal/')))
+if malformed_variants:
+    results_md += """
+### Malformed Block Rejection
+
+Time to reject each invalid variant of the mainnet block.
+
+| Library | Variant | Time | Memory |
+|---------|---------|------|--------|
+"""
+    for lib_name, results in ssz_libs:
+        for variant in malformed_variants:
+            results_md += make_malformed_row(lib_name, results, variant)
 
 results_md += """
 ### Block Minimal Benchmarks
@@ -151,6 +299,8 @@ for op in ['Unmarshal', 'UnmarshalReader', 'Marshal', 'MarshalWriter', 'HashTree
     results_md += make_table_row('dynamic-ssz (codegen)', dynamicssz_codegen, f'BenchmarkBlockMinimal_{op}', op)
 for op in ['Unmarshal', 'UnmarshalReader', 'Marshal', 'MarshalWriter', 'HashTreeRoot']:
     results_md += make_table_row('dynamic-ssz (reflection)', dynamicssz_refl, f'BenchmarkBlockMinimal_{op}', op)
+for op in ['Unmarshal', 'Marshal']:
+    results_md += make_table_row('encoding/json (baseline)', encodingjson, f'BenchmarkBlockMinimal_{op}', op)
 
 results_md += """
 ### State Minimal Benchmarks
@@ -166,9 +316,12 @@ for op in ['Unmarshal', 'UnmarshalReader', 'Marshal', 'MarshalWriter', 'HashTree
     results_md += make_table_row('dynamic-ssz (codegen)', dynamicssz_codegen, f'BenchmarkStateMinimal_{op}', op)
 for op in ['Unmarshal', 'UnmarshalReader', 'Marshal', 'MarshalWriter', 'HashTreeRoot']:
     results_md += make_table_row('dynamic-ssz (reflection)', dynamicssz_refl, f'BenchmarkStateMinimal_{op}', op)
+for op in ['Unmarshal', 'Marshal']:
+    results_md += make_table_row('encoding/json (baseline)', encodingjson, f'BenchmarkStateMinimal_{op}', op)
 
 results_md += """
 **Note:** karalabe-ssz and prysm-ssz do not support minimal preset out of the box.
+encoding/json rows decode and encode the beacon-API JSON of the same objects.
 """
 
 # Read current README
@@ -193,6 +346,6 @@ print("README.md updated successfully")
 EOF
 
 # Clean up result files
-rm -f fastssz-v1_results.txt fastssz-v2_results.txt dynamicssz-codegen_results.txt dynamicssz-reflection_results.txt karalabessz_results.txt prysmssz_results.txt
+rm -f fastssz-v1_results.txt fastssz-v2_results.txt dynamicssz-codegen_results.txt dynamicssz-reflection_results.txt karalabessz_results.txt prysmssz_results.txt encodingjson_results.txt
 
 echo "Done!"
\ No newline at end of file